@@ -618,7 +618,7 @@ func formatInsertValue(v string, colType string) string {
 	switch colType {
 	case sql.VarcharType:
 		return fmt.Sprintf("'%s'", v)
-	case sql.TimestampType, sql.JSONType, sql.UUIDType:
+	case sql.TimestampType, sql.DateType, sql.IntervalType, sql.JSONType, sql.UUIDType:
 		return fmt.Sprintf("CAST ('%s' AS %s)", v, colType)
 	case sql.BLOBType:
 		return fmt.Sprintf("x'%s'", v)
@@ -71,6 +71,10 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 		WithPrefix(opts.prefix).
 		WithLazyIndexConstraintValidation(true)
 
+	if opts.multidbHandler != nil {
+		sqlOpts.WithMultiDBHandler(&loggedUserHandler{opts.multidbHandler})
+	}
+
 	engine, err := sql.NewEngine(store, sqlOpts)
 	if err != nil {
 		return nil, err
@@ -82,6 +86,12 @@ func NewEngine(store *store.ImmuStore, opts *Options) (*Engine, error) {
 	}, nil
 }
 
+// Close stops the background work of the underlying SQL engine. The store
+// is not closed.
+func (e *Engine) Close() error {
+	return e.sqlEngine.Close()
+}
+
 func validateCollectionName(collectionName string) error {
 	_, isReservedWord := reservedWords[strings.ToLower(collectionName)]
 	if isReservedWord {
@@ -17,6 +17,7 @@ package document
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"sync"
@@ -2102,6 +2103,150 @@ func TestGetDocuments_WithOrderBy(t *testing.T) {
 	})
 }
 
+type loggedUserCtxKey struct{}
+
+var errNotLoggedIn = errors.New("not logged in")
+
+type mockUser struct {
+	username   string
+	permission sql.Permission
+}
+
+func (u *mockUser) Username() string                  { return u.username }
+func (u *mockUser) Permission() sql.Permission        { return u.permission }
+func (u *mockUser) SQLPrivileges() []sql.SQLPrivilege { return nil }
+
+// multidbHandlerMock reads the logged user from the context, as the server
+// does from the session of the call.
+type multidbHandlerMock struct {
+	sql.MultiDBHandler
+	users []*mockUser
+}
+
+func (h *multidbHandlerMock) GetLoggedUser(ctx context.Context) (sql.User, error) {
+	user, ok := ctx.Value(loggedUserCtxKey{}).(*mockUser)
+	if !ok {
+		return nil, errNotLoggedIn
+	}
+	return user, nil
+}
+
+func (h *multidbHandlerMock) ListUsers(ctx context.Context) ([]sql.User, error) {
+	users := make([]sql.User, len(h.users))
+	for i, u := range h.users {
+		users[i] = u
+	}
+	return users, nil
+}
+
+func TestCollectionPrivileges(t *testing.T) {
+	bob := &mockUser{username: "bob", permission: sql.PermissionReadWrite}
+	carol := &mockUser{username: "carol", permission: sql.PermissionReadWrite}
+
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer st.Close()
+
+	engine, err := NewEngine(st, DefaultOptions().
+		WithPrefix(docPrefix).
+		WithMultiDBHandler(&multidbHandlerMock{users: []*mockUser{bob, carol}}))
+	require.NoError(t, err)
+	defer engine.Close()
+
+	// internal calls are made without a logged user and are not checked
+	ctx := WithInternalCall(context.Background())
+
+	for _, collectionName := range []string{"employees", "depts"} {
+		err = engine.CreateCollection(ctx, "admin", collectionName, "", []*protomodel.Field{
+			{Name: "name", Type: protomodel.FieldType_STRING},
+		}, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.InsertDocument(ctx, "admin", collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("first"),
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	_, _, err = engine.sqlEngine.Exec(ctx, nil, "GRANT SELECT ON TABLE employees TO USER bob", nil)
+	require.NoError(t, err)
+
+	countDocuments := func(user *mockUser, collectionName string) (int64, error) {
+		userCtx := context.WithValue(context.Background(), loggedUserCtxKey{}, user)
+		return engine.CountDocuments(userCtx, &protomodel.Query{CollectionName: collectionName}, 0)
+	}
+
+	insertDocument := func(user *mockUser, collectionName string) error {
+		userCtx := context.WithValue(context.Background(), loggedUserCtxKey{}, user)
+
+		_, _, err := engine.InsertDocument(userCtx, user.username, collectionName, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue(user.username),
+			},
+		})
+		return err
+	}
+
+	t.Run("allowed user", func(t *testing.T) {
+		userCtx := context.WithValue(context.Background(), loggedUserCtxKey{}, bob)
+
+		reader, err := engine.GetDocuments(userCtx, &protomodel.Query{CollectionName: "employees"}, 0)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		doc, err := reader.Read(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, "first", doc.Document.Fields["name"].GetStringValue())
+
+		n, err := countDocuments(bob, "employees")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, n)
+	})
+
+	t.Run("denied user", func(t *testing.T) {
+		_, err := countDocuments(carol, "employees")
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		// the grant on employees does not allow reading other collections
+		_, err = countDocuments(bob, "depts")
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		_, err = engine.GetDocuments(context.WithValue(context.Background(), loggedUserCtxKey{}, bob), &protomodel.Query{CollectionName: "depts"}, 0)
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		err = insertDocument(bob, "employees")
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+	})
+
+	t.Run("no logged user", func(t *testing.T) {
+		_, err := engine.CountDocuments(context.Background(), &protomodel.Query{CollectionName: "employees"}, 0)
+		require.ErrorIs(t, err, errNotLoggedIn)
+
+		_, _, err = engine.InsertDocument(context.Background(), "admin", "employees", &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"name": structpb.NewStringValue("anonymous"),
+			},
+		})
+		require.ErrorIs(t, err, errNotLoggedIn)
+	})
+
+	t.Run("granted insert", func(t *testing.T) {
+		_, _, err := engine.sqlEngine.Exec(ctx, nil, "GRANT INSERT ON TABLE employees TO USER bob", nil)
+		require.NoError(t, err)
+
+		require.NoError(t, insertDocument(bob, "employees"))
+
+		err = insertDocument(bob, "depts")
+		require.ErrorIs(t, err, sql.ErrAccessDenied)
+
+		n, err := countDocuments(bob, "employees")
+		require.NoError(t, err)
+		require.EqualValues(t, 2, n)
+	})
+}
+
 func BenchmarkInsertion(b *testing.B) {
 	stOpts := store.DefaultOptions().
 		WithMultiIndexing(true).
@@ -16,8 +16,10 @@ limitations under the License.
 package document
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -26,6 +28,7 @@ const DefaultDocumentMaxNestedFields = 3
 type Options struct {
 	prefix          []byte
 	maxNestedFields int
+	multidbHandler  sql.MultiDBHandler
 }
 
 func DefaultOptions() *Options {
@@ -51,3 +54,30 @@ func (opts *Options) WithMaxNestedFields(maxNestedFields int) *Options {
 	opts.maxNestedFields = maxNestedFields
 	return opts
 }
+
+// WithMultiDBHandler sets the handler used by the underlying SQL engine to
+// check the permission and privileges of the logged user. Only the calls
+// made with a context returned by WithInternalCall are not checked.
+func (opts *Options) WithMultiDBHandler(multidbHandler sql.MultiDBHandler) *Options {
+	opts.multidbHandler = multidbHandler
+	return opts
+}
+
+// WithInternalCall returns a context for the in-process calls to the engine
+// made on behalf of no user, which are run without checking permissions
+// and privileges.
+func WithInternalCall(ctx context.Context) context.Context {
+	return sql.WithInternalCall(ctx)
+}
+
+// loggedUserHandler runs the internal calls to the engine unchecked.
+type loggedUserHandler struct {
+	sql.MultiDBHandler
+}
+
+func (h *loggedUserHandler) GetLoggedUser(ctx context.Context) (sql.User, error) {
+	if sql.IsInternalCall(ctx) {
+		return nil, nil
+	}
+	return h.MultiDBHandler.GetLoggedUser(ctx)
+}
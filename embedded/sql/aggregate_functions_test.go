@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateFunctionStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		SELECT
+			stddev_pop(val),
+			percentile_cont(0.25) WITHIN GROUP (ORDER BY val DESC),
+			mode() WITHIN GROUP (ORDER BY val),
+			json_object_agg(id, val),
+			count(*) FILTER (WHERE val > 1),
+			array_agg(DISTINCT val) FILTER (WHERE ok)
+		FROM t
+	`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	sel := stmts[0].(*SelectStmt)
+
+	require.Equal(t, "STDDEV_POP(val)", sel.targets[0].Exp.String())
+
+	pct := sel.targets[1].Exp.(*AggColSelector)
+	require.Equal(t, PERCENTILE_CONT, pct.aggFn)
+	require.Equal(t, &Float64{val: 0.25}, pct.fraction)
+	require.True(t, pct.desc)
+	require.Equal(t, "PERCENTILE_CONT(0.25) WITHIN GROUP (ORDER BY val DESC)", pct.String())
+
+	require.Equal(t, "MODE() WITHIN GROUP (ORDER BY val)", sel.targets[2].Exp.String())
+
+	obj := sel.targets[3].Exp.(*AggColSelector)
+	require.Equal(t, "id", obj.keySel.col)
+	require.Equal(t, "val", obj.col)
+
+	require.NotNil(t, sel.targets[4].Exp.(*AggColSelector).filter)
+
+	arr := sel.targets[5].Exp.(*AggColSelector)
+	require.True(t, arr.distinct)
+	require.NotNil(t, arr.filter)
+
+	aggFn, _, _ := arr.resolve("t")
+	require.NotEqual(t, ARRAY_AGG, aggFn)
+
+	// MODE is only taken as an aggregation when called
+	_, err = ParseSQLString("SELECT mode FROM t WHERE mode > 1")
+	require.NoError(t, err)
+
+	_, err = ParseSQLString("SELECT mode(val) FROM t")
+	require.ErrorContains(t, err, "MODE requires WITHIN GROUP")
+
+	_, err = ParseSQLString("SELECT sum() WITHIN GROUP (ORDER BY val) FROM t")
+	require.ErrorContains(t, err, "SUM does not support WITHIN GROUP")
+
+	_, err = ParseSQLString("SELECT percentile_disc() WITHIN GROUP (ORDER BY val) FROM t")
+	require.ErrorContains(t, err, "wrong number of arguments for PERCENTILE_DISC")
+
+	_, err = ParseSQLString("SELECT json_object_agg(val) FROM t")
+	require.ErrorContains(t, err, "wrong number of arguments for JSON_OBJECT_AGG")
+
+	_, err = ParseSQLString("SELECT sum(*) FROM t")
+	require.ErrorContains(t, err, "SUM(*) is not supported")
+}
+
+func TestAggregateFunctions(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE t (id INTEGER, grp VARCHAR, val INTEGER, ok BOOLEAN, PRIMARY KEY id);
+		CREATE TABLE empty_t (id INTEGER, val INTEGER, ok BOOLEAN, PRIMARY KEY id);
+		INSERT INTO t (id, grp, val, ok) VALUES
+			(1, 'a', 1, true), (2, 'a', 2, true), (3, 'a', 2, false), (4, 'a', 5, true),
+			(5, 'b', 10, true), (6, 'b', NULL, NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	query := func(t *testing.T, sql string, params map[string]interface{}) [][]interface{} {
+		return queryValues(t, engine, nil, sql, params)
+	}
+
+	t.Run("statistical", func(t *testing.T) {
+		rows := query(t, "SELECT grp, var_pop(val), var_samp(val), variance(val), stddev_pop(val), stddev_samp(val), stddev(val) FROM t GROUP BY grp ORDER BY grp", nil)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, "a", rows[0][0])
+		for i, expected := range []float64{2.25, 3, 3, 1.5, math.Sqrt(3), math.Sqrt(3)} {
+			require.InDelta(t, expected, rows[0][i+1], 1e-9)
+		}
+
+		require.Equal(t, []interface{}{"b", 0.0, nil, nil, 0.0, nil, nil}, rows[1])
+	})
+
+	t.Run("ordered-set", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"a", 2.0, 2.75, int64(2), int64(5), int64(5), int64(2)},
+				{"b", 10.0, 10.0, int64(10), int64(10), int64(10), int64(10)},
+			},
+			query(t, `
+				SELECT grp,
+					percentile_cont(0.5) WITHIN GROUP (ORDER BY val),
+					percentile_cont(0.75) WITHIN GROUP (ORDER BY val),
+					percentile_disc(0.5) WITHIN GROUP (ORDER BY val),
+					percentile_disc(1) WITHIN GROUP (ORDER BY val),
+					percentile_disc(0.25) WITHIN GROUP (ORDER BY val DESC),
+					mode() WITHIN GROUP (ORDER BY val)
+				FROM t
+				GROUP BY grp
+				ORDER BY grp`, nil),
+		)
+
+		require.Equal(t,
+			[][]interface{}{{5.0}},
+			query(t, "SELECT percentile_cont(@f) WITHIN GROUP (ORDER BY val) FROM t", map[string]interface{}{"f": 0.75}),
+		)
+
+		_, err := engine.queryAll(ctx, nil, "SELECT percentile_cont(2) WITHIN GROUP (ORDER BY val) FROM t", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"a", false, true},
+				{"b", true, true},
+			},
+			query(t, "SELECT grp, bool_and(ok), bool_or(ok) FROM t GROUP BY grp ORDER BY grp", nil),
+		)
+
+		_, err := engine.queryAll(ctx, nil, "SELECT bool_and(val) FROM t", nil)
+		require.ErrorIs(t, err, ErrInvalidTypes)
+	})
+
+	t.Run("json and arrays", func(t *testing.T) {
+		rows, err := engine.queryAll(ctx, nil, `
+			SELECT json_agg(val), json_object_agg(id, val), array_agg(val)
+			FROM t
+			WHERE grp = 'b'`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+
+		require.Equal(t, JSONType, rows[0].ValuesByPosition[0].Type())
+		require.Equal(t, "[10,null]", rows[0].ValuesByPosition[0].String())
+		require.Equal(t, `{"5":10,"6":null}`, rows[0].ValuesByPosition[1].String())
+		require.Equal(t, ArrayTypeOf(IntegerType), rows[0].ValuesByPosition[2].Type())
+	})
+
+	t.Run("distinct and filter", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"a", int64(10), int64(8), int64(3), int64(3), int64(8), int64(2)},
+				{"b", int64(10), int64(10), int64(1), int64(1), int64(10), int64(1)},
+			},
+			query(t, `
+				SELECT grp,
+					sum(val),
+					sum(DISTINCT val),
+					count(DISTINCT val),
+					count(*) FILTER (WHERE val > 1),
+					sum(val) FILTER (WHERE ok),
+					count(DISTINCT val) FILTER (WHERE val > 1)
+				FROM t
+				GROUP BY grp
+				ORDER BY grp`, nil),
+		)
+
+		rows, err := engine.queryAll(ctx, nil, `
+			SELECT array_agg(DISTINCT val) FILTER (WHERE ok), json_agg(DISTINCT val), stddev_pop(DISTINCT val)
+			FROM t
+			WHERE grp = 'a'`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, "{1,2,5}", rows[0].ValuesByPosition[0].String())
+		require.Equal(t, "[1,2,5]", rows[0].ValuesByPosition[1].String())
+		require.InDelta(t, math.Sqrt(26.0/9), rows[0].ValuesByPosition[2].RawValue(), 1e-9)
+
+		require.Equal(t,
+			[][]interface{}{{"a", int64(3)}},
+			query(t, `
+				SELECT grp, count(*) FILTER (WHERE val >= @min)
+				FROM t
+				GROUP BY grp
+				HAVING count(*) FILTER (WHERE val >= @min) > 1`, map[string]interface{}{"min": 2}),
+		)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{{nil, nil, nil, int64(0), nil}},
+			query(t, `
+				SELECT stddev(val), percentile_disc(0.5) WITHIN GROUP (ORDER BY val), bool_or(ok), count(*) FILTER (WHERE val > 0), json_agg(val)
+				FROM empty_t`, nil),
+		)
+	})
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package sql
 
 import (
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -674,3 +677,754 @@ func (v *StringAggValue) isConstant() bool {
 func (v *StringAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
 	return nil
 }
+
+// ArrayAggValue implements ARRAY_AGG(col). NULL values are kept as
+// array elements.
+type ArrayAggValue struct {
+	elemType SQLValueType
+	vals     []TypedValue
+	sel      string
+}
+
+func (v *ArrayAggValue) Selector() string {
+	return v.sel
+}
+
+func (v *ArrayAggValue) ColBounded() bool {
+	return true
+}
+
+func (v *ArrayAggValue) array() *Array {
+	elemType := v.elemType
+	if elemType == "" {
+		elemType = AnyType
+	}
+	return NewArray(elemType, v.vals)
+}
+
+func (v *ArrayAggValue) ElemType() SQLValueType {
+	return v.array().ElemType()
+}
+
+func (v *ArrayAggValue) Values() []TypedValue {
+	return v.vals
+}
+
+func (v *ArrayAggValue) Type() SQLValueType {
+	return v.array().Type()
+}
+
+func (v *ArrayAggValue) IsNull() bool {
+	return false
+}
+
+func (v *ArrayAggValue) String() string {
+	return v.array().String()
+}
+
+func (v *ArrayAggValue) RawValue() interface{} {
+	return v.array().RawValue()
+}
+
+func (v *ArrayAggValue) Compare(val TypedValue) (int, error) {
+	return v.array().Compare(val)
+}
+
+func (v *ArrayAggValue) updateWith(val TypedValue) error {
+	if val == nil {
+		return nil
+	}
+
+	if v.elemType == "" && val.Type() != AnyType {
+		v.elemType = val.Type()
+	}
+
+	if !val.IsNull() && val.Type() != v.elemType {
+		return ErrNotComparableValues
+	}
+
+	v.vals = append(v.vals, val)
+	return nil
+}
+
+func (v *ArrayAggValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return v.Type(), nil
+}
+
+func (v *ArrayAggValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != v.Type() {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *ArrayAggValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *ArrayAggValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *ArrayAggValue) jointColumnTo(col *Column, tableAlias string) (*ColSelector, error) {
+	return nil, nil
+}
+
+func (v *ArrayAggValue) selectors() []Selector {
+	return nil
+}
+
+func (v *ArrayAggValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *ArrayAggValue) isConstant() bool {
+	return false
+}
+
+func (v *ArrayAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// rowAggregatedValue is implemented by aggregated values which need the
+// whole row to be updated, rather than just the value of their column.
+type rowAggregatedValue interface {
+	AggregatedValue
+	updateWithRow(row *Row) error
+}
+
+// qualifiedAggValue applies the DISTINCT and FILTER (WHERE ...) clauses of
+// an aggregation, only handing over the qualifying values to the wrapped
+// aggregated value.
+type qualifiedAggValue struct {
+	AggregatedValue
+
+	distinct bool
+	seen     map[string]struct{}
+	keySel   string
+
+	filter     ValueExp
+	tx         *SQLTx
+	tableAlias string
+}
+
+func (v *qualifiedAggValue) updateWith(val TypedValue) error {
+	return ErrUnexpected
+}
+
+func (v *qualifiedAggValue) updateWithRow(row *Row) error {
+	if v.filter != nil {
+		r, err := v.filter.reduce(v.tx, row, v.tableAlias)
+		if err != nil {
+			return fmt.Errorf("%w: when evaluating FILTER clause", err)
+		}
+
+		if r.IsNull() {
+			return nil
+		}
+
+		satisfies, isBool := r.(*Bool)
+		if !isBool {
+			return fmt.Errorf("%w: expected '%s' in FILTER clause, but '%s' was provided", ErrInvalidCondition, BooleanType, r.Type())
+		}
+
+		if !satisfies.val {
+			return nil
+		}
+	}
+
+	var val TypedValue
+	if v.ColBounded() {
+		var exists bool
+
+		val, exists = row.ValuesBySelector[v.Selector()]
+		if !exists {
+			return ErrColumnDoesNotExist
+		}
+	}
+
+	if v.distinct {
+		vals := []TypedValue{val}
+		if v.keySel != "" {
+			vals = append(vals, row.ValuesBySelector[v.keySel])
+		}
+
+		key, err := distinctKey(vals)
+		if err != nil {
+			return err
+		}
+
+		if _, seen := v.seen[key]; seen {
+			return nil
+		}
+		v.seen[key] = struct{}{}
+	}
+
+	if rv, ok := v.AggregatedValue.(rowAggregatedValue); ok {
+		return rv.updateWithRow(row)
+	}
+	return v.AggregatedValue.updateWith(val)
+}
+
+func distinctKey(vals []TypedValue) (string, error) {
+	var key []byte
+	for _, val := range vals {
+		if val == nil || val.IsNull() {
+			key = append(key, 0)
+			continue
+		}
+
+		b, err := EncodeValue(val, val.Type(), 0)
+		if err != nil {
+			return "", err
+		}
+		key = append(key, 1)
+		key = append(key, b...)
+	}
+	return string(key), nil
+}
+
+func numericFloat64(val TypedValue) (float64, error) {
+	switch raw := val.RawValue().(type) {
+	case int64:
+		return float64(raw), nil
+	case float64:
+		return raw, nil
+	case *Decimal:
+		return raw.Float64(), nil
+	}
+	return 0, ErrNumericTypeExpected
+}
+
+// VarianceValue implements VAR_POP, VAR_SAMP, STDDEV_POP and STDDEV_SAMP,
+// VARIANCE and STDDEV being aliases of the sample variants. Values are
+// accumulated with Welford's algorithm.
+type VarianceValue struct {
+	aggFn AggregateFn
+	n     int64
+	mean  float64
+	m2    float64
+	sel   string
+}
+
+func (v *VarianceValue) Selector() string {
+	return v.sel
+}
+
+func (v *VarianceValue) ColBounded() bool {
+	return true
+}
+
+func (v *VarianceValue) calculate() TypedValue {
+	population := v.aggFn == VAR_POP || v.aggFn == STDDEV_POP
+
+	var variance float64
+	switch {
+	case population && v.n > 0:
+		variance = v.m2 / float64(v.n)
+	case !population && v.n > 1:
+		variance = v.m2 / float64(v.n-1)
+	default:
+		return NewNull(Float64Type)
+	}
+
+	if v.aggFn == STDDEV || v.aggFn == STDDEV_POP || v.aggFn == STDDEV_SAMP {
+		return NewFloat64(math.Sqrt(variance))
+	}
+	return NewFloat64(variance)
+}
+
+func (v *VarianceValue) Type() SQLValueType {
+	return Float64Type
+}
+
+func (v *VarianceValue) IsNull() bool {
+	return v.calculate().IsNull()
+}
+
+func (v *VarianceValue) String() string {
+	return v.calculate().String()
+}
+
+func (v *VarianceValue) RawValue() interface{} {
+	return v.calculate().RawValue()
+}
+
+func (v *VarianceValue) Compare(val TypedValue) (int, error) {
+	return v.calculate().Compare(val)
+}
+
+func (v *VarianceValue) updateWith(val TypedValue) error {
+	if val.IsNull() {
+		return nil
+	}
+
+	x, err := numericFloat64(val)
+	if err != nil {
+		return err
+	}
+
+	v.n++
+	delta := x - v.mean
+	v.mean += delta / float64(v.n)
+	v.m2 += delta * (x - v.mean)
+	return nil
+}
+
+func (v *VarianceValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return Float64Type, nil
+}
+
+func (v *VarianceValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != Float64Type {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *VarianceValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *VarianceValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *VarianceValue) selectors() []Selector {
+	return nil
+}
+
+func (v *VarianceValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *VarianceValue) isConstant() bool {
+	return false
+}
+
+func (v *VarianceValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// OrderedSetAggValue implements the ordered-set aggregations
+// PERCENTILE_CONT, PERCENTILE_DISC and MODE, which are computed over the
+// sorted non-NULL values of the group.
+type OrderedSetAggValue struct {
+	aggFn    AggregateFn
+	fraction float64
+	desc     bool
+	elemType SQLValueType
+	vals     []TypedValue
+	sorted   bool
+	sel      string
+}
+
+func (v *OrderedSetAggValue) Selector() string {
+	return v.sel
+}
+
+func (v *OrderedSetAggValue) ColBounded() bool {
+	return true
+}
+
+func (v *OrderedSetAggValue) sort() {
+	if v.sorted {
+		return
+	}
+
+	sort.SliceStable(v.vals, func(i, j int) bool {
+		cmp, err := v.vals[i].Compare(v.vals[j])
+		if err != nil {
+			return false
+		}
+		if v.desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	v.sorted = true
+}
+
+func (v *OrderedSetAggValue) calculate() TypedValue {
+	if len(v.vals) == 0 {
+		return NewNull(v.Type())
+	}
+
+	v.sort()
+
+	switch v.aggFn {
+	case PERCENTILE_CONT:
+		pos := v.fraction * float64(len(v.vals)-1)
+		lo := int(math.Floor(pos))
+		hi := int(math.Ceil(pos))
+
+		lv, err := numericFloat64(v.vals[lo])
+		if err != nil {
+			return NewNull(Float64Type)
+		}
+		hv, err := numericFloat64(v.vals[hi])
+		if err != nil {
+			return NewNull(Float64Type)
+		}
+		return NewFloat64(lv + (hv-lv)*(pos-float64(lo)))
+	case PERCENTILE_DISC:
+		i := int(math.Ceil(v.fraction*float64(len(v.vals)))) - 1
+		return v.vals[max(i, 0)]
+	}
+
+	// MODE: the most frequent value, the first one in sort order on ties.
+	mode, modeCount := 0, 0
+	for i := 0; i < len(v.vals); {
+		j := i + 1
+		for j < len(v.vals) {
+			cmp, err := v.vals[i].Compare(v.vals[j])
+			if err != nil || cmp != 0 {
+				break
+			}
+			j++
+		}
+
+		if j-i > modeCount {
+			mode, modeCount = i, j-i
+		}
+		i = j
+	}
+	return v.vals[mode]
+}
+
+func (v *OrderedSetAggValue) Type() SQLValueType {
+	if v.aggFn == PERCENTILE_CONT {
+		return Float64Type
+	}
+	if v.elemType == "" {
+		return AnyType
+	}
+	return v.elemType
+}
+
+func (v *OrderedSetAggValue) IsNull() bool {
+	return v.calculate().IsNull()
+}
+
+func (v *OrderedSetAggValue) String() string {
+	return v.calculate().String()
+}
+
+func (v *OrderedSetAggValue) RawValue() interface{} {
+	return v.calculate().RawValue()
+}
+
+func (v *OrderedSetAggValue) Compare(val TypedValue) (int, error) {
+	return v.calculate().Compare(val)
+}
+
+func (v *OrderedSetAggValue) updateWith(val TypedValue) error {
+	if val.IsNull() {
+		return nil
+	}
+
+	if v.aggFn == PERCENTILE_CONT && !IsNumericType(val.Type()) {
+		return ErrNumericTypeExpected
+	}
+
+	if v.elemType == "" {
+		v.elemType = val.Type()
+	}
+
+	v.vals = append(v.vals, val)
+	v.sorted = false
+	return nil
+}
+
+func (v *OrderedSetAggValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return v.Type(), nil
+}
+
+func (v *OrderedSetAggValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != v.Type() {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *OrderedSetAggValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *OrderedSetAggValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *OrderedSetAggValue) selectors() []Selector {
+	return nil
+}
+
+func (v *OrderedSetAggValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *OrderedSetAggValue) isConstant() bool {
+	return false
+}
+
+func (v *OrderedSetAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// BoolAggValue implements BOOL_AND and BOOL_OR.
+type BoolAggValue struct {
+	or  bool
+	val TypedValue
+	sel string
+}
+
+func (v *BoolAggValue) Selector() string {
+	return v.sel
+}
+
+func (v *BoolAggValue) ColBounded() bool {
+	return true
+}
+
+func (v *BoolAggValue) Type() SQLValueType {
+	return BooleanType
+}
+
+func (v *BoolAggValue) IsNull() bool {
+	return v.val.IsNull()
+}
+
+func (v *BoolAggValue) String() string {
+	return v.val.String()
+}
+
+func (v *BoolAggValue) RawValue() interface{} {
+	return v.val.RawValue()
+}
+
+func (v *BoolAggValue) Compare(val TypedValue) (int, error) {
+	return v.val.Compare(val)
+}
+
+func (v *BoolAggValue) updateWith(val TypedValue) error {
+	if val.IsNull() {
+		return nil
+	}
+
+	b, ok := val.RawValue().(bool)
+	if !ok {
+		return fmt.Errorf("%w: expected '%s' but '%s' was provided", ErrInvalidTypes, BooleanType, val.Type())
+	}
+
+	if v.val.IsNull() {
+		v.val = NewBool(b)
+		return nil
+	}
+
+	if v.or {
+		v.val = NewBool(v.val.RawValue().(bool) || b)
+	} else {
+		v.val = NewBool(v.val.RawValue().(bool) && b)
+	}
+	return nil
+}
+
+func (v *BoolAggValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return BooleanType, nil
+}
+
+func (v *BoolAggValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BooleanType {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *BoolAggValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *BoolAggValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *BoolAggValue) selectors() []Selector {
+	return nil
+}
+
+func (v *BoolAggValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *BoolAggValue) isConstant() bool {
+	return false
+}
+
+func (v *BoolAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// JSONAggValue implements JSON_AGG(col) and JSON_OBJECT_AGG(key, col). As
+// with ARRAY_AGG, NULL values are kept.
+type JSONAggValue struct {
+	// keySel is only set for JSON_OBJECT_AGG.
+	keySel string
+	elems  []interface{}
+	fields map[string]interface{}
+	sel    string
+}
+
+func (v *JSONAggValue) Selector() string {
+	return v.sel
+}
+
+func (v *JSONAggValue) ColBounded() bool {
+	return true
+}
+
+func (v *JSONAggValue) calculate() TypedValue {
+	if v.keySel != "" {
+		if v.fields == nil {
+			return NewNull(JSONType)
+		}
+		return NewJson(v.fields)
+	}
+
+	if v.elems == nil {
+		return NewNull(JSONType)
+	}
+	return NewJson(v.elems)
+}
+
+func (v *JSONAggValue) Type() SQLValueType {
+	return JSONType
+}
+
+func (v *JSONAggValue) IsNull() bool {
+	return v.calculate().IsNull()
+}
+
+func (v *JSONAggValue) String() string {
+	return v.calculate().String()
+}
+
+func (v *JSONAggValue) RawValue() interface{} {
+	return v.calculate().RawValue()
+}
+
+func (v *JSONAggValue) Compare(val TypedValue) (int, error) {
+	return v.calculate().Compare(val)
+}
+
+func (v *JSONAggValue) updateWith(val TypedValue) error {
+	if v.keySel != "" {
+		return ErrUnexpected
+	}
+
+	elem, err := jsonValueOf(val)
+	if err != nil {
+		return err
+	}
+
+	v.elems = append(v.elems, elem)
+	return nil
+}
+
+func (v *JSONAggValue) updateWithRow(row *Row) error {
+	if v.keySel == "" {
+		val, exists := row.ValuesBySelector[v.sel]
+		if !exists {
+			return ErrColumnDoesNotExist
+		}
+		return v.updateWith(val)
+	}
+
+	key, exists := row.ValuesBySelector[v.keySel]
+	if !exists {
+		return ErrColumnDoesNotExist
+	}
+
+	if key.IsNull() {
+		return fmt.Errorf("%w: %s key can not be NULL", ErrIllegalArguments, JSON_OBJECT_AGG)
+	}
+
+	val, exists := row.ValuesBySelector[v.sel]
+	if !exists {
+		return ErrColumnDoesNotExist
+	}
+
+	field, err := jsonValueOf(val)
+	if err != nil {
+		return err
+	}
+
+	if v.fields == nil {
+		v.fields = make(map[string]interface{})
+	}
+
+	if s, ok := key.RawValue().(string); ok {
+		v.fields[s] = field
+	} else {
+		v.fields[key.String()] = field
+	}
+	return nil
+}
+
+// jsonValueOf returns the value to be encoded in JSON documents for val.
+func jsonValueOf(val TypedValue) (interface{}, error) {
+	if val == nil || val.IsNull() {
+		return nil, nil
+	}
+
+	if d, ok := val.RawValue().(*Decimal); ok {
+		return d.Float64(), nil
+	}
+
+	if arr, ok := val.(interface{ Values() []TypedValue }); ok {
+		elems := make([]interface{}, len(arr.Values()))
+		for i, e := range arr.Values() {
+			elem, err := jsonValueOf(e)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = elem
+		}
+		return elems, nil
+	}
+	return val.RawValue(), nil
+}
+
+func (v *JSONAggValue) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return JSONType, nil
+}
+
+func (v *JSONAggValue) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != JSONType {
+		return ErrNotComparableValues
+	}
+	return nil
+}
+
+func (v *JSONAggValue) substitute(params map[string]interface{}) (ValueExp, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *JSONAggValue) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return nil, ErrUnexpected
+}
+
+func (v *JSONAggValue) selectors() []Selector {
+	return nil
+}
+
+func (v *JSONAggValue) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return nil
+}
+
+func (v *JSONAggValue) isConstant() bool {
+	return false
+}
+
+func (v *JSONAggValue) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
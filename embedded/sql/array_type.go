@@ -0,0 +1,1179 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const arrayTypeSuffix = "[]"
+
+// ArrayTypeOf returns the type of a one-dimensional array whose
+// elements are of type t, e.g. INTEGER[].
+func ArrayTypeOf(t SQLValueType) SQLValueType {
+	return t + arrayTypeSuffix
+}
+
+// IsArrayType returns true when t is an array type.
+func IsArrayType(t SQLValueType) bool {
+	return strings.HasSuffix(t, arrayTypeSuffix)
+}
+
+// ArrayElemType returns the type of the elements of the array type t.
+func ArrayElemType(t SQLValueType) SQLValueType {
+	return strings.TrimSuffix(t, arrayTypeSuffix)
+}
+
+func isArrayElemType(t SQLValueType) bool {
+	switch t {
+	case IntegerType,
+		Float64Type,
+		DecimalType,
+		BooleanType,
+		VarcharType,
+		UUIDType,
+		BLOBType,
+		TimestampType,
+		JSONType:
+		return true
+	}
+	return false
+}
+
+// isArrayCoercible returns true when values of types t1 and t2 can be
+// compared as arrays, either of them possibly being in the array text
+// representation.
+func isArrayCoercible(t1, t2 SQLValueType) bool {
+	if !IsArrayType(t1) && !IsArrayType(t2) {
+		return false
+	}
+	_, ok := coerceTypes(t1, t2)
+	return ok
+}
+
+// Array is a one-dimensional array value. Elements share the same type
+// and may be NULL.
+type Array struct {
+	elemType SQLValueType
+	vals     []TypedValue
+}
+
+func NewArray(elemType SQLValueType, vals []TypedValue) *Array {
+	return &Array{elemType: elemType, vals: vals}
+}
+
+func (v *Array) ElemType() SQLValueType {
+	return v.elemType
+}
+
+func (v *Array) Values() []TypedValue {
+	return v.vals
+}
+
+func (v *Array) Type() SQLValueType {
+	return ArrayTypeOf(v.elemType)
+}
+
+func (v *Array) IsNull() bool {
+	return false
+}
+
+// String returns the text representation of the array e.g. {1,2,NULL}
+func (v *Array) String() string {
+	return formatArrayText(v.vals)
+}
+
+func (v *Array) RawValue() interface{} {
+	raw := make([]interface{}, len(v.vals))
+	for i, e := range v.vals {
+		raw[i] = e.RawValue()
+	}
+	return raw
+}
+
+func (v *Array) Compare(val TypedValue) (int, error) {
+	if val.IsNull() {
+		return 1, nil
+	}
+
+	rval, err := asArray(val, v.elemType)
+	if err != nil {
+		return 0, ErrNotComparableValues
+	}
+
+	for i := 0; i < len(v.vals) && i < len(rval.vals); i++ {
+		res, err := compareArrayElems(v.vals[i], rval.vals[i])
+		if err != nil {
+			return 0, err
+		}
+		if res != 0 {
+			return res, nil
+		}
+	}
+
+	switch {
+	case len(v.vals) < len(rval.vals):
+		return -1, nil
+	case len(v.vals) > len(rval.vals):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// compareArrayElems compares two array elements, NULL elements sort
+// before any other value.
+func compareArrayElems(l, r TypedValue) (int, error) {
+	switch {
+	case l.IsNull() && r.IsNull():
+		return 0, nil
+	case l.IsNull():
+		return -1, nil
+	case r.IsNull():
+		return 1, nil
+	}
+	return l.Compare(r)
+}
+
+func (v *Array) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return v.Type(), nil
+}
+
+func (v *Array) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t == v.Type() {
+		return nil
+	}
+
+	if IsArrayType(t) {
+		if _, ok := coerceTypes(v.elemType, ArrayElemType(t)); ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, v.Type(), t)
+}
+
+func (v *Array) substitute(params map[string]interface{}) (ValueExp, error) {
+	return v, nil
+}
+
+func (v *Array) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return v, nil
+}
+
+func (v *Array) selectors() []Selector {
+	return nil
+}
+
+func (v *Array) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return v
+}
+
+func (v *Array) isConstant() bool {
+	return true
+}
+
+func (v *Array) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+// asArray returns val as an array. VARCHAR values are parsed from the
+// array text representation, using elemType as the type of the elements.
+func asArray(val TypedValue, elemType SQLValueType) (*Array, error) {
+	if arr, ok := val.(*Array); ok {
+		return arr, nil
+	}
+
+	if val.Type() != VarcharType {
+		return nil, fmt.Errorf("%w: %v is not an array", ErrInvalidTypes, val.Type())
+	}
+
+	if elemType == AnyType {
+		elemType = VarcharType
+	}
+
+	conv, err := getConverter(VarcharType, ArrayTypeOf(elemType))
+	if err != nil {
+		return nil, err
+	}
+
+	arr, err := conv(val)
+	if err != nil {
+		return nil, err
+	}
+	return arr.(*Array), nil
+}
+
+// newArrayOf builds an array of elemType out of vals, converting each
+// element to elemType.
+func newArrayOf(elemType SQLValueType, vals []TypedValue) (*Array, error) {
+	for i, val := range vals {
+		if val.IsNull() {
+			vals[i] = NewNull(elemType)
+			continue
+		}
+
+		conv, err := getConverter(val.Type(), elemType)
+		if err != nil {
+			return nil, err
+		}
+
+		cval, err := conv(val)
+		if err != nil {
+			return nil, err
+		}
+		vals[i] = cval
+	}
+	return NewArray(elemType, vals), nil
+}
+
+// arrayFromSlice builds an array out of a typed Go slice, as provided
+// in query parameters.
+func arrayFromSlice(val interface{}) (*Array, bool) {
+	var elemType SQLValueType
+	var vals []TypedValue
+
+	switch s := val.(type) {
+	case *Array:
+		return s, true
+	case []int64:
+		elemType = IntegerType
+		for _, e := range s {
+			vals = append(vals, NewInteger(e))
+		}
+	case []int:
+		elemType = IntegerType
+		for _, e := range s {
+			vals = append(vals, NewInteger(int64(e)))
+		}
+	case []float64:
+		elemType = Float64Type
+		for _, e := range s {
+			vals = append(vals, NewFloat64(e))
+		}
+	case []bool:
+		elemType = BooleanType
+		for _, e := range s {
+			vals = append(vals, NewBool(e))
+		}
+	case []string:
+		elemType = VarcharType
+		for _, e := range s {
+			vals = append(vals, NewVarchar(e))
+		}
+	case []time.Time:
+		elemType = TimestampType
+		for _, e := range s {
+			vals = append(vals, &Timestamp{val: e.Truncate(time.Microsecond).UTC()})
+		}
+	case []uuid.UUID:
+		elemType = UUIDType
+		for _, e := range s {
+			vals = append(vals, &UUID{val: e})
+		}
+	default:
+		return nil, false
+	}
+	return NewArray(elemType, vals), true
+}
+
+// ArrayExp is the ARRAY[...] constructor. The type of the elements is
+// the common type of the provided expressions.
+type ArrayExp struct {
+	elems []ValueExp
+}
+
+func (e *ArrayExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	t := AnyType
+
+	for _, elem := range e.elems {
+		et, err := elem.inferType(cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+
+		merged, ok := coerceTypes(t, et)
+		if !ok || IsArrayType(merged) {
+			return AnyType, fmt.Errorf("%w: ARRAY elements of types %v and %v can not be matched", ErrInvalidTypes, t, et)
+		}
+		t = merged
+	}
+
+	if t != AnyType {
+		err := e.requiresType(ArrayTypeOf(t), cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+
+	return ArrayTypeOf(t), nil
+}
+
+func (e *ArrayExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if !IsArrayType(t) {
+		return fmt.Errorf("%w: ARRAY can not be interpreted as type %v", ErrInvalidTypes, t)
+	}
+
+	for _, elem := range e.elems {
+		err := elem.requiresType(ArrayElemType(t), cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *ArrayExp) substitute(params map[string]interface{}) (ValueExp, error) {
+	elems := make([]ValueExp, len(e.elems))
+
+	for i, elem := range e.elems {
+		selem, err := elem.substitute(params)
+		if err != nil {
+			return nil, err
+		}
+		elems[i] = selem
+	}
+	return &ArrayExp{elems: elems}, nil
+}
+
+func (e *ArrayExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	t := AnyType
+	vals := make([]TypedValue, len(e.elems))
+
+	for i, elem := range e.elems {
+		val, err := elem.reduce(tx, row, implicitTable)
+		if err != nil {
+			return nil, err
+		}
+
+		merged, ok := coerceTypes(t, val.Type())
+		if !ok || IsArrayType(merged) {
+			return nil, fmt.Errorf("%w: ARRAY elements of types %v and %v can not be matched", ErrInvalidTypes, t, val.Type())
+		}
+
+		t = merged
+		vals[i] = val
+	}
+	return newArrayOf(t, vals)
+}
+
+func (e *ArrayExp) selectors() []Selector {
+	var sels []Selector
+	for _, elem := range e.elems {
+		sels = append(sels, elem.selectors()...)
+	}
+	return sels
+}
+
+func (e *ArrayExp) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	elems := make([]ValueExp, len(e.elems))
+	for i, elem := range e.elems {
+		elems[i] = elem.reduceSelectors(row, implicitTable)
+	}
+	return &ArrayExp{elems: elems}
+}
+
+func (e *ArrayExp) isConstant() bool {
+	for _, elem := range e.elems {
+		if !elem.isConstant() {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *ArrayExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (e *ArrayExp) String() string {
+	elems := make([]string, len(e.elems))
+	for i, elem := range e.elems {
+		elems[i] = elem.String()
+	}
+	return fmt.Sprintf("ARRAY[%s]", strings.Join(elems, ", "))
+}
+
+// AnyAllExp compares a value with each element of an array:
+// `val op ANY(array)` holds when the comparison holds for at least one
+// element, `val op ALL(array)` when it holds for every element.
+// Following SQL semantics, the result is NULL when no element decides
+// the outcome and a NULL was involved in any comparison.
+type AnyAllExp struct {
+	op    CmpOperator
+	all   bool
+	left  ValueExp
+	right ValueExp
+}
+
+func (bexp *AnyAllExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	tleft, err := bexp.left.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	tright, err := bexp.right.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	switch {
+	case tright == AnyType:
+		if tleft != AnyType {
+			err := bexp.right.requiresType(ArrayTypeOf(tleft), cols, params, implicitTable)
+			if err != nil {
+				return AnyType, err
+			}
+		}
+	case tright == VarcharType:
+		// array text representation, parsed at evaluation time
+	case IsArrayType(tright):
+		elemType := ArrayElemType(tright)
+
+		if tleft == AnyType {
+			if elemType != AnyType {
+				err := bexp.left.requiresType(elemType, cols, params, implicitTable)
+				if err != nil {
+					return AnyType, err
+				}
+			}
+			break
+		}
+
+		if _, ok := coerceTypes(tleft, elemType); !ok {
+			return AnyType, fmt.Errorf("%w: %v can not be compared with elements of type %v", ErrInvalidTypes, tleft, elemType)
+		}
+	default:
+		return AnyType, fmt.Errorf("%w: %s requires an array but %v was provided", ErrInvalidTypes, bexp.quantifier(), tright)
+	}
+
+	return BooleanType, nil
+}
+
+func (bexp *AnyAllExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BooleanType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+	}
+
+	_, err := bexp.inferType(cols, params, implicitTable)
+	return err
+}
+
+func (bexp *AnyAllExp) substitute(params map[string]interface{}) (ValueExp, error) {
+	rlexp, err := bexp.left.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rrexp, err := bexp.right.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnyAllExp{op: bexp.op, all: bexp.all, left: rlexp, right: rrexp}, nil
+}
+
+func (bexp *AnyAllExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	vl, err := bexp.left.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	vr, err := bexp.right.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	if vr.IsNull() {
+		return NewNull(BooleanType), nil
+	}
+
+	arr, err := asArray(vr, vl.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(arr.vals) == 0 {
+		return &Bool{val: bexp.all}, nil
+	}
+
+	sawNull := false
+
+	for _, elem := range arr.vals {
+		if vl.IsNull() || elem.IsNull() {
+			sawNull = true
+			continue
+		}
+
+		r, err := vl.Compare(elem)
+		if err != nil {
+			return nil, err
+		}
+
+		satisfied := cmpSatisfiesOp(r, bexp.op)
+		if satisfied != bexp.all {
+			return &Bool{val: satisfied}, nil
+		}
+	}
+
+	if sawNull {
+		return NewNull(BooleanType), nil
+	}
+	return &Bool{val: bexp.all}, nil
+}
+
+func (bexp *AnyAllExp) selectors() []Selector {
+	return append(bexp.left.selectors(), bexp.right.selectors()...)
+}
+
+func (bexp *AnyAllExp) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return &AnyAllExp{
+		op:    bexp.op,
+		all:   bexp.all,
+		left:  bexp.left.reduceSelectors(row, implicitTable),
+		right: bexp.right.reduceSelectors(row, implicitTable),
+	}
+}
+
+func (bexp *AnyAllExp) isConstant() bool {
+	return bexp.left.isConstant() && bexp.right.isConstant()
+}
+
+func (bexp *AnyAllExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (bexp *AnyAllExp) quantifier() string {
+	if bexp.all {
+		return "ALL"
+	}
+	return "ANY"
+}
+
+func (bexp *AnyAllExp) String() string {
+	return fmt.Sprintf("(%s %s %s(%s))", bexp.left.String(), CmpOperatorToString(bexp.op), bexp.quantifier(), bexp.right.String())
+}
+
+// ArrayContainsExp implements the `left @> right` operator, which holds
+// when every element of the right array is also an element of the left
+// one. NULL elements are never contained.
+type ArrayContainsExp struct {
+	left, right ValueExp
+}
+
+func (bexp *ArrayContainsExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	tleft, err := bexp.left.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	tright, err := bexp.right.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	if !IsArrayType(tleft) && !IsArrayType(tright) {
+		if tleft == AnyType || tright == AnyType {
+			return BooleanType, nil
+		}
+		return AnyType, fmt.Errorf("%w: @> requires array operands but %v and %v were provided", ErrInvalidTypes, tleft, tright)
+	}
+
+	t, ok := coerceTypes(tleft, tright)
+	if !ok {
+		return AnyType, fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, tleft, tright)
+	}
+
+	if tleft == AnyType {
+		err := bexp.left.requiresType(t, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+
+	if tright == AnyType {
+		err := bexp.right.requiresType(t, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+
+	return BooleanType, nil
+}
+
+func (bexp *ArrayContainsExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BooleanType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+	}
+
+	_, err := bexp.inferType(cols, params, implicitTable)
+	return err
+}
+
+func (bexp *ArrayContainsExp) substitute(params map[string]interface{}) (ValueExp, error) {
+	rlexp, err := bexp.left.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rrexp, err := bexp.right.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArrayContainsExp{left: rlexp, right: rrexp}, nil
+}
+
+func (bexp *ArrayContainsExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	vl, err := bexp.left.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	vr, err := bexp.right.reduce(tx, row, implicitTable)
+	if err != nil {
+		return nil, err
+	}
+
+	if vl.IsNull() || vr.IsNull() {
+		return NewNull(BooleanType), nil
+	}
+
+	// the text representation of an array takes the element type
+	// of the other operand
+	elemType := AnyType
+	if arr, ok := vl.(*Array); ok {
+		elemType = arr.elemType
+	} else if arr, ok := vr.(*Array); ok {
+		elemType = arr.elemType
+	}
+
+	larr, err := asArray(vl, elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	rarr, err := asArray(vr, elemType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rarr.vals {
+		if r.IsNull() {
+			return &Bool{val: false}, nil
+		}
+
+		found := false
+
+		for _, l := range larr.vals {
+			if l.IsNull() {
+				continue
+			}
+
+			res, err := l.Compare(r)
+			if err != nil {
+				return nil, err
+			}
+
+			if res == 0 {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return &Bool{val: false}, nil
+		}
+	}
+
+	return &Bool{val: true}, nil
+}
+
+func (bexp *ArrayContainsExp) selectors() []Selector {
+	return append(bexp.left.selectors(), bexp.right.selectors()...)
+}
+
+func (bexp *ArrayContainsExp) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return &ArrayContainsExp{
+		left:  bexp.left.reduceSelectors(row, implicitTable),
+		right: bexp.right.reduceSelectors(row, implicitTable),
+	}
+}
+
+func (bexp *ArrayContainsExp) isConstant() bool {
+	return bexp.left.isConstant() && bexp.right.isConstant()
+}
+
+func (bexp *ArrayContainsExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (bexp *ArrayContainsExp) String() string {
+	return fmt.Sprintf("(%s @> %s)", bexp.left.String(), bexp.right.String())
+}
+
+func getArrayConverter(src, dst SQLValueType) (converterFunc, error) {
+	if IsArrayType(src) && IsArrayType(dst) {
+		srcElemType := ArrayElemType(src)
+		dstElemType := ArrayElemType(dst)
+
+		var elemConv converterFunc
+		if srcElemType != AnyType {
+			conv, err := getConverter(srcElemType, dstElemType)
+			if err != nil {
+				return nil, err
+			}
+			elemConv = conv
+		}
+
+		return func(val TypedValue) (TypedValue, error) {
+			if val.RawValue() == nil {
+				return &NullValue{t: dst}, nil
+			}
+
+			arr := val.(*Array)
+
+			vals := make([]TypedValue, len(arr.vals))
+			for i, e := range arr.vals {
+				if e.IsNull() {
+					vals[i] = NewNull(dstElemType)
+					continue
+				}
+
+				if elemConv == nil {
+					return nil, ErrInvalidValue
+				}
+
+				cval, err := elemConv(e)
+				if err != nil {
+					return nil, err
+				}
+				vals[i] = cval
+			}
+			return NewArray(dstElemType, vals), nil
+		}, nil
+	}
+
+	if IsArrayType(dst) && src == VarcharType {
+		elemType := ArrayElemType(dst)
+
+		return func(val TypedValue) (TypedValue, error) {
+			if val.RawValue() == nil {
+				return &NullValue{t: dst}, nil
+			}
+
+			elems, err := parseArrayText(val.RawValue().(string))
+			if err != nil {
+				return nil, err
+			}
+
+			vals := make([]TypedValue, len(elems))
+			for i, e := range elems {
+				if e == nil {
+					vals[i] = NewNull(elemType)
+					continue
+				}
+
+				v, err := arrayElemFromText(*e, elemType)
+				if err != nil {
+					return nil, err
+				}
+				vals[i] = v
+			}
+			return NewArray(elemType, vals), nil
+		}, nil
+	}
+
+	if IsArrayType(dst) && src == JSONType {
+		elemType := ArrayElemType(dst)
+
+		return func(val TypedValue) (TypedValue, error) {
+			if val.RawValue() == nil {
+				return &NullValue{t: dst}, nil
+			}
+
+			elems, ok := val.RawValue().([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: can not cast JSON %s as %s", ErrUnsupportedCast, val.(*JSON).primitiveType(), dst)
+			}
+
+			vals := make([]TypedValue, len(elems))
+			for i, e := range elems {
+				if e == nil {
+					vals[i] = NewNull(elemType)
+					continue
+				}
+
+				if elemType == JSONType {
+					vals[i] = NewJson(e)
+					continue
+				}
+
+				v, err := jsonConverted(elemType)(NewJson(e))
+				if err != nil {
+					return nil, err
+				}
+				vals[i] = v
+			}
+			return NewArray(elemType, vals), nil
+		}, nil
+	}
+
+	if IsArrayType(src) && dst == VarcharType {
+		return func(val TypedValue) (TypedValue, error) {
+			if val.RawValue() == nil {
+				return &NullValue{t: VarcharType}, nil
+			}
+			return NewVarchar(val.String()), nil
+		}, nil
+	}
+
+	if IsArrayType(src) && dst == JSONType {
+		return func(val TypedValue) (TypedValue, error) {
+			if val.RawValue() == nil {
+				return &NullValue{t: JSONType}, nil
+			}
+
+			arr := val.(*Array)
+
+			elems := make([]interface{}, len(arr.vals))
+			for i, e := range arr.vals {
+				switch ev := e.(type) {
+				case *NullValue:
+					elems[i] = nil
+				case *Integer, *Float64, *Bool, *JSON:
+					elems[i] = ev.RawValue()
+				case *Decimal:
+					elems[i] = ev.Float64()
+				default:
+					elems[i] = arrayElemText(e)
+				}
+			}
+			return NewJson(elems), nil
+		}, nil
+	}
+
+	return nil, fmt.Errorf(
+		"%w: can not cast %s value as %s",
+		ErrUnsupportedCast,
+		src,
+		dst,
+	)
+}
+
+// parseArrayText splits the text representation of an array, such as
+// {1,2,NULL} or {"a,b",c}, into its elements. NULL elements are
+// returned as nil. Only one-dimensional arrays are supported.
+func parseArrayText(s string) ([]*string, error) {
+	s = strings.TrimSpace(s)
+
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+	}
+
+	inner := s[1 : len(s)-1]
+
+	elems := make([]*string, 0)
+
+	if strings.TrimSpace(inner) == "" {
+		return elems, nil
+	}
+
+	i := 0
+
+	for {
+		for i < len(inner) && isSpace(inner[i]) {
+			i++
+		}
+
+		var sb strings.Builder
+
+		quoted := i < len(inner) && inner[i] == '"'
+
+		if quoted {
+			i++
+
+			for {
+				if i >= len(inner) {
+					return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+				}
+
+				ch := inner[i]
+				i++
+
+				if ch == '"' {
+					break
+				}
+
+				if ch == '\\' {
+					if i >= len(inner) {
+						return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+					}
+					ch = inner[i]
+					i++
+				}
+
+				sb.WriteByte(ch)
+			}
+
+			for i < len(inner) && isSpace(inner[i]) {
+				i++
+			}
+		} else {
+			for i < len(inner) && inner[i] != ',' {
+				ch := inner[i]
+				i++
+
+				if ch == '{' || ch == '}' || ch == '"' {
+					return nil, fmt.Errorf("%w: malformed array literal '%s', only one-dimensional arrays are supported", ErrInvalidValue, s)
+				}
+
+				if ch == '\\' {
+					if i >= len(inner) {
+						return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+					}
+					ch = inner[i]
+					i++
+				}
+
+				sb.WriteByte(ch)
+			}
+		}
+
+		elem := sb.String()
+
+		if !quoted {
+			elem = strings.TrimSpace(elem)
+
+			if elem == "" {
+				return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+			}
+		}
+
+		if !quoted && strings.EqualFold(elem, "NULL") {
+			elems = append(elems, nil)
+		} else {
+			elems = append(elems, &elem)
+		}
+
+		if i >= len(inner) {
+			return elems, nil
+		}
+
+		if inner[i] != ',' {
+			return nil, fmt.Errorf("%w: malformed array literal '%s'", ErrInvalidValue, s)
+		}
+		i++
+	}
+}
+
+// formatArrayText returns the text representation of an array with the
+// given elements. Elements are double-quoted when needed, so that the
+// result can be parsed back with parseArrayText.
+func formatArrayText(vals []TypedValue) string {
+	var sb strings.Builder
+
+	sb.WriteByte('{')
+
+	for i, v := range vals {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+
+		if v.IsNull() {
+			sb.WriteString("NULL")
+			continue
+		}
+
+		s := arrayElemText(v)
+
+		if !arrayElemNeedsQuotes(s) {
+			sb.WriteString(s)
+			continue
+		}
+
+		sb.WriteByte('"')
+		for j := 0; j < len(s); j++ {
+			if s[j] == '"' || s[j] == '\\' {
+				sb.WriteByte('\\')
+			}
+			sb.WriteByte(s[j])
+		}
+		sb.WriteByte('"')
+	}
+
+	sb.WriteByte('}')
+
+	return sb.String()
+}
+
+func arrayElemNeedsQuotes(s string) bool {
+	return s == "" || strings.EqualFold(s, "NULL") || strings.ContainsAny(s, "{},\"\\ \t\r\n")
+}
+
+func arrayElemText(v TypedValue) string {
+	switch ev := v.(type) {
+	case *Varchar:
+		return ev.val
+	case *Bool:
+		if ev.val {
+			return "t"
+		}
+		return "f"
+	case *Blob:
+		return `\x` + hex.EncodeToString(ev.val)
+	}
+	return v.String()
+}
+
+func arrayElemFromText(s string, elemType SQLValueType) (TypedValue, error) {
+	if elemType == BLOBType {
+		if strings.HasPrefix(s, `\x`) {
+			b, err := hex.DecodeString(s[2:])
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid bytea array element '%s'", ErrInvalidValue, s)
+			}
+			return &Blob{val: b}, nil
+		}
+		return &Blob{val: []byte(s)}, nil
+	}
+
+	conv, err := getConverter(VarcharType, elemType)
+	if err != nil {
+		return nil, err
+	}
+	return conv(NewVarchar(s))
+}
+
+// mayApplyImplicitArrayConversion converts val into the raw representation
+// of an array of type t: a slice holding the raw value of each element.
+func mayApplyImplicitArrayConversion(val interface{}, t SQLValueType) (interface{}, error) {
+	var raw []interface{}
+
+	switch v := val.(type) {
+	case []interface{}:
+		raw = v
+	case string:
+		conv, err := getConverter(VarcharType, t)
+		if err != nil {
+			return nil, err
+		}
+
+		arr, err := conv(NewVarchar(v))
+		if err != nil {
+			return nil, err
+		}
+		raw = arr.RawValue().([]interface{})
+	default:
+		arr, ok := arrayFromSlice(val)
+		if !ok {
+			// No implicit conversion rule found, do not convert at all
+			return val, nil
+		}
+		raw = arr.RawValue().([]interface{})
+	}
+
+	elemType := ArrayElemType(t)
+
+	convVals := make([]interface{}, len(raw))
+	for i, e := range raw {
+		ce, err := mayApplyImplicitConversion(e, elemType)
+		if err != nil {
+			return nil, err
+		}
+		convVals[i] = ce
+	}
+	return convVals, nil
+}
+
+// encodeArrayValue encodes the elements of an array as
+//
+//	{len}{count}[{isNull}{element}]*
+//
+// where each non-NULL element follows the value encoding of its type.
+// maxLen applies to each element.
+func encodeArrayValue(vals []interface{}, elemType SQLValueType, maxLen int) ([]byte, error) {
+	encv := make([]byte, EncLenLen+4)
+	binary.BigEndian.PutUint32(encv[EncLenLen:], uint32(len(vals)))
+
+	for _, v := range vals {
+		if v == nil {
+			encv = append(encv, 0)
+			continue
+		}
+
+		encElem, err := EncodeRawValue(v, elemType, maxLen, false)
+		if err != nil {
+			return nil, err
+		}
+
+		encv = append(encv, 1)
+		encv = append(encv, encElem...)
+	}
+
+	binary.BigEndian.PutUint32(encv, uint32(len(encv)-EncLenLen))
+
+	return encv, nil
+}
+
+func decodeArrayValue(b []byte, elemType SQLValueType) (*Array, error) {
+	if len(b) < 4 {
+		return nil, ErrCorruptedData
+	}
+
+	n := int(binary.BigEndian.Uint32(b))
+	off := 4
+
+	if n > len(b)-off {
+		return nil, ErrCorruptedData
+	}
+
+	vals := make([]TypedValue, n)
+
+	for i := 0; i < n; i++ {
+		if off >= len(b) {
+			return nil, ErrCorruptedData
+		}
+
+		isNull := b[off] == 0
+		off++
+
+		if isNull {
+			vals[i] = NewNull(elemType)
+			continue
+		}
+
+		v, voff, err := decodeValue(b[off:], elemType, false)
+		if err != nil {
+			return nil, err
+		}
+
+		vals[i] = v
+		off += voff
+	}
+
+	if off != len(b) {
+		return nil, ErrCorruptedData
+	}
+
+	return NewArray(elemType, vals), nil
+}
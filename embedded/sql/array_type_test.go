@@ -0,0 +1,246 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayText(t *testing.T) {
+	for _, c := range []struct {
+		in    string
+		elems []interface{}
+		out   string
+	}{
+		{"{}", []interface{}{}, "{}"},
+		{"{1,2,3}", []interface{}{int64(1), int64(2), int64(3)}, "{1,2,3}"},
+		{" { 1 , NULL ,3 } ", []interface{}{int64(1), nil, int64(3)}, "{1,NULL,3}"},
+	} {
+		conv, err := getConverter(VarcharType, ArrayTypeOf(IntegerType))
+		require.NoError(t, err)
+
+		v, err := conv(NewVarchar(c.in))
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.elems, v.RawValue(), c.in)
+		require.Equal(t, c.out, v.String(), c.in)
+	}
+
+	for _, c := range []struct {
+		in    string
+		elems []interface{}
+		out   string
+	}{
+		{`{a,"b,c",""}`, []interface{}{"a", "b,c", ""}, `{a,"b,c",""}`},
+		{`{"NULL",NULL,"say \"hi\""}`, []interface{}{"NULL", nil, `say "hi"`}, `{"NULL",NULL,"say \"hi\""}`},
+		{`{hello world}`, []interface{}{"hello world"}, `{"hello world"}`},
+	} {
+		conv, err := getConverter(VarcharType, ArrayTypeOf(VarcharType))
+		require.NoError(t, err)
+
+		v, err := conv(NewVarchar(c.in))
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.elems, v.RawValue(), c.in)
+		require.Equal(t, c.out, v.String(), c.in)
+	}
+
+	for _, in := range []string{"", "1,2", "{1,2", "{{1},{2}}", "{1,,2}", `{"a}`, "{a\"b}"} {
+		_, err := parseArrayText(in)
+		require.ErrorIs(t, err, ErrInvalidValue, in)
+	}
+}
+
+func TestArrayValueEncoding(t *testing.T) {
+	arr := NewArray(VarcharType, []TypedValue{NewVarchar("a"), NewNull(VarcharType), NewVarchar("")})
+
+	enc, err := EncodeValue(arr, arr.Type(), 0)
+	require.NoError(t, err)
+
+	dec, n, err := DecodeValue(enc, arr.Type())
+	require.NoError(t, err)
+	require.Equal(t, len(enc), n)
+	require.Equal(t, arr.RawValue(), dec.RawValue())
+
+	empty := NewArray(IntegerType, nil)
+
+	enc, err = EncodeNullableValue(empty, empty.Type(), 0)
+	require.NoError(t, err)
+
+	dec, _, err = DecodeNullableValue(enc, empty.Type())
+	require.NoError(t, err)
+	require.False(t, dec.IsNull())
+	require.Equal(t, "{}", dec.String())
+
+	_, err = EncodeValue(arr, arr.Type(), 0)
+	require.NoError(t, err)
+
+	_, err = EncodeRawValue([]interface{}{"abc"}, ArrayTypeOf(VarcharType), 2, false)
+	require.ErrorIs(t, err, ErrMaxLengthExceeded)
+
+	_, _, err = DecodeValue([]byte{0, 0, 0, 5, 0, 0, 0, 9, 1}, ArrayTypeOf(IntegerType))
+	require.ErrorIs(t, err, ErrCorruptedData)
+}
+
+func TestArrayColumns(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE posts (
+			id INTEGER AUTO_INCREMENT,
+			tags VARCHAR[16][],
+			scores INTEGER[],
+			prices DECIMAL(5,2)[],
+			PRIMARY KEY id
+		)
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO posts (tags, scores, prices) VALUES
+			(ARRAY['go', 'sql'], ARRAY[1, 2, 3], ARRAY[1.005, 2]),
+			('{rust,"with space"}', '{4,NULL}', NULL),
+			(ARRAY[], '{}', '{}'),
+			(NULL, ARRAY[7], ARRAY[3])
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("values and casts", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT tags, scores, prices FROM posts ORDER BY id")
+		require.Equal(t, [][]string{
+			{"{go,sql}", "{1,2,3}", "{1.01,2.00}"},
+			{`{rust,"with space"}`, "{4,NULL}", "NULL"},
+			{"{}", "{}", "{}"},
+			{"NULL", "{7}", "{3.00}"},
+		}, rows)
+
+		rows = queryStrings(t, engine, "SELECT CAST(scores AS VARCHAR), '{1.5,2}'::FLOAT[], CAST(scores AS FLOAT[]) FROM posts WHERE id = 1")
+		require.Equal(t, [][]string{{"'{1,2,3}'", "{1.5,2}", "{1,2,3}"}}, rows)
+
+		_, _, err := engine.Exec(context.Background(), nil, "INSERT INTO posts (tags) VALUES (ARRAY['a very long tag name'])", nil)
+		require.ErrorIs(t, err, ErrMaxLengthExceeded)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO posts (scores) VALUES ('{a,b}')", nil)
+		require.ErrorIs(t, err, ErrInvalidValue)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO posts (scores) VALUES (ARRAY[1, 'a'])", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("ANY, ALL and containment", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT id FROM posts WHERE 'sql' = ANY(tags) ORDER BY id")
+		require.Equal(t, [][]string{{"1"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE 2 < ANY(scores) ORDER BY id")
+		require.Equal(t, [][]string{{"1"}, {"2"}, {"4"}}, rows)
+
+		// empty arrays satisfy ALL, NULL elements make the result unknown
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE 0 < ALL(scores) ORDER BY id")
+		require.Equal(t, [][]string{{"1"}, {"3"}, {"4"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE id = ANY(ARRAY[2, 4]) ORDER BY id")
+		require.Equal(t, [][]string{{"2"}, {"4"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE scores @> ARRAY[3, 1] ORDER BY id")
+		require.Equal(t, [][]string{{"1"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE scores @> '{}' ORDER BY id")
+		require.Equal(t, [][]string{{"1"}, {"2"}, {"3"}, {"4"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM posts WHERE tags = '{go,sql}'")
+		require.Equal(t, [][]string{{"1"}}, rows)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT id FROM posts WHERE id = ANY(@ids)", map[string]interface{}{"ids": []int64{1, 3}})
+		require.NoError(t, err)
+
+		n := 0
+		for ; ; n++ {
+			_, err := r.Read(context.Background())
+			if err == ErrNoMoreRows {
+				break
+			}
+			require.NoError(t, err)
+		}
+		require.NoError(t, r.Close())
+		require.Equal(t, 2, n)
+
+		r, err = engine.Query(context.Background(), nil, "SELECT id FROM posts WHERE id = ANY(id)", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrInvalidTypes)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("functions", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT array_upper(scores, 1), array_length(scores, 1), cardinality(scores), array_to_string(scores, '-', '*') FROM posts ORDER BY id")
+		require.Equal(t, [][]string{
+			{"3", "3", "3", "'1-2-3'"},
+			{"2", "2", "2", "'4-*'"},
+			{"NULL", "NULL", "0", "''"},
+			{"1", "1", "1", "'7'"},
+		}, rows)
+
+		rows = queryStrings(t, engine, "SELECT array_to_string(tags, ', ') FROM posts WHERE id = 2")
+		require.Equal(t, [][]string{{"'rust, with space'"}}, rows)
+	})
+
+	t.Run("array_agg", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT ARRAY_AGG(id) FROM posts")
+		require.Equal(t, [][]string{{"{1,2,3,4}"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT ARRAY_AGG(id) FROM posts WHERE id > 10")
+		require.Equal(t, [][]string{{"{}"}}, rows)
+	})
+
+	t.Run("unnest", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT unnest FROM unnest(ARRAY[3, 1, 2]) ORDER BY unnest")
+		require.Equal(t, [][]string{{"1"}, {"2"}, {"3"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT u.unnest FROM unnest('{a,NULL}') AS u")
+		require.Equal(t, [][]string{{"'a'"}, {"NULL"}}, rows)
+
+		_, err := engine.Query(context.Background(), nil, "SELECT * FROM unnest(1)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("arrays can not be indexed", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE INDEX ON posts(scores)", nil)
+		require.ErrorIs(t, err, ErrCannotIndexArray)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "ALTER TABLE posts ADD COLUMN flags BOOLEAN[]", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE posts SET flags = ARRAY[true, false] WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		require.NoError(t, st.Close())
+
+		st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+		require.NoError(t, err)
+		defer st.Close()
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows := queryStrings(t, engine, "SELECT tags, flags FROM posts WHERE id = 1")
+		require.Equal(t, [][]string{{"{go,sql}", "{t,f}"}}, rows)
+
+		rows = queryStrings(t, engine, "SHOW TABLE posts")
+		require.Equal(t, []string{"'scores'", "'INTEGER[]'"}, rows[2][:2])
+	})
+}
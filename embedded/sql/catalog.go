@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -40,17 +41,63 @@ type Catalog struct {
 	tablesByName map[string]*Table
 
 	maxTableID uint32 // The maxTableID variable is used to assign unique ids to new tables as they are created.
+
+	schemas    map[string]struct{} // user defined schemas
+	searchPath []string            // schemas searched for unqualified names, nil for public only
+
+	routines map[string]*Routine // stored functions and procedures
 }
 
 type Constraint interface{}
 
 type PrimaryKeyConstraint []string
 
-// ForeignKeyConstraint is parsed but not enforced — stored for ORM migration compatibility
+// ReferentialAction determines what happens to referencing rows when the
+// referenced row is deleted or its referenced columns are updated.
+type ReferentialAction byte
+
+const (
+	ReferentialNoAction ReferentialAction = iota
+	ReferentialRestrict
+	ReferentialCascade
+	ReferentialSetNull
+)
+
+func (a ReferentialAction) String() string {
+	switch a {
+	case ReferentialRestrict:
+		return "RESTRICT"
+	case ReferentialCascade:
+		return "CASCADE"
+	case ReferentialSetNull:
+		return "SET NULL"
+	}
+	return "NO ACTION"
+}
+
+// ForeignKeyConstraint is the parsed form of a FOREIGN KEY table element.
+// Columns are referenced by name; they are resolved into a ForeignKey when
+// the table is created.
 type ForeignKeyConstraint struct {
+	name     string
 	cols     []string
 	refTable string
-	refCols  []string
+	refCols  []string // empty means the primary key of refTable
+	onDelete ReferentialAction
+	onUpdate ReferentialAction
+}
+
+// ForeignKey is the catalog representation of an enforced foreign key.
+// Columns and the referenced table are tracked by id so renames do not
+// invalidate the constraint.
+type ForeignKey struct {
+	id         uint32
+	name       string
+	colIDs     []uint32
+	refTableID uint32
+	refColIDs  []uint32
+	onDelete   ReferentialAction
+	onUpdate   ReferentialAction
 }
 
 type CheckConstraint struct {
@@ -69,7 +116,19 @@ type Table struct {
 	indexes          []*Index
 	indexesByName    map[string]*Index
 	indexesByColID   map[uint32][]*Index
+	fullTextIndexes  map[uint32]*Index  // fulltext indexes by column id
+	expCols          map[uint32]*Column // columns computed by index expressions
 	checkConstraints map[string]CheckConstraint
+	foreignKeys      map[string]*ForeignKey
+	grants           []*tableGrant
+	policies         map[string]*Policy
+	matView          *MaterializedView
+	triggers         map[string]*Trigger
+	stats            *TableStats
+	columnMigrations []*columnMigration
+	partitioning     *partitionKey   // set on tables partitioned by range
+	partitionOf      *partitionBound // set on the partitions of a table
+	partitionList    []*Table        // partitions ordered by their bounds, see refreshPartitions
 	primaryIndex     *Index
 	autoIncrementPK  bool
 	maxPK            int64
@@ -83,6 +142,11 @@ type Table struct {
 	systemScan func(ctx context.Context, tx *SQLTx) ([]*Row, error)
 }
 
+const (
+	uniqueIndexFlag   byte = 1
+	fullTextIndexFlag byte = 2
+)
+
 type Index struct {
 	table     *Table
 	id        uint32
@@ -90,6 +154,7 @@ type Index struct {
 	cols      []*Column
 	colsByID  map[uint32]*Column
 	predicate ValueExp // WHERE clause for partial indexes (nil = full index)
+	fullText  bool     // inverted index of the terms of a text column
 }
 
 type Column struct {
@@ -101,6 +166,9 @@ type Column struct {
 	autoIncrement bool
 	notNull       bool
 	defaultValue  ValueExp
+	generated     ValueExp // expression computing a generated column
+	virtual       bool     // generated column computed on read
+	exp           ValueExp // index expression computing the column
 }
 
 func newCatalog(enginePrefix []byte) *Catalog {
@@ -188,7 +256,7 @@ var pgTypeBaseRows = []struct {
 }
 
 func (catlg *Catalog) ExistTable(table string) bool {
-	_, exists := catlg.tablesByName[table]
+	_, exists := catlg.lookupTable(table)
 	return exists
 }
 
@@ -201,7 +269,7 @@ func (catlg *Catalog) GetTables() []*Table {
 }
 
 func (catlg *Catalog) GetTableByName(name string) (*Table, error) {
-	table, exists := catlg.tablesByName[name]
+	table, exists := catlg.lookupTable(name)
 	if !exists {
 		return nil, fmt.Errorf("%w (%s)", ErrTableDoesNotExist, name)
 	}
@@ -251,7 +319,7 @@ func (t *Table) IsIndexed(colName string) (indexed bool, err error) {
 	if err != nil {
 		return false, err
 	}
-	return len(t.indexesByColID[col.id]) > 0, nil
+	return len(t.indexesByColID[col.id]) > 0 || t.fullTextIndexes[col.id] != nil, nil
 }
 
 func (t *Table) GetColumnByName(name string) (*Column, error) {
@@ -365,18 +433,7 @@ func (i *Index) hasPrefix(columns []*Column, ordExps []*OrdExp) bool {
 	}
 
 	for j, ordCol := range ordExps {
-		sel := ordCol.AsSelector()
-		if sel == nil {
-			return false
-		}
-
-		aggFn, _, colName := sel.resolve(i.table.Name())
-		if len(aggFn) > 0 {
-			return false
-		}
-
-		col, err := i.table.GetColumnByName(colName)
-		if err != nil || col.id != columns[j].id {
+		if !columns[j].refersTo(ordCol.exp, i.table.Name()) {
 			return false
 		}
 	}
@@ -385,23 +442,8 @@ func (i *Index) hasPrefix(columns []*Column, ordExps []*OrdExp) bool {
 
 func (i *Index) sortableUsing(columns []*OrdExp, rangesByColID map[uint32]*typedValueRange) bool {
 	// all columns before colID must be fixedValues otherwise the index can not be used
-	sel := columns[0].AsSelector()
-	if sel == nil {
-		return false
-	}
-
-	aggFn, _, colName := sel.resolve(i.table.Name())
-	if len(aggFn) > 0 {
-		return false
-	}
-
-	firstCol, err := i.table.GetColumnByName(colName)
-	if err != nil {
-		return false
-	}
-
 	for j, col := range i.cols {
-		if col.id == firstCol.id {
+		if col.refersTo(columns[0].exp, i.table.Name()) {
 			return i.hasPrefix(i.cols[j:], columns)
 		}
 
@@ -461,7 +503,7 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 		}
 	}
 
-	exists := catlg.ExistTable(name)
+	_, exists := catlg.tablesByName[name]
 	if exists {
 		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, name)
 	}
@@ -487,6 +529,7 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 		indexesByName:    make(map[string]*Index),
 		indexesByColID:   make(map[uint32][]*Index),
 		checkConstraints: checkConstraints,
+		foreignKeys:      make(map[string]*ForeignKey),
 		maxColID:         maxColID,
 	}
 
@@ -497,7 +540,9 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 			continue
 		}
 
-		if isReservedCol(cs.colName) {
+		// tables created before the pseudo-columns were introduced may have
+		// columns named after them, see CreateTableStmt.execAt
+		if isReservedCol(cs.colName) && !isSystemCol(cs.colName) {
 			return nil, fmt.Errorf("%w(%s)", ErrReservedWord, cs.colName)
 		}
 
@@ -523,6 +568,8 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 			autoIncrement: cs.autoIncrement,
 			notNull:       cs.notNull,
 			defaultValue:  cs.defaultValue,
+			generated:     cs.generated,
+			virtual:       cs.virtual,
 		}
 
 		table.cols = append(table.cols, col)
@@ -530,6 +577,14 @@ func (catlg *Catalog) newTable(name string, colsSpec map[uint32]*ColSpec, checkC
 		table.colsByName[col.colName] = col
 	}
 
+	for _, col := range table.cols {
+		if col.IsGenerated() {
+			if err := table.validateGeneratedColumn(col); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	catlg.tables = append(catlg.tables, table)
 	catlg.tablesByID[table.id] = table
 	catlg.tablesByName[table.name] = table
@@ -560,6 +615,10 @@ func (catlg *Catalog) deleteTable(table *Table) error {
 	delete(catlg.tablesByID, table.id)
 	delete(catlg.tablesByName, table.name)
 
+	if table.partitionOf != nil {
+		catlg.refreshPartitions(table.partitionOf.parentID)
+	}
+
 	return nil
 }
 
@@ -573,7 +632,7 @@ func (t *Table) newIndex(unique bool, colIDs []uint32) (index *Index, err error)
 	colsByID := make(map[uint32]*Column, len(colIDs))
 
 	for i, colID := range colIDs {
-		col, err := t.GetColumnByID(colID)
+		col, err := t.indexColumnByID(colID)
 		if err != nil {
 			return nil, err
 		}
@@ -643,10 +702,7 @@ func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnAlreadyExists, spec.colName)
 	}
 
-	t.maxColID++
-
 	col := &Column{
-		id:            t.maxColID,
 		table:         t,
 		colName:       spec.colName,
 		colType:       spec.colType,
@@ -654,8 +710,19 @@ func (t *Table) newColumn(spec *ColSpec) (*Column, error) {
 		autoIncrement: spec.autoIncrement,
 		notNull:       spec.notNull,
 		defaultValue:  spec.defaultValue,
+		generated:     spec.generated,
+		virtual:       spec.virtual,
 	}
 
+	if col.IsGenerated() {
+		if err := t.validateGeneratedColumn(col); err != nil {
+			return nil, err
+		}
+	}
+
+	t.maxColID++
+	col.id = t.maxColID
+
 	t.cols = append(t.cols, col)
 	t.colsByID[col.id] = col
 	t.colsByName[col.colName] = col
@@ -673,14 +740,26 @@ func (ctlg *Catalog) renameTable(oldName, newName string) (*Table, error) {
 		return nil, err
 	}
 
-	_, err = ctlg.GetTableByName(newName)
-	if err == nil {
+	// renamed tables stay in their schema
+	schema, _ := SplitTableName(t.name)
+
+	if isQualifiedName(newName) {
+		newSchema, name := SplitTableName(newName)
+		if newSchema != schema {
+			return nil, fmt.Errorf("%w: table '%s' can not be moved to schema '%s'", ErrIllegalArguments, oldName, newSchema)
+		}
+		newName = name
+	}
+	newName = schemaQualifiedName(schema, newName)
+
+	_, exists := ctlg.tablesByName[newName]
+	if exists {
 		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, newName)
 	}
 
-	t.name = newName
+	delete(ctlg.tablesByName, t.name)
 
-	delete(ctlg.tablesByName, oldName)
+	t.name = newName
 	ctlg.tablesByName[newName] = t
 
 	return t, nil
@@ -705,6 +784,14 @@ func (t *Table) renameColumn(oldName, newName string) (*Column, error) {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnAlreadyExists, newName)
 	}
 
+	if exps := t.indexExpsUsing(oldName); len(exps) > 0 {
+		return nil, fmt.Errorf("%w: column '%s' is used by index expression %s", ErrIllegalArguments, oldName, exps[0].colName)
+	}
+
+	if cols := t.generatedColumnsUsing(oldName); len(cols) > 0 {
+		return nil, fmt.Errorf("%w: column '%s' is used by generated column '%s'", ErrIllegalArguments, oldName, cols[0].colName)
+	}
+
 	col.colName = newName
 
 	delete(t.colsByName, oldName)
@@ -719,10 +806,22 @@ func (t *Table) deleteColumn(col *Column) error {
 		return err
 	}
 
-	if isIndexed {
+	if isIndexed || len(t.indexExpsUsing(col.colName)) > 0 {
 		return fmt.Errorf("%w %s because one or more indexes require it", ErrCannotDropColumn, col.colName)
 	}
 
+	if cols := t.generatedColumnsUsing(col.colName); len(cols) > 0 {
+		return fmt.Errorf("%w %s because generated column '%s' requires it", ErrCannotDropColumn, col.colName, cols[0].colName)
+	}
+
+	for _, fk := range t.foreignKeys {
+		for _, colID := range fk.colIDs {
+			if colID == col.id {
+				return fmt.Errorf("%w %s because foreign key '%s' requires it", ErrCannotDropColumn, col.colName, fk.name)
+			}
+		}
+	}
+
 	newCols := make([]*Column, 0, len(t.cols)-1)
 
 	for _, c := range t.cols {
@@ -748,6 +847,181 @@ func (t *Table) deleteCheck(name string) (uint32, error) {
 	return c.id, nil
 }
 
+// newForeignKey resolves spec against the catalog and registers the
+// resulting foreign key on t. The referenced columns must match the
+// primary key or a full unique index of the referenced table, and column
+// types must be identical on both sides.
+func (t *Table) newForeignKey(id uint32, spec *ForeignKeyConstraint) (*ForeignKey, error) {
+	refTable, err := t.catalog.GetTableByName(spec.refTable)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w (%s)", ErrInvalidForeignKey, err, spec.refTable)
+	}
+
+	if refTable.systemScan != nil {
+		return nil, fmt.Errorf("%w: system table '%s' can not be referenced", ErrInvalidForeignKey, refTable.name)
+	}
+
+	if t.partitioned() || refTable.partitioned() {
+		return nil, fmt.Errorf("%w: partitioned tables and their partitions can not take part in foreign keys", ErrInvalidForeignKey)
+	}
+
+	refCols := spec.refCols
+	if len(refCols) == 0 {
+		for _, col := range refTable.primaryIndex.cols {
+			refCols = append(refCols, col.colName)
+		}
+	}
+
+	if len(spec.cols) == 0 || len(spec.cols) != len(refCols) {
+		return nil, fmt.Errorf("%w: number of referencing and referenced columns must match", ErrInvalidForeignKey)
+	}
+
+	if len(spec.cols) > MaxNumberOfColumnsInIndex {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidForeignKey, ErrMaxNumberOfColumnsInIndexExceeded)
+	}
+
+	fk := &ForeignKey{
+		id:         id,
+		name:       spec.name,
+		colIDs:     make([]uint32, len(spec.cols)),
+		refTableID: refTable.id,
+		refColIDs:  make([]uint32, len(refCols)),
+		onDelete:   spec.onDelete,
+		onUpdate:   spec.onUpdate,
+	}
+
+	for i, colName := range spec.cols {
+		col, err := t.GetColumnByName(colName)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidForeignKey, err)
+		}
+
+		refCol, err := refTable.GetColumnByName(refCols[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidForeignKey, err)
+		}
+
+		if col.colType != refCol.colType {
+			return nil, fmt.Errorf("%w: column '%s' of type %s can not reference column '%s' of type %s",
+				ErrInvalidForeignKey, col.colName, col.colType, refCol.colName, refCol.colType)
+		}
+
+		fk.colIDs[i] = col.id
+		fk.refColIDs[i] = refCol.id
+	}
+
+	if refTable.uniqueIndexOn(fk.refColIDs) == nil {
+		return nil, fmt.Errorf("%w: there is no unique index on the referenced columns of table '%s'", ErrInvalidForeignKey, refTable.name)
+	}
+
+	if fk.name == "" {
+		fk.name = fmt.Sprintf("%s_%s_fkey", t.name, strings.Join(spec.cols, "_"))
+	}
+
+	if len(fk.name) > 256 {
+		return nil, fmt.Errorf("constraint name len: %w", ErrMaxLengthExceeded)
+	}
+
+	_, checkExists := t.checkConstraints[fk.name]
+	_, fkExists := t.foreignKeys[fk.name]
+	if checkExists || fkExists {
+		return nil, fmt.Errorf("%w: constraint '%s' already exists", ErrInvalidForeignKey, fk.name)
+	}
+
+	t.foreignKeys[fk.name] = fk
+
+	return fk, nil
+}
+
+// uniqueIndexOn returns the primary key or full unique index covering
+// exactly the given set of columns, or nil if there is none.
+func (t *Table) uniqueIndexOn(colIDs []uint32) *Index {
+	for _, index := range t.indexes {
+		if !index.unique || index.predicate != nil || len(index.cols) != len(colIDs) {
+			continue
+		}
+
+		covered := true
+		for _, colID := range colIDs {
+			if !index.IncludesCol(colID) {
+				covered = false
+				break
+			}
+		}
+
+		if covered {
+			return index
+		}
+	}
+	return nil
+}
+
+func (t *Table) deleteForeignKey(name string) (uint32, error) {
+	fk, exists := t.foreignKeys[name]
+	if !exists {
+		return 0, fmt.Errorf("%s.%s: %w", t.name, name, ErrConstraintNotFound)
+	}
+
+	delete(t.foreignKeys, name)
+	return fk.id, nil
+}
+
+// foreignKeyConstraintOf returns the by-name form of fk, declared on table.
+func (catlg *Catalog) foreignKeyConstraintOf(table *Table, fk *ForeignKey) (*ForeignKeyConstraint, error) {
+	refTable, err := catlg.GetTableByID(fk.refTableID)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &ForeignKeyConstraint{
+		name:     fk.name,
+		cols:     make([]string, len(fk.colIDs)),
+		refTable: refTable.name,
+		refCols:  make([]string, len(fk.refColIDs)),
+		onDelete: fk.onDelete,
+		onUpdate: fk.onUpdate,
+	}
+
+	for i, colID := range fk.colIDs {
+		col, err := table.GetColumnByID(colID)
+		if err != nil {
+			return nil, err
+		}
+		spec.cols[i] = col.colName
+	}
+
+	for i, colID := range fk.refColIDs {
+		col, err := refTable.GetColumnByID(colID)
+		if err != nil {
+			return nil, err
+		}
+		spec.refCols[i] = col.colName
+	}
+
+	return spec, nil
+}
+
+// tableForeignKey pairs a foreign key with the table that declares it.
+type tableForeignKey struct {
+	table *Table
+	fk    *ForeignKey
+}
+
+// referencingForeignKeys returns every foreign key, including
+// self-references, whose referenced table is table.
+func (catlg *Catalog) referencingForeignKeys(table *Table) []tableForeignKey {
+	var refs []tableForeignKey
+
+	for _, t := range catlg.tables {
+		for _, fk := range t.foreignKeys {
+			if fk.refTableID == table.id {
+				refs = append(refs, tableForeignKey{table: t, fk: fk})
+			}
+		}
+	}
+	return refs
+}
+
 func (t *Table) deleteIndex(index *Index) error {
 	if index.IsPrimary() {
 		return fmt.Errorf("%w: primary key index can NOT be deleted", ErrIllegalArguments)
@@ -786,10 +1060,14 @@ func (c *Column) MaxLen() int {
 		return 1
 	case IntegerType:
 		return 8
-	case TimestampType:
+	case TimestampType, DateType:
 		return 8
 	case Float64Type:
 		return 8
+	case DecimalType:
+		return decimalKeyLen
+	case IntervalType:
+		return intervalEncLen
 	case UUIDType:
 		return 16
 	}
@@ -797,6 +1075,25 @@ func (c *Column) MaxLen() int {
 	return c.maxLen
 }
 
+// Precision returns the declared precision of a DECIMAL column, zero
+// when the column is unconstrained.
+func (c *Column) Precision() int {
+	if c.colType != DecimalType {
+		return 0
+	}
+	precision, _ := decimalPrecisionAndScale(c.maxLen)
+	return precision
+}
+
+// Scale returns the declared scale of a DECIMAL column.
+func (c *Column) Scale() int {
+	if c.colType != DecimalType {
+		return 0
+	}
+	_, scale := decimalPrecisionAndScale(c.maxLen)
+	return scale
+}
+
 func (c *Column) IsNullable() bool {
 	return !c.notNull
 }
@@ -814,6 +1111,10 @@ func (c *Column) DefaultValue() ValueExp {
 }
 
 func validMaxLenForType(maxLen int, sqlType SQLValueType) bool {
+	if IsArrayType(sqlType) {
+		return validMaxLenForType(maxLen, ArrayElemType(sqlType))
+	}
+
 	switch sqlType {
 	case BooleanType:
 		return maxLen <= 1
@@ -821,8 +1122,12 @@ func validMaxLenForType(maxLen int, sqlType SQLValueType) bool {
 		return maxLen == 0 || maxLen == 8
 	case Float64Type:
 		return maxLen == 0 || maxLen == 8
-	case TimestampType:
+	case DecimalType:
+		return validDecimalTypeMod(maxLen)
+	case TimestampType, DateType:
 		return maxLen == 0 || maxLen == 8
+	case IntervalType:
+		return maxLen == 0 || maxLen == intervalEncLen
 	case UUIDType:
 		return maxLen == 0 || maxLen == 16
 	}
@@ -857,6 +1162,21 @@ func (catlg *Catalog) Clone() *Catalog {
 	cp := newCatalog(catlg.enginePrefix)
 	cp.maxTableID = catlg.maxTableID
 
+	if len(catlg.schemas) > 0 {
+		cp.schemas = make(map[string]struct{}, len(catlg.schemas))
+		for name := range catlg.schemas {
+			cp.schemas[name] = struct{}{}
+		}
+	}
+
+	if len(catlg.routines) > 0 {
+		// routines are never mutated once created
+		cp.routines = make(map[string]*Routine, len(catlg.routines))
+		for name, r := range catlg.routines {
+			cp.routines[name] = r
+		}
+	}
+
 	if len(catlg.tables) > 0 {
 		cp.tables = make([]*Table, 0, len(catlg.tables))
 	}
@@ -868,6 +1188,9 @@ func (catlg *Catalog) Clone() *Catalog {
 		cp.tablesByName[nt.name] = nt
 	}
 
+	// the partition lists must refer to the cloned tables
+	cp.refreshAllPartitions()
+
 	return cp
 }
 
@@ -889,7 +1212,27 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 		indexes:          make([]*Index, 0, len(t.indexes)),
 		indexesByName:    make(map[string]*Index, len(t.indexesByName)),
 		indexesByColID:   make(map[uint32][]*Index, len(t.indexesByColID)),
+		fullTextIndexes:  make(map[uint32]*Index, len(t.fullTextIndexes)),
 		checkConstraints: make(map[string]CheckConstraint, len(t.checkConstraints)),
+		foreignKeys:      make(map[string]*ForeignKey, len(t.foreignKeys)),
+		grants:           t.grants, // replaced by setGrants, never modified in place
+		policies:         make(map[string]*Policy, len(t.policies)),
+		matView:          t.matView, // replaced on refresh, never modified in place
+		triggers:         make(map[string]*Trigger, len(t.triggers)),
+		stats:            t.stats, // replaced by ANALYZE, never modified in place
+		columnMigrations: slices.Clone(t.columnMigrations),
+		partitioning:     t.partitioning, // never modified once created
+		partitionOf:      t.partitionOf,  // removed on detach, never modified in place
+	}
+
+	for name, tr := range t.triggers {
+		// Trigger is never mutated once created; share the pointer.
+		nt.triggers[name] = tr
+	}
+
+	for name, p := range t.policies {
+		// Policy is never mutated once created; share the pointer.
+		nt.policies[name] = p
 	}
 
 	for name, cc := range t.checkConstraints {
@@ -898,6 +1241,11 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 		nt.checkConstraints[name] = cc
 	}
 
+	for name, fk := range t.foreignKeys {
+		// ForeignKey is never mutated once created; share the pointer.
+		nt.foreignKeys[name] = fk
+	}
+
 	for _, c := range t.cols {
 		nc := *c
 		nc.table = nt
@@ -907,6 +1255,16 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 		nt.colsByName[nc.colName] = &nc
 	}
 
+	if len(t.expCols) > 0 {
+		nt.expCols = make(map[uint32]*Column, len(t.expCols))
+	}
+	for _, c := range t.expCols {
+		nc := *c
+		nc.table = nt
+		// nc.exp is immutable after parsing
+		nt.expCols[nc.id] = &nc
+	}
+
 	for _, idx := range t.indexes {
 		ni := &Index{
 			id:        idx.id,
@@ -917,10 +1275,10 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 			colsByID:  make(map[uint32]*Column, len(idx.colsByID)),
 		}
 		for i, c := range idx.cols {
-			ni.cols[i] = nt.colsByID[c.id]
+			ni.cols[i], _ = nt.indexColumnByID(c.id)
 		}
 		for id := range idx.colsByID {
-			ni.colsByID[id] = nt.colsByID[id]
+			ni.colsByID[id], _ = nt.indexColumnByID(id)
 		}
 		nt.indexes = append(nt.indexes, ni)
 		nt.indexesByName[ni.Name()] = ni
@@ -929,6 +1287,18 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 		}
 	}
 
+	for colID, idx := range t.fullTextIndexes {
+		col := nt.colsByID[colID]
+
+		nt.fullTextIndexes[colID] = &Index{
+			id:       idx.id,
+			table:    nt,
+			fullText: true,
+			cols:     []*Column{col},
+			colsByID: map[uint32]*Column{colID: col},
+		}
+	}
+
 	// Rebuild indexesByColID from the cloned indexes; it mirrors the source's
 	// mapping from column-id → list of indexes that reference that column.
 	for _, ni := range nt.indexes {
@@ -941,9 +1311,21 @@ func cloneTable(t *Table, newCatalog *Catalog) *Table {
 }
 
 func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copyToTx bool) error {
+	schemas, err := loadSchemas(ctx, tx, catlg.enginePrefix, copyToTx)
+	if err != nil {
+		return err
+	}
+	catlg.schemas = schemas
+
+	routines, err := loadRoutines(ctx, tx, catlg.enginePrefix, copyToTx)
+	if err != nil {
+		return err
+	}
+	catlg.routines = routines
+
 	prefix := MapKey(catlg.enginePrefix, catalogTablePrefix, EncodeID(1))
 
-	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+	err = iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
 		dbID, tableID, err := unmapTableID(catlg.enginePrefix, key)
 		if err != nil {
 			return err
@@ -977,13 +1359,66 @@ func (catlg *Catalog) loadCatalog(ctx context.Context, tx *store.OngoingTx, copy
 			return ErrCorruptedData
 		}
 
+		table.foreignKeys, err = loadForeignKeys(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.grants, err = loadTableGrants(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.policies, err = loadPolicies(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.matView, err = loadMaterializedView(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.triggers, err = loadTriggers(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.stats, err = loadTableStats(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
+		table.columnMigrations, err = loadColumnMigrations(ctx, dbID, tableID, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+		table.applyColumnMigrations()
+
+		table.partitioning, table.partitionOf, err = loadPartitioning(ctx, dbID, table, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
+
 		if copyToTx {
 			if err := tx.Set(key, nil, value); err != nil {
 				return err
 			}
 		}
+
+		err = loadIndexExpColumns(ctx, dbID, table, tx, catlg.enginePrefix, copyToTx)
+		if err != nil {
+			return err
+		}
 		return table.loadIndexes(ctx, catlg.enginePrefix, tx, copyToTx)
 	})
+	if err != nil {
+		return err
+	}
+
+	catlg.refreshAllPartitions()
+
+	return nil
 }
 
 func loadMaxPK(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, table *Table) ([]byte, error) {
@@ -1055,7 +1490,7 @@ func loadColSpec(sqlPrefix, key, value []byte, tableID uint32) (*ColSpec, uint32
 	var colName string
 	var defaultValue ValueExp
 
-	if flags&hasDefaultFlag != 0 && len(value) >= 7 {
+	if flags&(hasDefaultFlag|generatedFlag) != 0 && len(value) >= 7 {
 		// New format: {flags(1)}{maxLen(4)}{colNameLen(2)}{colName}{defaultSQL}
 		colNameLen := int(binary.BigEndian.Uint16(value[5:]))
 		if len(value) < 7+colNameLen {
@@ -1081,14 +1516,26 @@ func loadColSpec(sqlPrefix, key, value []byte, tableID uint32) (*ColSpec, uint32
 		colName = string(value[5:])
 	}
 
-	return &ColSpec{
+	spec := &ColSpec{
 		colName:       colName,
 		colType:       colType,
 		maxLen:        maxLen,
 		autoIncrement: flags&autoIncrementFlag != 0,
 		notNull:       flags&nullableFlag != 0,
 		defaultValue:  defaultValue,
-	}, colID, nil
+	}
+
+	if flags&generatedFlag != 0 {
+		if defaultValue == nil {
+			return nil, 0, ErrCorruptedData
+		}
+
+		spec.defaultValue = nil
+		spec.generated = defaultValue
+		spec.virtual = flags&virtualFlag != 0
+	}
+
+	return spec, colID, nil
 }
 
 func loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]CheckConstraint, error) {
@@ -1114,6 +1561,29 @@ func loadCheckConstraints(ctx context.Context, dbID, tableID uint32, tx *store.O
 	return checks, err
 }
 
+func loadForeignKeys(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]*ForeignKey, error) {
+	prefix := MapKey(sqlPrefix, catalogForeignKeyPrefix, EncodeID(dbID), EncodeID(tableID))
+	fks := make(map[string]*ForeignKey)
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		fk, err := parseForeignKey(sqlPrefix, key, value)
+		if err != nil {
+			return err
+		}
+		fks[fk.name] = fk
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return fks, err
+}
+
 func (table *Table) loadIndexes(ctx context.Context, sqlPrefix []byte, tx *store.OngoingTx, copyToTx bool) error {
 	prefix := MapKey(sqlPrefix, catalogIndexPrefix, EncodeID(1), EncodeID(table.id))
 
@@ -1154,7 +1624,16 @@ func (table *Table) loadIndexes(ctx context.Context, sqlPrefix []byte, tx *store
 				colIDs = append(colIDs, colID)
 			}
 
-			index, err := table.newIndex(value[0] > 0, colIDs)
+			var index *Index
+
+			if value[0]&fullTextIndexFlag != 0 {
+				if len(colIDs) != 1 {
+					return ErrCorruptedData
+				}
+				index, err = table.newFullTextIndex(colIDs[0])
+			} else {
+				index, err = table.newIndex(value[0]&uniqueIndexFlag != 0, colIDs)
+			}
 			if err != nil {
 				return err
 			}
@@ -1226,6 +1705,71 @@ func parseCheckConstraint(prefix, key, value []byte) (*CheckConstraint, error) {
 	}, nil
 }
 
+func unmapForeignKeyID(prefix, mkey []byte) (uint32, error) {
+	encID, err := trimPrefix(prefix, mkey, []byte(catalogForeignKeyPrefix))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(encID) != 3*EncIDLen {
+		return 0, ErrCorruptedData
+	}
+	return binary.BigEndian.Uint32(encID[2*EncIDLen:]), nil
+}
+
+// parseForeignKey decodes a value written by persistForeignKey:
+// {nameLen-1}{name}{onDelete}{onUpdate}{refTableID}{colCount}{colID}*{refColID}*
+func parseForeignKey(prefix, key, value []byte) (*ForeignKey, error) {
+	id, err := unmapForeignKeyID(prefix, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(value) < 1 {
+		return nil, ErrCorruptedData
+	}
+
+	nameLen := int(value[0]) + 1
+	if len(value) < 1+nameLen+2+EncIDLen+1 {
+		return nil, ErrCorruptedData
+	}
+
+	name := string(value[1 : 1+nameLen])
+	off := 1 + nameLen
+
+	onDelete := ReferentialAction(value[off])
+	onUpdate := ReferentialAction(value[off+1])
+	off += 2
+
+	refTableID := binary.BigEndian.Uint32(value[off:])
+	off += EncIDLen
+
+	colCount := int(value[off])
+	off++
+
+	if colCount == 0 || len(value) != off+2*colCount*EncIDLen {
+		return nil, ErrCorruptedData
+	}
+
+	colIDs := make([]uint32, colCount)
+	refColIDs := make([]uint32, colCount)
+
+	for i := 0; i < colCount; i++ {
+		colIDs[i] = binary.BigEndian.Uint32(value[off+i*EncIDLen:])
+		refColIDs[i] = binary.BigEndian.Uint32(value[off+(colCount+i)*EncIDLen:])
+	}
+
+	return &ForeignKey{
+		id:         id,
+		name:       name,
+		colIDs:     colIDs,
+		refTableID: refTableID,
+		refColIDs:  refColIDs,
+		onDelete:   onDelete,
+		onUpdate:   onUpdate,
+	}, nil
+}
+
 func unmapColSpec(prefix, mkey []byte) (dbID, tableID, colID uint32, colType SQLValueType, err error) {
 	encID, err := trimPrefix(prefix, mkey, []byte(catalogColumnPrefix))
 	if err != nil {
@@ -1252,14 +1796,21 @@ func asType(t string) (SQLValueType, error) {
 	switch t {
 	case IntegerType,
 		Float64Type,
+		DecimalType,
 		BooleanType,
 		VarcharType,
 		UUIDType,
 		BLOBType,
 		TimestampType,
+		DateType,
+		IntervalType,
 		JSONType:
 		return t, nil
 	}
+
+	if IsArrayType(t) && isArrayElemType(ArrayElemType(t)) {
+		return t, nil
+	}
 	return t, ErrCorruptedData
 }
 
@@ -1492,7 +2043,7 @@ func EncodeRawValueAsKey(val interface{}, colType SQLValueType, maxLen int) ([]b
 
 			return encv, 16, nil
 		}
-	case TimestampType:
+	case TimestampType, DateType:
 		{
 			if maxLen != 8 {
 				return nil, 0, ErrCorruptedData
@@ -1543,6 +2094,27 @@ func EncodeRawValueAsKey(val interface{}, colType SQLValueType, maxLen int) ([]b
 
 			return encv[:], 8, nil
 		}
+	case DecimalType:
+		{
+			if maxLen != decimalKeyLen {
+				return nil, 0, ErrCorruptedData
+			}
+
+			decVal, ok := convVal.(*Decimal)
+			if !ok {
+				return nil, 0, fmt.Errorf("value is not a decimal: %w", ErrInvalidValue)
+			}
+
+			encv := make([]byte, 1+decimalKeyLen)
+			encv[0] = KeyValPrefixNotNull
+
+			err := decVal.encodeAsKey(encv[1:])
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return encv, decimalKeyLen, nil
+		}
 	}
 
 	return nil, 0, ErrInvalidValue
@@ -1637,7 +2209,7 @@ func DecodeValueFromKey(buf []byte, colType SQLValueType, maxLen int) (TypedValu
 		copy(u[:], buf[1:17])
 		return &UUID{val: u}, 17, nil
 
-	case TimestampType:
+	case TimestampType, DateType:
 		if maxLen != 8 {
 			return nil, 0, ErrCorruptedData
 		}
@@ -1648,6 +2220,9 @@ func DecodeValueFromKey(buf []byte, colType SQLValueType, maxLen int) (TypedValu
 		copy(raw[:], buf[1:9])
 		raw[0] ^= 0x80
 		nanos := int64(binary.BigEndian.Uint64(raw[:]))
+		if colType == DateType {
+			return NewDate(time.Unix(0, nanos)), 9, nil
+		}
 		return &Timestamp{val: time.Unix(0, nanos).UTC()}, 9, nil
 
 	case Float64Type:
@@ -1672,6 +2247,19 @@ func DecodeValueFromKey(buf []byte, colType SQLValueType, maxLen int) (TypedValu
 		}
 		bits := binary.BigEndian.Uint64(raw[:])
 		return &Float64{val: math.Float64frombits(bits)}, 9, nil
+
+	case DecimalType:
+		if maxLen != decimalKeyLen {
+			return nil, 0, ErrCorruptedData
+		}
+		if len(buf) < 1+decimalKeyLen {
+			return nil, 0, ErrCorruptedData
+		}
+		d, err := decodeDecimalKey(buf[1 : 1+decimalKeyLen])
+		if err != nil {
+			return nil, 0, err
+		}
+		return d, 1 + decimalKeyLen, nil
 	}
 
 	return nil, 0, ErrInvalidValue
@@ -1727,6 +2315,14 @@ func EncodeRawValue(val interface{}, colType SQLValueType, maxLen int, nullable
 		return encv, nil
 	}
 
+	if IsArrayType(colType) {
+		vals, ok := convVal.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("value is not an array: %w", ErrInvalidValue)
+		}
+		return encodeArrayValue(vals, ArrayElemType(colType), maxLen)
+	}
+
 	switch colType {
 	case VarcharType:
 		{
@@ -1830,7 +2426,7 @@ func EncodeRawValue(val interface{}, colType SQLValueType, maxLen int, nullable
 
 			return encv[:], nil
 		}
-	case TimestampType:
+	case TimestampType, DateType:
 		{
 			timeVal, ok := convVal.(time.Time)
 			if !ok {
@@ -1858,6 +2454,25 @@ func EncodeRawValue(val interface{}, colType SQLValueType, maxLen int, nullable
 
 			return encv[:], nil
 		}
+	case DecimalType:
+		{
+			decVal, ok := convVal.(*Decimal)
+			if !ok {
+				return nil, fmt.Errorf("value is not a decimal: %w", ErrInvalidValue)
+			}
+
+			// len(v) + v
+			return encodeDecimalValue(decVal), nil
+		}
+	case IntervalType:
+		{
+			ivVal, ok := convVal.(*Interval)
+			if !ok {
+				return nil, fmt.Errorf("value is not an interval: %w", ErrInvalidValue)
+			}
+
+			return encodeIntervalValue(ivVal), nil
+		}
 	}
 
 	return nil, ErrInvalidValue
@@ -1896,6 +2511,14 @@ func decodeValue(b []byte, colType SQLValueType, nullable bool) (TypedValue, int
 		return &NullValue{t: colType}, voff, nil
 	}
 
+	if IsArrayType(colType) {
+		v, err := decodeArrayValue(b[voff:voff+vlen], ArrayElemType(colType))
+		if err != nil {
+			return nil, 0, err
+		}
+		return v, voff + vlen, nil
+	}
+
 	switch colType {
 	case VarcharType:
 		{
@@ -1969,6 +2592,26 @@ func decodeValue(b []byte, colType SQLValueType, nullable bool) (TypedValue, int
 
 			return &Timestamp{val: TimeFromInt64(int64(v))}, voff, nil
 		}
+	case DateType:
+		{
+			if vlen != 8 {
+				return nil, 0, ErrCorruptedData
+			}
+
+			v := binary.BigEndian.Uint64(b[voff:])
+			voff += vlen
+
+			return &Date{val: TimeFromInt64(int64(v))}, voff, nil
+		}
+	case IntervalType:
+		{
+			v, err := decodeIntervalValue(b[voff : voff+vlen])
+			if err != nil {
+				return nil, 0, err
+			}
+			voff += vlen
+			return v, voff, nil
+		}
 	case Float64Type:
 		{
 			if vlen != 8 {
@@ -1978,6 +2621,15 @@ func decodeValue(b []byte, colType SQLValueType, nullable bool) (TypedValue, int
 			voff += vlen
 			return &Float64{val: math.Float64frombits(v)}, voff, nil
 		}
+	case DecimalType:
+		{
+			v, err := decodeDecimalValue(b[voff : voff+vlen])
+			if err != nil {
+				return nil, 0, err
+			}
+			voff += vlen
+			return v, voff, nil
+		}
 	}
 
 	return nil, 0, ErrCorruptedData
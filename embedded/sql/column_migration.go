@@ -0,0 +1,961 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// columnMigrationsTable is the system table exposing the migrations
+// started by ALTER TABLE ... ALTER COLUMN ... TYPE, both the running and
+// the finished ones.
+const columnMigrationsTable = "immudb_column_migrations"
+
+// maxColumnMigrationErrLen bounds the error message kept in the record of a
+// failed migration.
+const maxColumnMigrationErrLen = 1024
+
+// maxColumnMigrationRetries bounds the number of times a step of a migration
+// conflicting with concurrent transactions is retried, waiting twice as long
+// as the previous time, starting from columnMigrationRetryDelay.
+const (
+	maxColumnMigrationRetries = 10
+	columnMigrationRetryDelay = 10 * time.Millisecond
+)
+
+var errColumnMigrationInactive = errors.New("column migration is no longer running")
+
+type columnMigrationState byte
+
+const (
+	columnMigrationRunning columnMigrationState = iota
+	columnMigrationCompleted
+	columnMigrationFailed
+)
+
+func (s columnMigrationState) String() string {
+	switch s {
+	case columnMigrationRunning:
+		return "running"
+	case columnMigrationCompleted:
+		return "completed"
+	}
+	return "failed"
+}
+
+// columnMigration is the persisted record of a column type change.
+//
+// Rows keep the value of the column encoded with its old type under colID,
+// while the converted value is written under shadowID, a column id reserved
+// for the migration which readers skip as if it belonged to a dropped
+// column. Once every row has been rewritten, shadowID takes the place of
+// colID in the catalog within a single transaction, which also marks the
+// record as completed. Records are regular catalog entries and are never
+// removed, so the history of type changes is part of the verifiable state
+// of the database.
+type columnMigration struct {
+	colID    uint32
+	shadowID uint32
+
+	colName  string
+	fromType SQLValueType
+	toType   SQLValueType
+	typeMod  int
+	using    ValueExp // nil converts the value of the column itself
+
+	state       columnMigrationState
+	startedAt   time.Time
+	completedAt time.Time
+	err         string
+}
+
+// id identifies the migration across tables, shadow ids being unique only
+// within a table.
+func (m *columnMigration) id(tableID uint32) int64 {
+	return int64(tableID)<<32 | int64(m.shadowID)
+}
+
+func (m *columnMigration) maxLen() int {
+	c := &Column{colType: m.toType, maxLen: m.typeMod}
+	return c.MaxLen()
+}
+
+// conversion returns the expression computing the converted value of col.
+func (m *columnMigration) conversion(table *Table, col *Column) ValueExp {
+	val := m.using
+	if val == nil {
+		val = &ColSelector{table: table.name, col: col.colName}
+	}
+	return &Cast{val: val, t: m.toType, typeMod: m.typeMod}
+}
+
+func (m *columnMigration) finished(state columnMigrationState, at time.Time, cause error) *columnMigration {
+	fm := *m
+	fm.state = state
+	fm.completedAt = at
+
+	if cause != nil {
+		fm.err = cause.Error()
+		if len(fm.err) > maxColumnMigrationErrLen {
+			fm.err = fm.err[:maxColumnMigrationErrLen]
+		}
+	}
+	return &fm
+}
+
+// activeColumnMigration returns the migration currently rewriting a column
+// of the table, if any.
+func (t *Table) activeColumnMigration() *columnMigration {
+	for _, m := range t.columnMigrations {
+		if m.state == columnMigrationRunning {
+			return m
+		}
+	}
+	return nil
+}
+
+// applyColumnMigrations moves the columns whose type was changed back to
+// the position of the column they replaced, the catalog being loaded in
+// column id order.
+func (t *Table) applyColumnMigrations() {
+	ord := make(map[uint32]uint32, len(t.columnMigrations))
+	for _, m := range t.columnMigrations {
+		if m.state != columnMigrationCompleted {
+			continue
+		}
+
+		pos, ok := ord[m.colID]
+		if !ok {
+			pos = m.colID
+		}
+		ord[m.shadowID] = pos
+	}
+
+	if len(ord) == 0 {
+		return
+	}
+
+	position := func(c *Column) uint32 {
+		if pos, ok := ord[c.id]; ok {
+			return pos
+		}
+		return c.id
+	}
+
+	sort.SliceStable(t.cols, func(i, j int) bool {
+		return position(t.cols[i]) < position(t.cols[j])
+	})
+}
+
+// colPosition returns the position of the column in the table, or -1.
+func (t *Table) colPosition(colID uint32) int {
+	for i, c := range t.cols {
+		if c.id == colID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (tx *SQLTx) alterColumnType(ctx context.Context, table *Table, col *Column, t SQLValueType, typeMod int, using ValueExp) error {
+	if table.systemScan != nil || table.matView != nil {
+		return fmt.Errorf("%w: %s can not be altered", ErrCannotAlterColumnType, table.name)
+	}
+
+	if m := table.activeColumnMigration(); m != nil {
+		return fmt.Errorf("%w: the type of column %s of table %s is already being changed", ErrCannotAlterColumnType, m.colName, table.name)
+	}
+
+	if using == nil && col.colType == t && col.maxLen == typeMod {
+		return nil
+	}
+
+	m := &columnMigration{
+		colID:     col.id,
+		shadowID:  table.maxColID + 1,
+		colName:   col.colName,
+		fromType:  col.colType,
+		toType:    t,
+		typeMod:   typeMod,
+		using:     using,
+		state:     columnMigrationRunning,
+		startedAt: tx.Timestamp(),
+	}
+
+	err := validateColumnTypeChange(table, col, m)
+	if err != nil {
+		return err
+	}
+
+	// the id of the shadow column is reserved as if it had belonged to a
+	// dropped column, so it is not taken by columns added in the meantime
+	md := store.NewKVMetadata()
+	md.AsDeleted(true)
+
+	err = tx.set(MapKey(
+		tx.sqlPrefix(),
+		catalogColumnPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		EncodeID(m.shadowID),
+		[]byte(m.toType),
+	), md, nil)
+	if err != nil {
+		return err
+	}
+
+	err = persistColumnMigration(tx, table, m)
+	if err != nil {
+		return err
+	}
+
+	err = persistColumnMigrationProgress(tx, table.id, m.shadowID, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	table.maxColID = m.shadowID
+	table.columnMigrations = append(table.columnMigrations, m)
+
+	tableID := table.id
+
+	return tx.addOnCommittedCallback(func(tx *SQLTx) error {
+		tx.engine.startColumnMigration(tableID, m.shadowID)
+		return nil
+	})
+}
+
+// validateColumnTypeChange checks the column can be switched to the type
+// of the migration. Values are converted when rows are rewritten, so only
+// the definitions depending on the column are checked here.
+func validateColumnTypeChange(table *Table, col *Column, m *columnMigration) error {
+	if col.autoIncrement || col.generated != nil {
+		return fmt.Errorf("%w: column %s is auto incremental or generated", ErrCannotAlterColumnType, col.colName)
+	}
+
+	if !validMaxLenForType(m.typeMod, m.toType) {
+		return fmt.Errorf("%w (%s)", ErrLimitedMaxLen, col.colName)
+	}
+
+	if len(table.indexesByColID[col.id]) > 0 || table.fullTextIndexes[col.id] != nil {
+		return fmt.Errorf("%w: column %s is indexed", ErrCannotAlterColumnType, col.colName)
+	}
+
+	for _, expCol := range table.expCols {
+		if expRefersToColumn(expCol.exp, col.colName) {
+			return fmt.Errorf("%w: column %s is indexed", ErrCannotAlterColumnType, col.colName)
+		}
+	}
+
+	for _, g := range table.grants {
+		if containsColID(g.colIDs, col.id) {
+			return fmt.Errorf("%w: column %s has column privileges", ErrCannotAlterColumnType, col.colName)
+		}
+	}
+
+	for _, fk := range table.foreignKeys {
+		if containsColID(fk.colIDs, col.id) {
+			return fmt.Errorf("%w: column %s is part of foreign key %s", ErrCannotAlterColumnType, col.colName, fk.name)
+		}
+	}
+
+	for _, t := range table.catalog.GetTables() {
+		for _, fk := range t.foreignKeys {
+			if fk.refTableID == table.id && containsColID(fk.refColIDs, col.id) {
+				return fmt.Errorf("%w: column %s is referenced by foreign key %s", ErrCannotAlterColumnType, col.colName, fk.name)
+			}
+		}
+	}
+
+	cols := make(map[string]ColDescriptor, len(table.cols))
+	for _, c := range table.cols {
+		desc := ColDescriptor{Table: table.name, Column: c.colName, Type: c.colType}
+		cols[desc.Selector()] = desc
+	}
+
+	srcType := col.colType
+	if m.using != nil {
+		t, err := m.using.inferType(cols, make(map[string]SQLValueType), table.name)
+		if err != nil {
+			return err
+		}
+		srcType = t
+	}
+
+	if srcType != AnyType {
+		if _, err := getConverter(srcType, m.toType); err != nil {
+			return fmt.Errorf("%w: %s can not be converted to %s: %v", ErrCannotAlterColumnType, srcType, m.toType, err)
+		}
+	}
+
+	if col.defaultValue != nil {
+		_, err := (&Cast{val: col.defaultValue, t: m.toType, typeMod: m.typeMod}).inferType(cols, make(map[string]SQLValueType), table.name)
+		if err != nil {
+			return fmt.Errorf("%w: default value of column %s: %v", ErrCannotAlterColumnType, col.colName, err)
+		}
+	}
+
+	desc := ColDescriptor{Table: table.name, Column: col.colName, Type: m.toType}
+	cols[desc.Selector()] = desc
+
+	for name, check := range table.checkConstraints {
+		err := check.exp.requiresType(BooleanType, cols, make(map[string]SQLValueType), table.name)
+		if err != nil {
+			return fmt.Errorf("%w: %s constraint requires column %s: %v", ErrCannotAlterColumnType, name, col.colName, err)
+		}
+	}
+
+	for name, policy := range table.policies {
+		err := policy.exp.requiresType(BooleanType, cols, make(map[string]SQLValueType), table.name)
+		if err != nil {
+			return fmt.Errorf("%w: %s policy requires column %s: %v", ErrCannotAlterColumnType, name, col.colName, err)
+		}
+	}
+
+	return nil
+}
+
+func expRefersToColumn(exp ValueExp, colName string) bool {
+	for _, sel := range exp.selectors() {
+		if c, ok := sel.(*ColSelector); ok && c.col == colName {
+			return true
+		}
+	}
+	return false
+}
+
+func containsColID(colIDs []uint32, colID uint32) bool {
+	for _, id := range colIDs {
+		if id == colID {
+			return true
+		}
+	}
+	return false
+}
+
+// migratedValue computes the value of the shadow column of a running
+// migration for a row being written.
+func (tx *SQLTx) migratedValue(table *Table, m *columnMigration, valuesByColID map[uint32]TypedValue) (TypedValue, error) {
+	col, ok := table.colsByID[m.colID]
+	if !ok {
+		return nil, nil
+	}
+
+	row := &Row{
+		ValuesByPosition: make([]TypedValue, len(table.cols)),
+		ValuesBySelector: make(map[string]TypedValue, len(table.cols)),
+	}
+
+	for i, c := range table.cols {
+		val, ok := valuesByColID[c.id]
+		if !ok {
+			val = &NullValue{t: c.colType}
+		}
+
+		row.ValuesByPosition[i] = val
+		row.ValuesBySelector[EncodeSelector("", table.name, c.colName)] = val
+	}
+
+	val, err := m.conversion(table, col).reduce(tx, row, table.name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: value of column %s can not be converted to %s: %v", ErrCannotAlterColumnType, col.colName, m.toType, err)
+	}
+
+	if val.IsNull() && col.notNull {
+		return nil, fmt.Errorf("%w: column %s is not nullable but is converted to NULL", ErrCannotAlterColumnType, col.colName)
+	}
+
+	return val, nil
+}
+
+// startColumnMigration rewrites, in the background, the rows of the table
+// not yet holding the value of the shadow column of the migration. Calls
+// for a migration already being processed, or made once the engine is
+// closed, are ignored.
+func (e *Engine) startColumnMigration(tableID, shadowID uint32) {
+	key := int64(tableID)<<32 | int64(shadowID)
+
+	e.migrationsMu.Lock()
+	defer e.migrationsMu.Unlock()
+
+	if e.migrationsCtx.Err() != nil {
+		return
+	}
+
+	if _, running := e.runningMigrations[key]; running {
+		return
+	}
+
+	if e.runningMigrations == nil {
+		e.runningMigrations = make(map[int64]struct{})
+	}
+	e.runningMigrations[key] = struct{}{}
+
+	e.migrationsWg.Add(1)
+
+	go func() {
+		defer func() {
+			e.migrationsMu.Lock()
+			delete(e.runningMigrations, key)
+			e.migrationsMu.Unlock()
+
+			e.migrationsWg.Done()
+		}()
+
+		e.runColumnMigration(e.migrationsCtx, tableID, shadowID)
+	}()
+}
+
+// resumeColumnMigrations restarts the migrations interrupted while running,
+// e.g. by a restart.
+func (e *Engine) resumeColumnMigrations(ctx context.Context) error {
+	tx, err := e.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+	if err != nil {
+		return err
+	}
+	defer tx.Cancel()
+
+	for _, table := range tx.catalog.GetTables() {
+		if m := table.activeColumnMigration(); m != nil {
+			e.startColumnMigration(table.id, m.shadowID)
+		}
+	}
+	return nil
+}
+
+// stopColumnMigrations cancels the migrations running in the background and
+// prevents new ones from being started.
+func (e *Engine) stopColumnMigrations() {
+	e.migrationsMu.Lock()
+	defer e.migrationsMu.Unlock()
+
+	e.stopMigrations()
+}
+
+// waitForColumnMigrations blocks until the background migrations complete.
+func (e *Engine) waitForColumnMigrations() {
+	e.migrationsWg.Wait()
+}
+
+// waitForColumnMigrationRetry sleeps before the given retry of a migration
+// step, doubling the delay on each one. It returns false when ctx is
+// cancelled in the meantime.
+func waitForColumnMigrationRetry(ctx context.Context, retry int) bool {
+	t := time.NewTimer(columnMigrationRetryDelay << retry)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// runColumnMigration rewrites the rows of the table batch by batch. Batches
+// conflicting with concurrent transactions are retried a bounded number of
+// times; any other error is recorded as the cause of the failure of the
+// migration. When ctx is cancelled, i.e. the engine is closed, the migration
+// is left running, to be resumed the next time an engine is created.
+func (e *Engine) runColumnMigration(ctx context.Context, tableID, shadowID uint32) {
+	retries := 0
+
+	for {
+		done, err := e.migrateColumnBatch(ctx, tableID, shadowID)
+		if ctx.Err() != nil || errors.Is(err, errColumnMigrationInactive) {
+			return
+		}
+		if errors.Is(err, store.ErrTxReadConflict) && retries < maxColumnMigrationRetries {
+			// a concurrent transaction updated some of the rows
+			if !waitForColumnMigrationRetry(ctx, retries) {
+				return
+			}
+			retries++
+			continue
+		}
+		if err != nil {
+			e.finishColumnMigration(ctx, tableID, shadowID, err)
+			return
+		}
+
+		if done {
+			break
+		}
+
+		retries = 0
+	}
+
+	e.finishColumnMigration(ctx, tableID, shadowID, nil)
+}
+
+// migrateColumnBatch rewrites, within a single transaction, the next batch
+// of rows in primary key order. Rewriting a row stores the converted value
+// under the shadow column, as any other write made while the migration is
+// running does.
+func (e *Engine) migrateColumnBatch(ctx context.Context, tableID, shadowID uint32) (done bool, err error) {
+	tx, err := e.NewTx(ctx, DefaultTxOptions())
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if !tx.Closed() {
+			tx.Cancel()
+		}
+	}()
+
+	table, err := tx.catalog.GetTableByID(tableID)
+	if err != nil {
+		return false, errColumnMigrationInactive
+	}
+
+	m := table.activeColumnMigration()
+	if m == nil || m.shadowID != shadowID {
+		return false, errColumnMigrationInactive
+	}
+
+	rows, lastKey, err := loadColumnMigrationProgress(ctx, tx.tx, e.prefix, tableID, shadowID)
+	if err != nil {
+		return false, err
+	}
+
+	n, lastKey, err := tx.rewriteColumnBatch(ctx, table, lastKey, e.columnMigrationBatchSize)
+	if err != nil {
+		return false, err
+	}
+
+	if n == 0 {
+		return true, nil
+	}
+
+	err = persistColumnMigrationProgress(tx, tableID, shadowID, rows+uint64(n), lastKey)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return n < e.columnMigrationBatchSize, nil
+}
+
+// rewriteColumnBatch upserts up to batchSize rows following lastKey and
+// returns the number of rows rewritten along with the key of the last one.
+// The row reader is closed before returning, so the transaction can be
+// committed.
+func (tx *SQLTx) rewriteColumnBatch(ctx context.Context, table *Table, lastKey []byte, batchSize int) (int, []byte, error) {
+	rowReader, err := newRawRowReader(tx, nil, table, period{}, "", &ScanSpecs{Index: table.primaryIndex, seekAfter: lastKey})
+	if err != nil {
+		return 0, nil, err
+	}
+	defer rowReader.Close()
+
+	n := 0
+	for ; n < batchSize; n++ {
+		row, err := rowReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+
+		valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+		for _, col := range table.cols {
+			valuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		lastKey = MapKey(tx.engine.prefix, MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals, pkEncVals)
+	}
+
+	return n, lastKey, nil
+}
+
+// finishColumnMigration switches the column to its new type once every row
+// has been rewritten, or records why the migration failed. Both the catalog
+// switch and the record update are committed within a single transaction.
+// A column which can not be switched is recorded as failed. When not even
+// the failure can be recorded, the migration is left running, to be resumed
+// the next time an engine is created.
+func (e *Engine) finishColumnMigration(ctx context.Context, tableID, shadowID uint32, cause error) {
+	retries := 0
+
+	for {
+		err := e.tryFinishColumnMigration(ctx, tableID, shadowID, cause)
+		if err == nil || ctx.Err() != nil || errors.Is(err, errColumnMigrationInactive) {
+			return
+		}
+		if errors.Is(err, store.ErrTxReadConflict) && retries < maxColumnMigrationRetries {
+			if !waitForColumnMigrationRetry(ctx, retries) {
+				return
+			}
+			retries++
+			continue
+		}
+		if cause != nil {
+			return
+		}
+
+		cause = err
+		retries = 0
+	}
+}
+
+func (e *Engine) tryFinishColumnMigration(ctx context.Context, tableID, shadowID uint32, cause error) error {
+	tx, err := e.NewTx(ctx, DefaultTxOptions())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if !tx.Closed() {
+			tx.Cancel()
+		}
+	}()
+
+	table, err := tx.catalog.GetTableByID(tableID)
+	if err != nil {
+		return errColumnMigrationInactive
+	}
+
+	m := table.activeColumnMigration()
+	if m == nil || m.shadowID != shadowID {
+		return errColumnMigrationInactive
+	}
+
+	if cause != nil {
+		err = persistColumnMigration(tx, table, m.finished(columnMigrationFailed, tx.Timestamp(), cause))
+		if err != nil {
+			return err
+		}
+
+		tx.mutatedCatalog = true
+
+		return tx.Commit(ctx)
+	}
+
+	col, err := table.GetColumnByID(m.colID)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCannotAlterColumnType, err)
+	}
+
+	err = validateColumnTypeChange(table, col, m)
+	if err != nil {
+		return err
+	}
+
+	migratedCol := *col
+	migratedCol.id = m.shadowID
+	migratedCol.colType = m.toType
+	migratedCol.maxLen = m.typeMod
+
+	if col.defaultValue != nil {
+		migratedCol.defaultValue = &Cast{val: col.defaultValue, t: m.toType, typeMod: m.typeMod}
+	}
+
+	err = persistColumn(tx, &migratedCol)
+	if err != nil {
+		return err
+	}
+
+	err = persistColumnDeletion(ctx, tx, col)
+	if err != nil {
+		return err
+	}
+
+	err = persistColumnMigration(tx, table, m.finished(columnMigrationCompleted, tx.Timestamp(), nil))
+	if err != nil {
+		return err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx.Commit(ctx)
+}
+
+func columnMigrationKey(sqlPrefix []byte, prefix string, tableID, shadowID uint32) []byte {
+	return MapKey(sqlPrefix, prefix, EncodeID(DatabaseID), EncodeID(tableID), EncodeID(shadowID))
+}
+
+func persistColumnMigration(tx *SQLTx, table *Table, m *columnMigration) error {
+	var usingSQL string
+	if m.using != nil {
+		usingSQL = m.using.String()
+	}
+
+	// {colID}{state}{typeMod}{startedAt}{completedAt}({len}{colName|fromType|toType|using|err})*
+	v := make([]byte, 0, EncIDLen+1+4+8+8+5*2+len(m.colName)+len(m.fromType)+len(m.toType)+len(usingSQL)+len(m.err))
+
+	v = binary.BigEndian.AppendUint32(v, m.colID)
+	v = append(v, byte(m.state))
+	v = binary.BigEndian.AppendUint32(v, uint32(m.typeMod))
+	v = binary.BigEndian.AppendUint64(v, uint64(TimeToInt64(m.startedAt)))
+
+	var completedAt int64
+	if !m.completedAt.IsZero() {
+		completedAt = TimeToInt64(m.completedAt)
+	}
+	v = binary.BigEndian.AppendUint64(v, uint64(completedAt))
+
+	for _, s := range []string{m.colName, string(m.fromType), string(m.toType), usingSQL, m.err} {
+		if len(s) > 0xFFFF {
+			return fmt.Errorf("%w: column migration of table %s", ErrMaxLengthExceeded, table.name)
+		}
+		v = binary.BigEndian.AppendUint16(v, uint16(len(s)))
+		v = append(v, s...)
+	}
+
+	return tx.set(columnMigrationKey(tx.sqlPrefix(), catalogMigrationPrefix, table.id, m.shadowID), nil, v)
+}
+
+func persistColumnMigrationProgress(tx *SQLTx, tableID, shadowID uint32, rows uint64, lastKey []byte) error {
+	// {rows}{lastKey}
+	v := make([]byte, 8, 8+len(lastKey))
+	binary.BigEndian.PutUint64(v, rows)
+	v = append(v, lastKey...)
+
+	return tx.set(columnMigrationKey(tx.sqlPrefix(), catalogMigrationProgressPrefix, tableID, shadowID), nil, v)
+}
+
+func loadColumnMigrations(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) ([]*columnMigration, error) {
+	prefix := MapKey(sqlPrefix, catalogMigrationPrefix, EncodeID(dbID), EncodeID(tableID))
+
+	var migrations []*columnMigration
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(key) != len(prefix)+EncIDLen {
+			return ErrCorruptedData
+		}
+
+		m, err := decodeColumnMigration(value)
+		if err != nil {
+			return err
+		}
+		m.shadowID = binary.BigEndian.Uint32(key[len(prefix):])
+
+		migrations = append(migrations, m)
+
+		if !copyToTx {
+			return nil
+		}
+
+		err = tx.Set(key, nil, value)
+		if err != nil {
+			return err
+		}
+
+		progressKey := columnMigrationKey(sqlPrefix, catalogMigrationProgressPrefix, tableID, m.shadowID)
+
+		progress, err := tx.Get(ctx, progressKey)
+		if errors.Is(err, store.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		v, err := progress.Resolve()
+		if err != nil {
+			return err
+		}
+		return tx.Set(progressKey, nil, v)
+	})
+	return migrations, err
+}
+
+func decodeColumnMigration(v []byte) (*columnMigration, error) {
+	const fixedLen = EncIDLen + 1 + 4 + 8 + 8
+
+	if len(v) < fixedLen {
+		return nil, ErrCorruptedData
+	}
+
+	m := &columnMigration{
+		colID:     binary.BigEndian.Uint32(v),
+		state:     columnMigrationState(v[EncIDLen]),
+		typeMod:   int(binary.BigEndian.Uint32(v[EncIDLen+1:])),
+		startedAt: TimeFromInt64(int64(binary.BigEndian.Uint64(v[EncIDLen+5:]))),
+	}
+
+	if completedAt := int64(binary.BigEndian.Uint64(v[EncIDLen+13:])); completedAt != 0 {
+		m.completedAt = TimeFromInt64(completedAt)
+	}
+
+	off := fixedLen
+
+	strs := make([]string, 5)
+	for i := range strs {
+		if len(v) < off+2 {
+			return nil, ErrCorruptedData
+		}
+
+		n := int(binary.BigEndian.Uint16(v[off:]))
+		off += 2
+
+		if len(v) < off+n {
+			return nil, ErrCorruptedData
+		}
+
+		strs[i] = string(v[off : off+n])
+		off += n
+	}
+
+	if off != len(v) {
+		return nil, ErrCorruptedData
+	}
+
+	m.colName = strs[0]
+	m.err = strs[4]
+
+	var err error
+
+	m.fromType, err = asType(strs[1])
+	if err != nil {
+		return nil, err
+	}
+
+	m.toType, err = asType(strs[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if strs[3] != "" {
+		m.using, err = ParseExpFromString(strs[3])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorruptedData, err)
+		}
+	}
+
+	return m, nil
+}
+
+func loadColumnMigrationProgress(ctx context.Context, tx *store.OngoingTx, sqlPrefix []byte, tableID, shadowID uint32) (rows uint64, lastKey []byte, err error) {
+	vref, err := tx.Get(ctx, columnMigrationKey(sqlPrefix, catalogMigrationProgressPrefix, tableID, shadowID))
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	v, err := vref.Resolve()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(v) < 8 {
+		return 0, nil, ErrCorruptedData
+	}
+
+	if len(v) > 8 {
+		lastKey = v[8:]
+	}
+
+	return binary.BigEndian.Uint64(v), lastKey, nil
+}
+
+func init() {
+	RegisterSystemTable(&SystemTableDef{
+		Name: columnMigrationsTable,
+		Columns: []SystemTableColumn{
+			{Name: "id", Type: IntegerType},
+			{Name: "table_name", Type: VarcharType},
+			{Name: "column_name", Type: VarcharType},
+			{Name: "from_type", Type: VarcharType},
+			{Name: "to_type", Type: VarcharType},
+			{Name: "using_exp", Type: VarcharType},
+			{Name: "state", Type: VarcharType},
+			{Name: "rows_rewritten", Type: IntegerType},
+			{Name: "started_at", Type: TimestampType},
+			{Name: "completed_at", Type: TimestampType},
+			{Name: "error", Type: VarcharType},
+		},
+		PKColumn: "id",
+		Scan:     scanColumnMigrations,
+	})
+}
+
+func scanColumnMigrations(ctx context.Context, tx *SQLTx) ([]*Row, error) {
+	var rows []*Row
+
+	for _, table := range tx.catalog.GetTables() {
+		for _, m := range table.columnMigrations {
+			rewritten, _, err := loadColumnMigrationProgress(ctx, tx.tx, tx.sqlPrefix(), table.id, m.shadowID)
+			if err != nil {
+				return nil, err
+			}
+
+			colName := m.colName
+			if col, err := table.GetColumnByID(m.colID); err == nil {
+				colName = col.colName
+			} else if col, err := table.GetColumnByID(m.shadowID); err == nil {
+				colName = col.colName
+			}
+
+			using := TypedValue(NewNull(VarcharType))
+			if m.using != nil {
+				using = NewVarchar(m.using.String())
+			}
+
+			completedAt := TypedValue(NewNull(TimestampType))
+			if !m.completedAt.IsZero() {
+				completedAt = &Timestamp{val: m.completedAt}
+			}
+
+			errMsg := TypedValue(NewNull(VarcharType))
+			if m.err != "" {
+				errMsg = NewVarchar(m.err)
+			}
+
+			rows = append(rows, &Row{ValuesByPosition: []TypedValue{
+				NewInteger(m.id(table.id)),
+				NewVarchar(table.name),
+				NewVarchar(colName),
+				NewVarchar(string(m.fromType)),
+				NewVarchar(string(m.toType)),
+				using,
+				NewVarchar(m.state.String()),
+				NewInteger(int64(rewritten)),
+				&Timestamp{val: m.startedAt},
+				completedAt,
+				errMsg,
+			}})
+		}
+	}
+	return rows, nil
+}
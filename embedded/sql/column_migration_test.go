@@ -0,0 +1,264 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlterColumnTypeStmt(t *testing.T) {
+	stmts, err := ParseSQLString("ALTER TABLE t ALTER COLUMN c TYPE DECIMAL(10,2) USING c * 2")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	stmt := stmts[0].(*AlterColumnStmt)
+	require.Equal(t, AlterColumnSetType, stmt.action)
+	require.Equal(t, DecimalType, stmt.newType)
+	require.NotZero(t, stmt.typeMod)
+	require.Equal(t, "(c * 2)", stmt.using.String())
+
+	stmts, err = ParseSQLString("ALTER TABLE t ALTER COLUMN c TYPE INTEGER")
+	require.NoError(t, err)
+	require.Nil(t, stmts[0].(*AlterColumnStmt).using)
+
+	_, err = ParseSQLString("ALTER TABLE t ALTER COLUMN c KIND INTEGER")
+	require.ErrorContains(t, err, "expected TYPE keyword")
+}
+
+func TestAlterColumnType(t *testing.T) {
+	opts := DefaultOptions().WithPrefix(sqlPrefix).WithColumnMigrationBatchSize(2)
+
+	engine, st := setupCommonTestWithEngineOptions(t, opts)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE t (id INTEGER AUTO_INCREMENT, amount VARCHAR, note VARCHAR[16], PRIMARY KEY id);
+		CREATE INDEX ON t(note);
+		INSERT INTO t (amount, note) VALUES ('1', 'a'), ('2', 'b'), ('3', 'c'), ('4', 'd'), (NULL, 'e');
+	`, nil)
+	require.NoError(t, err)
+
+	query := func(t *testing.T, e *Engine, sql string) [][]interface{} {
+		return queryValues(t, e, nil, sql, nil)
+	}
+
+	migrations := func(t *testing.T, e *Engine) [][]interface{} {
+		return query(t, e, "SELECT column_name, from_type, to_type, using_exp, state, rows_rewritten FROM immudb_column_migrations")
+	}
+
+	t.Run("unsupported changes", func(t *testing.T) {
+		_, _, err := engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN note TYPE INTEGER", nil)
+		require.ErrorIs(t, err, ErrCannotAlterColumnType)
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN id TYPE VARCHAR", nil)
+		require.ErrorIs(t, err, ErrCannotAlterColumnType)
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN amount TYPE UUID USING 1", nil)
+		require.ErrorIs(t, err, ErrCannotAlterColumnType)
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN amount TYPE VARCHAR", nil)
+		require.NoError(t, err)
+
+		require.Empty(t, migrations(t, engine))
+	})
+
+	t.Run("rewrite in batches", func(t *testing.T) {
+		// keep the background worker from starting, so batches are run
+		// one at a time
+		table, err := engine.Catalog(ctx, nil)
+		require.NoError(t, err)
+
+		tt, err := table.GetTableByName("t")
+		require.NoError(t, err)
+
+		key := int64(tt.id)<<32 | int64(tt.maxColID+1)
+		engine.runningMigrations = map[int64]struct{}{key: {}}
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN amount TYPE INTEGER", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN note TYPE VARCHAR[10]", nil)
+		require.ErrorIs(t, err, ErrCannotAlterColumnType)
+
+		done, err := engine.migrateColumnBatch(ctx, tt.id, tt.maxColID+1)
+		require.NoError(t, err)
+		require.False(t, done)
+
+		// the old encoding stays readable while rows are rewritten
+		require.Equal(t,
+			[][]interface{}{{int64(1), "1", "a"}, {int64(2), "2", "b"}, {int64(3), "3", "c"}, {int64(4), "4", "d"}, {int64(5), nil, "e"}},
+			query(t, engine, "SELECT * FROM t"),
+		)
+		require.Equal(t, [][]interface{}{{"amount", "VARCHAR", "INTEGER", nil, "running", int64(2)}}, migrations(t, engine))
+
+		// writes must be convertible to the new type
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO t (amount, note) VALUES ('x', 'f')", nil)
+		require.ErrorIs(t, err, ErrCannotAlterColumnType)
+
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO t (amount, note) VALUES ('6', 'f')", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(ctx, nil, "UPDATE t SET amount = '10' WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		for !done {
+			done, err = engine.migrateColumnBatch(ctx, tt.id, tt.maxColID+1)
+			require.NoError(t, err)
+		}
+
+		engine.finishColumnMigration(ctx, tt.id, tt.maxColID+1, nil)
+		delete(engine.runningMigrations, key)
+
+		expected := [][]interface{}{
+			{int64(1), int64(10), "a"},
+			{int64(2), int64(2), "b"},
+			{int64(3), int64(3), "c"},
+			{int64(4), int64(4), "d"},
+			{int64(5), nil, "e"},
+			{int64(6), int64(6), "f"},
+		}
+
+		require.Equal(t, expected, query(t, engine, "SELECT * FROM t"))
+		require.Equal(t, [][]interface{}{{"amount", "VARCHAR", "INTEGER", nil, "completed", int64(6)}}, migrations(t, engine))
+
+		require.Equal(t,
+			[][]interface{}{{int64(4)}, {int64(6)}, {int64(1)}},
+			query(t, engine, "SELECT id FROM t WHERE amount > 3 ORDER BY amount"),
+		)
+
+		// the catalog switch survives a reload from the store
+		reopened, err := NewEngine(st, opts)
+		require.NoError(t, err)
+		require.Equal(t, expected, query(t, reopened, "SELECT * FROM t"))
+
+		_, _, err = reopened.Exec(ctx, nil, "INSERT INTO t (amount, note) VALUES (7, 'g')", nil)
+		require.NoError(t, err)
+		require.Equal(t, [][]interface{}{{int64(7)}}, query(t, reopened, "SELECT amount FROM t WHERE note = 'g'"))
+	})
+
+	t.Run("background rewrite with using", func(t *testing.T) {
+		_, _, err := engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN amount TYPE FLOAT USING COALESCE(amount, 0) * 2", nil)
+		require.NoError(t, err)
+
+		engine.waitForColumnMigrations()
+
+		require.Equal(t,
+			[][]interface{}{{20.0}, {4.0}, {6.0}, {8.0}, {0.0}, {12.0}, {14.0}},
+			query(t, engine, "SELECT amount FROM t"),
+		)
+
+		rows := migrations(t, engine)
+		require.Len(t, rows, 2)
+		require.Equal(t, []interface{}{"amount", "INTEGER", "FLOAT", "(coalesce(amount,0) * 2)", "completed", int64(7)}, rows[1])
+	})
+
+	t.Run("failed conversion", func(t *testing.T) {
+		_, _, err := engine.Exec(ctx, nil, `
+			CREATE TABLE notes (id INTEGER, body VARCHAR, PRIMARY KEY id);
+			INSERT INTO notes (id, body) VALUES (1, '10'), (2, 'ten');
+			ALTER TABLE notes ALTER COLUMN body TYPE INTEGER;
+		`, nil)
+		require.NoError(t, err)
+
+		engine.waitForColumnMigrations()
+
+		require.Equal(t,
+			[][]interface{}{{"10"}, {"ten"}},
+			query(t, engine, "SELECT body FROM notes"),
+		)
+
+		rows, err := engine.queryAll(ctx, nil, "SELECT state, error FROM immudb_column_migrations WHERE table_name = 'notes'", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, "failed", rows[0].ValuesByPosition[0].RawValue())
+		require.Contains(t, rows[0].ValuesByPosition[1].RawValue(), "can not be converted to INTEGER")
+
+		// a failed migration no longer constrains writes
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO notes (id, body) VALUES (3, 'three')", nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestAlterColumnTypeResume(t *testing.T) {
+	opts := DefaultOptions().WithPrefix(sqlPrefix).WithColumnMigrationBatchSize(1)
+
+	engine, st := setupCommonTestWithEngineOptions(t, opts)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE t (id INTEGER, val INTEGER, PRIMARY KEY id);
+		INSERT INTO t (id, val) VALUES (1, 1), (2, 2), (3, 3);
+	`, nil)
+	require.NoError(t, err)
+
+	// the migration is interrupted before any row is rewritten
+	engine.runningMigrations = map[int64]struct{}{int64(1)<<32 | 3: {}}
+
+	_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN val TYPE VARCHAR", nil)
+	require.NoError(t, err)
+
+	reopened, err := NewEngine(st, opts)
+	require.NoError(t, err)
+
+	_, err = reopened.queryAll(ctx, nil, "SELECT * FROM t", nil)
+	require.NoError(t, err)
+
+	reopened.waitForColumnMigrations()
+
+	rows, err := reopened.queryAll(ctx, nil, "SELECT val FROM t", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, "1", rows[0].ValuesByPosition[0].RawValue())
+
+	rows, err = reopened.queryAll(ctx, nil, "SELECT state, rows_rewritten FROM immudb_column_migrations", nil)
+	require.NoError(t, err)
+	require.Equal(t, "completed", rows[0].ValuesByPosition[0].RawValue())
+	require.Equal(t, int64(3), rows[0].ValuesByPosition[1].RawValue())
+}
+
+func TestAlterColumnTypeClose(t *testing.T) {
+	opts := DefaultOptions().WithPrefix(sqlPrefix).WithColumnMigrationBatchSize(1)
+
+	engine, st := setupCommonTestWithEngineOptions(t, opts)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, "CREATE TABLE t (id INTEGER AUTO_INCREMENT, val INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO t (val) VALUES (@val)", map[string]interface{}{"val": i})
+		require.NoError(t, err)
+	}
+
+	_, _, err = engine.Exec(ctx, nil, "ALTER TABLE t ALTER COLUMN val TYPE VARCHAR", nil)
+	require.NoError(t, err)
+
+	// closing the engine stops the migration, leaving it to be resumed
+	require.NoError(t, engine.Close())
+	require.Empty(t, engine.runningMigrations)
+
+	engine.startColumnMigration(1, 3)
+	require.Empty(t, engine.runningMigrations)
+
+	reopened, err := NewEngine(st, opts)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	reopened.waitForColumnMigrations()
+
+	require.Equal(t,
+		[][]interface{}{{"completed", int64(100)}},
+		queryValues(t, reopened, nil, "SELECT state, rows_rewritten FROM immudb_column_migrations", nil),
+	)
+	require.Equal(t, [][]interface{}{{"99"}}, queryValues(t, reopened, nil, "SELECT val FROM t WHERE id = 100", nil))
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+)
+
+// A cursor is a query opened by DECLARE CURSOR whose rows are read in
+// batches by FETCH. It reads from the snapshot of the transaction that
+// declared it and is closed by CLOSE or when the transaction ends.
+type cursor struct {
+	reader RowReader
+}
+
+func (sqlTx *SQLTx) declareCursor(name string, reader RowReader) error {
+	if _, exists := sqlTx.cursors[name]; exists {
+		return fmt.Errorf("%w (%s)", ErrCursorAlreadyExists, name)
+	}
+
+	if sqlTx.cursors == nil {
+		sqlTx.cursors = make(map[string]*cursor)
+	}
+
+	sqlTx.cursors[name] = &cursor{reader: reader}
+	return nil
+}
+
+func (sqlTx *SQLTx) cursor(name string) (*cursor, error) {
+	c, exists := sqlTx.cursors[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrCursorDoesNotExist, name)
+	}
+	return c, nil
+}
+
+func (sqlTx *SQLTx) closeCursor(name string) error {
+	c, err := sqlTx.cursor(name)
+	if err != nil {
+		return err
+	}
+
+	delete(sqlTx.cursors, name)
+
+	return c.reader.Close()
+}
+
+// closeCursors closes the cursors still open when the transaction ends.
+func (sqlTx *SQLTx) closeCursors() error {
+	var firstErr error
+
+	for name, c := range sqlTx.cursors {
+		err := c.reader.Close()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(sqlTx.cursors, name)
+	}
+	return firstErr
+}
+
+type DeclareCursorStmt struct {
+	name  string
+	query DataSource
+}
+
+func (stmt *DeclareCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *DeclareCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return stmt.query.requiredPrivileges()
+}
+
+func (stmt *DeclareCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return stmt.query.inferParameters(ctx, tx, params)
+}
+
+func (stmt *DeclareCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	// rows are read by the following statements, so the cursor must
+	// outlive the current one
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	if _, exists := tx.cursors[stmt.name]; exists {
+		return nil, fmt.Errorf("%w (%s)", ErrCursorAlreadyExists, stmt.name)
+	}
+
+	_, err := stmt.query.execAt(ctx, tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := stmt.query.Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.declareCursor(stmt.name, reader)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// FetchStmt reads the next rows of a cursor, all the remaining ones when
+// count is nil.
+type FetchStmt struct {
+	cursor string
+	count  ValueExp
+}
+
+func (stmt *FetchStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *FetchStmt) requiredPrivileges() []SQLPrivilege {
+	// privileges were checked when the cursor was declared
+	return nil
+}
+
+func (stmt *FetchStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	if stmt.count == nil {
+		return nil
+	}
+
+	t, err := stmt.count.inferType(nil, params, "")
+	if err != nil {
+		return err
+	}
+
+	if t != IntegerType {
+		return fmt.Errorf("%w: fetch count must be an integer", ErrInvalidTypes)
+	}
+	return nil
+}
+
+func (stmt *FetchStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	_, err := tx.cursor(stmt.cursor)
+	return tx, err
+}
+
+func (stmt *FetchStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
+	c, err := tx.cursor(stmt.cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	count := -1
+
+	if stmt.count != nil {
+		count, err = evalExpAsInt(tx, stmt.count, params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid fetch count", err)
+		}
+
+		if count < 0 {
+			return nil, fmt.Errorf("%w: fetch count must not be negative", ErrIllegalArguments)
+		}
+	}
+
+	return newFetchRowReader(c.reader, count), nil
+}
+
+func (stmt *FetchStmt) Alias() string {
+	return ""
+}
+
+type CloseCursorStmt struct {
+	name string
+	all  bool
+}
+
+func (stmt *CloseCursorStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *CloseCursorStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CloseCursorStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.IsExplicitCloseRequired() {
+		return nil, ErrNoOngoingTx
+	}
+
+	if stmt.all {
+		return tx, tx.closeCursors()
+	}
+	return tx, tx.closeCursor(stmt.name)
+}
+
+// fetchRowReader reads up to a number of rows from a cursor, which is left
+// open for the following fetches.
+type fetchRowReader struct {
+	rowReader RowReader
+
+	count int // negative when all the remaining rows are read
+	read  int
+
+	onCloseCallback func()
+}
+
+func newFetchRowReader(rowReader RowReader, count int) *fetchRowReader {
+	return &fetchRowReader{
+		rowReader: rowReader,
+		count:     count,
+	}
+}
+
+func (fr *fetchRowReader) onClose(callback func()) {
+	fr.onCloseCallback = callback
+}
+
+func (fr *fetchRowReader) Tx() *SQLTx {
+	return fr.rowReader.Tx()
+}
+
+func (fr *fetchRowReader) TableAlias() string {
+	return fr.rowReader.TableAlias()
+}
+
+func (fr *fetchRowReader) Parameters() map[string]interface{} {
+	return fr.rowReader.Parameters()
+}
+
+func (fr *fetchRowReader) OrderBy() []ColDescriptor {
+	return fr.rowReader.OrderBy()
+}
+
+func (fr *fetchRowReader) ScanSpecs() *ScanSpecs {
+	return fr.rowReader.ScanSpecs()
+}
+
+func (fr *fetchRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	return fr.rowReader.Columns(ctx)
+}
+
+func (fr *fetchRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	return fr.rowReader.colsBySelector(ctx)
+}
+
+func (fr *fetchRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return fr.rowReader.InferParameters(ctx, params)
+}
+
+func (fr *fetchRowReader) Read(ctx context.Context) (*Row, error) {
+	if fr.count >= 0 && fr.read >= fr.count {
+		return nil, ErrNoMoreRows
+	}
+
+	row, err := fr.rowReader.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fr.read++
+
+	return row, nil
+}
+
+func (fr *fetchRowReader) Close() error {
+	if fr.onCloseCallback != nil {
+		fr.onCloseCallback()
+	}
+	return nil
+}
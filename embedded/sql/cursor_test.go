@@ -0,0 +1,120 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorStmts(t *testing.T) {
+	stmts, err := ParseSQLString("DECLARE c CURSOR FOR SELECT id FROM t; FETCH 10 FROM c; FETCH FROM c; FETCH ALL FROM c; CLOSE c; CLOSE ALL")
+	require.NoError(t, err)
+	require.Len(t, stmts, 6)
+
+	declare := stmts[0].(*DeclareCursorStmt)
+	require.Equal(t, "c", declare.name)
+	require.IsType(t, &SelectStmt{}, declare.query)
+
+	require.Equal(t, &FetchStmt{cursor: "c", count: &Integer{val: 10}}, stmts[1])
+	require.Equal(t, &FetchStmt{cursor: "c", count: &Integer{val: 1}}, stmts[2])
+	require.Equal(t, &FetchStmt{cursor: "c"}, stmts[3])
+	require.Equal(t, &CloseCursorStmt{name: "c"}, stmts[4])
+	require.Equal(t, &CloseCursorStmt{all: true}, stmts[5])
+
+	_, err = ParseSQLString("DECLARE c CURSOR FOR INSERT INTO t(id) VALUES (1)")
+	require.Error(t, err)
+}
+
+func TestCursors(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, "CREATE TABLE table1(id INTEGER, title VARCHAR[50], PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO table1(id, title) VALUES (@id, 'title')", map[string]interface{}{"id": i})
+		require.NoError(t, err)
+	}
+
+	fetch := func(tx *SQLTx, sql string, params map[string]interface{}) []int64 {
+		rows, err := engine.queryAll(ctx, tx, sql, params)
+		require.NoError(t, err, sql)
+
+		ids := make([]int64, len(rows))
+		for i, row := range rows {
+			ids[i] = row.ValuesByPosition[0].RawValue().(int64)
+		}
+		return ids
+	}
+
+	t.Run("cursors require an explicit transaction", func(t *testing.T) {
+		_, _, err := engine.Exec(ctx, nil, "DECLARE c CURSOR FOR SELECT id FROM table1", nil)
+		require.ErrorIs(t, err, ErrNoOngoingTx)
+
+		_, err = engine.queryAll(ctx, nil, "FETCH 1 FROM c", nil)
+		require.ErrorIs(t, err, ErrNoOngoingTx)
+	})
+
+	t.Run("rows should be fetched in batches", func(t *testing.T) {
+		tx, _, err := engine.Exec(ctx, nil, "BEGIN; DECLARE c CURSOR FOR SELECT id FROM table1 WHERE id > @min ORDER BY id DESC", map[string]interface{}{"min": 1})
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		require.Equal(t, []int64{5, 4}, fetch(tx, "FETCH @n FROM c", map[string]interface{}{"n": 2}))
+		require.Equal(t, []int64{3}, fetch(tx, "FETCH FROM c", nil))
+		require.Equal(t, []int64{2}, fetch(tx, "FETCH ALL FROM c", nil))
+		require.Empty(t, fetch(tx, "FETCH 10 FROM c", nil))
+
+		_, err = engine.queryAll(ctx, tx, "FETCH -1 FROM c", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, _, err = engine.Exec(ctx, tx, "CLOSE c", nil)
+		require.NoError(t, err)
+
+		_, err = engine.queryAll(ctx, tx, "FETCH 1 FROM c", nil)
+		require.ErrorIs(t, err, ErrCursorDoesNotExist)
+
+		_, _, err = engine.Exec(ctx, tx, "CLOSE c", nil)
+		require.ErrorIs(t, err, ErrCursorDoesNotExist)
+	})
+
+	t.Run("cursors should read from the snapshot of the transaction", func(t *testing.T) {
+		tx, _, err := engine.Exec(ctx, nil, "BEGIN; DECLARE c CURSOR FOR SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO table1(id, title) VALUES (6, 'title')", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, []int64{1, 2, 3, 4, 5}, fetch(tx, "FETCH ALL FROM c", nil))
+
+		_, _, err = engine.Exec(ctx, tx, "COMMIT", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("cursors should be closed when the transaction ends", func(t *testing.T) {
+		tx, _, err := engine.Exec(ctx, nil, "BEGIN; DECLARE c1 CURSOR FOR SELECT id FROM table1; DECLARE c2 CURSOR FOR SELECT title FROM table1", nil)
+		require.NoError(t, err)
+		require.Len(t, tx.cursors, 2)
+
+		_, _, err = engine.Exec(ctx, tx, "CLOSE ALL", nil)
+		require.NoError(t, err)
+		require.Empty(t, tx.cursors)
+
+		_, _, err = engine.Exec(ctx, tx, "DECLARE c1 CURSOR FOR SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		// the failed statement cancels the transaction
+		_, _, err = engine.Exec(ctx, tx, "DECLARE c1 CURSOR FOR SELECT id FROM table1", nil)
+		require.ErrorIs(t, err, ErrCursorAlreadyExists)
+		require.Empty(t, tx.cursors)
+	})
+}
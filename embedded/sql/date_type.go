@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date without time of day. It is kept as the UTC
+// midnight of the day, and shares the encoding of TIMESTAMP values so
+// that DATE columns can be indexed in chronological order.
+type Date struct {
+	val time.Time
+}
+
+func NewDate(t time.Time) *Date {
+	return &Date{val: truncateToDate(t)}
+}
+
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (v *Date) Type() SQLValueType {
+	return DateType
+}
+
+func (v *Date) IsNull() bool {
+	return false
+}
+
+func (v *Date) String() string {
+	return v.val.Format("2006-01-02")
+}
+
+func (v *Date) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return DateType, nil
+}
+
+func (v *Date) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	// dates are promoted to the midnight timestamp of the day
+	if t != DateType && t != TimestampType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, DateType, t)
+	}
+	return nil
+}
+
+func (v *Date) selectors() []Selector {
+	return nil
+}
+
+func (v *Date) substitute(params map[string]interface{}) (ValueExp, error) {
+	return v, nil
+}
+
+func (v *Date) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return v, nil
+}
+
+func (v *Date) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return v
+}
+
+func (v *Date) isConstant() bool {
+	return true
+}
+
+func (v *Date) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (v *Date) RawValue() interface{} {
+	return v.val
+}
+
+func (v *Date) Compare(val TypedValue) (int, error) {
+	if val.IsNull() {
+		return 1, nil
+	}
+
+	if val.Type() != DateType && val.Type() != TimestampType {
+		return 0, ErrNotComparableValues
+	}
+
+	return compareTimes(v.val, val.RawValue().(time.Time)), nil
+}
+
+func compareTimes(t1, t2 time.Time) int {
+	if t1.Before(t2) {
+		return -1
+	}
+
+	if t1.After(t2) {
+		return 1
+	}
+
+	return 0
+}
+
+// parseDate parses a date in ISO format, also accepting timestamps whose
+// time of day is then discarded.
+func parseDate(s string) (*Date, error) {
+	t, err := time.ParseInLocation("2006-01-02", s, time.UTC)
+	if err == nil {
+		return &Date{val: t}, nil
+	}
+
+	conv, err := getConverter(VarcharType, TimestampType)
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := conv(&Varchar{val: s})
+	if err != nil {
+		if len(s) > 30 {
+			s = s[:30] + "..."
+		}
+		return nil, fmt.Errorf("%w: can not cast string '%s' as a DATE", ErrUnsupportedCast, s)
+	}
+
+	return NewDate(ts.RawValue().(time.Time)), nil
+}
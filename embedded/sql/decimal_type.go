@@ -0,0 +1,598 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DecimalMaxPrecision is the maximum number of significant digits
+	// a DECIMAL value may hold once stored in a column.
+	DecimalMaxPrecision = 38
+
+	// decimalMaxScale bounds the number of fractional digits accepted
+	// when parsing or computing DECIMAL values.
+	decimalMaxScale = 1000
+
+	// decimalDivScale is the minimum number of fractional digits kept
+	// by a DECIMAL division.
+	decimalDivScale = 16
+
+	// Key encoding: {sign}{exponent}{significant digits, two per byte}
+	decimalKeyLen = 2 + (DecimalMaxPrecision+1)/2
+
+	decimalKeySignNegative byte = 0x01
+	decimalKeySignZero     byte = 0x02
+	decimalKeySignPositive byte = 0x03
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact numeric value represented as an arbitrary
+// precision unscaled integer and a base-10 scale: val * 10^-scale.
+type Decimal struct {
+	val   *big.Int
+	scale int
+}
+
+func NewDecimal(unscaled *big.Int, scale int) *Decimal {
+	return &Decimal{val: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// ParseDecimal parses a base-10 numeric literal such as "-12.50" or
+// "1.5e3" into an exact DECIMAL value.
+func ParseDecimal(s string) (*Decimal, error) {
+	str := strings.TrimSpace(s)
+
+	mantissa := str
+	exp := 0
+
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.Atoi(str[i+1:])
+		if err != nil || e > decimalMaxScale || e < -decimalMaxScale {
+			return nil, fmt.Errorf("%w: invalid decimal value '%s'", ErrInvalidValue, s)
+		}
+		mantissa = str[:i]
+		exp = e
+	}
+
+	neg := false
+	if len(mantissa) > 0 && (mantissa[0] == '-' || mantissa[0] == '+') {
+		neg = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, fmt.Errorf("%w: invalid decimal value '%s'", ErrInvalidValue, s)
+	}
+
+	val, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		val.Neg(val)
+	}
+
+	scale := len(fracPart) - exp
+	if scale < 0 {
+		val.Mul(val, pow10(-scale))
+		scale = 0
+	}
+
+	if scale > decimalMaxScale {
+		return nil, fmt.Errorf("%w: invalid decimal value '%s'", ErrInvalidValue, s)
+	}
+
+	return &Decimal{val: val, scale: scale}, nil
+}
+
+func decimalFromInt64(v int64) *Decimal {
+	return &Decimal{val: big.NewInt(v)}
+}
+
+func decimalFromFloat64(f float64) (*Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, fmt.Errorf("%w: %v can not be represented as %s", ErrInvalidValue, f, DecimalType)
+	}
+
+	// the shortest representation that round-trips keeps literals such
+	// as 0.1 exact instead of exposing their binary approximation
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (v *Decimal) Type() SQLValueType {
+	return DecimalType
+}
+
+func (v *Decimal) IsNull() bool {
+	return false
+}
+
+func (v *Decimal) String() string {
+	digits := new(big.Int).Abs(v.val).String()
+
+	if v.scale > 0 {
+		if len(digits) <= v.scale {
+			digits = strings.Repeat("0", v.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-v.scale] + "." + digits[len(digits)-v.scale:]
+	}
+
+	if v.val.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Scale returns the number of fractional digits of the value.
+func (v *Decimal) Scale() int {
+	return v.scale
+}
+
+// Float64 returns the nearest float64 to the decimal value.
+func (v *Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(v.val, pow10(v.scale)).Float64()
+	return f
+}
+
+// MarshalJSON renders the value as a JSON number without loss of precision.
+func (v *Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+func (v *Decimal) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return DecimalType, nil
+}
+
+func (v *Decimal) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != DecimalType && t != Float64Type && t != JSONType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, DecimalType, t)
+	}
+	return nil
+}
+
+func (v *Decimal) selectors() []Selector {
+	return nil
+}
+
+func (v *Decimal) substitute(params map[string]interface{}) (ValueExp, error) {
+	return v, nil
+}
+
+func (v *Decimal) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return v, nil
+}
+
+func (v *Decimal) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return v
+}
+
+func (v *Decimal) isConstant() bool {
+	return true
+}
+
+func (v *Decimal) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (v *Decimal) RawValue() interface{} {
+	return v
+}
+
+func (v *Decimal) Compare(val TypedValue) (int, error) {
+	if val.IsNull() {
+		return 1, nil
+	}
+
+	if val.Type() == JSONType {
+		res, err := val.Compare(v)
+		return -res, err
+	}
+
+	convVal, err := mayApplyImplicitConversion(val.RawValue(), DecimalType)
+	if err != nil {
+		return 0, err
+	}
+
+	rval, ok := convVal.(*Decimal)
+	if !ok {
+		return 0, ErrNotComparableValues
+	}
+
+	return v.cmp(rval), nil
+}
+
+func (v *Decimal) cmp(d *Decimal) int {
+	l, r := alignDecimals(v, d)
+	return l.Cmp(r)
+}
+
+// alignDecimals returns the unscaled values of both operands expressed
+// with the largest of their scales.
+func alignDecimals(l, r *Decimal) (*big.Int, *big.Int) {
+	switch {
+	case l.scale < r.scale:
+		return new(big.Int).Mul(l.val, pow10(r.scale-l.scale)), r.val
+	case l.scale > r.scale:
+		return l.val, new(big.Int).Mul(r.val, pow10(l.scale-r.scale))
+	}
+	return l.val, r.val
+}
+
+// rescale returns the value rounded half away from zero to the given
+// number of fractional digits.
+func (v *Decimal) rescale(scale int) *Decimal {
+	if scale >= v.scale {
+		return &Decimal{val: new(big.Int).Mul(v.val, pow10(scale-v.scale)), scale: scale}
+	}
+
+	return &Decimal{val: divRound(v.val, pow10(v.scale-scale)), scale: scale}
+}
+
+// divRound divides n by d rounding half away from zero.
+func divRound(n, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+
+	if new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(new(big.Int).Abs(d)) >= 0 {
+		if n.Sign()*d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return q
+}
+
+// digits returns the number of digits of the unscaled value.
+func (v *Decimal) digits() int {
+	if v.val.Sign() == 0 {
+		return 1
+	}
+	return len(new(big.Int).Abs(v.val).String())
+}
+
+// decimalTypeMod packs the precision and scale of a DECIMAL column
+// into the value persisted as the column max length.
+func decimalTypeMod(precision, scale int) int {
+	return precision<<16 | scale
+}
+
+func decimalPrecisionAndScale(typeMod int) (precision, scale int) {
+	return typeMod >> 16, typeMod & 0xFFFF
+}
+
+func validDecimalTypeMod(typeMod int) bool {
+	if typeMod == 0 {
+		return true
+	}
+
+	precision, scale := decimalPrecisionAndScale(typeMod)
+
+	return precision >= 1 && precision <= DecimalMaxPrecision && scale <= precision
+}
+
+// typeModFromArgs maps the arguments of a column type, as in
+// VARCHAR(n) or DECIMAL(p,s), to the max length kept by the column.
+func typeModFromArgs(t SQLValueType, args []uint64) (int, error) {
+	if t == DecimalType && len(args) <= 2 {
+		if len(args) == 0 {
+			return 0, nil
+		}
+
+		precision, scale := args[0], uint64(0)
+		if len(args) == 2 {
+			scale = args[1]
+		}
+
+		if precision < 1 || precision > DecimalMaxPrecision || scale > precision {
+			return 0, fmt.Errorf("%w: invalid precision or scale %s(%d,%d), precision must be between 1 and %d and scale cannot exceed it",
+				ErrIllegalArguments, DecimalType, precision, scale, DecimalMaxPrecision)
+		}
+
+		return decimalTypeMod(int(precision), int(scale)), nil
+	}
+
+	switch len(args) {
+	case 0:
+		return 0, nil
+	case 1:
+		return int(args[0]), nil
+	}
+
+	return 0, fmt.Errorf("%w: too many arguments for type %s", ErrLimitedMaxLen, t)
+}
+
+// normalizeColumnValue adapts a value about to be written into col to
+// the column definition. DECIMAL values are rounded to the declared
+// scale and checked against the declared precision, so that the row
+// and every index entry agree on the stored value. Array values are
+// converted to the element type of the column, with DECIMAL elements
+// following the same rules.
+func normalizeColumnValue(col *Column, val TypedValue) (TypedValue, error) {
+	if val.IsNull() {
+		return val, nil
+	}
+
+	if IsArrayType(col.colType) {
+		return normalizeArrayColumnValue(col, val)
+	}
+
+	if col.colType == DateType {
+		// dates are stored at midnight so that equal dates share the same key
+		if t, ok := val.RawValue().(time.Time); ok {
+			return NewDate(t), nil
+		}
+		return val, nil
+	}
+
+	if col.colType != DecimalType {
+		return val, nil
+	}
+
+	convVal, err := mayApplyImplicitConversion(val.RawValue(), DecimalType)
+	if err != nil {
+		return nil, err
+	}
+
+	d, ok := convVal.(*Decimal)
+	if !ok {
+		return nil, fmt.Errorf("%w: value is not a decimal (%s)", ErrInvalidValue, col.colName)
+	}
+
+	d, err = applyDecimalTypeMod(d, col.maxLen)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%s)", err, col.colName)
+	}
+
+	return d, nil
+}
+
+func normalizeArrayColumnValue(col *Column, val TypedValue) (TypedValue, error) {
+	conv, err := getConverter(val.Type(), col.colType)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%s)", err, col.colName)
+	}
+
+	cval, err := conv(val)
+	if err != nil {
+		return nil, fmt.Errorf("%w (%s)", err, col.colName)
+	}
+
+	arr, ok := cval.(*Array)
+	if !ok || ArrayElemType(col.colType) != DecimalType {
+		return cval, nil
+	}
+
+	vals := make([]TypedValue, len(arr.vals))
+	for i, e := range arr.vals {
+		d, ok := e.(*Decimal)
+		if !ok {
+			vals[i] = e
+			continue
+		}
+
+		d, err = applyDecimalTypeMod(d, col.maxLen)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%s)", err, col.colName)
+		}
+		vals[i] = d
+	}
+	return NewArray(DecimalType, vals), nil
+}
+
+// applyDecimalTypeMod rounds the value to the scale packed in typeMod and
+// checks it fits the declared precision.
+func applyDecimalTypeMod(d *Decimal, typeMod int) (*Decimal, error) {
+	if typeMod == 0 {
+		return d, nil
+	}
+
+	precision, scale := decimalPrecisionAndScale(typeMod)
+
+	d = d.rescale(scale)
+
+	if d.val.Sign() != 0 && d.digits() > precision {
+		return nil, fmt.Errorf("%w: %s does not fit %s(%d,%d)", ErrNumericValueOutOfRange, d.String(), DecimalType, precision, scale)
+	}
+
+	return d, nil
+}
+
+// encodeAsKey writes a fixed-length, order-preserving representation of
+// the value into buf. Only significant digits are kept, so the encoding
+// does not depend on the scale of the value:
+//
+//	{sign}{exponent+128}{digit+1 nibbles, zero padded}
+//
+// Negative values are encoded as the bitwise complement of their
+// absolute value so that larger magnitudes sort first.
+func (v *Decimal) encodeAsKey(buf []byte) error {
+	if len(buf) != decimalKeyLen {
+		return ErrCorruptedData
+	}
+
+	for i := range buf {
+		buf[i] = 0
+	}
+
+	if v.val.Sign() == 0 {
+		buf[0] = decimalKeySignZero
+		return nil
+	}
+
+	digits := new(big.Int).Abs(v.val).String()
+	exp := len(digits) - v.scale
+
+	digits = strings.TrimRight(digits, "0")
+
+	if len(digits) > DecimalMaxPrecision || exp < math.MinInt8 || exp > math.MaxInt8 {
+		return fmt.Errorf("%w: %s can not be indexed", ErrNumericValueOutOfRange, v.String())
+	}
+
+	buf[0] = decimalKeySignPositive
+	buf[1] = byte(exp + 128)
+
+	for i, d := range digits {
+		nibble := byte(d-'0') + 1
+		if i%2 == 0 {
+			buf[2+i/2] = nibble << 4
+		} else {
+			buf[2+i/2] |= nibble
+		}
+	}
+
+	if v.val.Sign() < 0 {
+		buf[0] = decimalKeySignNegative
+		for i := 1; i < len(buf); i++ {
+			buf[i] = ^buf[i]
+		}
+	}
+
+	return nil
+}
+
+func decodeDecimalKey(buf []byte) (*Decimal, error) {
+	if len(buf) != decimalKeyLen {
+		return nil, ErrCorruptedData
+	}
+
+	if buf[0] == decimalKeySignZero {
+		return decimalFromInt64(0), nil
+	}
+
+	if buf[0] != decimalKeySignPositive && buf[0] != decimalKeySignNegative {
+		return nil, ErrCorruptedData
+	}
+
+	neg := buf[0] == decimalKeySignNegative
+
+	b := make([]byte, len(buf)-1)
+	copy(b, buf[1:])
+
+	if neg {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+
+	exp := int(b[0]) - 128
+
+	var digits strings.Builder
+	for _, c := range b[1:] {
+		hi, lo := c>>4, c&0x0F
+		if hi == 0 {
+			break
+		}
+		digits.WriteByte('0' + hi - 1)
+		if lo == 0 {
+			break
+		}
+		digits.WriteByte('0' + lo - 1)
+	}
+
+	if digits.Len() == 0 {
+		return nil, ErrCorruptedData
+	}
+
+	val, _ := new(big.Int).SetString(digits.String(), 10)
+	if neg {
+		val.Neg(val)
+	}
+
+	scale := digits.Len() - exp
+	if scale < 0 {
+		val.Mul(val, pow10(-scale))
+		scale = 0
+	}
+
+	return &Decimal{val: val, scale: scale}, nil
+}
+
+// encodeDecimalValue returns the row encoding of the value:
+// {scale(4)}{two's complement unscaled value}
+func encodeDecimalValue(v *Decimal) []byte {
+	unscaled := bigIntToTwosComplement(v.val)
+
+	encv := make([]byte, EncLenLen+4+len(unscaled))
+	binary.BigEndian.PutUint32(encv, uint32(4+len(unscaled)))
+	binary.BigEndian.PutUint32(encv[EncLenLen:], uint32(v.scale))
+	copy(encv[EncLenLen+4:], unscaled)
+
+	return encv
+}
+
+func decodeDecimalValue(b []byte) (*Decimal, error) {
+	if len(b) < 5 {
+		return nil, ErrCorruptedData
+	}
+
+	scale := binary.BigEndian.Uint32(b)
+	if scale > decimalMaxScale {
+		return nil, ErrCorruptedData
+	}
+
+	return &Decimal{val: bigIntFromTwosComplement(b[4:]), scale: int(scale)}, nil
+}
+
+func bigIntToTwosComplement(n *big.Int) []byte {
+	if n.Sign() >= 0 {
+		b := n.Bytes()
+		// keep the sign bit clear for positive values
+		return append([]byte{0}, b...)
+	}
+
+	// -n = ^(n-1): complement the magnitude of n-1 on one extra byte
+	m := new(big.Int).Sub(new(big.Int).Neg(n), big.NewInt(1)).Bytes()
+
+	b := make([]byte, len(m)+1)
+	copy(b[1:], m)
+
+	for i := range b {
+		b[i] = ^b[i]
+	}
+
+	return b
+}
+
+func bigIntFromTwosComplement(b []byte) *big.Int {
+	if b[0]&0x80 == 0 {
+		return new(big.Int).SetBytes(b)
+	}
+
+	m := make([]byte, len(b))
+	for i := range b {
+		m[i] = ^b[i]
+	}
+
+	n := new(big.Int).SetBytes(m)
+	n.Add(n, big.NewInt(1))
+
+	return n.Neg(n)
+}
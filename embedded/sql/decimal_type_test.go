@@ -0,0 +1,272 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	for _, c := range []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"12.50", "12.50"},
+		{"-0.001", "-0.001"},
+		{"+7", "7"},
+		{".5", "0.5"},
+		{"1.5e3", "1500"},
+		{"1.25E-2", "0.0125"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	} {
+		d, err := ParseDecimal(c.in)
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.out, d.String(), c.in)
+	}
+
+	for _, in := range []string{"", "abc", "1.2.3", "1e", "--1", "1e100000"} {
+		_, err := ParseDecimal(in)
+		require.ErrorIs(t, err, ErrInvalidValue, in)
+	}
+}
+
+func TestDecimalKeyEncodingOrder(t *testing.T) {
+	values := []string{
+		"-12345678901234567890.5", "-100", "-99.99", "-1", "-0.5", "-0.05",
+		"0", "0.0001", "0.05", "0.5", "1", "1.00001", "10", "99.99", "100", "1e30",
+	}
+
+	keys := make([][]byte, len(values))
+
+	for i, s := range values {
+		d, err := ParseDecimal(s)
+		require.NoError(t, err)
+
+		key, n, err := EncodeValueAsKey(d, DecimalType, decimalKeyLen)
+		require.NoError(t, err)
+		require.Equal(t, decimalKeyLen, n)
+		keys[i] = key
+
+		decoded, consumed, err := DecodeValueFromKey(key, DecimalType, decimalKeyLen)
+		require.NoError(t, err)
+		require.Equal(t, len(key), consumed)
+
+		cmp, err := decoded.Compare(d)
+		require.NoError(t, err)
+		require.Zero(t, cmp, s)
+	}
+
+	require.True(t, sort.SliceIsSorted(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	}))
+
+	// encoding does not depend on the scale of the value
+	a, _, err := EncodeValueAsKey(&Decimal{val: big.NewInt(150), scale: 2}, DecimalType, decimalKeyLen)
+	require.NoError(t, err)
+
+	b, _, err := EncodeValueAsKey(&Decimal{val: big.NewInt(15), scale: 1}, DecimalType, decimalKeyLen)
+	require.NoError(t, err)
+	require.Equal(t, a, b)
+
+	tooPrecise, err := ParseDecimal("1.000000000000000000000000000000000000001")
+	require.NoError(t, err)
+
+	_, _, err = EncodeValueAsKey(tooPrecise, DecimalType, decimalKeyLen)
+	require.ErrorIs(t, err, ErrNumericValueOutOfRange)
+}
+
+func TestDecimalValueEncoding(t *testing.T) {
+	for _, s := range []string{"0", "12.50", "-12.50", "-128", "255", "-0.000001", "98765432109876543210.0123456789"} {
+		d, err := ParseDecimal(s)
+		require.NoError(t, err)
+
+		enc, err := EncodeValue(d, DecimalType, 0)
+		require.NoError(t, err)
+
+		decoded, n, err := DecodeValue(enc, DecimalType)
+		require.NoError(t, err)
+		require.Equal(t, len(enc), n)
+		require.Equal(t, s, decoded.String())
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	dec := func(s string) *Decimal {
+		d, err := ParseDecimal(s)
+		require.NoError(t, err)
+		return d
+	}
+
+	for _, c := range []struct {
+		op   NumOperator
+		l, r TypedValue
+		out  string
+	}{
+		{ADDOP, dec("0.1"), dec("0.2"), "0.3"},
+		{ADDOP, dec("1.25"), &Integer{val: 2}, "3.25"},
+		{SUBSOP, dec("1"), dec("0.001"), "0.999"},
+		{MULTOP, dec("1.5"), dec("-2.25"), "-3.375"},
+		{MULTOP, dec("19.99"), &Float64{val: 0.1}, "1.999"},
+		{DIVOP, dec("1"), dec("3"), "0.3333333333333333"},
+		{DIVOP, dec("-2"), dec("3"), "-0.6666666666666667"},
+		{DIVOP, &Integer{val: 10}, dec("4"), "2.5000000000000000"},
+		{MODOP, dec("10.5"), dec("3"), "1.5"},
+	} {
+		v, err := applyNumOperator(c.op, c.l, c.r)
+		require.NoError(t, err)
+		require.Equal(t, DecimalType, v.Type())
+		require.Equal(t, c.out, v.String())
+	}
+
+	_, err := applyNumOperator(DIVOP, dec("1"), dec("0.00"))
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = applyNumOperator(MODOP, dec("1"), &Integer{val: 0})
+	require.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestDecimalColumns(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE products (
+			id INTEGER AUTO_INCREMENT,
+			price DECIMAL(10,2) NOT NULL,
+			weight NUMERIC,
+			qty NUMERIC(4),
+			PRIMARY KEY id
+		);
+		CREATE INDEX ON products(price);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO products (price, weight, qty) VALUES
+			(19.999, 0.1, 3),
+			(5, 1.123456789, 10.5),
+			('7.125', NULL, NULL),
+			(-3.5, 2, 0);
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("values are rounded to the column scale", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT price, weight, qty FROM products ORDER BY id")
+		require.Equal(t, [][]string{
+			{"20.00", "0.1", "3"},
+			{"5.00", "1.123456789", "11"},
+			{"7.13", "NULL", "NULL"},
+			{"-3.50", "2", "0"},
+		}, rows)
+	})
+
+	t.Run("precision is enforced", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "INSERT INTO products (price) VALUES (123456789.0)", nil)
+		require.ErrorIs(t, err, ErrNumericValueOutOfRange)
+
+		_, _, err = engine.Exec(context.Background(), nil, "UPDATE products SET qty = 12345 WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrNumericValueOutOfRange)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO products (price) VALUES ('abc')", nil)
+		require.ErrorIs(t, err, ErrUnsupportedCast)
+	})
+
+	t.Run("index order and ranges", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT price FROM products USE INDEX ON (price) ORDER BY price DESC")
+		require.Equal(t, [][]string{{"20.00"}, {"7.13"}, {"5.00"}, {"-3.50"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM products WHERE price >= 5 AND price < 7.13 ORDER BY price")
+		require.Equal(t, [][]string{{"2"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM products WHERE price = '20'")
+		require.Equal(t, [][]string{{"1"}}, rows)
+	})
+
+	t.Run("arithmetic and aggregations", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT price * qty, price + 0.005 FROM products WHERE id = 1")
+		require.Equal(t, [][]string{{"60.00", "20.005"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT SUM(price), MIN(price), MAX(price) FROM products")
+		require.Equal(t, [][]string{{"28.63", "-3.50", "20.00"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT CAST(price AS INTEGER), CAST(price AS VARCHAR), CAST(weight AS DECIMAL(5,3)), 10.555::NUMERIC(4,2) FROM products WHERE id = 2")
+		require.Equal(t, [][]string{{"5", "'5.00'", "1.123", "10.56"}}, rows)
+
+		_, _, err := engine.Exec(context.Background(), nil, "UPDATE products SET price = price * 1.1 WHERE id = 3", nil)
+		require.NoError(t, err)
+
+		rows = queryStrings(t, engine, "SELECT price FROM products WHERE id = 3")
+		require.Equal(t, [][]string{{"7.84"}}, rows)
+	})
+
+	t.Run("parameters", func(t *testing.T) {
+		d, err := ParseDecimal("1.005")
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO products (price, weight) VALUES (@price, @weight)", map[string]interface{}{
+			"price":  d,
+			"weight": "42.4200",
+		})
+		require.NoError(t, err)
+
+		rows := queryStrings(t, engine, "SELECT price, weight FROM products WHERE id = 5")
+		require.Equal(t, [][]string{{"1.01", "42.4200"}}, rows)
+	})
+
+	t.Run("invalid definitions", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE t1 (id INTEGER, v DECIMAL(39,2), PRIMARY KEY id)", nil)
+		require.ErrorIs(t, err, ErrParsingError)
+		require.ErrorContains(t, err, "invalid precision or scale DECIMAL(39,2)")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE t1 (id INTEGER, v DECIMAL(4,5), PRIMARY KEY id)", nil)
+		require.ErrorContains(t, err, "invalid precision or scale DECIMAL(4,5)")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE t1 (id INTEGER, v NUMERIC(0), PRIMARY KEY id)", nil)
+		require.ErrorContains(t, err, "invalid precision or scale DECIMAL(0,0)")
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE t1 (id INTEGER, v VARCHAR(4,2), PRIMARY KEY id)", nil)
+		require.ErrorContains(t, err, "too many arguments for type VARCHAR")
+	})
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	t.Run("precision and scale are persisted", func(t *testing.T) {
+		st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+		require.NoError(t, err)
+		defer st.Close()
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows := queryStrings(t, engine, "SHOW TABLE products")
+		require.Len(t, rows, 4)
+
+		for i, typ := range []string{"'INTEGER'", "'DECIMAL(10,2)'", "'DECIMAL'", "'DECIMAL(4,0)'"} {
+			require.Equal(t, typ, rows[i][1])
+		}
+
+		_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO products (price) VALUES (0.125)", nil)
+		require.NoError(t, err)
+
+		rows = queryStrings(t, engine, "SELECT price FROM products WHERE price > 0 AND price < 1")
+		require.Equal(t, [][]string{{"0.13"}}, rows)
+	})
+}
@@ -44,6 +44,10 @@ type diffRowReader struct {
 	beforeEOF bool
 	afterEOF  bool
 
+	// prevRow holds the version of the row preceding the range for the
+	// change last returned by Read, nil for insertions
+	prevRow *Row
+
 	pkCols []ColDescriptor
 
 	onCloseCallback func()
@@ -233,6 +237,7 @@ func (r *diffRowReader) Read(ctx context.Context) (*Row, error) {
 		// Only "before" has rows → DELETE
 		if r.afterEOF {
 			row := r.buildDiffRow(diffDelete, r.beforeRow)
+			r.prevRow = r.beforeRow
 			r.beforeRow = nil
 			return row, nil
 		}
@@ -240,6 +245,7 @@ func (r *diffRowReader) Read(ctx context.Context) (*Row, error) {
 		// Only "after" has rows → INSERT
 		if r.beforeEOF {
 			row := r.buildDiffRow(diffInsert, r.afterRow)
+			r.prevRow = nil
 			r.afterRow = nil
 			return row, nil
 		}
@@ -261,12 +267,14 @@ func (r *diffRowReader) Read(ctx context.Context) (*Row, error) {
 		case cmp < 0:
 			// before's row comes first in scan order → row was deleted
 			row := r.buildDiffRow(diffDelete, r.beforeRow)
+			r.prevRow = r.beforeRow
 			r.beforeRow = nil
 			return row, nil
 
 		case cmp > 0:
 			// after's row comes first in scan order → row was inserted
 			row := r.buildDiffRow(diffInsert, r.afterRow)
+			r.prevRow = nil
 			r.afterRow = nil
 			return row, nil
 
@@ -286,6 +294,7 @@ func (r *diffRowReader) Read(ctx context.Context) (*Row, error) {
 
 			// UPDATE — emit "after" values
 			row := r.buildDiffRow(diffUpdate, r.afterRow)
+			r.prevRow = r.beforeRow
 			r.beforeRow = nil
 			r.afterRow = nil
 			return row, nil
@@ -293,6 +302,12 @@ func (r *diffRowReader) Read(ctx context.Context) (*Row, error) {
 	}
 }
 
+// previousRow returns the version of the row changed by the last call to
+// Read as it was before the diff range, or nil if the row was inserted.
+func (r *diffRowReader) previousRow() *Row {
+	return r.prevRow
+}
+
 func (r *diffRowReader) comparePK(beforeRow, afterRow *Row) (int, error) {
 	for _, pkCol := range r.pkCols {
 		sel := pkCol.Selector()
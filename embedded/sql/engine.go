@@ -25,6 +25,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/codenotary/immudb/embedded/cache"
 	"github.com/codenotary/immudb/embedded/store"
 )
 
@@ -42,6 +43,7 @@ var (
 	ErrColumnDoesNotExist                     = errors.New("column does not exist")
 	ErrColumnAlreadyExists                    = errors.New("column already exists")
 	ErrCannotDropColumn                       = errors.New("cannot drop column")
+	ErrCannotAlterColumnType                  = errors.New("cannot alter column type")
 	ErrSameOldAndNewNames                     = errors.New("same old and new names")
 	ErrColumnNotIndexed                       = errors.New("column is not indexed")
 	ErrFunctionDoesNotExist                   = errors.New("function does not exist")
@@ -52,6 +54,18 @@ var (
 	ErrInvalidColumn                          = errors.New("invalid column")
 	ErrInvalidCheckConstraint                 = errors.New("invalid check constraint")
 	ErrCheckConstraintViolation               = errors.New("check constraint violation")
+	ErrInvalidForeignKey                      = errors.New("invalid foreign key constraint")
+	ErrForeignKeyViolation                    = errors.New("foreign key constraint violation")
+	ErrInvalidPolicy                          = errors.New("invalid row-level security policy")
+	ErrPolicyViolation                        = errors.New("row-level security policy violation")
+	ErrPolicyAlreadyExists                    = errors.New("policy already exists")
+	ErrPolicyDoesNotExist                     = errors.New("policy does not exist")
+	ErrMaterializedViewIsReadOnly             = errors.New("materialized view can only be modified by refreshing it")
+	ErrNoIncrementalRefresh                   = errors.New("materialized view can not be refreshed incrementally")
+	ErrInvalidTrigger                         = errors.New("invalid trigger")
+	ErrTriggerAlreadyExists                   = errors.New("trigger already exists")
+	ErrTriggerDoesNotExist                    = errors.New("trigger does not exist")
+	ErrMaxTriggerDepthExceeded                = errors.New("max nesting depth of triggers exceeded")
 	ErrReservedWord                           = errors.New("reserved word")
 	ErrNoPrimaryKey                           = errors.New("no primary key specified")
 	ErrPKCanNotBeNull                         = errors.New("primary key can not be null")
@@ -90,6 +104,7 @@ var (
 	ErrNoOngoingTx                            = errors.New("no ongoing transaction")
 	ErrNonTransactionalStmt                   = errors.New("non transactional statement")
 	ErrDivisionByZero                         = errors.New("division by zero")
+	ErrNumericValueOutOfRange                 = errors.New("numeric value out of range")
 	ErrMissingParameter                       = errors.New("missing parameter")
 	ErrUnsupportedParameter                   = errors.New("unsupported parameter")
 	ErrDuplicatedParameters                   = errors.New("duplicated parameters")
@@ -100,9 +115,29 @@ var (
 	ErrUnsupportedCast                        = fmt.Errorf("%w: unsupported cast", ErrInvalidValue)
 	ErrColumnMismatchInUnionStmt              = errors.New("column mismatch in union statement")
 	ErrCannotIndexJson                        = errors.New("cannot index column of type JSON")
+	ErrCannotIndexArray                       = errors.New("cannot index column of ARRAY type")
+	ErrCannotIndexInterval                    = errors.New("cannot index column of type INTERVAL")
+	ErrInvalidIndexExpression                 = errors.New("invalid index expression")
+	ErrInvalidGeneratedColumn                 = errors.New("invalid generated column")
+	ErrCannotWriteGeneratedColumn             = errors.New("cannot write generated column")
+	ErrCannotIndexVirtualColumn               = errors.New("cannot index virtual generated column")
+	ErrInvalidFullTextIndex                   = errors.New("invalid fulltext index")
+	ErrSchemaAlreadyExists                    = errors.New("schema already exists")
+	ErrSchemaDoesNotExist                     = errors.New("schema does not exist")
+	ErrSchemaNotEmpty                         = errors.New("schema is not empty")
+	ErrNoSchemaSelected                       = errors.New("no schema has been selected to create in")
+	ErrMergeRowMatchedTwice                   = errors.New("merge can not write a row more than once")
+	ErrRoutineAlreadyExists                   = errors.New("function or procedure already exists")
+	ErrRoutineDoesNotExist                    = errors.New("function or procedure does not exist")
+	ErrInvalidRoutine                         = errors.New("invalid function or procedure")
+	ErrMaxRoutineDepthExceeded                = errors.New("max nesting depth of function and procedure calls exceeded")
 	ErrInvalidTxMetadata                      = errors.New("invalid transaction metadata")
 	ErrAccessDenied                           = errors.New("access denied")
 	ErrDiffRequiresPeriod                     = errors.New("DIFF requires both SINCE/AFTER and UNTIL/BEFORE clauses")
+	ErrInvalidPartition                       = errors.New("invalid partition")
+	ErrPartitionConstraintViolation           = errors.New("partition constraint violation")
+	ErrCursorAlreadyExists                    = errors.New("cursor already exists")
+	ErrCursorDoesNotExist                     = errors.New("cursor does not exist")
 )
 
 // MaxKeyLen caps the length of variable-width indexed columns (the
@@ -149,6 +184,16 @@ type Engine struct {
 	multidbHandler                MultiDBHandler
 	tableResolvers                map[string]TableResolver
 	sequences                     map[string]*Sequence
+	columnMigrationBatchSize      int
+
+	// migrationsMu guards runningMigrations, the column migrations being
+	// processed in the background (see column_migration.go). They run on
+	// migrationsCtx, which is cancelled by Close.
+	migrationsMu      sync.Mutex
+	runningMigrations map[int64]struct{}
+	migrationsWg      sync.WaitGroup
+	migrationsCtx     context.Context
+	stopMigrations    context.CancelFunc
 
 	// catalogMu guards cachedCatalog. The cached catalog is built once from the
 	// store and reused across read-only transactions to avoid redundant B-tree
@@ -165,6 +210,12 @@ type Engine struct {
 	// pre-dates a concurrent DDL commit could overwrite the cache with a
 	// stale schema view (the doc's "stale-view race").
 	cachedCatalogVersion atomic.Uint64
+
+	// stmtCache holds the statements parsed by PrepareStmts and
+	// InferParameters, keyed by stmtKey. It is nil when the cache is
+	// disabled.
+	stmtCache      *cache.Cache
+	stmtCacheMutex sync.Mutex
 }
 
 // Sequence represents a named auto-incrementing counter
@@ -297,6 +348,8 @@ type MultiDBHandler interface {
 	ListDatabases(ctx context.Context) ([]string, error)
 	CreateDatabase(ctx context.Context, db string, ifNotExists bool) error
 	UseDatabase(ctx context.Context, db string) error
+	// GetLoggedUser returns the user running the statements of ctx.
+	// A nil user is only accepted for contexts returned by WithInternalCall.
 	GetLoggedUser(ctx context.Context) (User, error)
 	ListUsers(ctx context.Context) ([]User, error)
 	CreateUser(ctx context.Context, username, password string, permission Permission) error
@@ -352,10 +405,20 @@ func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
 		lazyIndexConstraintValidation: opts.lazyIndexConstraintValidation,
 		parseTxMetadata:               opts.parseTxMetadata,
 		multidbHandler:                opts.multidbHandler,
+		columnMigrationBatchSize:      opts.columnMigrationBatchSize,
 	}
 
 	copy(e.prefix, opts.prefix)
 
+	e.migrationsCtx, e.stopMigrations = context.WithCancel(context.Background())
+
+	if opts.stmtCacheSize > 0 {
+		e.stmtCache, err = cache.NewCache(opts.stmtCacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = st.InitIndexing(&store.IndexSpec{
 		SourcePrefix:     append(e.prefix, []byte(catalogPrefix)...),
 		TargetPrefix:     append(e.prefix, []byte(catalogPrefix)...),
@@ -365,6 +428,16 @@ func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
 		return nil, err
 	}
 
+	// postings of the fulltext indexes of all tables
+	err = st.InitIndexing(&store.IndexSpec{
+		SourcePrefix:     append(e.prefix, []byte(FullTextPrefix)...),
+		TargetPrefix:     append(e.prefix, []byte(FullTextPrefix)...),
+		InjectiveMapping: true,
+	})
+	if err != nil && !errors.Is(err, store.ErrIndexAlreadyInitialized) {
+		return nil, err
+	}
+
 	for _, r := range opts.tableResolvers {
 		e.registerTableResolver(r.Table(), r)
 	}
@@ -372,9 +445,25 @@ func NewEngine(st *store.ImmuStore, opts *Options) (*Engine, error) {
 	// TODO: find a better way to handle parsing errors
 	yyErrorVerbose = true
 
+	if opts.resumeColumnMigrations {
+		err = e.resumeColumnMigrations(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return e, nil
 }
 
+// Close stops the column migrations running in the background and waits
+// for them to return. Interrupted migrations are resumed the next time an
+// engine is created over the same store.
+func (e *Engine) Close() error {
+	e.stopColumnMigrations()
+	e.waitForColumnMigrations()
+	return nil
+}
+
 func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 	err := opts.Validate()
 	if err != nil {
@@ -434,7 +523,7 @@ func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 			engine:             e,
 			opts:               opts,
 			tx:                 tx,
-			catalog:            cached,
+			catalog:            cached.withSearchPath(opts.SearchPath),
 			openCatalogVersion: openVersion,
 			lastInsertedPKs:    make(map[string]int64),
 			firstInsertedPKs:   make(map[string]int64),
@@ -469,6 +558,7 @@ func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 			return nil, err
 		}
 	}
+	catalog.searchPath = opts.SearchPath
 
 	// Load persisted sequences (only once, on first tx)
 	if e.sequences == nil {
@@ -600,12 +690,23 @@ func (e *Engine) NewTx(ctx context.Context, opts *TxOptions) (*SQLTx, error) {
 func (e *Engine) seedCatalogReadSet(ctx context.Context, tx *store.OngoingTx, tables []*Table) error {
 	prefixes := [][]byte{
 		MapKey(e.prefix, catalogTablePrefix, EncodeID(DatabaseID)),
+		MapKey(e.prefix, catalogSchemaPrefix, EncodeID(DatabaseID)),
+		MapKey(e.prefix, catalogRoutinePrefix, EncodeID(DatabaseID)),
 	}
 	for _, t := range tables {
 		prefixes = append(prefixes,
 			MapKey(e.prefix, catalogColumnPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
 			MapKey(e.prefix, catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
 			MapKey(e.prefix, catalogCheckPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogForeignKeyPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogPrivilegePrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogPolicyPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogMatViewPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogTriggerPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogStatsPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogIndexExpPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogMigrationPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
+			MapKey(e.prefix, catalogPartitionPrefix, EncodeID(DatabaseID), EncodeID(t.id)),
 		)
 	}
 	for _, p := range prefixes {
@@ -732,6 +833,11 @@ func indexEntryMapperFor(index, primaryIndex *Index) store.EntryMapper {
 			return nil, err
 		}
 
+		valuesByColID, err = index.table.withIndexExpValues(valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		for i, col := range index.cols {
 			encKey, _, err := EncodeValueAsKey(valuesByColID[col.id], col.Type(), col.MaxLen())
 			if err != nil {
@@ -753,10 +859,11 @@ func indexEntryMapperFor(index, primaryIndex *Index) store.EntryMapper {
 }
 
 func (e *Engine) Exec(ctx context.Context, tx *SQLTx, sql string, params map[string]interface{}) (ntx *SQLTx, committedTxs []*SQLTx, err error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	stmts, release, err := e.PrepareStmts(sql)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", ErrParsingError, err)
 	}
+	defer release()
 
 	return e.ExecPreparedStmts(ctx, tx, stmts, params)
 }
@@ -841,7 +948,7 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 		}
 
 		if e.multidbHandler != nil {
-			if err := e.checkUserPermissions(ctx, stmt); err != nil {
+			if err := e.checkUserPermissions(ctx, currTx, stmt); err != nil {
 				currTx.Cancel()
 				return nil, committedTxs, stmts[execStmts:], err
 			}
@@ -889,19 +996,59 @@ func (e *Engine) execPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLSt
 	return currTx, committedTxs, stmts[execStmts:], nil
 }
 
-func (e *Engine) checkUserPermissions(ctx context.Context, stmt SQLStmt) error {
+type internalCallCtxKey struct{}
+
+// WithInternalCall returns a context for the in-process calls to the engine
+// made on behalf of no user, which are run without checking permissions
+// and privileges when the MultiDBHandler returns no logged user.
+func WithInternalCall(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internalCallCtxKey{}, true)
+}
+
+// IsInternalCall reports whether ctx was returned by WithInternalCall.
+func IsInternalCall(ctx context.Context) bool {
+	internal, _ := ctx.Value(internalCallCtxKey{}).(bool)
+	return internal
+}
+
+// checkUserPermissions verifies the database-wide permission and privileges
+// required by stmt. Missing table privileges are checked against the grants
+// on the tables accessed by stmt, and again for the columns it reads or
+// writes while it is planned, so the logged user is attached to tx.
+// Only internal calls are run without a logged user, unchecked.
+func (e *Engine) checkUserPermissions(ctx context.Context, tx *SQLTx, stmt SQLStmt) error {
 	user, err := e.multidbHandler.GetLoggedUser(ctx)
 	if err != nil {
 		return err
 	}
 
+	tx.user = user
+
+	if user == nil {
+		if IsInternalCall(ctx) {
+			return nil
+		}
+		return fmt.Errorf("%w: no logged user", ErrAccessDenied)
+	}
+
 	if !stmt.readOnly() && user.Permission() == PermissionReadOnly {
 		return fmt.Errorf("%w: statement requires %s permission", ErrAccessDenied, PermissionReadWrite)
 	}
 
 	requiredPrivileges := stmt.requiredPrivileges()
-	if !hasAllPrivileges(user.SQLPrivileges(), requiredPrivileges) {
-		return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
+	for _, p := range requiredPrivileges {
+		if hasAllPrivileges(user.SQLPrivileges(), []SQLPrivilege{p}) {
+			continue
+		}
+
+		if !isTablePrivilege(p) {
+			return fmt.Errorf("%w: statement requires %v privileges", ErrAccessDenied, requiredPrivileges)
+		}
+
+		err := tx.checkTablesAccessedBy(stmt, p)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -934,20 +1081,28 @@ func (e *Engine) queryAll(ctx context.Context, tx *SQLTx, sql string, params map
 }
 
 func (e *Engine) Query(ctx context.Context, tx *SQLTx, sql string, params map[string]interface{}) (RowReader, error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	stmts, release, err := e.PrepareStmts(sql)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrParsingError, err)
 	}
 	if len(stmts) != 1 {
+		release()
 		return nil, ErrExpectingDQLStmt
 	}
 
 	stmt, ok := stmts[0].(DataSource)
 	if !ok {
+		release()
 		return nil, ErrExpectingDQLStmt
 	}
 
-	return e.QueryPreparedStmt(ctx, tx, stmt, params)
+	r, err := e.QueryPreparedStmt(ctx, tx, stmt, params)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	return &stmtsRowReader{RowReader: r, release: release}, nil
 }
 
 func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSource, params map[string]interface{}) (rowReader RowReader, err error) {
@@ -976,7 +1131,7 @@ func (e *Engine) QueryPreparedStmt(ctx context.Context, tx *SQLTx, stmt DataSour
 	}
 
 	if e.multidbHandler != nil {
-		if err := e.checkUserPermissions(ctx, stmt); err != nil {
+		if err := e.checkUserPermissions(ctx, qtx, stmt); err != nil {
 			return nil, err
 		}
 	}
@@ -1022,11 +1177,23 @@ func (e *Engine) Catalog(ctx context.Context, tx *SQLTx) (catalog *Catalog, err
 }
 
 func (e *Engine) InferParameters(ctx context.Context, tx *SQLTx, sql string) (params map[string]SQLValueType, err error) {
-	stmts, err := ParseSQL(strings.NewReader(sql))
+	p, release, err := e.prepareStmts(sql)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", ErrParsingError, err)
 	}
-	return e.InferParametersPreparedStmts(ctx, tx, stmts)
+	defer release()
+
+	qtx := tx
+
+	if qtx == nil {
+		qtx, err = e.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+		if err != nil {
+			return nil, err
+		}
+		defer qtx.Cancel()
+	}
+
+	return e.inferParameters(ctx, qtx, p)
 }
 
 func (e *Engine) InferParametersPreparedStmts(ctx context.Context, tx *SQLTx, stmts []SQLStmt) (params map[string]SQLValueType, err error) {
@@ -18,6 +18,7 @@ package sql
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -29,6 +30,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -61,6 +63,54 @@ func setupCommonTest(t *testing.T) *Engine {
 	return engine
 }
 
+func setupCommonTestWithEngineOptions(t *testing.T, opts *Options) (*Engine, *store.ImmuStore) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	t.Cleanup(func() { closeStore(t, st) })
+
+	engine, err := NewEngine(st, opts)
+	require.NoError(t, err)
+
+	return engine, st
+}
+
+// queryValues returns the raw values of the rows returned by query.
+func queryValues(t *testing.T, engine *Engine, tx *SQLTx, query string, params map[string]interface{}) [][]interface{} {
+	t.Helper()
+	return queryRowsAs(t, engine, tx, query, params, TypedValue.RawValue)
+}
+
+// queryStrings returns the values of the rows returned by query as strings.
+func queryStrings(t *testing.T, engine *Engine, query string) [][]string {
+	t.Helper()
+	return queryRowsAs(t, engine, nil, query, nil, TypedValue.String)
+}
+
+func queryRowsAs[V any](t *testing.T, engine *Engine, tx *SQLTx, query string, params map[string]interface{}, conv func(TypedValue) V) [][]V {
+	t.Helper()
+
+	r, err := engine.Query(context.Background(), tx, query, params)
+	require.NoError(t, err)
+	defer r.Close()
+
+	var rows [][]V
+
+	for {
+		row, err := r.Read(context.Background())
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		require.NoError(t, err)
+
+		vals := make([]V, len(row.ValuesByPosition))
+		for i, v := range row.ValuesByPosition {
+			vals[i] = conv(v)
+		}
+		rows = append(rows, vals)
+	}
+	return rows
+}
+
 func TestCreateDatabaseWithoutMultiIndexingEnabled(t *testing.T) {
 	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(false))
 	require.NoError(t, err)
@@ -6310,7 +6360,7 @@ func TestWindowFunctionsAdvanced(t *testing.T) {
 		row, err := r.Read(context.Background())
 		require.NoError(t, err)
 		require.Equal(t, int64(70), row.ValuesByPosition[1].RawValue())  // first_value = min
-		require.Equal(t, int64(90), row.ValuesByPosition[2].RawValue())  // last_value = max
+		require.Equal(t, int64(70), row.ValuesByPosition[2].RawValue())  // last_value = current row
 
 		r.Close()
 	})
@@ -6669,7 +6719,6 @@ func TestForeignKeyConstraint(t *testing.T) {
 		`CREATE TABLE customers (id INTEGER, name VARCHAR, PRIMARY KEY id)`, nil)
 	require.NoError(t, err)
 
-	// Table with FOREIGN KEY constraint (parsed but not enforced)
 	_, _, err = engine.Exec(context.Background(), nil,
 		`CREATE TABLE orders (
 			id INTEGER,
@@ -6679,9 +6728,12 @@ func TestForeignKeyConstraint(t *testing.T) {
 		)`, nil)
 	require.NoError(t, err)
 
-	// Should be able to insert without FK enforcement
 	_, _, err = engine.Exec(context.Background(), nil,
 		`INSERT INTO orders (id, customer_id) VALUES (1, 999)`, nil) // non-existent customer
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil,
+		`INSERT INTO customers (id, name) VALUES (999, 'jane'); INSERT INTO orders (id, customer_id) VALUES (1, 999)`, nil)
 	require.NoError(t, err)
 }
 
@@ -8411,7 +8463,12 @@ func TestInferParametersInvalidCases(t *testing.T) {
 	_, err = engine.InferParameters(context.Background(), nil, "BEGIN TRANSACTION; INSERT INTO mytable(id, title) VALUES (@param1, @param1); COMMIT;")
 	require.ErrorIs(t, err, ErrInferredMultipleTypes)
 
+	// unknown functions may be stored functions, which are only looked up
+	// when called
 	_, err = engine.InferParameters(context.Background(), nil, "SELECT * FROM mytable WHERE id > INVALID_FUNCTION()")
+	require.NoError(t, err)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT INVALID_FUNCTION()", nil)
 	require.ErrorIs(t, err, ErrIllegalArguments)
 
 	_, err = engine.InferParameters(context.Background(), nil, "SELECT * FROM mytable WHERE id > CAST(wrong_column_name AS INTEGER)")
@@ -9150,6 +9207,294 @@ func TestTemporalQueries(t *testing.T) {
 	})
 }
 
+func TestTemporalTableReferences(t *testing.T) {
+	var clock atomic.Int64
+	clock.Store(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Unix())
+
+	// tx timestamps have a resolution of a second, the clock is moved
+	// forward before each tx so that every tx has its own timestamp
+	engine, _ := setupCommonTestWithOptions(t, store.DefaultOptions().WithTimeFunc(func() time.Time {
+		return time.Unix(clock.Load(), 0)
+	}))
+
+	exec := func(t *testing.T, stmt string) time.Time {
+		clock.Add(10)
+
+		_, ctxs, err := engine.Exec(context.Background(), nil, stmt, nil)
+		require.NoError(t, err)
+		require.Len(t, ctxs, 1)
+
+		return time.Unix(ctxs[0].TxHeader().Ts, 0)
+	}
+
+	exec(t, "CREATE TABLE table1(id INTEGER, title VARCHAR[50], PRIMARY KEY id)")
+	ts1 := exec(t, "INSERT INTO table1(id, title) VALUES (1, 'title1'), (2, 'other')")
+	ts2 := exec(t, "UPDATE table1 SET title = 'title2' WHERE id = 1")
+	ts3 := exec(t, "DELETE FROM table1 WHERE id = 2")
+
+	params := map[string]interface{}{
+		"ts1":         ts1,
+		"ts2":         ts2,
+		"ts3":         ts3,
+		"afterTs1":    ts1.Add(5 * time.Second),
+		"afterTs3":    ts3.Add(5 * time.Second),
+		"beforeFirst": ts1.Add(-time.Hour),
+	}
+
+	queryTitles := func(t *testing.T, query string) []string {
+		rows := queryRowsAs(t, engine, nil, query, params, TypedValue.String)
+
+		titles := make([]string, len(rows))
+		for i, row := range rows {
+			titles[i] = strings.Join(row, ",")
+		}
+		return titles
+	}
+
+	t.Run("as of timestamp before the update should return the old version", func(t *testing.T) {
+		require.Equal(t, []string{"'title1'", "'other'"}, queryTitles(t, "SELECT title FROM table1 AS OF TIMESTAMP @ts1"))
+		require.Equal(t, []string{"'title1'", "'other'"}, queryTitles(t, "SELECT title FROM table1 AS OF TIMESTAMP @afterTs1"))
+	})
+
+	t.Run("as of timestamp after the update should return the new version", func(t *testing.T) {
+		require.Equal(t, []string{"'title2'", "'other'"}, queryTitles(t, "SELECT t.title FROM table1 AS OF TIMESTAMP @ts2 AS t"))
+		require.Equal(t, []string{"'title2'"}, queryTitles(t, "SELECT title FROM table1 AS OF TIMESTAMP @ts3"))
+	})
+
+	t.Run("as of timestamp before any tx should return no rows", func(t *testing.T) {
+		require.Empty(t, queryTitles(t, "SELECT title FROM table1 AS OF TIMESTAMP @beforeFirst"))
+	})
+
+	t.Run("for system_time between should return the versions valid within the period", func(t *testing.T) {
+		require.Empty(t, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @beforeFirst AND @beforeFirst"))
+		require.Equal(t, []string{"'title1'", "'other'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @beforeFirst AND @ts1"))
+		require.Equal(t, []string{"'title1'", "'title2'", "'other'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @ts1 AND @ts2"))
+		require.Equal(t, []string{"'title2'", "'other'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @ts2 AND @ts3"))
+	})
+
+	t.Run("for system_time between should return the rows written before the period and still valid in it", func(t *testing.T) {
+		require.Equal(t, []string{"'title1'", "'other'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @afterTs1 AND @afterTs1"))
+		require.Equal(t, []string{"'title2'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @ts3 AND now()"))
+		require.Equal(t, []string{"'title2'"}, queryTitles(t, "SELECT title FROM table1 FOR SYSTEM_TIME BETWEEN @afterTs3 AND @afterTs3"))
+	})
+
+	t.Run("for system_time between should return every version in descending order", func(t *testing.T) {
+		require.Equal(t, []string{"2,'other'", "1,'title2'", "1,'title1'"}, queryTitles(t, "SELECT id, title FROM table1 FOR SYSTEM_TIME BETWEEN @ts1 AND @ts3 ORDER BY id DESC"))
+	})
+
+	t.Run("a table can be joined with itself at two points in time", func(t *testing.T) {
+		titles := queryTitles(t, `
+			SELECT old.title, new.title
+			FROM table1 AS OF TIMESTAMP @ts1 AS old
+			INNER JOIN table1 AS OF TIMESTAMP @ts2 AS new ON old.id = new.id
+		`)
+		require.Equal(t, []string{"'title1','title2'", "'other','other'"}, titles)
+	})
+}
+
+func TestSystemColumns(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, "CREATE TABLE table1(id INTEGER, title VARCHAR[50], _tx_id INTEGER, PRIMARY KEY id)", nil)
+	require.ErrorIs(t, err, ErrReservedWord)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE table1(id INTEGER, title VARCHAR[50], PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE table2(id INTEGER, table1_id INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	tsBefore := time.Now().Truncate(time.Second)
+
+	_, txs1, err := engine.Exec(context.Background(), nil, "INSERT INTO table1(id, title) VALUES (1, 'title1'), (2, 'title2')", nil)
+	require.NoError(t, err)
+	hdr1 := txs1[0].TxHeader()
+
+	_, txs2, err := engine.Exec(context.Background(), nil, "UPDATE table1 SET title = 'title1b' WHERE id = 1", nil)
+	require.NoError(t, err)
+	hdr2 := txs2[0].TxHeader()
+
+	_, txs3, err := engine.Exec(context.Background(), nil, "INSERT INTO table2(id, table1_id) VALUES (1, 1)", nil)
+	require.NoError(t, err)
+	hdr3 := txs3[0].TxHeader()
+
+	t.Run("system columns should be selectable on any table", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id, _tx_id, _tx_timestamp, _revision FROM table1", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, int64(hdr2.ID), rows[0].ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(2), rows[0].ValuesByPosition[3].RawValue())
+
+		require.Equal(t, int64(hdr1.ID), rows[1].ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(1), rows[1].ValuesByPosition[3].RawValue())
+
+		for _, row := range rows {
+			ts := row.ValuesByPosition[2].RawValue().(time.Time)
+			require.False(t, ts.Before(tsBefore))
+			require.False(t, ts.After(time.Now()))
+		}
+	})
+
+	t.Run("system columns should be filterable", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT id FROM table1 WHERE _tx_id = @tx", map[string]interface{}{"tx": hdr1.ID})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(2), rows[0].ValuesByPosition[0].RawValue())
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT COUNT(*) FROM table1 WHERE _revision > 1 AND _tx_timestamp <= NOW()", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	t.Run("system columns should not be returned by wildcard selection", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, "SELECT * FROM table1 WHERE _tx_id > 0", nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		cols, err := r.Columns(context.Background())
+		require.NoError(t, err)
+		require.Len(t, cols, 2)
+	})
+
+	t.Run("system columns should be combinable with history", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT title, _tx_id, _revision FROM (HISTORY OF table1) WHERE id = 1", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		require.Equal(t, "title1", rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(hdr1.ID), rows[0].ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(1), rows[0].ValuesByPosition[2].RawValue())
+
+		require.Equal(t, "title1b", rows[1].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(hdr2.ID), rows[1].ValuesByPosition[1].RawValue())
+		require.Equal(t, int64(2), rows[1].ValuesByPosition[2].RawValue())
+	})
+
+	t.Run("system columns of joined tables should be selectable", func(t *testing.T) {
+		rows, err := engine.queryAll(context.Background(), nil, `
+			SELECT t1._tx_id, t2._tx_id
+			FROM table2 AS t2
+			INNER JOIN table1 AS t1 ON t1.id = t2.table1_id AND t1._revision = 2
+		`, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, int64(hdr2.ID), rows[0].ValuesByPosition[0].RawValue())
+		require.Equal(t, int64(hdr3.ID), rows[0].ValuesByPosition[1].RawValue())
+	})
+
+	t.Run("rows written by the ongoing transaction have no tx yet", func(t *testing.T) {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithExplicitClose(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		_, _, err = engine.Exec(context.Background(), tx, "INSERT INTO table1(id, title) VALUES (3, 'title3')", nil)
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(context.Background(), tx, "SELECT _tx_id, _tx_timestamp FROM table1 WHERE id = 3", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.True(t, rows[0].ValuesByPosition[0].IsNull())
+		require.True(t, rows[0].ValuesByPosition[1].IsNull())
+	})
+}
+
+func TestSystemColumnsShadowedByExistingColumns(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE legacy(id INTEGER, tx_id INTEGER, title VARCHAR[50], revision INTEGER, PRIMARY KEY id);
+		INSERT INTO legacy(id, tx_id, title, revision) VALUES (1, 100, 'title1', 10), (2, 200, 'title2', 20);
+	`, nil)
+	require.NoError(t, err)
+
+	// the columns are named after the pseudo-columns, as they could have been
+	// when the pseudo-columns did not exist yet, by overwriting their catalog
+	// entries: {flags(1)}{maxLen(4)}{colName}
+	legacyCol := func(colID uint32, colName string) (k, v []byte) {
+		k = MapKey(sqlPrefix, catalogColumnPrefix, EncodeID(1), EncodeID(1), EncodeID(colID), []byte(IntegerType))
+
+		v = make([]byte, 1+4+len(colName))
+		binary.BigEndian.PutUint32(v[1:], 8)
+		copy(v[5:], colName)
+
+		return k, v
+	}
+
+	tx, err := st.NewTx(context.Background(), store.DefaultTxOptions())
+	require.NoError(t, err)
+
+	for colID, colName := range map[uint32]string{2: "_tx_id", 4: "_revision"} {
+		k, v := legacyCol(colID, colName)
+
+		err = tx.Set(k, nil, v)
+		require.NoError(t, err)
+	}
+
+	_, err = tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+	engine = reopened
+
+	_, ctxs, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE other(id INTEGER, PRIMARY KEY id);
+		INSERT INTO other(id) VALUES (1);
+	`, nil)
+	require.NoError(t, err)
+
+	otherTxID := int64(ctxs[0].TxHeader().ID)
+
+	t.Run("the legacy table should be loaded", func(t *testing.T) {
+		catalog, err := engine.Catalog(context.Background(), nil)
+		require.NoError(t, err)
+
+		table, err := catalog.GetTableByName("legacy")
+		require.NoError(t, err)
+
+		for colID, colName := range map[uint32]string{1: "id", 2: "_tx_id", 3: "title", 4: "_revision"} {
+			col, err := table.GetColumnByName(colName)
+			require.NoError(t, err)
+			require.Equal(t, colID, col.ID())
+		}
+	})
+
+	t.Run("the existing columns should be returned by select *", func(t *testing.T) {
+		require.Equal(t, [][]interface{}{
+			{int64(1), int64(100), "title1", int64(10)},
+			{int64(2), int64(200), "title2", int64(20)},
+		}, queryValues(t, engine, nil, "SELECT * FROM legacy", nil))
+	})
+
+	t.Run("the existing columns should be read instead of the pseudo-columns", func(t *testing.T) {
+		require.Equal(t, [][]interface{}{{int64(200)}}, queryValues(t, engine, nil, "SELECT _tx_id FROM legacy WHERE _tx_id = 200", nil))
+		require.Equal(t, [][]interface{}{{int64(100), int64(10)}}, queryValues(t, engine, nil, "SELECT _tx_id, _revision FROM legacy WHERE id = 1", nil))
+		require.Equal(t, [][]interface{}{{int64(2)}}, queryValues(t, engine, nil, "SELECT id FROM legacy WHERE _revision = 20", nil))
+	})
+
+	t.Run("_rev should still be read on the history of the table", func(t *testing.T) {
+		require.Equal(t, [][]interface{}{{int64(1), int64(10)}}, queryValues(t, engine, nil, "SELECT _rev, _revision FROM (HISTORY OF legacy) WHERE id = 1", nil))
+	})
+
+	t.Run("the pseudo-columns should still be read on other tables", func(t *testing.T) {
+		require.Equal(t, [][]interface{}{{int64(100), otherTxID, int64(10), int64(1)}}, queryValues(t, engine, nil, `
+			SELECT legacy._tx_id, other._tx_id, legacy._revision, other._revision
+			FROM legacy
+			INNER JOIN other ON other.id = legacy.id
+		`, nil))
+	})
+
+	t.Run("no other column should be named after a pseudo-column", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "ALTER TABLE legacy ADD COLUMN _tx_timestamp TIMESTAMP", nil)
+		require.ErrorIs(t, err, ErrReservedWord)
+
+		_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE legacy2(id INTEGER, _tx_id INTEGER, PRIMARY KEY id)", nil)
+		require.ErrorIs(t, err, ErrReservedWord)
+	})
+}
+
 func TestHistoricalQueries(t *testing.T) {
 	engine := setupCommonTest(t)
 
@@ -9862,6 +10207,7 @@ func (u *mockUser) SQLPrivileges() []SQLPrivilege {
 type multidbHandlerMock struct {
 	dbs    []string
 	user   *mockUser
+	users  []*mockUser // listed users, only the logged one when empty
 	engine *Engine
 }
 
@@ -9893,7 +10239,15 @@ func (h *multidbHandlerMock) GetLoggedUser(ctx context.Context) (User, error) {
 }
 
 func (h *multidbHandlerMock) ListUsers(ctx context.Context) ([]User, error) {
-	return []User{h.user}, nil
+	if len(h.users) == 0 {
+		return []User{h.user}, nil
+	}
+
+	users := make([]User, len(h.users))
+	for i, u := range h.users {
+		users[i] = u
+	}
+	return users, nil
 }
 
 func (h *multidbHandlerMock) CreateUser(ctx context.Context, username, password string, permission Permission) error {
@@ -11802,6 +12156,42 @@ func TestQueryTxMetadata(t *testing.T) {
 	require.ErrorIs(t, err, ErrInvalidTxMetadata)
 }
 
+type internalCallsHandlerMock struct {
+	multidbHandlerMock
+}
+
+func (h *internalCallsHandlerMock) GetLoggedUser(ctx context.Context) (User, error) {
+	return nil, nil
+}
+
+func TestStmtsWithoutLoggedUser(t *testing.T) {
+	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	handler := &internalCallsHandlerMock{}
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler))
+	require.NoError(t, err)
+
+	handler.engine = engine
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE mytable(id INTEGER, PRIMARY KEY id)", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	_, err = engine.queryAll(context.Background(), nil, "SELECT * FROM mytable", nil)
+	require.ErrorIs(t, err, ErrAccessDenied)
+
+	ctx := WithInternalCall(context.Background())
+
+	_, _, err = engine.Exec(ctx, nil, "CREATE TABLE mytable(id INTEGER, PRIMARY KEY id); INSERT INTO mytable(id) VALUES (1)", nil)
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(ctx, nil, "SELECT * FROM mytable", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+}
+
 func TestGrantSQLPrivileges(t *testing.T) {
 	st, err := store.Open(t.TempDir(), store.DefaultOptions().WithMultiIndexing(true))
 	require.NoError(t, err)
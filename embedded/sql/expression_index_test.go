@@ -0,0 +1,242 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexExpressionStmts(t *testing.T) {
+	stmts, err := ParseSQLString("CREATE INDEX ON t (id, (lower(email)), (data->'customer'->>'id'))")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	stmt, ok := stmts[0].(*CreateIndexStmt)
+	require.True(t, ok)
+	require.Equal(t, []string{"id", "lower(email)", "data->'customer'->>'id'"}, stmt.cols)
+	require.Len(t, stmt.exps, 3)
+	require.Nil(t, stmt.exps[0])
+	require.IsType(t, &FnCall{}, stmt.exps[1])
+	require.Equal(t, &JSONSelector{ColSelector: &ColSelector{col: "data"}, fields: []string{"customer", "id"}, text: true}, stmt.exps[2])
+
+	stmts, err = ParseSQLString("CREATE INDEX ON t ((email))")
+	require.NoError(t, err)
+	require.Equal(t, &CreateIndexStmt{table: "t", cols: []string{"email"}}, stmts[0])
+
+	stmts, err = ParseSQLString("DROP INDEX ON t ((lower(email)))")
+	require.NoError(t, err)
+	require.Equal(t, &DropIndexStmt{table: "t", cols: []string{"lower(email)"}}, stmts[0])
+}
+
+func TestJSONTextSelector(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE docs (id INTEGER AUTO_INCREMENT, data JSON, PRIMARY KEY id);
+		INSERT INTO docs (data) VALUES
+			('{"customer": {"id": "c1", "age": 30, "tags": ["a"]}}'),
+			('{"customer": {"id": null}}'),
+			(NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(context.Background(), nil, `
+		SELECT data->'customer'->>'id', data->'customer'->>'age', data->'customer'->>'tags', data->>'missing'
+		FROM docs
+		ORDER BY id`, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	require.Equal(t, []TypedValue{NewVarchar("c1"), NewVarchar("30"), NewVarchar(`["a"]`), NewNull(VarcharType)}, rows[0].ValuesByPosition)
+	require.Equal(t, []TypedValue{NewNull(VarcharType), NewNull(VarcharType), NewNull(VarcharType), NewNull(VarcharType)}, rows[1].ValuesByPosition)
+	require.Equal(t, []TypedValue{NewNull(VarcharType), NewNull(VarcharType), NewNull(VarcharType), NewNull(VarcharType)}, rows[2].ValuesByPosition)
+
+	cols, err := engine.queryAll(context.Background(), nil, "SELECT data->'customer'->>'id' FROM docs WHERE data->'customer'->>'id' = 'c1'", nil)
+	require.NoError(t, err)
+	require.Len(t, cols, 1)
+}
+
+func TestExpressionIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	exec := func(sql string) error {
+		_, _, err := engine.Exec(context.Background(), nil, sql, nil)
+		return err
+	}
+
+	query := func(sql string) []*Row {
+		rows, err := engine.queryAll(context.Background(), nil, sql, nil)
+		require.NoError(t, err, sql)
+		return rows
+	}
+
+	ids := func(sql string) []int64 {
+		var ids []int64
+		for _, row := range query(sql) {
+			ids = append(ids, row.ValuesByPosition[0].RawValue().(int64))
+		}
+		return ids
+	}
+
+	plan := func(sql string) string {
+		var lines []string
+		for _, row := range query("EXPLAIN " + sql) {
+			lines = append(lines, row.ValuesByPosition[0].RawValue().(string))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	err = exec(`
+		CREATE TABLE users (id INTEGER AUTO_INCREMENT, email VARCHAR[64], payload JSON, PRIMARY KEY id);
+		INSERT INTO users (email, payload) VALUES
+			('Alice@Example.com', '{"customer": {"id": "c3"}}'),
+			('bob@example.com', '{"customer": {"id": "c1"}}'),
+			(NULL, NULL);
+	`)
+	require.NoError(t, err)
+
+	// indexes are built over the rows already in the table
+	err = exec(`
+		CREATE INDEX ON users ((lower(email)));
+		CREATE INDEX ON users ((payload->'customer'->>'id'));
+	`)
+	require.NoError(t, err)
+
+	err = exec(`INSERT INTO users (email, payload) VALUES ('CAROL@example.com', '{"customer": {"id": "c2"}}')`)
+	require.NoError(t, err)
+
+	t.Run("expressions in WHERE should be looked up using the index", func(t *testing.T) {
+		require.Contains(t, plan("SELECT id FROM users WHERE lower(email) = 'alice@example.com'"), "-> Index Scan using (lower(email)) on users")
+		require.Equal(t, []int64{1}, ids("SELECT id FROM users WHERE lower(email) = 'alice@example.com'"))
+		require.Equal(t, []int64{4}, ids("SELECT id FROM users WHERE 'carol@example.com' = lower(email)"))
+		require.Equal(t, []int64{4}, ids("SELECT id FROM users u WHERE lower(u.email) = 'carol@example.com'"))
+		require.Empty(t, ids("SELECT id FROM users WHERE lower(email) = 'Alice@Example.com'"))
+
+		require.Contains(t, plan("SELECT id FROM users WHERE payload->'customer'->>'id' = 'c1'"), "-> Index Scan using (payload->'customer'->>'id') on users")
+		require.Equal(t, []int64{2}, ids("SELECT id FROM users WHERE payload->'customer'->>'id' = 'c1'"))
+		// NULL values sort first and compare lower than any value
+		require.Equal(t, []int64{3, 2, 4}, ids("SELECT id FROM users WHERE payload->'customer'->>'id' < 'c3' ORDER BY payload->'customer'->>'id'"))
+	})
+
+	t.Run("expressions in ORDER BY should be sorted by the index", func(t *testing.T) {
+		require.Equal(t, []int64{3, 1, 2, 4}, ids("SELECT id FROM users ORDER BY lower(email)"))
+		require.Equal(t, []int64{1, 4, 2, 3}, ids("SELECT id FROM users ORDER BY payload->'customer'->>'id' DESC"))
+
+		rows := query("EXPLAIN ANALYZE SELECT id FROM users ORDER BY lower(email)")
+		for _, row := range rows {
+			require.NotContains(t, row.ValuesByPosition[0].RawValue().(string), "Sort")
+		}
+	})
+
+	t.Run("updated and deleted rows should be reindexed", func(t *testing.T) {
+		err := exec("UPDATE users SET email = 'Bobby@example.com' WHERE id = 2")
+		require.NoError(t, err)
+
+		require.Empty(t, ids("SELECT id FROM users WHERE lower(email) = 'bob@example.com'"))
+		require.Equal(t, []int64{2}, ids("SELECT id FROM users WHERE lower(email) = 'bobby@example.com'"))
+
+		err = exec("DELETE FROM users WHERE id = 4")
+		require.NoError(t, err)
+
+		require.Empty(t, ids("SELECT id FROM users WHERE payload->'customer'->>'id' = 'c2'"))
+	})
+
+	t.Run("unique expression indexes should reject duplicated values", func(t *testing.T) {
+		err := exec(`
+			CREATE TABLE accounts (id INTEGER AUTO_INCREMENT, name VARCHAR[32], PRIMARY KEY id);
+			CREATE UNIQUE INDEX ON accounts ((lower(name)));
+		`)
+		require.NoError(t, err)
+
+		err = exec("INSERT INTO accounts (name) VALUES ('Admin')")
+		require.NoError(t, err)
+
+		err = exec("INSERT INTO accounts (name) VALUES ('ADMIN')")
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+	})
+
+	t.Run("invalid index expressions should be rejected", func(t *testing.T) {
+		err := exec("CREATE INDEX ON users ((payload->'customer'))")
+		require.ErrorIs(t, err, ErrCannotIndexJson)
+
+		err = exec("CREATE INDEX ON users ((1 + 1))")
+		require.ErrorIs(t, err, ErrInvalidIndexExpression)
+
+		err = exec("CREATE INDEX ON users ((lower(missing)))")
+		require.ErrorIs(t, err, ErrInvalidIndexExpression)
+
+		err = exec("CREATE INDEX ON users ((unknown_fn(email)))")
+		require.ErrorIs(t, err, ErrInvalidIndexExpression)
+	})
+
+	t.Run("values too long to be indexed should be rejected", func(t *testing.T) {
+		long := strings.Repeat("x", MaxIndexExpLen+1)
+
+		err := exec(`INSERT INTO users (payload) VALUES ('{"customer": {"id": "` + long + `"}}')`)
+		require.ErrorIs(t, err, ErrMaxLengthExceeded)
+
+		err = exec(`
+			CREATE TABLE notes (id INTEGER AUTO_INCREMENT, body JSON, PRIMARY KEY id);
+			INSERT INTO notes (body) VALUES ('{"title": "` + long + `"}');
+		`)
+		require.NoError(t, err)
+
+		err = exec("CREATE INDEX ON notes ((body->>'title'))")
+		require.ErrorIs(t, err, ErrMaxLengthExceeded)
+	})
+
+	t.Run("columns used by index expressions can not be dropped nor renamed", func(t *testing.T) {
+		err := exec("ALTER TABLE users DROP COLUMN email")
+		require.ErrorIs(t, err, ErrCannotDropColumn)
+
+		err = exec("ALTER TABLE users RENAME COLUMN email TO mail")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	require.NoError(t, st.Close())
+
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	t.Run("index expressions should be loaded from the catalog", func(t *testing.T) {
+		require.Contains(t, plan("SELECT id FROM users WHERE lower(email) = 'bobby@example.com'"), "-> Index Scan using (lower(email)) on users")
+		require.Equal(t, []int64{2}, ids("SELECT id FROM users WHERE lower(email) = 'bobby@example.com'"))
+
+		err = exec("INSERT INTO users (email) VALUES ('Dave@example.com')")
+		require.NoError(t, err)
+		require.Equal(t, []int64{5}, ids("SELECT id FROM users WHERE lower(email) = 'dave@example.com'"))
+	})
+
+	t.Run("dropping the index should release its columns", func(t *testing.T) {
+		err := exec("DROP INDEX ON users ((lower(email)))")
+		require.NoError(t, err)
+
+		require.NotContains(t, plan("SELECT id FROM users WHERE lower(email) = 'bobby@example.com'"), "Index Scan")
+		require.Equal(t, []int64{2}, ids("SELECT id FROM users WHERE lower(email) = 'bobby@example.com'"))
+
+		err = exec("ALTER TABLE users DROP COLUMN email")
+		require.NoError(t, err)
+
+		err = exec("DROP TABLE users")
+		require.NoError(t, err)
+	})
+}
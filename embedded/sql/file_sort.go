@@ -64,7 +64,12 @@ func (s *fileSorter) update(r *Row) error {
 		s.nextIdx = 0
 	}
 
-	s.sortBuf[s.nextIdx] = r
+	// the buffer is allocated lazily by sorters never expected to spill
+	if s.nextIdx == len(s.sortBuf) {
+		s.sortBuf = append(s.sortBuf, r)
+	} else {
+		s.sortBuf[s.nextIdx] = r
+	}
 	s.nextIdx++
 
 	return nil
@@ -198,6 +203,9 @@ func (s *fileSorter) mergeAllChunks() (resultReader, error) {
 		}
 
 		chunks = newChunks
+
+		// each pass rewrites all the sorted rows
+		s.tx.addSpilledBytes(s.tempFileSize)
 	}
 
 	return &fileRowReader{
@@ -430,6 +438,7 @@ func (s *fileSorter) flushBuffer() error {
 		size:   chunkSize,
 	})
 	s.tempFileSize += chunkSize
+	s.tx.addSpilledBytes(chunkSize)
 	return nil
 }
 
@@ -448,6 +457,10 @@ func (s *fileSorter) tempFileWriter() (*bufio.Writer, error) {
 }
 
 func (s *fileSorter) encodeRow(r *Row) ([]byte, error) {
+	return encodeRow(r, s.colTypes)
+}
+
+func encodeRow(r *Row, colTypes []SQLValueType) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.Write([]byte{0, 0}) // make room for size field
 
@@ -457,7 +470,7 @@ func (s *fileSorter) encodeRow(r *Row) ([]byte, error) {
 		// NULL. Substitute a typed NULL so encoding never dereferences nil and
 		// the slot round-trips through the temp file as NULL.
 		if v == nil {
-			v = &NullValue{t: s.colTypes[i]}
+			v = &NullValue{t: colTypes[i]}
 		}
 		rawValue, err := EncodeNullableValue(v, v.Type(), -1)
 		if err != nil {
@@ -0,0 +1,678 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Foreign keys are enforced inside the SQLTx that modifies the rows, so
+// every check observes the writes made earlier in the same transaction.
+//
+// Existence checks on the referencing side and NO ACTION checks on the
+// referenced side are deferred until the end of the statement (see
+// validateForeignKeys), which lets a single statement insert rows that
+// reference each other or delete a whole self-referencing hierarchy.
+// RESTRICT is checked immediately; CASCADE and SET NULL are applied as
+// soon as the referenced row is deleted or its key is updated.
+
+// pendingFKCheck is a foreign key validation deferred to the end of the
+// statement that triggered it.
+type pendingFKCheck struct {
+	table *Table // referencing table
+	fk    *ForeignKey
+	vals  []TypedValue // key values, ordered as fk.colIDs / fk.refColIDs
+
+	// when referenced is true a row holding vals must exist in the
+	// referenced table, otherwise no row of table may still reference vals.
+	referenced bool
+}
+
+// checkForeignKeys registers the validation of the foreign keys declared
+// on table for a row being written with newVals. oldVals holds the
+// previous values of the row, if any, so unchanged references are not
+// checked again.
+func (tx *SQLTx) checkForeignKeys(table *Table, oldVals, newVals map[uint32]TypedValue) error {
+	for _, fk := range table.foreignKeys {
+		vals, hasNull := valuesOf(newVals, fk.colIDs)
+		if hasNull {
+			// MATCH SIMPLE: a reference with a NULL column is not checked
+			continue
+		}
+
+		if oldVals != nil {
+			prevVals, _ := valuesOf(oldVals, fk.colIDs)
+
+			same, err := sameValues(prevVals, vals)
+			if err != nil {
+				return err
+			}
+			if same {
+				continue
+			}
+		}
+
+		tx.pendingFKChecks = append(tx.pendingFKChecks, pendingFKCheck{
+			table:      table,
+			fk:         fk,
+			vals:       vals,
+			referenced: true,
+		})
+	}
+	return nil
+}
+
+// onReferencedRowDelete applies the ON DELETE action of every foreign key
+// referencing a deleted row of table.
+func (tx *SQLTx) onReferencedRowDelete(ctx context.Context, table *Table, oldVals map[uint32]TypedValue) error {
+	refs := tx.catalog.referencingForeignKeys(table)
+	if len(refs) == 0 {
+		return nil
+	}
+
+	// rows modified by referential actions are not reported as updated rows
+	updatedRows := tx.updatedRows
+	defer func() { tx.updatedRows = updatedRows }()
+
+	for _, ref := range refs {
+		vals, hasNull := valuesOf(oldVals, ref.fk.refColIDs)
+		if hasNull {
+			continue
+		}
+
+		err := tx.applyReferentialAction(ctx, ref, ref.fk.onDelete, vals, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onReferencedRowUpdate applies the ON UPDATE action of every foreign key
+// whose referenced columns of table changed from oldVals to newVals.
+func (tx *SQLTx) onReferencedRowUpdate(ctx context.Context, table *Table, oldVals, newVals map[uint32]TypedValue) error {
+	refs := tx.catalog.referencingForeignKeys(table)
+	if len(refs) == 0 {
+		return nil
+	}
+
+	updatedRows := tx.updatedRows
+	defer func() { tx.updatedRows = updatedRows }()
+
+	for _, ref := range refs {
+		vals, hasNull := valuesOf(oldVals, ref.fk.refColIDs)
+		if hasNull {
+			continue
+		}
+
+		newRefVals, _ := valuesOf(newVals, ref.fk.refColIDs)
+
+		same, err := sameValues(vals, newRefVals)
+		if err != nil {
+			return err
+		}
+		if same {
+			continue
+		}
+
+		err = tx.applyReferentialAction(ctx, ref, ref.fk.onUpdate, vals, newRefVals)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyReferentialAction handles the rows of ref.table referencing vals.
+// newVals is nil when the referenced row was deleted.
+func (tx *SQLTx) applyReferentialAction(ctx context.Context, ref tableForeignKey, action ReferentialAction, vals, newVals []TypedValue) error {
+	switch action {
+	case ReferentialNoAction:
+		{
+			tx.pendingFKChecks = append(tx.pendingFKChecks, pendingFKCheck{
+				table: ref.table,
+				fk:    ref.fk,
+				vals:  vals,
+			})
+			return nil
+		}
+	case ReferentialRestrict:
+		{
+			rows, err := tx.lookupRows(ctx, ref.table, ref.fk.colIDs, vals, 1)
+			if err != nil {
+				return err
+			}
+
+			if len(rows) > 0 {
+				return referencedKeyViolation(tx.catalog, ref)
+			}
+			return nil
+		}
+	}
+
+	rows, err := tx.lookupRows(ctx, ref.table, ref.fk.colIDs, vals, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if action == ReferentialCascade && newVals == nil {
+			err := tx.deleteReferencingRow(ctx, ref.table, row)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		newRow := make(map[uint32]TypedValue, len(row))
+		for colID, v := range row {
+			newRow[colID] = v
+		}
+
+		for i, colID := range ref.fk.colIDs {
+			col := ref.table.colsByID[colID]
+
+			if action == ReferentialSetNull {
+				newRow[colID] = &NullValue{t: col.colType}
+				continue
+			}
+
+			v, err := normalizeColumnValue(col, newVals[i])
+			if err != nil {
+				return err
+			}
+			newRow[colID] = v
+		}
+
+		err := tx.rewriteReferencingRow(ctx, ref.table, row, newRow)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *SQLTx) deleteReferencingRow(ctx context.Context, table *Table, vals map[uint32]TypedValue) error {
+	pkEncVals, err := encodedKey(table.primaryIndex, vals)
+	if err != nil {
+		return err
+	}
+
+	err = tx.deleteIndexEntries(pkEncVals, vals, table)
+	if err != nil {
+		return err
+	}
+
+	return tx.onReferencedRowDelete(ctx, table, vals)
+}
+
+func (tx *SQLTx) rewriteReferencingRow(ctx context.Context, table *Table, oldVals, newVals map[uint32]TypedValue) error {
+	err := table.computeGeneratedValues(tx, newVals)
+	if err != nil {
+		return err
+	}
+
+	row := &Row{
+		ValuesByPosition: make([]TypedValue, len(table.cols)),
+		ValuesBySelector: make(map[string]TypedValue, len(table.cols)),
+	}
+
+	for i, col := range table.cols {
+		v := newVals[col.id]
+
+		if col.notNull && v.IsNull() {
+			return fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
+		}
+
+		row.ValuesByPosition[i] = v
+		row.ValuesBySelector[EncodeSelector("", table.name, col.colName)] = v
+	}
+
+	if err := checkConstraints(tx, table.checkConstraints, row, table.name); err != nil {
+		return err
+	}
+
+	oldPKEncVals, err := encodedKey(table.primaryIndex, oldVals)
+	if err != nil {
+		return err
+	}
+
+	pkEncVals, err := encodedKey(table.primaryIndex, newVals)
+	if err != nil {
+		return err
+	}
+
+	samePK := bytes.Equal(oldPKEncVals, pkEncVals)
+
+	if !samePK {
+		// the referencing columns are part of the primary key: the row
+		// moves to a new key
+		err = tx.deleteIndexEntries(oldPKEncVals, oldVals, table)
+		if err != nil {
+			return err
+		}
+
+		mkey := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals, pkEncVals)
+
+		_, err = tx.get(ctx, mkey)
+		if err == nil {
+			return store.ErrKeyAlreadyExists
+		}
+		if !errors.Is(err, store.ErrKeyNotFound) {
+			return err
+		}
+	}
+
+	err = tx.doUpsert(ctx, pkEncVals, newVals, table, samePK)
+	if err != nil {
+		return err
+	}
+
+	return tx.onReferencedRowUpdate(ctx, table, oldVals, newVals)
+}
+
+// validateForeignKeys runs the checks deferred by the current statement.
+func (tx *SQLTx) validateForeignKeys(ctx context.Context) error {
+	pending := tx.pendingFKChecks
+	tx.pendingFKChecks = nil
+
+	for _, check := range pending {
+		refTable, err := tx.catalog.GetTableByID(check.fk.refTableID)
+		if err != nil {
+			return err
+		}
+
+		refRows, err := tx.lookupRows(ctx, refTable, check.fk.refColIDs, check.vals, 1)
+		if err != nil {
+			return err
+		}
+
+		if check.referenced {
+			if len(refRows) == 0 {
+				return fmt.Errorf("%w: key referenced by '%s' of table '%s' is not present in table '%s'",
+					ErrForeignKeyViolation, check.fk.name, check.table.name, refTable.name)
+			}
+			continue
+		}
+
+		if len(refRows) > 0 {
+			// the referenced key exists again
+			continue
+		}
+
+		rows, err := tx.lookupRows(ctx, check.table, check.fk.colIDs, check.vals, 1)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) > 0 {
+			return referencedKeyViolation(tx.catalog, tableForeignKey{table: check.table, fk: check.fk})
+		}
+	}
+	return nil
+}
+
+func referencedKeyViolation(catlg *Catalog, ref tableForeignKey) error {
+	refTableName := ""
+	if refTable, err := catlg.GetTableByID(ref.fk.refTableID); err == nil {
+		refTableName = refTable.name
+	}
+
+	return fmt.Errorf("%w: key of table '%s' is still referenced by '%s' of table '%s'",
+		ErrForeignKeyViolation, refTableName, ref.fk.name, ref.table.name)
+}
+
+// writtenRows are the rows written to a table within a transaction, see
+// trackWrittenRow.
+type writtenRows struct {
+	byPK    map[string]map[uint32]TypedValue
+	indexes map[string]*writtenRowsIndex // by encoded column ids
+}
+
+// writtenRowsIndex holds the encoded primary keys of written rows by the
+// values they were written with in the columns colIDs.
+type writtenRowsIndex struct {
+	colIDs []uint32
+	pks    map[string]map[string]struct{}
+}
+
+// trackWrittenRow records the key values of a row written to table when the
+// table has foreign keys. Secondary index entries written within a transaction
+// are keyed by value only, so a non-unique index holds at most one of the rows
+// the transaction wrote with the same value; lookupRows checks the tracked rows
+// to find the others.
+//
+// The rows are indexed by every set of columns lookupRows looked them up by,
+// so each lookup only checks the rows written with the values it looks for.
+func (tx *SQLTx) trackWrittenRow(table *Table, pkEncVals []byte, valuesByColID map[uint32]TypedValue) error {
+	if len(table.foreignKeys) == 0 {
+		return nil
+	}
+
+	if tx.writtenRows == nil {
+		tx.writtenRows = make(map[uint32]*writtenRows)
+	}
+
+	rows, ok := tx.writtenRows[table.id]
+	if !ok {
+		rows = &writtenRows{
+			byPK:    make(map[string]map[uint32]TypedValue),
+			indexes: make(map[string]*writtenRowsIndex),
+		}
+		tx.writtenRows[table.id] = rows
+	}
+
+	pk := string(pkEncVals)
+
+	prevVals := rows.byPK[pk]
+	rows.byPK[pk] = valuesByColID
+
+	for _, index := range rows.indexes {
+		if prevVals != nil {
+			valsKey, err := table.encodeColValues(prevVals, index.colIDs)
+			if err != nil {
+				return err
+			}
+			delete(index.pks[valsKey], pk)
+		}
+
+		err := index.add(table, pk, valuesByColID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writtenWith returns the encoded primary keys of the rows written to table
+// with vals in the columns colIDs. The rows are indexed by those columns on
+// the first lookup.
+func (rows *writtenRows) writtenWith(table *Table, colIDs []uint32, vals []TypedValue) (map[string]struct{}, error) {
+	var colsKey []byte
+	for _, colID := range colIDs {
+		colsKey = append(colsKey, EncodeID(colID)...)
+	}
+
+	index, ok := rows.indexes[string(colsKey)]
+	if !ok {
+		index = &writtenRowsIndex{
+			colIDs: colIDs,
+			pks:    make(map[string]map[string]struct{}),
+		}
+
+		for pk, rowVals := range rows.byPK {
+			err := index.add(table, pk, rowVals)
+			if err != nil {
+				return nil, err
+			}
+		}
+		rows.indexes[string(colsKey)] = index
+	}
+
+	valsByColID := make(map[uint32]TypedValue, len(colIDs))
+	for i, colID := range colIDs {
+		valsByColID[colID] = vals[i]
+	}
+
+	valsKey, err := table.encodeColValues(valsByColID, colIDs)
+	if err != nil {
+		return nil, err
+	}
+	return index.pks[valsKey], nil
+}
+
+func (index *writtenRowsIndex) add(table *Table, pk string, rowVals map[uint32]TypedValue) error {
+	valsKey, err := table.encodeColValues(rowVals, index.colIDs)
+	if err != nil {
+		return err
+	}
+
+	pks, ok := index.pks[valsKey]
+	if !ok {
+		pks = make(map[string]struct{})
+		index.pks[valsKey] = pks
+	}
+	pks[pk] = struct{}{}
+
+	return nil
+}
+
+// encodeColValues encodes the values of colIDs in valuesByColID, NULL when
+// missing, into a key comparing them by equality.
+func (t *Table) encodeColValues(valuesByColID map[uint32]TypedValue, colIDs []uint32) (string, error) {
+	var key []byte
+
+	for _, colID := range colIDs {
+		col := t.colsByID[colID]
+
+		v, ok := valuesByColID[colID]
+		if !ok || v == nil {
+			v = &NullValue{t: col.colType}
+		}
+
+		encVal, err := EncodeNullableValue(v, col.colType, 0)
+		if err != nil {
+			return "", err
+		}
+		key = append(key, encVal...)
+	}
+	return string(key), nil
+}
+
+// lookupRows returns the current values of up to limit rows of table
+// (no limit when limit <= 0) holding vals in the columns colIDs.
+//
+// The scan is narrowed with the index sharing the longest column prefix
+// with colIDs. Non-unique indexes are only used on tables with foreign keys,
+// whose rows written within the transaction are tracked: those rows may be
+// missing from the index and the ones written with vals are checked after the
+// scan. Entries read through a secondary index are re-read by primary key and
+// compared again, so rows deleted or updated earlier in the transaction are
+// seen in their current state.
+func (tx *SQLTx) lookupRows(ctx context.Context, table *Table, colIDs []uint32, vals []TypedValue, limit int) ([]map[uint32]TypedValue, error) {
+	valsByColID := make(map[uint32]TypedValue, len(colIDs))
+	for i, colID := range colIDs {
+		valsByColID[colID] = vals[i]
+	}
+
+	trackedRows := len(table.foreignKeys) > 0
+
+	index := table.primaryIndex
+	prefixLen := indexPrefixLen(index, valsByColID)
+
+	for _, idx := range table.indexes {
+		if idx.predicate != nil || (!idx.unique && !trackedRows) {
+			continue
+		}
+
+		if n := indexPrefixLen(idx, valsByColID); n > prefixLen {
+			index = idx
+			prefixLen = n
+		}
+	}
+
+	rangesByColID := make(map[uint32]*typedValueRange, prefixLen)
+	for _, col := range index.cols[:prefixLen] {
+		v := valsByColID[col.id]
+
+		rangesByColID[col.id] = &typedValueRange{
+			lRange: &typedValueSemiRange{val: v, inclusive: true},
+			hRange: &typedValueSemiRange{val: v, inclusive: true},
+		}
+	}
+
+	r, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: index, rangesByColID: rangesByColID})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var rows []map[uint32]TypedValue
+	seen := make(map[string]struct{})
+
+	collect := func(rowVals map[uint32]TypedValue) error {
+		currVals, _ := valuesOf(rowVals, colIDs)
+
+		match, err := sameValues(currVals, vals)
+		if err != nil || !match {
+			return err
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, rowVals)
+		if err != nil {
+			return err
+		}
+
+		if _, dup := seen[string(pkEncVals)]; dup {
+			return nil
+		}
+		seen[string(pkEncVals)] = struct{}{}
+
+		rows = append(rows, rowVals)
+		return nil
+	}
+
+	for limit <= 0 || len(rows) < limit {
+		row, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		rowVals := table.valuesByColID(row)
+
+		if !index.IsPrimary() {
+			row, err = tx.fetchPKRow(ctx, table, rowVals)
+			if errors.Is(err, ErrNoMoreRows) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			rowVals = table.valuesByColID(row)
+		}
+
+		if err := collect(rowVals); err != nil {
+			return nil, err
+		}
+	}
+
+	if index.IsUnique() {
+		return rows, nil
+	}
+
+	written, ok := tx.writtenRows[table.id]
+	if !ok {
+		return rows, nil
+	}
+
+	pks, err := written.writtenWith(table, colIDs, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	for pk := range pks {
+		if limit > 0 && len(rows) >= limit {
+			break
+		}
+
+		row, err := tx.fetchPKRow(ctx, table, written.byPK[pk])
+		if errors.Is(err, ErrNoMoreRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := collect(table.valuesByColID(row)); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+// indexPrefixLen returns the number of leading columns of index with a
+// value in valsByColID.
+func indexPrefixLen(index *Index, valsByColID map[uint32]TypedValue) int {
+	n := 0
+	for _, col := range index.cols {
+		if _, ok := valsByColID[col.id]; !ok {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+func (t *Table) valuesByColID(row *Row) map[uint32]TypedValue {
+	vals := make(map[uint32]TypedValue, len(t.cols))
+
+	for _, col := range t.cols {
+		v := row.ValuesBySelector[EncodeSelector("", t.name, col.colName)]
+		if v == nil {
+			v = &NullValue{t: col.colType}
+		}
+		vals[col.id] = v
+	}
+	return vals
+}
+
+// valuesOf returns the values of colIDs and whether any of them is NULL.
+func valuesOf(valuesByColID map[uint32]TypedValue, colIDs []uint32) ([]TypedValue, bool) {
+	vals := make([]TypedValue, len(colIDs))
+	hasNull := false
+
+	for i, colID := range colIDs {
+		v, ok := valuesByColID[colID]
+		if !ok || v == nil {
+			v = &NullValue{t: AnyType}
+		}
+
+		hasNull = hasNull || v.IsNull()
+		vals[i] = v
+	}
+	return vals, hasNull
+}
+
+func sameValues(a, b []TypedValue) (bool, error) {
+	for i := range a {
+		if a[i].IsNull() || b[i].IsNull() {
+			if a[i].IsNull() != b[i].IsNull() {
+				return false, nil
+			}
+			continue
+		}
+
+		cmp, err := a[i].Compare(b[i])
+		if err != nil {
+			return false, err
+		}
+
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
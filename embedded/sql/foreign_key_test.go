@@ -0,0 +1,404 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func setupForeignKeyTest(t *testing.T, onDelete string) *Engine {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE customers (id INTEGER, email VARCHAR[64], PRIMARY KEY id);
+		CREATE TABLE orders (
+			id INTEGER,
+			customer_id INTEGER,
+			PRIMARY KEY id,
+			CONSTRAINT orders_customer FOREIGN KEY (customer_id) REFERENCES customers `+onDelete+`
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO customers (id, email) VALUES (1, 'a@x'), (2, 'b@x');
+		INSERT INTO orders (id, customer_id) VALUES (10, 1), (11, 1), (12, 2), (13, NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	return engine
+}
+
+func TestForeignKeyInsertAndUpdate(t *testing.T) {
+	engine := setupForeignKeyTest(t, "")
+
+	_, _, err := engine.Exec(context.Background(), nil, "INSERT INTO orders (id, customer_id) VALUES (20, 3)", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPSERT INTO orders (id, customer_id) VALUES (10, 3)", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE orders SET customer_id = 3 WHERE id = 10", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE orders SET customer_id = 2 WHERE id = 10", nil)
+	require.NoError(t, err)
+
+	// a NULL reference is not checked
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE orders SET customer_id = NULL WHERE id = 11", nil)
+	require.NoError(t, err)
+
+	// a single statement may insert the referenced row after the referencing one
+	_, _, err = engine.Exec(context.Background(), nil, `
+		BEGIN TRANSACTION;
+			INSERT INTO orders (id, customer_id) VALUES (21, 3);
+			INSERT INTO customers (id, email) VALUES (3, 'c@x');
+		COMMIT;
+	`, nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		BEGIN TRANSACTION;
+			INSERT INTO customers (id, email) VALUES (3, 'c@x');
+			INSERT INTO orders (id, customer_id) VALUES (21, 3);
+		COMMIT;
+	`, nil)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[][]interface{}{{int64(10), int64(2)}, {int64(11), nil}, {int64(12), int64(2)}, {int64(13), nil}, {int64(21), int64(3)}},
+		queryValues(t, engine, nil, "SELECT id, customer_id FROM orders", nil),
+	)
+}
+
+func TestForeignKeyOnDelete(t *testing.T) {
+	t.Run("no action", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "")
+
+		_, _, err := engine.Exec(context.Background(), nil, "DELETE FROM customers WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+		// references removed earlier in the same transaction do not count
+		_, _, err = engine.Exec(context.Background(), nil, `
+			BEGIN TRANSACTION;
+				DELETE FROM orders WHERE customer_id = 1;
+				DELETE FROM customers WHERE id = 1;
+			COMMIT;
+		`, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("restrict", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "ON DELETE RESTRICT")
+
+		_, _, err := engine.Exec(context.Background(), nil, "DELETE FROM customers WHERE id = 2", nil)
+		require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM orders WHERE id = 12; DELETE FROM customers WHERE id = 2", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("cascade", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "ON DELETE CASCADE")
+
+		_, ctxs, err := engine.Exec(context.Background(), nil, "DELETE FROM customers WHERE id = 1", nil)
+		require.NoError(t, err)
+		require.Len(t, ctxs, 1)
+		require.Equal(t, 1, ctxs[0].UpdatedRows())
+
+		require.Equal(t,
+			[][]interface{}{{int64(12), int64(2)}, {int64(13), nil}},
+			queryValues(t, engine, nil, "SELECT id, customer_id FROM orders", nil),
+		)
+	})
+
+	t.Run("cascade within transaction", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "ON DELETE CASCADE")
+
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE INDEX ON orders (customer_id)", nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			BEGIN TRANSACTION;
+				INSERT INTO customers (id, email) VALUES (3, 'c@x');
+				INSERT INTO orders (id, customer_id) VALUES (20, 3), (21, 3), (22, 2), (23, 1);
+				DELETE FROM customers WHERE id = 3;
+				DELETE FROM customers WHERE id = 1;
+			COMMIT;
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(12), int64(2)}, {int64(13), nil}, {int64(22), int64(2)}},
+			queryValues(t, engine, nil, "SELECT id, customer_id FROM orders", nil),
+		)
+	})
+
+	t.Run("cascade on rows updated within transaction", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "ON DELETE CASCADE")
+
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE INDEX ON orders (customer_id)", nil)
+		require.NoError(t, err)
+
+		// the rows written within the transaction are looked up by the
+		// values they hold when the referenced rows are deleted, not the
+		// ones they were first written with
+		_, _, err = engine.Exec(context.Background(), nil, `
+			BEGIN TRANSACTION;
+				INSERT INTO customers (id, email) VALUES (3, 'c@x'), (4, 'd@x');
+				INSERT INTO orders (id, customer_id) VALUES (20, 3), (21, 3), (22, 2), (24, 4);
+				DELETE FROM customers WHERE id = 4;
+				UPDATE orders SET customer_id = 2 WHERE id = 11;
+				INSERT INTO orders (id, customer_id) VALUES (25, 3);
+				DELETE FROM customers WHERE id = 3;
+				DELETE FROM customers WHERE id = 1;
+			COMMIT;
+		`, nil)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(11), int64(2)}, {int64(12), int64(2)}, {int64(13), nil}, {int64(22), int64(2)}},
+			queryValues(t, engine, nil, "SELECT id, customer_id FROM orders", nil),
+		)
+	})
+
+	t.Run("set null", func(t *testing.T) {
+		engine := setupForeignKeyTest(t, "ON DELETE SET NULL")
+
+		_, _, err := engine.Exec(context.Background(), nil, "DELETE FROM customers WHERE id = 1", nil)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(10), nil}, {int64(11), nil}, {int64(12), int64(2)}, {int64(13), nil}},
+			queryValues(t, engine, nil, "SELECT id, customer_id FROM orders", nil),
+		)
+	})
+
+	t.Run("set null on not null column", func(t *testing.T) {
+		engine := setupCommonTest(t)
+
+		_, _, err := engine.Exec(context.Background(), nil, `
+			CREATE TABLE parent (id INTEGER, PRIMARY KEY id);
+			CREATE TABLE child (id INTEGER, parent_id INTEGER NOT NULL, PRIMARY KEY id,
+				FOREIGN KEY (parent_id) REFERENCES parent (id) ON DELETE SET NULL);
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, `
+			INSERT INTO parent (id) VALUES (1);
+			INSERT INTO child (id, parent_id) VALUES (1, 1);
+		`, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM parent WHERE id = 1", nil)
+		require.ErrorIs(t, err, ErrNotNullableColumnCannotBeNull)
+	})
+}
+
+func TestForeignKeyOnUpdate(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE accounts (id INTEGER, code VARCHAR[16], PRIMARY KEY id);
+		CREATE UNIQUE INDEX ON accounts (code);
+		CREATE TABLE entries (
+			id INTEGER,
+			account_code VARCHAR[16],
+			PRIMARY KEY id,
+			FOREIGN KEY (account_code) REFERENCES accounts (code) ON UPDATE CASCADE ON DELETE RESTRICT
+		);
+		CREATE INDEX ON entries (account_code);
+		CREATE TABLE notes (
+			id INTEGER,
+			account_code VARCHAR[16],
+			PRIMARY KEY id,
+			FOREIGN KEY (account_code) REFERENCES accounts (code) ON UPDATE SET NULL
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO accounts (id, code) VALUES (1, 'A'), (2, 'B');
+		INSERT INTO entries (id, account_code) VALUES (1, 'A'), (2, 'A'), (3, 'B');
+		INSERT INTO notes (id, account_code) VALUES (1, 'A');
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "UPDATE accounts SET code = 'C' WHERE id = 1", nil)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[][]interface{}{{int64(1), "C"}, {int64(2), "C"}, {int64(3), "B"}},
+		queryValues(t, engine, nil, "SELECT id, account_code FROM entries", nil),
+	)
+
+	require.Equal(t,
+		[][]interface{}{{int64(1), nil}},
+		queryValues(t, engine, nil, "SELECT id, account_code FROM notes", nil),
+	)
+
+	// the secondary index on entries.account_code reflects the cascade
+	require.Equal(t,
+		[][]interface{}{{int64(1)}, {int64(2)}},
+		queryValues(t, engine, nil, "SELECT id FROM entries WHERE account_code = 'C'", nil),
+	)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM accounts WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+}
+
+func TestForeignKeySelfReference(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE nodes (
+			id INTEGER,
+			parent_id INTEGER,
+			PRIMARY KEY id,
+			FOREIGN KEY (parent_id) REFERENCES nodes (id) ON DELETE CASCADE
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO nodes (id, parent_id) VALUES (1, NULL), (2, 1), (3, 2), (4, 4), (5, 6), (6, NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM nodes WHERE id = 1", nil)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[][]interface{}{{int64(4)}, {int64(5)}, {int64(6)}},
+		queryValues(t, engine, nil, "SELECT id FROM nodes", nil),
+	)
+}
+
+func TestForeignKeyColumnReferences(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE users (id INTEGER, PRIMARY KEY id);
+		CREATE TABLE orders (
+			id INTEGER PRIMARY KEY,
+			user_id INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			reviewer_id INTEGER REFERENCES users
+		);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO users (id) VALUES (1), (2);
+		INSERT INTO orders (id, user_id, reviewer_id) VALUES (1, 1, 2), (2, 2, NULL);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO orders (id, user_id) VALUES (3, 3)", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM users WHERE id = 2", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM users WHERE id = 1", nil)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[][]interface{}{{int64(2)}},
+		queryValues(t, engine, nil, "SELECT id FROM orders", nil),
+	)
+
+	_, _, err = engine.Exec(context.Background(), nil, "ALTER TABLE orders ADD COLUMN owner_id INTEGER REFERENCES users (id)", nil)
+	require.ErrorIs(t, err, ErrInvalidForeignKey)
+}
+
+func TestForeignKeyDDL(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "CREATE TABLE parent (id INTEGER, name VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	t.Run("invalid definitions", func(t *testing.T) {
+		for _, stmt := range []string{
+			"CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES missing (id))",
+			"CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES parent (name))",
+			"CREATE TABLE child (id INTEGER, pid VARCHAR, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES parent (id))",
+			"CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid, id) REFERENCES parent (id))",
+			"CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (missing) REFERENCES parent (id))",
+		} {
+			_, _, err := engine.Exec(context.Background(), nil, stmt, nil)
+			require.ErrorIs(t, err, ErrInvalidForeignKey, stmt)
+		}
+
+		_, err := ParseSQLString("CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES parent ON DELETE NOTHING)")
+		require.Error(t, err)
+	})
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE child (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES parent ON DELETE NO ACTION ON UPDATE RESTRICT);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO parent (id) VALUES (1);
+		INSERT INTO child (id, pid) VALUES (1, 1);
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "ALTER TABLE child DROP COLUMN pid", nil)
+	require.ErrorIs(t, err, ErrCannotDropColumn)
+
+	_, _, err = engine.Exec(context.Background(), nil, "TRUNCATE TABLE parent", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DROP TABLE parent", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	err = st.Close()
+	require.NoError(t, err)
+
+	// the constraint survives a restart
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO child (id, pid) VALUES (2, 2)", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "DELETE FROM parent WHERE id = 1", nil)
+	require.ErrorIs(t, err, ErrForeignKeyViolation)
+
+	_, _, err = engine.Exec(context.Background(), nil, "ALTER TABLE child DROP CONSTRAINT child_pid_fkey", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO child (id, pid) VALUES (2, 2)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE child2 (id INTEGER, pid INTEGER, PRIMARY KEY id, FOREIGN KEY (pid) REFERENCES parent (id));
+		DROP TABLE parent CASCADE;
+	`, nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO child (id, pid) VALUES (3, 3)", nil)
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, "INSERT INTO child2 (id, pid) VALUES (1, 100)", nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,802 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Fulltext indexes are created with
+//
+//	CREATE INDEX ON table USING FULLTEXT (col)
+//
+// and keep, for each term of the VARCHAR column col, a posting entry per row
+// containing it. Postings are regular store entries written within the same
+// transaction as the row, so they can be read as of any past transaction,
+// the same way rows are.
+//
+// MATCH(col, 'query') is true when the text contains every term of the query.
+// When a top-level conjunct of the WHERE clause is a MATCH over an indexed
+// column, the rows are read by looking up the postings of the query terms
+// instead of scanning the whole table. MATCH_SCORE(col, 'query') ranks the
+// rows by a TF-IDF score, using the document frequencies collected by the
+// fulltext scan of the same query, if any.
+
+// maxFullTextTermLen is the max length in bytes of an indexed term. Longer
+// terms are truncated.
+const maxFullTextTermLen = 64
+
+// fullTextTerms splits s into lowercase terms made of letters and digits.
+func fullTextTerms(s string) []string {
+	terms := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, term := range terms {
+		if len(term) <= maxFullTextTermLen {
+			continue
+		}
+
+		n := maxFullTextTermLen
+		for n > 0 && !utf8.RuneStart(term[n]) {
+			n--
+		}
+		terms[i] = term[:n]
+	}
+	return terms
+}
+
+// fullTextTermFreqs returns the number of occurrences of each term of val.
+func fullTextTermFreqs(val TypedValue) map[string]uint32 {
+	if val == nil || val.IsNull() {
+		return nil
+	}
+
+	s, ok := val.RawValue().(string)
+	if !ok {
+		return nil
+	}
+
+	freqs := make(map[string]uint32)
+	for _, term := range fullTextTerms(s) {
+		freqs[term]++
+	}
+	return freqs
+}
+
+// fullTextQuery returns the distinct terms of a query, sorted.
+func fullTextQuery(query string) []string {
+	freqs := make(map[string]struct{})
+	for _, term := range fullTextTerms(query) {
+		freqs[term] = struct{}{}
+	}
+
+	terms := make([]string, 0, len(freqs))
+	for term := range freqs {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	return terms
+}
+
+func (t *Table) newFullTextIndex(colID uint32) (*Index, error) {
+	col, err := t.GetColumnByID(colID)
+	if err != nil {
+		return nil, err
+	}
+
+	if col.colType != VarcharType {
+		return nil, fmt.Errorf("%w: column '%s' is not of type %s", ErrInvalidFullTextIndex, col.colName, VarcharType)
+	}
+
+	if col.virtual {
+		return nil, fmt.Errorf("%w (%s)", ErrCannotIndexVirtualColumn, col.colName)
+	}
+
+	if _, exists := t.fullTextIndexes[colID]; exists {
+		return nil, ErrIndexAlreadyExists
+	}
+
+	index := &Index{
+		id:       t.maxIndexID,
+		table:    t,
+		fullText: true,
+		cols:     []*Column{col},
+		colsByID: map[uint32]*Column{colID: col},
+	}
+
+	if t.fullTextIndexes == nil {
+		t.fullTextIndexes = make(map[uint32]*Index)
+	}
+	t.fullTextIndexes[colID] = index
+
+	t.maxIndexID++
+
+	return index, nil
+}
+
+func (t *Table) GetFullTextIndexes() []*Index {
+	idxs := make([]*Index, 0, len(t.fullTextIndexes))
+
+	for _, index := range t.fullTextIndexes {
+		idxs = append(idxs, index)
+	}
+
+	sort.Slice(idxs, func(i, j int) bool {
+		return idxs[i].id < idxs[j].id
+	})
+	return idxs
+}
+
+func (i *Index) IsFullText() bool {
+	return i.fullText
+}
+
+func (tx *SQLTx) fullTextTermPrefix(index *Index, term string) ([]byte, error) {
+	encTerm, _, err := EncodeValueAsKey(&Varchar{val: term}, VarcharType, maxFullTextTermLen)
+	if err != nil {
+		return nil, err
+	}
+	return MapKey(tx.sqlPrefix(), FullTextPrefix, EncodeID(index.table.id), EncodeID(index.id), encTerm), nil
+}
+
+// updateFullTextEntries updates the postings of the fulltext indexes of
+// table when a row goes from prevValues to newValues. Either of them is nil
+// when the row is inserted or deleted.
+func (tx *SQLTx) updateFullTextEntries(table *Table, pkEncVals []byte, prevValues, newValues map[uint32]TypedValue) error {
+	for _, index := range table.GetFullTextIndexes() {
+		if err := tx.updateFullTextIndexEntries(index, pkEncVals, prevValues, newValues); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx *SQLTx) updateFullTextIndexEntries(index *Index, pkEncVals []byte, prevValues, newValues map[uint32]TypedValue) error {
+	colID := index.cols[0].id
+
+	prevFreqs := fullTextTermFreqs(prevValues[colID])
+	newFreqs := fullTextTermFreqs(newValues[colID])
+
+	terms := make([]string, 0, len(prevFreqs)+len(newFreqs))
+	for term := range prevFreqs {
+		terms = append(terms, term)
+	}
+	for term := range newFreqs {
+		if _, exists := prevFreqs[term]; !exists {
+			terms = append(terms, term)
+		}
+	}
+	sort.Strings(terms)
+
+	for _, term := range terms {
+		prevFreq := prevFreqs[term]
+		newFreq := newFreqs[term]
+
+		if prevFreq == newFreq {
+			continue
+		}
+
+		termPrefix, err := tx.fullTextTermPrefix(index, term)
+		if err != nil {
+			return err
+		}
+
+		// key=F.{tableID}{indexID}{term}{pkVals}, value={termFreq}
+		key := append(termPrefix, pkEncVals...)
+
+		if newFreq == 0 {
+			md := store.NewKVMetadata()
+			md.AsDeleted(true)
+
+			err = tx.set(key, md, nil)
+		} else {
+			var encFreq [4]byte
+			binary.BigEndian.PutUint32(encFreq[:], newFreq)
+
+			err = tx.set(key, nil, encFreq[:])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillFullTextIndex writes the postings of the rows already in the
+// table of the new fulltext index.
+func (tx *SQLTx) backfillFullTextIndex(ctx context.Context, index *Index) error {
+	table := index.table
+
+	reader, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if errors.Is(err, store.ErrIndexNotFound) {
+		// the table is being created by the transaction
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		row, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		valuesByColID := table.valuesByColID(row)
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.updateFullTextIndexEntries(index, pkEncVals, nil, valuesByColID); err != nil {
+			return err
+		}
+	}
+}
+
+func (stmt *CreateIndexStmt) execFullTextAt(ctx context.Context, tx *SQLTx) (*SQLTx, error) {
+	if len(stmt.cols) != 1 {
+		return nil, fmt.Errorf("%w: fulltext indexes are defined over a single column", ErrInvalidFullTextIndex)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stmt.checkPartitioning(table); err != nil {
+		return nil, err
+	}
+
+	col, err := table.GetColumnByName(stmt.cols[0])
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := table.newFullTextIndex(col.id)
+	if errors.Is(err, ErrIndexAlreadyExists) && stmt.ifNotExists {
+		return tx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// v={flags {colID}(ASC|DESC)}
+	encodedValues := make([]byte, 1+EncIDLen+1)
+	encodedValues[0] = fullTextIndexFlag
+	copy(encodedValues[1:], EncodeID(col.id))
+
+	mappedKey := MapKey(tx.sqlPrefix(), catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(table.id), EncodeID(index.id))
+
+	err = tx.set(mappedKey, nil, encodedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.backfillFullTextIndex(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// execFullTextAt deletes a fulltext index. As for other indexes, the
+// postings are kept in the store but are no longer reachable.
+func (stmt *DropIndexStmt) execFullTextAt(ctx context.Context, tx *SQLTx) (*SQLTx, error) {
+	if len(stmt.cols) != 1 {
+		return nil, fmt.Errorf("%w: fulltext indexes are defined over a single column", ErrInvalidFullTextIndex)
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	col, err := table.GetColumnByName(stmt.cols[0])
+	if err != nil {
+		return nil, err
+	}
+
+	index, exists := table.fullTextIndexes[col.id]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s(%s) USING FULLTEXT)", ErrIndexNotFound, table.name, col.colName)
+	}
+
+	mappedKey := MapKey(tx.sqlPrefix(), catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(table.id), EncodeID(index.id))
+
+	err = tx.delete(ctx, mappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(table.fullTextIndexes, col.id)
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// fullTextScan is the lookup of the rows containing the terms of a query in
+// a fulltext index.
+type fullTextScan struct {
+	index *Index
+	terms []string
+}
+
+// fullTextScanFor returns the fulltext scan which can be used to read the
+// rows of table satisfying where, if any.
+func fullTextScanFor(tx *SQLTx, table *Table, asTable string, where ValueExp, params map[string]interface{}) *fullTextScan {
+	switch exp := where.(type) {
+	case *BinBoolExp:
+		if exp.op != And {
+			return nil
+		}
+
+		if scan := fullTextScanFor(tx, table, asTable, exp.left, params); scan != nil {
+			return scan
+		}
+		return fullTextScanFor(tx, table, asTable, exp.right, params)
+	case *FnCall:
+		if strings.ToUpper(exp.fn) != MatchFnCall || len(exp.params) != 2 {
+			return nil
+		}
+
+		sel, ok := exp.params[0].(*ColSelector)
+		if !ok || (sel.table != "" && sel.table != asTable) {
+			return nil
+		}
+
+		col, exists := table.colsByName[sel.col]
+		if !exists {
+			return nil
+		}
+
+		index, exists := table.fullTextIndexes[col.id]
+		if !exists {
+			return nil
+		}
+
+		query, err := exp.params[1].substitute(params)
+		if err != nil || !query.isConstant() {
+			return nil
+		}
+
+		val, err := query.reduce(tx, nil, asTable)
+		if err != nil || val.IsNull() || val.Type() != VarcharType {
+			return nil
+		}
+
+		return &fullTextScan{
+			index: index,
+			terms: fullTextQuery(val.RawValue().(string)),
+		}
+	}
+	return nil
+}
+
+// fullTextStats are the statistics of the terms of a query, collected when
+// looking them up in a fulltext index.
+type fullTextStats struct {
+	docFreqs map[string]uint64
+
+	docs      uint64
+	countDocs func() (uint64, error) // nil once docs is known
+}
+
+func (s *fullTextStats) docCount() (uint64, error) {
+	if s.countDocs != nil {
+		docs, err := s.countDocs()
+		if err != nil {
+			return 0, err
+		}
+
+		s.docs = docs
+		s.countDocs = nil
+	}
+	return s.docs, nil
+}
+
+// fullTextKeyReader reads the primary index entries of the rows found by a
+// fulltext scan, in primary key order.
+type fullTextKeyReader struct {
+	tx        *SQLTx
+	scan      *fullTextScan
+	scanSpecs *ScanSpecs
+
+	pks  [][]byte // encoded primary keys, nil until the postings are read
+	next int
+
+	// used instead of the postings when the index did not exist yet in the
+	// period read by the query
+	fallback store.KeyReader
+
+	nodesRead uint64
+}
+
+func newFullTextKeyReader(tx *SQLTx, scanSpecs *ScanSpecs) *fullTextKeyReader {
+	return &fullTextKeyReader{
+		tx:        tx,
+		scan:      scanSpecs.fullText,
+		scanSpecs: scanSpecs,
+	}
+}
+
+func (r *fullTextKeyReader) Read(ctx context.Context) (key []byte, val store.ValueRef, err error) {
+	return r.read(ctx, nil)
+}
+
+func (r *fullTextKeyReader) ReadBetween(ctx context.Context, initialTxID uint64, finalTxID uint64) (key []byte, val store.ValueRef, err error) {
+	return r.read(ctx, &txRange{initialTxID: initialTxID, finalTxID: finalTxID})
+}
+
+func (r *fullTextKeyReader) read(ctx context.Context, txRange *txRange) (key []byte, val store.ValueRef, err error) {
+	if r.pks == nil && r.fallback == nil {
+		err := r.lookup(ctx, txRange)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if r.fallback != nil {
+		return readKey(ctx, r.fallback, txRange)
+	}
+
+	for r.next < len(r.pks) {
+		pk := r.pks[r.next]
+		r.next++
+
+		key, val, err := r.readRow(ctx, pk, txRange)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			// the row was deleted or is out of range
+			continue
+		}
+		return key, val, err
+	}
+	return nil, nil, store.ErrNoMoreEntries
+}
+
+func readKey(ctx context.Context, reader store.KeyReader, txRange *txRange) (key []byte, val store.ValueRef, err error) {
+	if txRange == nil {
+		return reader.Read(ctx)
+	}
+	return reader.ReadBetween(ctx, txRange.initialTxID, txRange.finalTxID)
+}
+
+func (r *fullTextKeyReader) pkPrefix() []byte {
+	table := r.scan.index.table
+	return MapKey(r.tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id))
+}
+
+func (r *fullTextKeyReader) newKeyReader(prefix []byte) (store.KeyReader, error) {
+	return r.tx.newKeyReader(store.KeyReaderSpec{
+		Prefix:  prefix,
+		Filters: []store.FilterFn{store.IgnoreExpired, store.IgnoreDeleted},
+	})
+}
+
+// lookup reads the postings of the query terms, keeping the rows containing
+// all of them.
+func (r *fullTextKeyReader) lookup(ctx context.Context, rng *txRange) error {
+	if rng != nil {
+		createdAt, err := r.indexCreationTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		if createdAt > rng.finalTxID {
+			rSpec, err := keyReaderSpecFrom(r.tx.engine.prefix, r.scan.index.table, r.scanSpecs)
+			if err != nil {
+				return err
+			}
+
+			r.fallback, err = r.tx.newKeyReader(*rSpec)
+			return err
+		}
+	}
+
+	var postingsRange *txRange
+	if rng != nil {
+		// postings are kept as long as the row contains the term, even if
+		// they were written before the beginning of the period
+		postingsRange = &txRange{initialTxID: 0, finalTxID: rng.finalTxID}
+	}
+
+	docFreqs := make(map[string]uint64, len(r.scan.terms))
+
+	var pks map[string]struct{}
+
+	for _, term := range r.scan.terms {
+		termPrefix, err := r.tx.fullTextTermPrefix(r.scan.index, term)
+		if err != nil {
+			return err
+		}
+
+		termPKs, err := r.readPostings(ctx, termPrefix, postingsRange)
+		if err != nil {
+			return err
+		}
+
+		docFreqs[term] = uint64(len(termPKs))
+
+		if pks == nil {
+			pks = termPKs
+			continue
+		}
+
+		for pk := range pks {
+			if _, exists := termPKs[pk]; !exists {
+				delete(pks, pk)
+			}
+		}
+	}
+
+	r.pks = make([][]byte, 0, len(pks))
+	for pk := range pks {
+		r.pks = append(r.pks, []byte(pk))
+	}
+
+	sort.Slice(r.pks, func(i, j int) bool {
+		if r.scanSpecs.DescOrder {
+			return bytes.Compare(r.pks[i], r.pks[j]) > 0
+		}
+		return bytes.Compare(r.pks[i], r.pks[j]) < 0
+	})
+
+	r.tx.setFullTextStats(r.scan.terms, &fullTextStats{
+		docFreqs: docFreqs,
+		countDocs: func() (uint64, error) {
+			return r.countRows(ctx, rng)
+		},
+	})
+
+	return nil
+}
+
+func (r *fullTextKeyReader) readPostings(ctx context.Context, termPrefix []byte, txRange *txRange) (map[string]struct{}, error) {
+	reader, err := r.newKeyReader(termPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	pks := make(map[string]struct{})
+
+	for {
+		key, _, err := readKey(ctx, reader, txRange)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			r.nodesRead += nodesRead(reader)
+			return pks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pks[string(key[len(termPrefix):])] = struct{}{}
+	}
+}
+
+func (r *fullTextKeyReader) readRow(ctx context.Context, pk []byte, txRange *txRange) (key []byte, val store.ValueRef, err error) {
+	reader, err := r.newKeyReader(append(r.pkPrefix(), pk...))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	key, val, err = readKey(ctx, reader, txRange)
+
+	r.nodesRead += nodesRead(reader)
+
+	return key, val, err
+}
+
+func (r *fullTextKeyReader) countRows(ctx context.Context, txRange *txRange) (uint64, error) {
+	reader, err := r.newKeyReader(r.pkPrefix())
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	var n uint64
+
+	for {
+		_, _, err := readKey(ctx, reader, txRange)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return n, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		n++
+	}
+}
+
+// indexCreationTx returns the id of the transaction which created the index,
+// math.MaxUint64 if it is created by the current transaction.
+func (r *fullTextKeyReader) indexCreationTx(ctx context.Context) (uint64, error) {
+	index := r.scan.index
+
+	mappedKey := MapKey(r.tx.sqlPrefix(), catalogIndexPrefix, EncodeID(DatabaseID), EncodeID(index.table.id), EncodeID(index.id))
+
+	valRef, err := r.tx.get(ctx, mappedKey)
+	if errors.Is(err, store.ErrKeyNotFound) {
+		return math.MaxUint64, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return valRef.Tx(), nil
+}
+
+func (r *fullTextKeyReader) Reset() error {
+	r.pks = nil
+	r.next = 0
+
+	if r.fallback != nil {
+		err := r.fallback.Close()
+		r.fallback = nil
+		return err
+	}
+	return nil
+}
+
+func (r *fullTextKeyReader) Close() error {
+	if r.fallback != nil {
+		return r.fallback.Close()
+	}
+	return nil
+}
+
+func (r *fullTextKeyReader) NodesRead() uint64 {
+	if r.fallback != nil {
+		return r.nodesRead + nodesRead(r.fallback)
+	}
+	return r.nodesRead
+}
+
+func (tx *SQLTx) setFullTextStats(terms []string, stats *fullTextStats) {
+	if tx.fullTextStats == nil {
+		tx.fullTextStats = make(map[string]*fullTextStats)
+	}
+	tx.fullTextStats[strings.Join(terms, " ")] = stats
+}
+
+func (tx *SQLTx) getFullTextStats(terms []string) *fullTextStats {
+	return tx.fullTextStats[strings.Join(terms, " ")]
+}
+
+type matchFn struct{}
+
+func (f *matchFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return BooleanType, nil
+}
+
+func (f *matchFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != BooleanType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+	}
+	return nil
+}
+
+func (f *matchFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	freqs, terms, err := matchParams(MatchFnCall, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(terms) == 0 {
+		return &Bool{val: false}, nil
+	}
+
+	for _, term := range terms {
+		if freqs[term] == 0 {
+			return &Bool{val: false}, nil
+		}
+	}
+	return &Bool{val: true}, nil
+}
+
+type matchScoreFn struct{}
+
+func (f *matchScoreFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return Float64Type, nil
+}
+
+func (f *matchScoreFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != Float64Type {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, Float64Type, t)
+	}
+	return nil
+}
+
+// Apply computes the sum over the query terms of (1 + ln(tf)) * ln(1 + N/df),
+// where tf is the number of occurrences of the term in the text, N the number
+// of rows and df the number of rows containing the term. The inverse document
+// frequency is taken as 1 when the rows were not read by a fulltext scan of
+// the same query.
+func (f *matchScoreFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	freqs, terms, err := matchParams(MatchScoreFnCall, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats *fullTextStats
+	if tx != nil {
+		stats = tx.getFullTextStats(terms)
+	}
+
+	var score float64
+
+	for _, term := range terms {
+		tf := freqs[term]
+		if tf == 0 {
+			continue
+		}
+
+		idf := 1.0
+
+		if stats != nil && stats.docFreqs[term] > 0 {
+			docs, err := stats.docCount()
+			if err != nil {
+				return nil, err
+			}
+			idf = math.Log(1 + float64(docs)/float64(stats.docFreqs[term]))
+		}
+
+		score += (1 + math.Log(float64(tf))) * idf
+	}
+	return &Float64{val: score}, nil
+}
+
+// matchParams returns the term frequencies of the text and the terms of the
+// query passed to fn. NULL values have no terms.
+func matchParams(fn string, params []TypedValue) (map[string]uint32, []string, error) {
+	if len(params) != 2 {
+		return nil, nil, fmt.Errorf("%w: '%s' function expects two arguments but %d were provided", ErrIllegalArguments, fn, len(params))
+	}
+
+	for _, p := range params {
+		if !p.IsNull() && p.Type() != VarcharType {
+			return nil, nil, fmt.Errorf("%w: '%s' function expects arguments of type %s", ErrIllegalArguments, fn, VarcharType)
+		}
+	}
+
+	if params[1].IsNull() {
+		return nil, nil, nil
+	}
+	return fullTextTermFreqs(params[0]), fullTextQuery(params[1].RawValue().(string)), nil
+}
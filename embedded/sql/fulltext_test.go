@@ -0,0 +1,212 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextTerms(t *testing.T) {
+	require.Equal(t, []string{"the", "quick", "brown", "fox", "42"}, fullTextTerms("The quick, brown-fox! 42"))
+	require.Equal(t, []string{"über", "straße"}, fullTextTerms("Über Straße"))
+	require.Empty(t, fullTextTerms(" ,;. "))
+
+	long := strings.Repeat("é", maxFullTextTermLen)
+	require.Equal(t, strings.Repeat("é", maxFullTextTermLen/2), fullTextTerms(long)[0])
+
+	require.Equal(t, []string{"brown", "fox"}, fullTextQuery("fox Brown fox"))
+}
+
+func TestFullTextIndexStmts(t *testing.T) {
+	stmts, err := ParseSQLString("CREATE INDEX IF NOT EXISTS ON docs USING FULLTEXT (body)")
+	require.NoError(t, err)
+	require.Equal(t, &CreateIndexStmt{ifNotExists: true, table: "docs", cols: []string{"body"}, fullText: true}, stmts[0])
+
+	stmts, err = ParseSQLString("DROP INDEX ON docs USING FULLTEXT (body)")
+	require.NoError(t, err)
+	require.Equal(t, &DropIndexStmt{table: "docs", cols: []string{"body"}, fullText: true}, stmts[0])
+
+	stmts, err = ParseSQLString("SELECT id FROM docs WHERE MATCH(body, 'fox') ORDER BY MATCH_SCORE(body, 'fox') DESC")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+}
+
+func TestFullTextIndex(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	exec := func(sql string, params map[string]interface{}) uint64 {
+		_, txs, err := engine.Exec(context.Background(), nil, sql, params)
+		require.NoError(t, err, sql)
+
+		hdr := txs[len(txs)-1].TxHeader()
+		if hdr == nil {
+			return 0
+		}
+		return hdr.ID
+	}
+
+	execErr := func(sql string) error {
+		_, _, err := engine.Exec(context.Background(), nil, sql, nil)
+		return err
+	}
+
+	ids := func(sql string, params map[string]interface{}) []int64 {
+		rows, err := engine.queryAll(context.Background(), nil, sql, params)
+		require.NoError(t, err, sql)
+
+		res := make([]int64, 0, len(rows))
+		for _, row := range rows {
+			res = append(res, row.ValuesByPosition[0].RawValue().(int64))
+		}
+		return res
+	}
+
+	plan := func(sql string) string {
+		rows, err := engine.queryAll(context.Background(), nil, "EXPLAIN "+sql, nil)
+		require.NoError(t, err)
+
+		var lines []string
+		for _, row := range rows {
+			lines = append(lines, row.ValuesByPosition[0].RawValue().(string))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	exec(`
+		CREATE TABLE docs (id INTEGER AUTO_INCREMENT, title VARCHAR[64], body VARCHAR, views INTEGER, PRIMARY KEY id);
+		INSERT INTO docs (title, body, views) VALUES
+			('Foxes', 'The quick brown fox jumps over the lazy dog', 10),
+			('Dogs', 'A dog is loyal. My dog is a friend', 20),
+			('Birds', 'Birds fly; a fox can not fly', 30);
+	`, nil)
+
+	beforeIndexTx := exec("INSERT INTO docs (title, body, views) VALUES ('Empty', NULL, 0)", nil)
+
+	t.Run("invalid fulltext indexes should be rejected", func(t *testing.T) {
+		err := execErr("CREATE INDEX ON docs USING FULLTEXT (views)")
+		require.ErrorIs(t, err, ErrInvalidFullTextIndex)
+
+		err = execErr("CREATE INDEX ON docs USING FULLTEXT (missing)")
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+
+		err = execErr("DROP INDEX ON docs USING FULLTEXT (body)")
+		require.ErrorIs(t, err, ErrIndexNotFound)
+	})
+
+	exec("CREATE INDEX ON docs USING FULLTEXT (body)", nil)
+
+	t.Run("fulltext indexes should be created only once", func(t *testing.T) {
+		err := execErr("CREATE INDEX ON docs USING FULLTEXT (body)")
+		require.ErrorIs(t, err, ErrIndexAlreadyExists)
+
+		exec("CREATE INDEX IF NOT EXISTS ON docs USING FULLTEXT (body)", nil)
+	})
+
+	t.Run("existing rows should be indexed", func(t *testing.T) {
+		require.Contains(t, plan("SELECT id FROM docs WHERE MATCH(body, 'fox')"), "Full Text Scan using (body) on docs")
+
+		require.Equal(t, []int64{1, 3}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox')", nil))
+		require.Equal(t, []int64{3}, ids("SELECT id FROM docs WHERE MATCH(body, 'FOX fly')", nil))
+		require.Equal(t, []int64{2}, ids("SELECT id FROM docs WHERE MATCH(body, @q)", map[string]interface{}{"q": "Loyal"}))
+		require.Empty(t, ids("SELECT id FROM docs WHERE MATCH(body, 'cat')", nil))
+		require.Empty(t, ids("SELECT id FROM docs WHERE MATCH(body, '...')", nil))
+		require.Equal(t, []int64{3, 1}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox') ORDER BY id DESC", nil))
+		require.Equal(t, []int64{3}, ids("SELECT id FROM docs WHERE views > 10 AND MATCH(body, 'fox')", nil))
+	})
+
+	t.Run("match should work without using the index", func(t *testing.T) {
+		require.NotContains(t, plan("SELECT id FROM docs WHERE MATCH(title, 'dogs')"), "Full Text Scan")
+		require.Equal(t, []int64{2}, ids("SELECT id FROM docs WHERE MATCH(title, 'dogs')", nil))
+		require.Equal(t, []int64{1, 2}, ids("SELECT id FROM docs WHERE MATCH(body, 'dog') OR views = 20", nil))
+	})
+
+	t.Run("rows should be ranked by relevance", func(t *testing.T) {
+		require.Equal(t, []int64{2, 1}, ids("SELECT id FROM docs WHERE MATCH(body, 'dog') ORDER BY MATCH_SCORE(body, 'dog') DESC", nil))
+
+		rows, err := engine.queryAll(context.Background(), nil, "SELECT MATCH_SCORE(body, 'fox fly') FROM docs WHERE MATCH(body, 'fox fly')", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Greater(t, rows[0].ValuesByPosition[0].RawValue().(float64), 0.0)
+
+		rows, err = engine.queryAll(context.Background(), nil, "SELECT MATCH_SCORE(body, 'cat') FROM docs WHERE id = 1", nil)
+		require.NoError(t, err)
+		require.Equal(t, 0.0, rows[0].ValuesByPosition[0].RawValue())
+	})
+
+	txBeforeUpdate := exec("UPDATE docs SET body = 'A cat sleeps' WHERE id = 1", nil)
+	exec("DELETE FROM docs WHERE id = 3", nil)
+	exec("INSERT INTO docs (title, body, views) VALUES ('Cats', 'Cats and a fox', 5)", nil)
+
+	t.Run("postings should follow updates and deletions", func(t *testing.T) {
+		require.Equal(t, []int64{5}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox')", nil))
+		require.Equal(t, []int64{1}, ids("SELECT id FROM docs WHERE MATCH(body, 'sleeps')", nil))
+
+		exec("UPSERT INTO docs (id, title, body, views) VALUES (4, 'Empty', 'a fox at last', 0)", nil)
+		require.Equal(t, []int64{4, 5}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox')", nil))
+	})
+
+	t.Run("history queries should read the postings as of the past transaction", func(t *testing.T) {
+		params := map[string]interface{}{"tx": txBeforeUpdate - 1}
+
+		require.Contains(t, plan("SELECT id FROM docs UNTIL TX 1 WHERE MATCH(body, 'fox')"), "Full Text Scan")
+		require.Equal(t, []int64{1, 3}, ids("SELECT id FROM docs UNTIL TX @tx WHERE MATCH(body, 'fox')", params))
+		require.Empty(t, ids("SELECT id FROM docs UNTIL TX @tx WHERE MATCH(body, 'sleeps')", params))
+
+		// the index did not exist yet
+		params = map[string]interface{}{"tx": beforeIndexTx}
+		require.Equal(t, []int64{1, 3}, ids("SELECT id FROM docs UNTIL TX @tx WHERE MATCH(body, 'fox')", params))
+	})
+
+	t.Run("indexed columns can not be dropped", func(t *testing.T) {
+		err := execErr("ALTER TABLE docs DROP COLUMN body")
+		require.ErrorIs(t, err, ErrCannotDropColumn)
+	})
+
+	require.NoError(t, st.Close())
+
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	t.Run("fulltext indexes should be loaded from the catalog", func(t *testing.T) {
+		require.Contains(t, plan("SELECT id FROM docs WHERE MATCH(body, 'fox')"), "Full Text Scan")
+
+		exec("INSERT INTO docs (title, body, views) VALUES ('Wolves', 'Wolves and foxes', 1)", nil)
+		require.Equal(t, []int64{6}, ids("SELECT id FROM docs WHERE MATCH(body, 'foxes')", nil))
+	})
+
+	t.Run("truncated tables should keep their fulltext indexes", func(t *testing.T) {
+		exec("TRUNCATE TABLE docs", nil)
+		exec("INSERT INTO docs (title, body, views) VALUES ('Again', 'the fox is back', 1)", nil)
+
+		require.Contains(t, plan("SELECT id FROM docs WHERE MATCH(body, 'fox')"), "Full Text Scan")
+		require.Equal(t, []int64{1}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox')", nil))
+	})
+
+	t.Run("dropped fulltext indexes should no longer be used", func(t *testing.T) {
+		exec("DROP INDEX ON docs USING FULLTEXT (body)", nil)
+
+		require.NotContains(t, plan("SELECT id FROM docs WHERE MATCH(body, 'fox')"), "Full Text Scan")
+		require.Equal(t, []int64{1}, ids("SELECT id FROM docs WHERE MATCH(body, 'fox')", nil))
+
+		exec("ALTER TABLE docs DROP COLUMN body", nil)
+	})
+}
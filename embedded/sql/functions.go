@@ -46,6 +46,7 @@ const (
 	ColumnsFnCall            string = "COLUMNS"
 	IndexesFnCall            string = "INDEXES"
 	GrantsFnCall             string = "GRANTS"
+	UnnestFnCall             string = "UNNEST"
 	JSONTypeOfFnCall         string = "JSON_TYPEOF"
 	PGGetUserByIDFnCall          string = "PG_GET_USERBYID"
 	PgTableIsVisibleFnCall       string = "PG_TABLE_IS_VISIBLE"
@@ -66,6 +67,8 @@ const (
 	HasFunctionPrivilegeFnCall   string = "HAS_FUNCTION_PRIVILEGE"
 	ArrayUpperFnCall             string = "ARRAY_UPPER"
 	ArrayToStringFnCall          string = "ARRAY_TO_STRING"
+	ArrayLengthFnCall            string = "ARRAY_LENGTH"
+	CardinalityFnCall            string = "CARDINALITY"
 	QuoteIdentFnCall             string = "QUOTE_IDENT"
 	PgTotalRelationSizeFnCall    string = "PG_TOTAL_RELATION_SIZE"
 	PgRelationSizeFnCall         string = "PG_RELATION_SIZE"
@@ -113,6 +116,7 @@ const (
 	DatePartFnCall   string = "DATE_PART"
 	AgeFnCall        string = "AGE"
 	ClockTimestampFnCall string = "CLOCK_TIMESTAMP"
+	CurrentDateFnCall    string = "CURRENT_DATE"
 
 	// Aliases
 	SubstrFnCall        string = "SUBSTR"
@@ -128,6 +132,10 @@ const (
 	RandomFnCall      string = "RANDOM"
 	GenRandomUUIDCall string = "GEN_RANDOM_UUID"
 	ToNumberFnCall    string = "TO_NUMBER"
+
+	// Fulltext search functions
+	MatchFnCall      string = "MATCH"
+	MatchScoreFnCall string = "MATCH_SCORE"
 )
 
 var builtinFunctions = map[string]Function{
@@ -158,7 +166,9 @@ var builtinFunctions = map[string]Function{
 	HasSchemaPrivilegeFnCall:     &pgBoolStub{name: HasSchemaPrivilegeFnCall, nParams: -1},
 	HasDatabasePrivilegeFnCall:   &pgBoolStub{name: HasDatabasePrivilegeFnCall, nParams: -1},
 	HasFunctionPrivilegeFnCall:   &pgBoolStub{name: HasFunctionPrivilegeFnCall, nParams: -1},
-	ArrayUpperFnCall:             &pgNullIntStub{name: ArrayUpperFnCall},
+	ArrayUpperFnCall:             &arrayLengthFn{name: ArrayUpperFnCall},
+	ArrayLengthFnCall:            &arrayLengthFn{name: ArrayLengthFnCall},
+	CardinalityFnCall:            &arrayLengthFn{name: CardinalityFnCall},
 	ArrayToStringFnCall:          &pgArrayToString{},
 	QuoteIdentFnCall:             &pgQuoteIdent{},
 	PgTotalRelationSizeFnCall:    &pgZeroIntStub{name: PgTotalRelationSizeFnCall, nParams: -1},
@@ -207,6 +217,7 @@ var builtinFunctions = map[string]Function{
 	DatePartFnCall:       &datePartFn{},
 	AgeFnCall:            &ageFn{},
 	ClockTimestampFnCall: &NowFn{},
+	CurrentDateFnCall:    &currentDateFn{},
 
 	// Aliases
 	SubstrFnCall:        &SubstringFn{},
@@ -220,6 +231,10 @@ var builtinFunctions = map[string]Function{
 	RandomFnCall:      &randomFn{},
 	GenRandomUUIDCall: &UUIDFn{},
 	ToNumberFnCall:    &toNumberFn{},
+
+	// Fulltext search functions
+	MatchFnCall:      &matchFn{},
+	MatchScoreFnCall: &matchScoreFn{},
 }
 
 // RegisteredFunctions returns a snapshot of all built-in functions
@@ -681,7 +696,15 @@ func (f *pgCurrentSchema) Apply(tx *SQLTx, params []TypedValue) (TypedValue, err
 	if len(params) > 0 {
 		return nil, fmt.Errorf("%w: '%s' function does not expect any argument but %d were provided", ErrIllegalArguments, CurrentSchemaFnCall, len(params))
 	}
-	return NewVarchar("public"), nil
+	if tx == nil || tx.catalog == nil {
+		return NewVarchar(PublicSchema), nil
+	}
+
+	schema := tx.catalog.CurrentSchema()
+	if schema == "" {
+		return NewNull(VarcharType), nil
+	}
+	return NewVarchar(schema), nil
 }
 
 // current_user — returns the logged-in username
@@ -703,6 +726,10 @@ func (f *pgCurrentUser) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error
 		return nil, fmt.Errorf("%w: '%s' function does not expect any argument but %d were provided", ErrIllegalArguments, CurrentUserFnCall, len(params))
 	}
 
+	if tx.user != nil {
+		return NewVarchar(tx.user.Username()), nil
+	}
+
 	users, err := tx.ListUsers(tx.tx.Context())
 	if err != nil {
 		return NewVarchar("immudb"), nil
@@ -884,26 +911,6 @@ func (f *pgBoolStub) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 	return NewBool(true), nil
 }
 
-// pgNullIntStub — returns NULL integer (for array_upper etc.)
-type pgNullIntStub struct {
-	name string
-}
-
-func (f *pgNullIntStub) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
-	return IntegerType, nil
-}
-
-func (f *pgNullIntStub) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if t != IntegerType {
-		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
-	}
-	return nil
-}
-
-func (f *pgNullIntStub) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
-	return NewNull(IntegerType), nil
-}
-
 // pgZeroIntStub — returns integer 0, used for pg_*_size functions which
 // psql calls from \d+ to render size columns. Returning 0 rather than
 // NULL keeps psql's formatting happy (NULL→`—`, 0→`0 bytes`).
@@ -1025,14 +1032,14 @@ func (f *pgQuoteIdent) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error)
 	return NewVarchar(`"` + strings.ReplaceAll(s, `"`, `""`) + `"`), nil
 }
 
-// array_to_string(array_literal, delim[, null_repr]) — joins the
-// elements of a PG array literal (text form: `{a,b,c}`) with delim.
-// Real PG arrays aren't representable in the engine today, so this
-// function accepts the VARCHAR stand-in emitted by current_schemas and
-// any other source that follows the curly-brace text convention. When
-// the argument isn't in that shape we pass it through unchanged —
-// that's what psql's callers expect when the "array" only has one
-// element and the server happened to render it as a bare scalar.
+// array_to_string(array, delim[, null_repr]) — joins the elements of
+// an array with delim. NULL elements are skipped unless null_repr is
+// provided. The VARCHAR stand-in emitted by current_schemas and any other
+// source that follows the curly-brace text convention (`{a,b,c}`) is
+// accepted as well. When a VARCHAR argument isn't in that shape we pass
+// it through unchanged — that's what psql's callers expect when the
+// "array" only has one element and the server happened to render it as
+// a bare scalar.
 type pgArrayToString struct{}
 
 func (f *pgArrayToString) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -1053,11 +1060,30 @@ func (f *pgArrayToString) Apply(tx *SQLTx, params []TypedValue) (TypedValue, err
 	if params[0].IsNull() {
 		return NewNull(VarcharType), nil
 	}
-	raw, _ := params[0].RawValue().(string)
 	delim := ""
 	if !params[1].IsNull() {
 		delim, _ = params[1].RawValue().(string)
 	}
+	if arr, ok := params[0].(*Array); ok {
+		var nullRepr *string
+		if len(params) == 3 && !params[2].IsNull() {
+			s, _ := params[2].RawValue().(string)
+			nullRepr = &s
+		}
+
+		parts := make([]string, 0, len(arr.vals))
+		for _, e := range arr.vals {
+			if e.IsNull() {
+				if nullRepr != nil {
+					parts = append(parts, *nullRepr)
+				}
+				continue
+			}
+			parts = append(parts, arrayElemText(e))
+		}
+		return NewVarchar(strings.Join(parts, delim)), nil
+	}
+	raw, _ := params[0].RawValue().(string)
 	// Curly-brace PG array literal → strip the braces and re-join.
 	if len(raw) >= 2 && raw[0] == '{' && raw[len(raw)-1] == '}' {
 		inner := raw[1 : len(raw)-1]
@@ -1070,6 +1096,70 @@ func (f *pgArrayToString) Apply(tx *SQLTx, params []TypedValue) (TypedValue, err
 	return NewVarchar(raw), nil
 }
 
+// array_upper(array, dim), array_length(array, dim) and cardinality(array).
+// Arrays are one-dimensional with a lower bound of 1, so array_upper and
+// array_length agree: both return NULL for an empty array or for any
+// dimension other than 1, while cardinality returns 0 for an empty array.
+// VARCHAR values in the array text representation are accepted too.
+type arrayLengthFn struct {
+	name string
+}
+
+func (f *arrayLengthFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntegerType, nil
+}
+
+func (f *arrayLengthFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+func (f *arrayLengthFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	nParams := 2
+	if f.name == CardinalityFnCall {
+		nParams = 1
+	}
+
+	if len(params) != nParams {
+		return nil, fmt.Errorf("%w: '%s' function expects %d arguments but %d were provided", ErrIllegalArguments, f.name, nParams, len(params))
+	}
+
+	if params[0].IsNull() {
+		return NewNull(IntegerType), nil
+	}
+
+	if !IsArrayType(params[0].Type()) && params[0].Type() != VarcharType {
+		return nil, fmt.Errorf("%w: '%s' function expects an array but %v was provided", ErrIllegalArguments, f.name, params[0].Type())
+	}
+
+	arr, err := asArray(params[0], AnyType)
+	if err != nil {
+		// catalog columns emulated as VARCHAR may not follow the array
+		// text representation
+		return NewNull(IntegerType), nil
+	}
+
+	if f.name == CardinalityFnCall {
+		return NewInteger(int64(len(arr.vals))), nil
+	}
+
+	if params[1].IsNull() {
+		return NewNull(IntegerType), nil
+	}
+
+	dim, ok := params[1].RawValue().(int64)
+	if !ok {
+		return nil, fmt.Errorf("%w: '%s' function expects an integer dimension", ErrIllegalArguments, f.name)
+	}
+
+	if dim != 1 || len(arr.vals) == 0 {
+		return NewNull(IntegerType), nil
+	}
+	return NewInteger(int64(len(arr.vals))), nil
+}
+
 // -------------------------------------
 // Math Functions
 // -------------------------------------
@@ -1083,7 +1173,7 @@ func (f *mathFn) InferType(cols map[string]ColDescriptor, params map[string]SQLV
 }
 
 func (f *mathFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if t != Float64Type && t != IntegerType {
+	if !IsNumericType(t) {
 		return fmt.Errorf("%w: %v can not be interpreted as numeric type", ErrInvalidTypes, t)
 	}
 	return nil
@@ -1155,6 +1245,8 @@ func (f *mathFn) applyUnary(p TypedValue, fn func(float64) float64) (TypedValue,
 		v = float64(raw)
 	case float64:
 		v = raw
+	case *Decimal:
+		v = raw.Float64()
 	default:
 		return nil, fmt.Errorf("%w: '%s' expects a numeric argument", ErrIllegalArguments, f.name)
 	}
@@ -1172,6 +1264,8 @@ func (f *mathFn) applyBinary(a, b TypedValue, fn func(float64, float64) float64)
 		va = float64(raw)
 	case float64:
 		va = raw
+	case *Decimal:
+		va = raw.Float64()
 	default:
 		return nil, fmt.Errorf("%w: '%s' expects numeric arguments", ErrIllegalArguments, f.name)
 	}
@@ -1180,6 +1274,8 @@ func (f *mathFn) applyBinary(a, b TypedValue, fn func(float64, float64) float64)
 		vb = float64(raw)
 	case float64:
 		vb = raw
+	case *Decimal:
+		vb = raw.Float64()
 	default:
 		return nil, fmt.Errorf("%w: '%s' expects numeric arguments", ErrIllegalArguments, f.name)
 	}
@@ -1719,6 +1815,9 @@ func (f *datePartFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 		return NewNull(Float64Type), nil
 	}
 	field, _ := params[0].RawValue().(string)
+	if iv, ok := params[1].RawValue().(*Interval); ok {
+		return NewFloat64(intervalField(field, iv)), nil
+	}
 	ts, ok := params[1].RawValue().(time.Time)
 	if !ok {
 		return NewNull(Float64Type), nil
@@ -1748,14 +1847,55 @@ func (f *datePartFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 	return NewFloat64(val), nil
 }
 
+// intervalField returns the given field of an interval, as done by
+// date_part() and EXTRACT.
+func intervalField(field string, iv *Interval) float64 {
+	switch strings.ToLower(field) {
+	case "year":
+		return float64(iv.months / 12)
+	case "month":
+		return float64(iv.months % 12)
+	case "day":
+		return float64(iv.days)
+	case "hour":
+		return float64(iv.micros / (3600 * microsPerSecond))
+	case "minute":
+		return float64(iv.micros / (60 * microsPerSecond) % 60)
+	case "second":
+		return float64(iv.micros%(60*microsPerSecond)) / float64(microsPerSecond)
+	case "epoch":
+		days, micros := iv.span()
+		return float64(days*microsPerDay+micros) / float64(microsPerSecond)
+	}
+	return 0
+}
+
+type currentDateFn struct{}
+
+func (f *currentDateFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return DateType, nil
+}
+func (f *currentDateFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != DateType && t != TimestampType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, DateType, t)
+	}
+	return nil
+}
+func (f *currentDateFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if len(params) > 0 {
+		return nil, fmt.Errorf("%w: '%s' function does not expect any argument but %d were provided", ErrIllegalArguments, CurrentDateFnCall, len(params))
+	}
+	return NewDate(tx.Timestamp()), nil
+}
+
 type ageFn struct{}
 
 func (f *ageFn) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
-	return VarcharType, nil
+	return IntervalType, nil
 }
 func (f *ageFn) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if t != VarcharType {
-		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	if t != IntervalType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntervalType, t)
 	}
 	return nil
 }
@@ -1886,6 +2026,8 @@ func (f *nextValFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 	if !ok {
 		return nil, fmt.Errorf("%w: '%s' expects a string argument", ErrIllegalArguments, NextValFnCall)
 	}
+	name = tx.resolveSequenceName(name)
+
 	val, err := tx.engine.NextVal(name)
 	if err != nil {
 		return nil, err
@@ -1924,7 +2066,7 @@ func (f *currValFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 	if !ok {
 		return nil, fmt.Errorf("%w: '%s' expects a string argument", ErrIllegalArguments, CurrValFnCall)
 	}
-	val, err := tx.engine.CurrVal(name)
+	val, err := tx.engine.CurrVal(tx.resolveSequenceName(name))
 	if err != nil {
 		return nil, err
 	}
@@ -1936,7 +2078,7 @@ func (f *ageFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 		return nil, fmt.Errorf("%w: '%s' expects 1-2 arguments", ErrIllegalArguments, AgeFnCall)
 	}
 	if params[0].IsNull() {
-		return NewNull(VarcharType), nil
+		return NewNull(IntervalType), nil
 	}
 
 	var from, to time.Time
@@ -1944,27 +2086,21 @@ func (f *ageFn) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
 		var ok bool
 		from, ok = params[1].RawValue().(time.Time)
 		if !ok {
-			return NewNull(VarcharType), nil
+			return NewNull(IntervalType), nil
 		}
 		to, ok = params[0].RawValue().(time.Time)
 		if !ok {
-			return NewNull(VarcharType), nil
+			return NewNull(IntervalType), nil
 		}
 	} else {
 		var ok bool
 		from, ok = params[0].RawValue().(time.Time)
 		if !ok {
-			return NewNull(VarcharType), nil
+			return NewNull(IntervalType), nil
 		}
-		to = tx.Timestamp().UTC()
+		// age(ts) is counted from midnight of the current date
+		to = truncateToDate(tx.Timestamp())
 	}
 
-	diff := to.Sub(from)
-	days := int(diff.Hours() / 24)
-	years := days / 365
-	remainDays := days % 365
-	months := remainDays / 30
-	d := remainDays % 30
-
-	return NewVarchar(fmt.Sprintf("%d years %d mons %d days", years, months, d)), nil
+	return ageBetween(to.UTC(), from.UTC()), nil
 }
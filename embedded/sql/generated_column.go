@@ -0,0 +1,324 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Generated columns are declared as
+//
+//	col TYPE GENERATED ALWAYS AS (exp) [STORED | VIRTUAL]
+//
+// and computed from the other columns of the same row. Stored columns are
+// computed when a row is written and kept in the row like any other column,
+// so they can be indexed. Virtual columns are not stored: they are computed
+// each time a row is read.
+
+func (c *Column) IsGenerated() bool {
+	return c.generated != nil
+}
+
+func (c *Column) IsVirtual() bool {
+	return c.virtual
+}
+
+func (c *Column) GeneratedExp() ValueExp {
+	return c.generated
+}
+
+// generatedColumnRef returns the name of the column of table t referred by
+// sel, if any.
+func (t *Table) generatedColumnRef(sel Selector) (string, error) {
+	var colSel *ColSelector
+
+	switch s := sel.(type) {
+	case *ColSelector:
+		colSel = s
+	case *JSONSelector:
+		colSel = s.ColSelector
+	default:
+		return "", fmt.Errorf("%s is not a column", sel)
+	}
+
+	if colSel.table != "" && colSel.table != t.name {
+		return "", fmt.Errorf("%s is not a column of table %s", sel, t.name)
+	}
+	return colSel.col, nil
+}
+
+// validateGeneratedColumn checks that the expression of the generated column
+// col only uses regular columns of its table and computes a value that can
+// be assigned to the column.
+func (t *Table) validateGeneratedColumn(col *Column) error {
+	if col.defaultValue != nil {
+		return fmt.Errorf("%w: column '%s' can not have both a default and a generation expression", ErrInvalidGeneratedColumn, col.colName)
+	}
+
+	if col.autoIncrement {
+		return fmt.Errorf("%w: column '%s' can not be auto incremental", ErrInvalidGeneratedColumn, col.colName)
+	}
+
+	if expContainsSubquery(col.generated) {
+		return fmt.Errorf("%w: subqueries are not allowed in the expression of column '%s'", ErrInvalidGeneratedColumn, col.colName)
+	}
+
+	for _, sel := range col.generated.selectors() {
+		name, err := t.generatedColumnRef(sel)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidGeneratedColumn, err)
+		}
+
+		refCol, err := t.GetColumnByName(name)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidGeneratedColumn, err)
+		}
+
+		if refCol.IsGenerated() {
+			return fmt.Errorf("%w: column '%s' can not use generated column '%s'", ErrInvalidGeneratedColumn, col.colName, refCol.colName)
+		}
+	}
+
+	cols := make(map[string]ColDescriptor, len(t.cols))
+	for _, c := range t.cols {
+		desc := ColDescriptor{Table: t.name, Column: c.colName, Type: c.colType}
+		cols[desc.Selector()] = desc
+	}
+
+	params := make(map[string]SQLValueType)
+
+	colType, err := col.generated.inferType(cols, params, t.name)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidGeneratedColumn, err)
+	}
+
+	if len(params) > 0 {
+		return fmt.Errorf("%w: parameters are not allowed", ErrInvalidGeneratedColumn)
+	}
+
+	if colType != AnyType {
+		if _, err := getConverter(colType, col.colType); err != nil {
+			return fmt.Errorf("%w: expression of type %s can not be assigned to column '%s' of type %s",
+				ErrInvalidGeneratedColumn, colType, col.colName, col.colType)
+		}
+	}
+	return nil
+}
+
+// generatedColumnsUsing returns the generated columns whose expressions use
+// the column with the given name.
+func (t *Table) generatedColumnsUsing(colName string) []*Column {
+	var cols []*Column
+
+	for _, col := range t.cols {
+		if !col.IsGenerated() {
+			continue
+		}
+
+		for _, sel := range col.generated.selectors() {
+			name, err := t.generatedColumnRef(sel)
+			if err == nil && name == colName {
+				cols = append(cols, col)
+				break
+			}
+		}
+	}
+	return cols
+}
+
+// generatedDeps returns the ids of the columns used to compute col.
+func (t *Table) generatedDeps(col *Column) []uint32 {
+	var ids []uint32
+
+	for _, sel := range col.generated.selectors() {
+		name, err := t.generatedColumnRef(sel)
+		if err != nil {
+			continue
+		}
+
+		if c, exists := t.colsByName[name]; exists {
+			ids = append(ids, c.id)
+		}
+	}
+	return ids
+}
+
+func (t *Table) hasGeneratedColumns() bool {
+	for _, col := range t.cols {
+		if col.IsGenerated() {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) hasVirtualColumns() bool {
+	for _, col := range t.cols {
+		if col.virtual {
+			return true
+		}
+	}
+	return false
+}
+
+// evalGeneratedColumn computes the value of the generated column col from a
+// row of its table.
+func (t *Table) evalGeneratedColumn(tx *SQLTx, col *Column, row *Row) (TypedValue, error) {
+	val, err := col.generated.reduce(tx, row, t.name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: when evaluating generated column '%s'", err, col.colName)
+	}
+
+	if val.IsNull() {
+		return &NullValue{t: col.colType}, nil
+	}
+
+	if val.Type() != col.colType {
+		conv, err := getConverter(val.Type(), col.colType)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%s)", err, col.colName)
+		}
+
+		val, err = conv(val)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%s)", err, col.colName)
+		}
+	}
+
+	return normalizeColumnValue(col, val)
+}
+
+// computeGeneratedValues sets the values of the generated columns of a row
+// about to be written. Virtual values are computed as well, so that
+// constraints and triggers see the complete row, but they are never encoded.
+func (t *Table) computeGeneratedValues(tx *SQLTx, valuesByColID map[uint32]TypedValue) error {
+	if !t.hasGeneratedColumns() {
+		return nil
+	}
+
+	row, err := t.valuesRow(valuesByColID)
+	if err != nil {
+		return err
+	}
+
+	for _, col := range t.cols {
+		if !col.IsGenerated() {
+			continue
+		}
+
+		val, err := t.evalGeneratedColumn(tx, col, row)
+		if err != nil {
+			return err
+		}
+
+		if val.IsNull() && col.notNull {
+			return fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
+		}
+
+		valuesByColID[col.id] = val
+	}
+	return nil
+}
+
+// evalVirtualColumns computes the virtual columns of a row just decoded by
+// the reader.
+func (r *rawRowReader) evalVirtualColumns(valuesByPosition []TypedValue, valuesBySelector map[string]TypedValue, extraCols int) error {
+	var row *Row
+
+	for pos, col := range r.table.cols {
+		if !col.virtual {
+			continue
+		}
+
+		if r.scanSpecs.neededColIDs != nil && !r.scanSpecs.neededColIDs[col.id] {
+			continue
+		}
+
+		if row == nil {
+			valuesByColID := make(map[uint32]TypedValue, len(r.table.cols))
+
+			for i, c := range r.table.cols {
+				if v := valuesByPosition[i+extraCols]; v != nil {
+					valuesByColID[c.id] = v
+				}
+			}
+
+			var err error
+
+			row, err = r.table.valuesRow(valuesByColID)
+			if err != nil {
+				return err
+			}
+		}
+
+		val, err := r.table.evalGeneratedColumn(r.tx, col, row)
+		if err != nil {
+			return err
+		}
+
+		valuesByPosition[pos+extraCols] = val
+		valuesBySelector[EncodeSelector("", r.tableAlias, col.colName)] = val
+	}
+	return nil
+}
+
+// backfillGeneratedColumn computes the new stored column col for the rows
+// already in its table.
+func (tx *SQLTx) backfillGeneratedColumn(ctx context.Context, table *Table, col *Column) error {
+	reader, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if errors.Is(err, store.ErrIndexNotFound) {
+		// the table is being created by the transaction
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		row, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		valuesByColID := table.valuesByColID(row)
+
+		if err := table.computeGeneratedValues(tx, valuesByColID); err != nil {
+			return err
+		}
+
+		if valuesByColID[col.id].IsNull() {
+			continue
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true); err != nil {
+			return err
+		}
+	}
+}
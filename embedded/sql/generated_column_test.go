@@ -0,0 +1,258 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedColumnStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		CREATE TABLE t (
+			id INTEGER,
+			price FLOAT,
+			qty INTEGER,
+			total FLOAT GENERATED ALWAYS AS (price * qty) STORED NOT NULL,
+			label VARCHAR[16] GENERATED ALWAYS AS (upper(name)) VIRTUAL,
+			other INTEGER GENERATED ALWAYS AS (qty + 1),
+			PRIMARY KEY id
+		)`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	stmt, ok := stmts[0].(*CreateTableStmt)
+	require.True(t, ok)
+	require.Len(t, stmt.colsSpec, 6)
+
+	total := stmt.colsSpec[3]
+	require.Equal(t, "total", total.colName)
+	require.Equal(t, Float64Type, total.colType)
+	require.True(t, total.notNull)
+	require.False(t, total.virtual)
+	require.Equal(t, "(price * qty)", total.generated.String())
+
+	label := stmt.colsSpec[4]
+	require.Equal(t, 16, label.maxLen)
+	require.True(t, label.virtual)
+	require.IsType(t, &FnCall{}, label.generated)
+
+	// virtual is the default kind
+	require.True(t, stmt.colsSpec[5].virtual)
+
+	stmts, err = ParseSQLString("ALTER TABLE t ADD COLUMN half FLOAT GENERATED ALWAYS AS (price / 2) STORED")
+	require.NoError(t, err)
+	require.NotNil(t, stmts[0].(*AddColumnStmt).colSpec.generated)
+}
+
+func TestGeneratedColumns(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	exec := func(sql string) error {
+		_, _, err := engine.Exec(context.Background(), nil, sql, nil)
+		return err
+	}
+
+	query := func(sql string) []*Row {
+		rows, err := engine.queryAll(context.Background(), nil, sql, nil)
+		require.NoError(t, err, sql)
+		return rows
+	}
+
+	values := func(sql string) [][]interface{} {
+		var res [][]interface{}
+		for _, row := range query(sql) {
+			vals := make([]interface{}, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				vals[i] = v.RawValue()
+			}
+			res = append(res, vals)
+		}
+		return res
+	}
+
+	plan := func(sql string) string {
+		var lines []string
+		for _, row := range query("EXPLAIN " + sql) {
+			lines = append(lines, row.ValuesByPosition[0].RawValue().(string))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	err = exec(`
+		CREATE TABLE items (
+			id INTEGER AUTO_INCREMENT,
+			name VARCHAR[32],
+			price INTEGER,
+			qty INTEGER,
+			total INTEGER GENERATED ALWAYS AS (price * qty) STORED,
+			label VARCHAR[32] GENERATED ALWAYS AS (lower(name)) VIRTUAL,
+			PRIMARY KEY id
+		);
+		CREATE INDEX ON items (total);
+		INSERT INTO items (name, price, qty) VALUES ('Pen', 2, 10), ('Book', 15, 2), ('Lamp', 40, 1);
+	`)
+	require.NoError(t, err)
+
+	t.Run("generated columns should be computed on insert", func(t *testing.T) {
+		require.Equal(t, [][]interface{}{
+			{int64(1), int64(20), "pen"},
+			{int64(2), int64(30), "book"},
+			{int64(3), int64(40), "lamp"},
+		}, values("SELECT id, total, label FROM items ORDER BY id"))
+
+		// virtual columns are computed even when their inputs are not selected
+		require.Equal(t, [][]interface{}{{"book"}}, values("SELECT label FROM items WHERE label = 'book'"))
+
+		rows := query("SELECT * FROM items WHERE id = 1")
+		require.Len(t, rows, 1)
+		require.Equal(t, "pen", rows[0].ValuesByPosition[5].RawValue())
+	})
+
+	t.Run("stored columns should be maintained on update and upsert", func(t *testing.T) {
+		err := exec("UPDATE items SET qty = 3 WHERE id = 3")
+		require.NoError(t, err)
+
+		err = exec("UPSERT INTO items (id, name, price, qty) VALUES (1, 'Pencil', 3, 10)")
+		require.NoError(t, err)
+
+		err = exec("INSERT INTO items (id, name, price, qty) VALUES (2, 'Book', 15, 2) ON CONFLICT DO UPDATE SET qty = 4")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{
+			{int64(1), int64(30), "pencil"},
+			{int64(2), int64(60), "book"},
+			{int64(3), int64(120), "lamp"},
+		}, values("SELECT id, total, label FROM items ORDER BY id"))
+	})
+
+	t.Run("stored columns should be indexable", func(t *testing.T) {
+		require.Contains(t, plan("SELECT id FROM items WHERE total = 60"), "-> Index Scan using (total) on items")
+		require.Equal(t, [][]interface{}{{int64(2)}}, values("SELECT id FROM items WHERE total = 60"))
+		require.Empty(t, values("SELECT id FROM items WHERE total = 20"))
+		require.Equal(t, [][]interface{}{{int64(1)}, {int64(2)}, {int64(3)}}, values("SELECT id FROM items ORDER BY total"))
+	})
+
+	t.Run("direct writes to generated columns should be rejected", func(t *testing.T) {
+		err := exec("INSERT INTO items (name, total) VALUES ('Cup', 1)")
+		require.ErrorIs(t, err, ErrCannotWriteGeneratedColumn)
+
+		err = exec("INSERT INTO items (name, label) VALUES ('Cup', 'cup')")
+		require.ErrorIs(t, err, ErrCannotWriteGeneratedColumn)
+
+		err = exec("UPDATE items SET total = 1 WHERE id = 1")
+		require.ErrorIs(t, err, ErrCannotWriteGeneratedColumn)
+
+		err = exec("INSERT INTO items (id, name) VALUES (1, 'Cup') ON CONFLICT DO UPDATE SET label = 'cup'")
+		require.ErrorIs(t, err, ErrCannotWriteGeneratedColumn)
+	})
+
+	t.Run("invalid generated columns should be rejected", func(t *testing.T) {
+		err := exec("CREATE TABLE bad (id INTEGER, v INTEGER GENERATED ALWAYS AS (missing + 1) STORED, PRIMARY KEY id)")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("CREATE TABLE bad (id INTEGER, a INTEGER, b INTEGER GENERATED ALWAYS AS (a + 1), c INTEGER GENERATED ALWAYS AS (b + 1), PRIMARY KEY id)")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("CREATE TABLE bad (id INTEGER, a INTEGER, b BOOLEAN GENERATED ALWAYS AS (a + 1) STORED, PRIMARY KEY id)")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("CREATE TABLE bad (id INTEGER GENERATED ALWAYS AS (a + 1) STORED, a INTEGER, PRIMARY KEY id)")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("CREATE TABLE bad (id INTEGER, a INTEGER, b INTEGER GENERATED ALWAYS AS (a + @p) STORED, PRIMARY KEY id)")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("CREATE INDEX ON items (label)")
+		require.ErrorIs(t, err, ErrCannotIndexVirtualColumn)
+
+		err = exec("CREATE INDEX ON items ((upper(label)))")
+		require.ErrorIs(t, err, ErrCannotIndexVirtualColumn)
+	})
+
+	t.Run("not null generated columns should be enforced", func(t *testing.T) {
+		err := exec(`
+			CREATE TABLE lines (id INTEGER AUTO_INCREMENT, a VARCHAR, b VARCHAR GENERATED ALWAYS AS (lower(a)) STORED NOT NULL, PRIMARY KEY id);
+		`)
+		require.NoError(t, err)
+
+		err = exec("INSERT INTO lines (a) VALUES (NULL)")
+		require.ErrorIs(t, err, ErrNotNullableColumnCannotBeNull)
+
+		err = exec("INSERT INTO lines (a) VALUES ('A')")
+		require.NoError(t, err)
+	})
+
+	t.Run("added stored columns should be computed for existing rows", func(t *testing.T) {
+		err := exec("ALTER TABLE items ADD COLUMN discounted INTEGER GENERATED ALWAYS AS (total - 5) STORED")
+		require.ErrorIs(t, err, ErrInvalidGeneratedColumn)
+
+		err = exec("ALTER TABLE items ADD COLUMN discounted INTEGER GENERATED ALWAYS AS (price * qty - 5) STORED")
+		require.NoError(t, err)
+
+		err = exec("ALTER TABLE items ADD COLUMN doubled INTEGER GENERATED ALWAYS AS (qty * 2) VIRTUAL")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{
+			{int64(1), int64(25), int64(20)},
+			{int64(2), int64(55), int64(8)},
+			{int64(3), int64(115), int64(6)},
+		}, values("SELECT id, discounted, doubled FROM items ORDER BY id"))
+	})
+
+	t.Run("columns used by generated columns can not be dropped nor renamed", func(t *testing.T) {
+		err := exec("ALTER TABLE items DROP COLUMN price")
+		require.ErrorIs(t, err, ErrCannotDropColumn)
+
+		err = exec("ALTER TABLE items RENAME COLUMN name TO title")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec("ALTER TABLE items DROP COLUMN doubled")
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, st.Close())
+
+	st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+	defer closeStore(t, st)
+
+	engine, err = NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	t.Run("generated columns should be loaded from the catalog", func(t *testing.T) {
+		err := exec("INSERT INTO items (name, price, qty) VALUES ('Mug', 5, 5)")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{
+			{int64(4), int64(25), "mug", int64(20)},
+		}, values("SELECT id, total, label, discounted FROM items WHERE id = 4"))
+
+		err = exec("UPDATE items SET total = 1 WHERE id = 4")
+		require.ErrorIs(t, err, ErrCannotWriteGeneratedColumn)
+	})
+
+	t.Run("truncated tables should keep their generated columns", func(t *testing.T) {
+		err := exec("TRUNCATE TABLE items")
+		require.NoError(t, err)
+
+		err = exec("INSERT INTO items (name, price, qty) VALUES ('Cup', 4, 2)")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{int64(8), "cup"}}, values("SELECT total, label FROM items"))
+	})
+}
@@ -0,0 +1,865 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Table grants complement the database-wide SQL privileges of a user. They
+// are stored in the catalog of the database, one entry per table and user,
+// and are checked while planning each statement: a user lacking a
+// database-wide privilege may still read or write a table, or some of its
+// columns, if the privilege was granted on it.
+
+// tablePrivileges are the privileges that can be granted on a single table.
+var tablePrivileges = []SQLPrivilege{
+	SQLPrivilegeSelect,
+	SQLPrivilegeInsert,
+	SQLPrivilegeUpdate,
+	SQLPrivilegeDelete,
+}
+
+func isTablePrivilege(privilege SQLPrivilege) bool {
+	for _, p := range tablePrivileges {
+		if p == privilege {
+			return true
+		}
+	}
+	return false
+}
+
+// privilegeSpec is a privilege listed in a GRANT or REVOKE statement,
+// optionally restricted to some columns. A nil list of specs stands for
+// ALL PRIVILEGES.
+type privilegeSpec struct {
+	privilege SQLPrivilege
+	cols      []string
+}
+
+func databasePrivileges(specs []*privilegeSpec) ([]SQLPrivilege, error) {
+	if specs == nil {
+		return allPrivileges, nil
+	}
+
+	privileges := make([]SQLPrivilege, len(specs))
+
+	for i, spec := range specs {
+		if len(spec.cols) > 0 {
+			return nil, fmt.Errorf("column privileges can only be granted on tables")
+		}
+		privileges[i] = spec.privilege
+	}
+	return privileges, nil
+}
+
+// tableGrant is a privilege granted to a user on a table. When colIDs is
+// empty the privilege applies to the whole table.
+type tableGrant struct {
+	user      string
+	privilege SQLPrivilege
+	colIDs    []uint32
+}
+
+func (g *tableGrant) covers(colID uint32) bool {
+	if len(g.colIDs) == 0 {
+		return true
+	}
+
+	for _, id := range g.colIDs {
+		if id == colID {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) grantOf(user string, privilege SQLPrivilege) *tableGrant {
+	for _, g := range t.grants {
+		if g.user == user && g.privilege == privilege {
+			return g
+		}
+	}
+	return nil
+}
+
+// setGrants replaces the grants held by user on the table. The grants
+// slice is never modified in place, so it can be shared between clones
+// of the catalog.
+func (t *Table) setGrants(user string, grants []*tableGrant) {
+	newGrants := make([]*tableGrant, 0, len(t.grants)+len(grants))
+
+	for _, g := range t.grants {
+		if g.user != user {
+			newGrants = append(newGrants, g)
+		}
+	}
+	newGrants = append(newGrants, grants...)
+
+	sort.SliceStable(newGrants, func(i, j int) bool {
+		return newGrants[i].user < newGrants[j].user
+	})
+
+	t.grants = newGrants
+}
+
+func (t *Table) grantsOf(user string) []*tableGrant {
+	var grants []*tableGrant
+
+	for _, g := range t.grants {
+		if g.user == user {
+			grants = append(grants, g)
+		}
+	}
+	return grants
+}
+
+// checkTablesAccessedBy verifies that the user running the statement holds
+// privilege on every table stmt accesses with it. Statements whose tables
+// are not known before planning, or which access no table, require the
+// privilege database-wide.
+func (tx *SQLTx) checkTablesAccessedBy(stmt SQLStmt, privilege SQLPrivilege) error {
+	refs, known := tablesAccessedBy(stmt, privilege)
+
+	checked := 0
+
+	for _, ref := range refs {
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			// not a table, e.g. a view or a common table expression
+			continue
+		}
+
+		err = tx.checkTablePrivilege(table, privilege, nil)
+		if err != nil {
+			return err
+		}
+		checked++
+	}
+
+	if !known || checked == 0 {
+		return fmt.Errorf("%w: statement requires %s privilege", ErrAccessDenied, privilege)
+	}
+	return nil
+}
+
+// tablesAccessedBy returns the tables stmt accesses with privilege. Tables
+// read by subqueries are not included, their privileges are checked when
+// they are planned. It reports false when stmt is not a query or a DML
+// statement.
+func tablesAccessedBy(stmt SQLStmt, privilege SQLPrivilege) ([]*tableRef, bool) {
+	switch s := stmt.(type) {
+	case *DeclareCursorStmt:
+		return tablesAccessedBy(s.query, privilege)
+	case *ReturningStmt:
+		return tablesAccessedBy(s.dml, privilege)
+	case *UpsertIntoStmt:
+		if privilege == SQLPrivilegeSelect {
+			refs, _ := tablesReadBy(s.ds)
+			return refs, true
+		}
+		return []*tableRef{s.tableRef}, true
+	case *UpdateStmt:
+		return []*tableRef{s.tableRef}, true
+	case *DeleteFromStmt:
+		return []*tableRef{s.tableRef}, true
+	case *MergeStmt:
+		if privilege == SQLPrivilegeSelect {
+			refs, _ := tablesReadBy(s.source)
+			return append(refs, s.target), true
+		}
+		return []*tableRef{s.target}, true
+	case *RefreshMaterializedViewStmt:
+		return []*tableRef{NewTableRef(s.name, "")}, true
+	case DataSource:
+		if refs, ok := tablesReadBy(s); ok {
+			return refs, privilege == SQLPrivilegeSelect
+		}
+	}
+	return nil, false
+}
+
+// tablesReadBy returns the tables scanned by the query ds. It reports false
+// when ds is not a query.
+func tablesReadBy(ds DataSource) ([]*tableRef, bool) {
+	var refs []*tableRef
+
+	read := func(dss ...DataSource) {
+		for _, ds := range dss {
+			dsRefs, _ := tablesReadBy(ds)
+			refs = append(refs, dsRefs...)
+		}
+	}
+
+	switch s := ds.(type) {
+	case *tableRef:
+		refs = append(refs, s)
+	case *SelectStmt:
+		read(s.ds)
+		for _, jspec := range s.joins {
+			read(jspec.ds)
+		}
+	case *UnionStmt:
+		read(s.left, s.right)
+	case *ExceptStmt:
+		read(s.left, s.right)
+	case *IntersectStmt:
+		read(s.left, s.right)
+	case *CTEStmt:
+		for _, cte := range s.ctes {
+			read(cte.query)
+		}
+		read(s.query)
+	case *ExplainStmt:
+		read(s.query)
+	default:
+		return nil, false
+	}
+	return refs, true
+}
+
+type AlterTablePrivilegesStmt struct {
+	table      string
+	user       string
+	privileges []*privilegeSpec
+	isGrant    bool
+}
+
+func (stmt *AlterTablePrivilegesStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *AlterTablePrivilegesStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *AlterTablePrivilegesStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *AlterTablePrivilegesStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if tx.engine.multidbHandler == nil {
+		return nil, ErrUnspecifiedMultiDBHandler
+	}
+
+	if tx.user != nil && tx.user.Permission() != PermissionAdmin && tx.user.Permission() != PermissionSysAdmin {
+		return nil, fmt.Errorf("%w: statement requires %s permission", ErrAccessDenied, PermissionAdmin)
+	}
+
+	if err := stmt.checkUserExists(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.systemScan != nil {
+		return nil, fmt.Errorf("%w: privileges can not be granted on system table '%s'", ErrIllegalArguments, table.name)
+	}
+
+	specs := stmt.privileges
+	if specs == nil {
+		for _, p := range tablePrivileges {
+			specs = append(specs, &privilegeSpec{privilege: p})
+		}
+	}
+
+	grants := make(map[SQLPrivilege]*tableGrant)
+	for _, g := range table.grantsOf(stmt.user) {
+		grants[g.privilege] = g
+	}
+
+	for _, spec := range specs {
+		if !isTablePrivilege(spec.privilege) {
+			return nil, fmt.Errorf("%w: %s privilege can not be granted on a table", ErrIllegalArguments, spec.privilege)
+		}
+
+		if len(spec.cols) > 0 && spec.privilege == SQLPrivilegeDelete {
+			return nil, fmt.Errorf("%w: %s privilege can not be granted on columns", ErrIllegalArguments, spec.privilege)
+		}
+
+		colIDs := make([]uint32, len(spec.cols))
+		for i, colName := range spec.cols {
+			col, err := table.GetColumnByName(colName)
+			if err != nil {
+				return nil, err
+			}
+			colIDs[i] = col.id
+		}
+
+		grant := grants[spec.privilege]
+
+		if stmt.isGrant {
+			grants[spec.privilege] = grantColumns(stmt.user, spec.privilege, grant, colIDs)
+			continue
+		}
+
+		grant = revokeColumns(grant, colIDs)
+		if grant == nil {
+			delete(grants, spec.privilege)
+		} else {
+			grants[spec.privilege] = grant
+		}
+	}
+
+	userGrants := make([]*tableGrant, 0, len(grants))
+	for _, p := range tablePrivileges {
+		if g, ok := grants[p]; ok {
+			userGrants = append(userGrants, g)
+		}
+	}
+
+	table.setGrants(stmt.user, userGrants)
+
+	err = persistTableGrants(ctx, tx, table, stmt.user)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func (stmt *AlterTablePrivilegesStmt) checkUserExists(ctx context.Context, tx *SQLTx) error {
+	users, err := tx.engine.multidbHandler.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range users {
+		if u.Username() == stmt.user {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: user '%s' does not exist", ErrIllegalArguments, stmt.user)
+}
+
+// grantColumns extends grant with colIDs, or with the whole table when
+// colIDs is empty.
+func grantColumns(user string, privilege SQLPrivilege, grant *tableGrant, colIDs []uint32) *tableGrant {
+	if grant == nil || len(colIDs) == 0 {
+		return &tableGrant{user: user, privilege: privilege, colIDs: colIDs}
+	}
+
+	if len(grant.colIDs) == 0 {
+		return grant
+	}
+
+	newGrant := &tableGrant{
+		user:      user,
+		privilege: privilege,
+		colIDs:    append([]uint32(nil), grant.colIDs...),
+	}
+
+	for _, id := range colIDs {
+		if !newGrant.covers(id) {
+			newGrant.colIDs = append(newGrant.colIDs, id)
+		}
+	}
+	return newGrant
+}
+
+// revokeColumns removes colIDs from grant, or the whole grant when colIDs is
+// empty. As in PostgreSQL, revoking a column privilege does not affect a
+// privilege granted on the whole table.
+func revokeColumns(grant *tableGrant, colIDs []uint32) *tableGrant {
+	if grant == nil || len(colIDs) == 0 {
+		return nil
+	}
+
+	if len(grant.colIDs) == 0 {
+		return grant
+	}
+
+	newGrant := &tableGrant{user: grant.user, privilege: grant.privilege}
+
+	for _, id := range grant.colIDs {
+		revoked := false
+		for _, rid := range colIDs {
+			if id == rid {
+				revoked = true
+				break
+			}
+		}
+
+		if !revoked {
+			newGrant.colIDs = append(newGrant.colIDs, id)
+		}
+	}
+
+	if len(newGrant.colIDs) == 0 {
+		return nil
+	}
+	return newGrant
+}
+
+func persistTableGrants(ctx context.Context, tx *SQLTx, table *Table, user string) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogPrivilegePrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		[]byte(user),
+	)
+
+	grants := table.grantsOf(user)
+	if len(grants) == 0 {
+		return tx.delete(ctx, mappedKey)
+	}
+
+	// {count}({privLen-1}{privilege}{colCount}{colID}*)*
+	val := []byte{byte(len(grants))}
+
+	for _, g := range grants {
+		val = append(val, byte(len(g.privilege)-1))
+		val = append(val, []byte(g.privilege)...)
+		val = binary.BigEndian.AppendUint32(val, uint32(len(g.colIDs)))
+
+		for _, id := range g.colIDs {
+			val = binary.BigEndian.AppendUint32(val, id)
+		}
+	}
+
+	return tx.set(mappedKey, nil, val)
+}
+
+func persistTableGrantsDeletion(ctx context.Context, tx *SQLTx, table *Table) error {
+	users := make(map[string]struct{})
+
+	for _, g := range table.grants {
+		if _, deleted := users[g.user]; deleted {
+			continue
+		}
+		users[g.user] = struct{}{}
+
+		mappedKey := MapKey(
+			tx.sqlPrefix(),
+			catalogPrivilegePrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+			[]byte(g.user),
+		)
+
+		if err := tx.delete(ctx, mappedKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyGrants re-creates on table the grants held on src, which may be a
+// previous incarnation of the same table using different column ids.
+func (tx *SQLTx) copyGrants(ctx context.Context, src, table *Table) error {
+	users := make(map[string]struct{})
+
+	for _, g := range src.grants {
+		colIDs := make([]uint32, 0, len(g.colIDs))
+
+		for _, id := range g.colIDs {
+			col, err := src.GetColumnByID(id)
+			if err != nil {
+				continue
+			}
+
+			newCol, err := table.GetColumnByName(col.colName)
+			if err != nil {
+				return err
+			}
+			colIDs = append(colIDs, newCol.id)
+		}
+
+		if len(g.colIDs) > 0 && len(colIDs) == 0 {
+			continue
+		}
+
+		table.setGrants(g.user, append(table.grantsOf(g.user), &tableGrant{
+			user:      g.user,
+			privilege: g.privilege,
+			colIDs:    colIDs,
+		}))
+
+		users[g.user] = struct{}{}
+	}
+
+	for user := range users {
+		if err := persistTableGrants(ctx, tx, table, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadTableGrants(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) ([]*tableGrant, error) {
+	prefix := MapKey(sqlPrefix, catalogPrivilegePrefix, EncodeID(dbID), EncodeID(tableID))
+
+	var grants []*tableGrant
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		userGrants, err := parseTableGrants(sqlPrefix, key, value)
+		if err != nil {
+			return err
+		}
+		grants = append(grants, userGrants...)
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return grants, err
+}
+
+// parseTableGrants decodes a value written by persistTableGrants:
+// {count}({privLen-1}{privilege}{colCount}{colID}*)*
+func parseTableGrants(prefix, key, value []byte) ([]*tableGrant, error) {
+	encKey, err := trimPrefix(prefix, key, []byte(catalogPrivilegePrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encKey) <= 2*EncIDLen || len(value) < 1 {
+		return nil, ErrCorruptedData
+	}
+
+	user := string(encKey[2*EncIDLen:])
+
+	grants := make([]*tableGrant, int(value[0]))
+	off := 1
+
+	for i := range grants {
+		if len(value) < off+1 {
+			return nil, ErrCorruptedData
+		}
+
+		privLen := int(value[off]) + 1
+		off++
+
+		if len(value) < off+privLen+EncIDLen {
+			return nil, ErrCorruptedData
+		}
+
+		privilege := SQLPrivilege(value[off : off+privLen])
+		off += privLen
+
+		colCount := int(binary.BigEndian.Uint32(value[off:]))
+		off += EncIDLen
+
+		if len(value) < off+colCount*EncIDLen {
+			return nil, ErrCorruptedData
+		}
+
+		var colIDs []uint32
+		for j := 0; j < colCount; j++ {
+			colIDs = append(colIDs, binary.BigEndian.Uint32(value[off:]))
+			off += EncIDLen
+		}
+
+		grants[i] = &tableGrant{user: user, privilege: privilege, colIDs: colIDs}
+	}
+
+	if off != len(value) {
+		return nil, ErrCorruptedData
+	}
+	return grants, nil
+}
+
+// checkTablePrivilege verifies that the user running the current statement
+// holds privilege on table, either database-wide, on the whole table or on
+// each of cols. An empty cols requires the privilege on at least one column.
+func (tx *SQLTx) checkTablePrivilege(table *Table, privilege SQLPrivilege, cols []*Column) error {
+	if tx.user == nil || table.systemScan != nil {
+		return nil
+	}
+
+	if hasAllPrivileges(tx.user.SQLPrivileges(), []SQLPrivilege{privilege}) {
+		return nil
+	}
+
+	grant := table.grantOf(tx.user.Username(), privilege)
+	if grant == nil {
+		return fmt.Errorf("%w: %s privilege required on table '%s'", ErrAccessDenied, privilege, table.name)
+	}
+
+	for _, col := range cols {
+		if !grant.covers(col.id) {
+			return fmt.Errorf("%w: %s privilege required on column '%s' of table '%s'", ErrAccessDenied, privilege, col.colName, table.name)
+		}
+	}
+	return nil
+}
+
+// checkPrivileges verifies that the user running the statement can read
+// the columns it references from each of the tables it scans.
+func (stmt *SelectStmt) checkPrivileges(tx *SQLTx) error {
+	if tx == nil || tx.user == nil || stmt.privilegesChecked {
+		return nil
+	}
+
+	dss := []DataSource{stmt.ds}
+	for _, jspec := range stmt.joins {
+		dss = append(dss, jspec.ds)
+	}
+
+	for _, ds := range dss {
+		ref, ok := ds.(*tableRef)
+		if !ok {
+			continue
+		}
+
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			// not a table, resolving the data source reports any error
+			continue
+		}
+
+		err = tx.checkTablePrivilege(table, SQLPrivilegeSelect, stmt.readColumns(table, ref.Alias()))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readColumns returns the columns of table, scanned as alias, read by the
+// statement.
+func (stmt *SelectStmt) readColumns(table *Table, alias string) []*Column {
+	// SELECT * and window functions may read any column
+	if len(stmt.targets) == 0 {
+		return table.cols
+	}
+
+	exps := make([]ValueExp, 0, len(stmt.targets)+4)
+
+	for _, t := range stmt.targets {
+		if _, isWin := t.Exp.(*WindowFnExp); isWin {
+			return table.cols
+		}
+		exps = append(exps, t.Exp)
+	}
+
+	exps = append(exps, stmt.where, stmt.having)
+
+	for _, gb := range stmt.groupBy {
+		exps = append(exps, gb)
+	}
+
+	for _, ob := range stmt.orderBy {
+		exps = append(exps, ob.exp)
+	}
+
+	for _, jspec := range stmt.joins {
+		exps = append(exps, jspec.cond)
+	}
+
+	return columnsReadBy(table, alias, exps...)
+}
+
+// columnsReadBy returns the columns of table, scanned as alias, referenced by
+// exps. Unqualified names not found in table are assumed to belong to other
+// tables of the statement. Subqueries may read any column of the outer table.
+func columnsReadBy(table *Table, alias string, exps ...ValueExp) []*Column {
+	var cols []*Column
+	seen := make(map[uint32]bool)
+
+	for _, exp := range exps {
+		if exp == nil {
+			continue
+		}
+
+		if expContainsSubquery(exp) {
+			return table.cols
+		}
+
+		for _, sel := range expandAggSelectors(exp.selectors()) {
+			var colName, colTable string
+
+			switch s := sel.(type) {
+			case *ColSelector:
+				if s.col == "*" {
+					return table.cols
+				}
+				colName, colTable = s.col, s.table
+			case *AggColSelector:
+				if s.col == "*" {
+					continue
+				}
+				colName, colTable = s.col, s.table
+			default:
+				return table.cols
+			}
+
+			if colTable != "" && colTable != alias {
+				continue
+			}
+
+			col, err := table.GetColumnByName(colName)
+			if err != nil || seen[col.id] {
+				continue
+			}
+
+			seen[col.id] = true
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// checkPrivileges verifies that the user running the statement can insert
+// into the listed columns, and update them when rows may be replaced.
+func (stmt *UpsertIntoStmt) checkPrivileges(tx *SQLTx, table *Table) error {
+	if tx.user == nil {
+		return nil
+	}
+
+	cols := make([]*Column, len(stmt.cols))
+	for i, colName := range stmt.cols {
+		col, err := table.GetColumnByName(colName)
+		if err != nil {
+			return err
+		}
+		cols[i] = col
+	}
+
+	for _, privilege := range stmt.privileges() {
+		if err := tx.checkTablePrivilege(table, privilege, cols); err != nil {
+			return err
+		}
+	}
+
+	if stmt.onConflict == nil || stmt.onConflict.updates == nil {
+		return nil
+	}
+	return checkUpdatePrivileges(tx, table, stmt.tableRef.Alias(), stmt.onConflict.updates)
+}
+
+// checkPrivileges verifies that the user running the statement can update
+// the assigned columns and read the ones used to compute the new values or
+// to filter rows.
+func (stmt *UpdateStmt) checkPrivileges(tx *SQLTx, table *Table) error {
+	if tx.user == nil {
+		return nil
+	}
+
+	err := checkUpdatePrivileges(tx, table, stmt.tableRef.Alias(), stmt.updates)
+	if err != nil {
+		return err
+	}
+	return checkReadPrivileges(tx, table, stmt.tableRef.Alias(), stmt.where)
+}
+
+// checkPrivileges verifies that the user running the statement can delete
+// rows from the table and read the columns used to filter them.
+func (stmt *DeleteFromStmt) checkPrivileges(tx *SQLTx, table *Table) error {
+	if tx.user == nil {
+		return nil
+	}
+
+	err := tx.checkTablePrivilege(table, SQLPrivilegeDelete, nil)
+	if err != nil {
+		return err
+	}
+
+	exps := []ValueExp{stmt.where}
+	for _, ob := range stmt.orderBy {
+		exps = append(exps, ob.exp)
+	}
+	return checkReadPrivileges(tx, table, stmt.tableRef.Alias(), exps...)
+}
+
+// checkPrivileges verifies that the user running the statement can write
+// the target table as its actions do and read the columns used to match and
+// choose the rows.
+func (stmt *MergeStmt) checkPrivileges(tx *SQLTx, table *Table) error {
+	if tx.user == nil {
+		return nil
+	}
+
+	alias := stmt.target.Alias()
+
+	exps := []ValueExp{stmt.on}
+
+	for _, action := range stmt.actions {
+		var err error
+
+		switch action.kind {
+		case MergeUpdate:
+			err = checkUpdatePrivileges(tx, table, alias, action.updates)
+		case MergeDelete:
+			err = tx.checkTablePrivilege(table, SQLPrivilegeDelete, nil)
+		case MergeInsert:
+			cols := make([]*Column, 0, len(action.values))
+			for _, colName := range stmt.insertCols(table, action) {
+				col, cerr := table.GetColumnByName(colName)
+				if cerr != nil {
+					return cerr
+				}
+				cols = append(cols, col)
+			}
+			err = tx.checkTablePrivilege(table, SQLPrivilegeInsert, cols)
+		}
+		if err != nil {
+			return err
+		}
+
+		if action.matched && action.cond != nil {
+			exps = append(exps, action.cond)
+		}
+	}
+	return checkReadPrivileges(tx, table, alias, exps...)
+}
+
+func checkUpdatePrivileges(tx *SQLTx, table *Table, alias string, updates []*colUpdate) error {
+	cols := make([]*Column, len(updates))
+	vals := make([]ValueExp, len(updates))
+
+	for i, update := range updates {
+		col, err := table.GetColumnByName(update.col)
+		if err != nil {
+			return err
+		}
+		cols[i] = col
+		vals[i] = update.val
+	}
+
+	err := tx.checkTablePrivilege(table, SQLPrivilegeUpdate, cols)
+	if err != nil {
+		return err
+	}
+	return checkReadPrivileges(tx, table, alias, vals...)
+}
+
+// checkReadPrivileges requires SELECT on the columns of table read by exps,
+// if any.
+func checkReadPrivileges(tx *SQLTx, table *Table, alias string, exps ...ValueExp) error {
+	cols := columnsReadBy(table, alias, exps...)
+	if len(cols) == 0 {
+		return nil
+	}
+	return tx.checkTablePrivilege(table, SQLPrivilegeSelect, cols)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableGrants(t *testing.T) {
+	admin := &mockUser{username: "dba", permission: PermissionAdmin, sqlPrivileges: allPrivileges}
+	bob := &mockUser{username: "bob", permission: PermissionReadWrite}
+
+	handler := &multidbHandlerMock{user: admin, users: []*mockUser{admin, bob}}
+
+	engine, st := setupCommonTestWithEngineOptions(t, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler))
+
+	exec := func(user *mockUser, sql string) error {
+		handler.user = user
+		_, _, err := engine.Exec(context.Background(), nil, sql, nil)
+		return err
+	}
+
+	query := func(user *mockUser, sql string) ([][]string, error) {
+		handler.user = user
+
+		r, err := engine.Query(context.Background(), nil, sql, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		var rows [][]string
+		for {
+			row, err := r.Read(context.Background())
+			if err == ErrNoMoreRows {
+				return rows, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			vals := make([]string, len(row.ValuesByPosition))
+			for i, v := range row.ValuesByPosition {
+				vals[i] = v.String()
+			}
+			rows = append(rows, vals)
+		}
+	}
+
+	require.NoError(t, exec(admin, `
+		CREATE TABLE employees (id INTEGER, name VARCHAR, salary INTEGER, PRIMARY KEY id);
+		CREATE TABLE depts (id INTEGER, title VARCHAR, PRIMARY KEY id);
+		INSERT INTO employees (id, name, salary) VALUES (1, 'alice', 100), (2, 'carol', 200);
+		INSERT INTO depts (id, title) VALUES (1, 'sales');
+	`))
+
+	t.Run("no grants", func(t *testing.T) {
+		_, err := query(bob, "SELECT id FROM employees")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, err = query(bob, "SELECT 1")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("column grants", func(t *testing.T) {
+		require.NoError(t, exec(admin, "GRANT SELECT (id, name) ON TABLE employees TO USER bob"))
+
+		rows, err := query(bob, "SELECT id, name FROM employees WHERE id > 0 ORDER BY name")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"1", "'alice'"}, {"2", "'carol'"}}, rows)
+
+		rows, err = query(bob, "SELECT COUNT(*) FROM employees")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"2"}}, rows)
+
+		for _, q := range []string{
+			// a grant on some table does not allow reading other tables
+			"SELECT 1",
+			"SELECT id FROM depts",
+			"EXPLAIN SELECT id FROM depts",
+			"SELECT id FROM employees UNION SELECT id FROM depts",
+			"WITH d AS (SELECT id FROM depts) SELECT id FROM d",
+			"SELECT * FROM employees",
+			"SELECT salary FROM employees",
+			"SELECT id FROM employees WHERE salary > 100",
+			"SELECT name FROM employees ORDER BY salary",
+			"SELECT e.name FROM employees e WHERE e.salary > 100",
+			"SELECT id FROM employees WHERE id IN (SELECT id FROM employees)",
+		} {
+			_, err := query(bob, q)
+			require.ErrorIs(t, err, ErrAccessDenied, q)
+		}
+	})
+
+	t.Run("joins", func(t *testing.T) {
+		_, err := query(bob, "SELECT e.name, d.title FROM employees e JOIN depts d ON e.id = d.id")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		require.NoError(t, exec(admin, "GRANT SELECT ON depts TO USER bob"))
+
+		rows, err := query(bob, "SELECT e.name, d.title FROM employees e JOIN depts d ON e.id = d.id")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"'alice'", "'sales'"}}, rows)
+
+		_, err = query(bob, "SELECT e.salary, d.title FROM employees e JOIN depts d ON e.id = d.id")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		err := exec(bob, "INSERT INTO employees (id, name) VALUES (3, 'dave')")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		require.NoError(t, exec(admin, "GRANT INSERT (id, name), UPDATE (name), DELETE ON TABLE employees TO USER bob"))
+
+		require.NoError(t, exec(bob, "INSERT INTO employees (id, name) VALUES (3, 'dave')"))
+
+		err = exec(bob, "INSERT INTO employees (id, name, salary) VALUES (4, 'erin', 10)")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		// upserts may replace rows, so they also require UPDATE on the columns
+		err = exec(bob, "UPSERT INTO employees (id, name) VALUES (3, 'dave')")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		require.NoError(t, exec(bob, "UPDATE employees SET name = 'david' WHERE id = 3"))
+
+		err = exec(bob, "UPDATE employees SET salary = 0 WHERE id = 3")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		err = exec(bob, "UPDATE employees SET name = 'david' WHERE salary IS NULL")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		err = exec(bob, "DELETE FROM employees WHERE salary IS NULL")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		require.NoError(t, exec(bob, "DELETE FROM employees WHERE id = 3"))
+
+		err = exec(bob, "DELETE FROM depts")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("show grants", func(t *testing.T) {
+		rows, err := query(admin, "SHOW GRANTS FOR bob")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{
+			{"'bob'", "'SELECT'", "'employees'", "'id'"},
+			{"'bob'", "'SELECT'", "'employees'", "'name'"},
+			{"'bob'", "'INSERT'", "'employees'", "'id'"},
+			{"'bob'", "'INSERT'", "'employees'", "'name'"},
+			{"'bob'", "'UPDATE'", "'employees'", "'name'"},
+			{"'bob'", "'DELETE'", "'employees'", "NULL"},
+			{"'bob'", "'SELECT'", "'depts'", "NULL"},
+		}, rows)
+
+		rows, err = query(admin, "SHOW GRANTS FOR dba")
+		require.NoError(t, err)
+		require.Len(t, rows, len(allPrivileges))
+		require.Equal(t, []string{"'dba'", "'SELECT'", "NULL", "NULL"}, rows[0])
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		require.NoError(t, exec(admin, "REVOKE SELECT (name) ON TABLE employees FROM USER bob"))
+
+		_, err := query(bob, "SELECT name FROM employees")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, err = query(bob, "SELECT id FROM employees")
+		require.NoError(t, err)
+
+		// revoking a column privilege keeps the one granted on the whole table
+		require.NoError(t, exec(admin, "REVOKE SELECT (title) ON TABLE depts FROM USER bob"))
+
+		_, err = query(bob, "SELECT title FROM depts")
+		require.NoError(t, err)
+
+		require.NoError(t, exec(admin, "REVOKE ALL PRIVILEGES ON TABLE depts TO USER bob"))
+
+		_, err = query(bob, "SELECT title FROM depts")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		rows, err := query(admin, "SHOW GRANTS FOR bob")
+		require.NoError(t, err)
+		require.Len(t, rows, 5)
+	})
+
+	t.Run("invalid grants", func(t *testing.T) {
+		err := exec(bob, "GRANT SELECT ON TABLE employees TO USER bob")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		err = exec(admin, "GRANT SELECT ON TABLE employees TO USER mallory")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec(admin, "GRANT CREATE ON TABLE employees TO USER bob")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec(admin, "GRANT DELETE (id) ON TABLE employees TO USER bob")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec(admin, "GRANT SELECT (unknown) ON TABLE employees TO USER bob")
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+
+		err = exec(admin, "GRANT SELECT ON TABLE unknown TO USER bob")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	t.Run("grants are transactional", func(t *testing.T) {
+		require.NoError(t, exec(admin, "BEGIN; GRANT SELECT ON depts TO USER bob; ROLLBACK;"))
+
+		_, err := query(bob, "SELECT title FROM depts")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+
+	t.Run("truncate and drop", func(t *testing.T) {
+		require.NoError(t, exec(admin, "ALTER TABLE employees DROP COLUMN salary"))
+		require.NoError(t, exec(admin, "TRUNCATE TABLE employees"))
+
+		rows, err := query(bob, "SELECT id FROM employees")
+		require.NoError(t, err)
+		require.Empty(t, rows)
+
+		require.NoError(t, exec(admin, "DROP TABLE employees"))
+		require.NoError(t, exec(admin, "CREATE TABLE employees (id INTEGER, PRIMARY KEY id)"))
+
+		_, err = query(bob, "SELECT id FROM employees")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		rows, err = query(admin, "SHOW GRANTS FOR bob")
+		require.NoError(t, err)
+		require.Empty(t, rows)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, exec(admin, "GRANT SELECT (title) ON TABLE depts TO USER bob"))
+
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler))
+		require.NoError(t, err)
+		engine = reopened
+
+		rows, err := query(bob, "SELECT title FROM depts")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"'sales'"}}, rows)
+
+		_, err = query(bob, "SELECT id FROM depts")
+		require.ErrorIs(t, err, ErrAccessDenied)
+	})
+}
+
+func TestTableGrantsEncoding(t *testing.T) {
+	key := MapKey([]byte(sqlPrefix), catalogPrivilegePrefix, EncodeID(DatabaseID), EncodeID(3), []byte("bob"))
+
+	_, err := parseTableGrants([]byte(sqlPrefix), key, []byte{1, 5})
+	require.ErrorIs(t, err, ErrCorruptedData)
+
+	_, err = parseTableGrants([]byte(sqlPrefix), key[:len(key)-3], []byte{0})
+	require.ErrorIs(t, err, ErrCorruptedData)
+
+	grants, err := parseTableGrants([]byte(sqlPrefix), key, []byte{1, 5, 'S', 'E', 'L', 'E', 'C', 'T', 0, 0, 0, 1, 0, 0, 0, 2})
+	require.NoError(t, err)
+	require.Equal(t, []*tableGrant{{user: "bob", privilege: SQLPrivilegeSelect, colIDs: []uint32{2}}}, grants)
+}
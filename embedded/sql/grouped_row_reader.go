@@ -32,6 +32,10 @@ type groupedRowReader struct {
 	cols            []ColDescriptor
 	allAggregations bool
 
+	// groupingSets is only set for GROUP BY ROLLUP, CUBE and GROUPING SETS,
+	// which are aggregated by hashGroupedRowReader.
+	groupingSets [][]*ColSelector
+
 	currRow *Row
 	empty   bool
 }
@@ -100,6 +104,13 @@ func (gr *groupedRowReader) columns() ([]ColDescriptor, error) {
 			colsByPos = append(colsByPos, colsBySel[sel])
 		}
 	}
+
+	if gr.groupingSets != nil {
+		for _, col := range gr.groupByCols {
+			_, table, c := col.resolve(gr.rowReader.TableAlias())
+			colsByPos = append(colsByPos, colsBySel[groupingSelector(table, c)])
+		}
+	}
 	return colsByPos, nil
 }
 
@@ -125,12 +136,12 @@ func (gr *groupedRowReader) colsBySelector(ctx context.Context) (map[string]ColD
 
 		encSel := des.Selector()
 
-		if aggFn == COUNT && !sel.distinct {
+		if sel.aggFn == COUNT && !sel.distinct {
 			colDescriptors[encSel] = des
 			continue
 		}
 
-		if aggFn == COUNT && sel.distinct {
+		if sel.aggFn == COUNT && sel.distinct {
 			// COUNT(DISTINCT col) needs the column type for selector resolution
 			colDesc, ok := colDescriptors[EncodeSelector("", table, col)]
 			if !ok {
@@ -146,9 +157,30 @@ func (gr *groupedRowReader) colsBySelector(ctx context.Context) (map[string]ColD
 			return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, col)
 		}
 
-		des.Type = colDesc.Type
+		if sel.keySel != nil {
+			_, keyTable, keyCol := sel.keySel.resolve(gr.rowReader.TableAlias())
+			if _, ok := colDescriptors[EncodeSelector("", keyTable, keyCol)]; !ok {
+				return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, keyCol)
+			}
+		}
+
+		des.Type = sel.resultType(colDesc.Type)
 		colDescriptors[encSel] = des
 	}
+
+	if gr.groupingSets != nil {
+		for _, col := range gr.groupByCols {
+			_, table, c := col.resolve(gr.rowReader.TableAlias())
+
+			des := ColDescriptor{
+				AggFn:  groupingFn,
+				Table:  table,
+				Column: c,
+				Type:   IntegerType,
+			}
+			colDescriptors[des.Selector()] = des
+		}
+	}
 	return colDescriptors, nil
 }
 
@@ -168,6 +200,8 @@ func zeroForType(t SQLValueType) TypedValue {
 		return &Integer{}
 	case Float64Type:
 		return &Float64{}
+	case DecimalType:
+		return decimalFromInt64(0)
 	case BooleanType:
 		return &Bool{}
 	case VarcharType:
@@ -180,6 +214,14 @@ func zeroForType(t SQLValueType) TypedValue {
 		return &Blob{}
 	case TimestampType:
 		return &Timestamp{}
+	case DateType:
+		return &Date{}
+	case IntervalType:
+		return &Interval{}
+	}
+
+	if IsArrayType(t) {
+		return NewArray(ArrayElemType(t), nil)
 	}
 	return nil
 }
@@ -240,6 +282,13 @@ func (gr *groupedRowReader) Read(ctx context.Context) (*Row, error) {
 
 func updateRow(currRow, newRow *Row) error {
 	for _, v := range currRow.ValuesBySelector {
+		if rowAggV, ok := v.(rowAggregatedValue); ok {
+			if err := rowAggV.updateWithRow(newRow); err != nil {
+				return err
+			}
+			continue
+		}
+
 		aggV, isAggregatedValue := v.(AggregatedValue)
 
 		if isAggregatedValue {
@@ -304,10 +353,13 @@ func (gr *groupedRowReader) zeroRow(ctx context.Context) (*Row, error) {
 		encSel := EncodeSelector(aggFn, table, col)
 
 		var zero TypedValue
-		if aggFn == COUNT {
+		switch sel.aggFn {
+		case COUNT:
 			zero = zeroForType(IntegerType)
-		} else {
+		case SUM, MIN, MAX, AVG, STRING_AGG, ARRAY_AGG:
 			zero = zeroForType(colsBySelector[encSel].Type)
+		default:
+			zero = NewNull(colsBySelector[encSel].Type)
 		}
 
 		zeroRow.ValuesByPosition[i] = zero
@@ -319,9 +371,7 @@ func (gr *groupedRowReader) zeroRow(ctx context.Context) (*Row, error) {
 func (gr *groupedRowReader) initAggregations(row *Row) error {
 	// augment row with aggregated values
 	for _, sel := range gr.selectors {
-		aggFn, table, col := sel.resolve(gr.rowReader.TableAlias())
-
-		v, err := initAggValue(aggFn, table, col, sel.distinct, sel.separator)
+		v, err := gr.newAggValue(sel)
 		if err != nil {
 			return err
 		}
@@ -330,8 +380,7 @@ func (gr *groupedRowReader) initAggregations(row *Row) error {
 			continue
 		}
 
-		encSel := EncodeSelector(aggFn, table, col)
-		row.ValuesBySelector[encSel] = v
+		row.ValuesBySelector[EncodeSelector(sel.resolve(gr.rowReader.TableAlias()))] = v
 	}
 
 	for i, col := range gr.cols {
@@ -347,6 +396,90 @@ func (gr *groupedRowReader) initAggregations(row *Row) error {
 	return updateRow(row, row)
 }
 
+// newAggValue returns the initial aggregated value of sel, wrapped in a
+// qualifiedAggValue when DISTINCT or FILTER clauses are to be applied.
+func (gr *groupedRowReader) newAggValue(sel *AggColSelector) (TypedValue, error) {
+	tableAlias := gr.rowReader.TableAlias()
+
+	_, table, col := sel.resolve(tableAlias)
+	encSel := EncodeSelector("", table, col)
+
+	var keySel string
+	if sel.keySel != nil {
+		keySel = EncodeSelector(sel.keySel.resolve(tableAlias))
+	}
+
+	var v AggregatedValue
+
+	switch {
+	case isStatisticalAggregation(sel.aggFn):
+		v = &VarianceValue{aggFn: sel.aggFn, sel: encSel}
+	case isOrderedSetAggregation(sel.aggFn):
+		var fraction float64
+		if sel.fraction != nil {
+			f, err := gr.evalFraction(sel)
+			if err != nil {
+				return nil, err
+			}
+			fraction = f
+		}
+		v = &OrderedSetAggValue{aggFn: sel.aggFn, fraction: fraction, desc: sel.desc, sel: encSel}
+	case sel.aggFn == BOOL_AND || sel.aggFn == BOOL_OR:
+		v = &BoolAggValue{or: sel.aggFn == BOOL_OR, val: NewNull(BooleanType), sel: encSel}
+	case sel.aggFn == JSON_AGG || sel.aggFn == JSON_OBJECT_AGG:
+		v = &JSONAggValue{keySel: keySel, sel: encSel}
+	default:
+		tv, err := initAggValue(sel.aggFn, table, col, sel.distinct && sel.aggFn == COUNT, sel.separator)
+		if err != nil || tv == nil {
+			return nil, err
+		}
+		v = tv.(AggregatedValue)
+	}
+
+	if !(sel.distinct && sel.aggFn != COUNT) && sel.filter == nil {
+		return v, nil
+	}
+
+	qv := &qualifiedAggValue{
+		AggregatedValue: v,
+		distinct:        sel.distinct && sel.aggFn != COUNT,
+		keySel:          keySel,
+		tx:              gr.rowReader.Tx(),
+		tableAlias:      tableAlias,
+	}
+
+	if qv.distinct {
+		qv.seen = make(map[string]struct{})
+	}
+
+	if sel.filter != nil {
+		filter, err := sel.filter.substitute(gr.rowReader.Parameters())
+		if err != nil {
+			return nil, fmt.Errorf("%w: when evaluating FILTER clause", err)
+		}
+		qv.filter = filter
+	}
+	return qv, nil
+}
+
+func (gr *groupedRowReader) evalFraction(sel *AggColSelector) (float64, error) {
+	exp, err := sel.fraction.substitute(gr.rowReader.Parameters())
+	if err != nil {
+		return 0, err
+	}
+
+	v, err := exp.reduce(gr.rowReader.Tx(), nil, "")
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := numericFloat64(v)
+	if err != nil || f < 0 || f > 1 {
+		return 0, fmt.Errorf("%w: %s fraction %s is not between 0 and 1", ErrIllegalArguments, sel.aggFn, v)
+	}
+	return f, nil
+}
+
 func initAggValue(aggFn, table, col string, opts ...interface{}) (TypedValue, error) {
 	isDistinct := false
 	separator := ", "
@@ -404,6 +537,12 @@ func initAggValue(aggFn, table, col string, opts ...interface{}) (TypedValue, er
 				sel: EncodeSelector("", table, col),
 			}
 		}
+	case ARRAY_AGG:
+		{
+			v = &ArrayAggValue{
+				sel: EncodeSelector("", table, col),
+			}
+		}
 	}
 	return v, nil
 }
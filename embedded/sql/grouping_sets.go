@@ -0,0 +1,216 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxCubeColumns bounds CUBE, which expands into 2^n grouping sets.
+	maxCubeColumns = 12
+	// maxGroupingSets bounds the number of grouping sets a GROUP BY clause
+	// may expand into.
+	maxGroupingSets = 4096
+
+	// groupingFn tags the hidden GROUPING columns of grouped rows.
+	groupingFn = "GROUPING"
+)
+
+// groupByClause is the parsed GROUP BY clause. Each element expands into a
+// list of grouping sets: a plain column into a single one-column set,
+// ROLLUP/CUBE/GROUPING SETS into several. The grouping sets of the clause
+// are the concatenations of one set taken from each element.
+type groupByClause struct {
+	elems [][][]*ColSelector
+
+	// cols holds every grouped column, in order of first appearance.
+	cols []*ColSelector
+	// sets holds the expanded grouping sets, nil for a plain GROUP BY.
+	sets [][]*ColSelector
+}
+
+func (c *groupByClause) expand() error {
+	plain := true
+	for _, elem := range c.elems {
+		if len(elem) != 1 || len(elem[0]) != 1 {
+			plain = false
+			break
+		}
+	}
+
+	if plain {
+		for _, elem := range c.elems {
+			c.cols = append(c.cols, elem[0][0])
+		}
+		return nil
+	}
+
+	sets := [][]*ColSelector{{}}
+	for _, elem := range c.elems {
+		if len(sets)*len(elem) > maxGroupingSets {
+			return fmt.Errorf("%w: too many grouping sets (max %d)", ErrIllegalArguments, maxGroupingSets)
+		}
+
+		expanded := make([][]*ColSelector, 0, len(sets)*len(elem))
+		for _, prefix := range sets {
+			for _, set := range elem {
+				expanded = append(expanded, appendGroupingCols(prefix, set))
+			}
+		}
+		sets = expanded
+	}
+
+	c.sets = sets
+	for _, set := range sets {
+		c.cols = appendGroupingCols(c.cols, set)
+	}
+	return nil
+}
+
+// appendGroupingCols returns a new slice holding the columns of set followed
+// by the columns of cols not already in set.
+func appendGroupingCols(set, cols []*ColSelector) []*ColSelector {
+	res := make([]*ColSelector, len(set), len(set)+len(cols))
+	copy(res, set)
+
+	for _, col := range cols {
+		if !groupingSetContains(res, col, "") {
+			res = append(res, col)
+		}
+	}
+	return res
+}
+
+func groupingSetContains(set []*ColSelector, col *ColSelector, implicitTable string) bool {
+	sel := EncodeSelector(col.resolve(implicitTable))
+	for _, c := range set {
+		if EncodeSelector(c.resolve(implicitTable)) == sel {
+			return true
+		}
+	}
+	return false
+}
+
+// rollupSets expands ROLLUP(a, b, ...) into every prefix of its columns,
+// from the longest down to the empty set.
+func rollupSets(cols []*ColSelector) [][]*ColSelector {
+	sets := make([][]*ColSelector, 0, len(cols)+1)
+	for i := len(cols); i >= 0; i-- {
+		sets = append(sets, cols[:i])
+	}
+	return sets
+}
+
+// cubeSets expands CUBE(a, b, ...) into every subset of its columns.
+func cubeSets(cols []*ColSelector) ([][]*ColSelector, error) {
+	if len(cols) > maxCubeColumns {
+		return nil, fmt.Errorf("%w: CUBE is limited to %d columns", ErrIllegalArguments, maxCubeColumns)
+	}
+
+	n := 1 << len(cols)
+
+	sets := make([][]*ColSelector, 0, n)
+	for mask := n - 1; mask >= 0; mask-- {
+		set := make([]*ColSelector, 0, len(cols))
+		for i, col := range cols {
+			if mask&(1<<(len(cols)-1-i)) != 0 {
+				set = append(set, col)
+			}
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// groupingSelector is the selector of the hidden column through which the
+// grouped row reader reports whether col is aggregated away in the
+// grouping set a row belongs to.
+func groupingSelector(table, col string) string {
+	return EncodeSelector(groupingFn, table, col)
+}
+
+// GroupingExp implements GROUPING(a, b, ...): an integer bit mask in which
+// each argument, the first one being the most significant bit, is set when
+// that column is not part of the grouping set of the current row.
+type GroupingExp struct {
+	cols []*ColSelector
+}
+
+func (g *GroupingExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	for _, col := range g.cols {
+		if _, err := col.inferType(cols, params, implicitTable); err != nil {
+			return AnyType, err
+		}
+	}
+	return IntegerType, nil
+}
+
+func (g *GroupingExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntegerType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
+	}
+	return nil
+}
+
+func (g *GroupingExp) substitute(params map[string]interface{}) (ValueExp, error) {
+	return g, nil
+}
+
+func (g *GroupingExp) selectors() []Selector {
+	sels := make([]Selector, len(g.cols))
+	for i, col := range g.cols {
+		sels[i] = col
+	}
+	return sels
+}
+
+func (g *GroupingExp) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	var mask int64
+	for _, col := range g.cols {
+		mask <<= 1
+
+		_, table, c := col.resolve(implicitTable)
+
+		v, ok := row.ValuesBySelector[groupingSelector(table, c)]
+		if ok && !v.IsNull() && v.RawValue() == int64(1) {
+			mask |= 1
+		}
+	}
+	return NewInteger(mask), nil
+}
+
+func (g *GroupingExp) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return g
+}
+
+func (g *GroupingExp) isConstant() bool {
+	return false
+}
+
+func (g *GroupingExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (g *GroupingExp) String() string {
+	cols := make([]string, len(g.cols))
+	for i, col := range g.cols {
+		cols[i] = col.String()
+	}
+	return fmt.Sprintf("GROUPING(%s)", strings.Join(cols, ", "))
+}
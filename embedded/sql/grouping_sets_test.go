@@ -0,0 +1,195 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupingSetsStmts(t *testing.T) {
+	cols := func(stmt *SelectStmt) [][]string {
+		sets := make([][]string, len(stmt.groupingSets))
+		for i, set := range stmt.groupingSets {
+			sets[i] = make([]string, len(set))
+			for j, col := range set {
+				sets[i][j] = col.col
+			}
+		}
+		return sets
+	}
+
+	parse := func(t *testing.T, sql string) *SelectStmt {
+		stmts, err := ParseSQLString(sql)
+		require.NoError(t, err)
+		require.Len(t, stmts, 1)
+		return stmts[0].(*SelectStmt)
+	}
+
+	sel := parse(t, "SELECT a, b, COUNT(*) FROM t GROUP BY a, b")
+	require.Len(t, sel.groupBy, 2)
+	require.Nil(t, sel.groupingSets)
+
+	sel = parse(t, "SELECT a, b, COUNT(*) FROM t GROUP BY ROLLUP(a, b)")
+	require.Len(t, sel.groupBy, 2)
+	require.Equal(t, [][]string{{"a", "b"}, {"a"}, {}}, cols(sel))
+
+	sel = parse(t, "SELECT a, b, COUNT(*) FROM t GROUP BY CUBE(a, b)")
+	require.Equal(t, [][]string{{"a", "b"}, {"a"}, {"b"}, {}}, cols(sel))
+
+	sel = parse(t, "SELECT a, b, c, COUNT(*) FROM t GROUP BY GROUPING SETS ((a, b), c, ())")
+	require.Len(t, sel.groupBy, 3)
+	require.Equal(t, [][]string{{"a", "b"}, {"c"}, {}}, cols(sel))
+
+	sel = parse(t, "SELECT a, b, c, COUNT(*) FROM t GROUP BY c, ROLLUP(a, b)")
+	require.Equal(t, []string{"c", "a", "b"}, []string{sel.groupBy[0].col, sel.groupBy[1].col, sel.groupBy[2].col})
+	require.Equal(t, [][]string{{"c", "a", "b"}, {"c", "a"}, {"c"}}, cols(sel))
+
+	sel = parse(t, "SELECT GROUPING(a, b), COUNT(*) FROM t GROUP BY ROLLUP(a, b)")
+	require.Equal(t, "GROUPING(a, b)", sel.targets[0].Exp.String())
+
+	sel = parse(t, "SELECT rollup, cube, sets FROM t GROUP BY rollup, cube, sets")
+	require.Len(t, sel.groupBy, 3)
+	require.Nil(t, sel.groupingSets)
+
+	_, err := ParseSQLString("SELECT COUNT(*) FROM t GROUP BY CUBE(a, b, c, d, e, f, g, h, i, j, k, l, m)")
+	require.ErrorContains(t, err, "CUBE is limited to 12 columns")
+
+	_, err = ParseSQLString("SELECT COUNT(*) FROM t GROUP BY CUBE(a, b, c, d, e, f, g, h, i, j, k, l), CUBE(m, n)")
+	require.ErrorContains(t, err, "too many grouping sets")
+}
+
+func TestGroupingSets(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE sales (id INTEGER, region VARCHAR, product VARCHAR, amount INTEGER, PRIMARY KEY id);
+		CREATE TABLE empty_sales (id INTEGER, region VARCHAR, amount INTEGER, PRIMARY KEY id);
+		INSERT INTO sales (id, region, product, amount) VALUES
+			(1, 'east', 'x', 10), (2, 'east', 'y', 20), (3, 'east', 'x', 5), (4, 'west', 'x', 7), (5, 'west', 'y', 3);
+	`, nil)
+	require.NoError(t, err)
+
+	query := func(t *testing.T, sql string) [][]interface{} {
+		return queryValues(t, engine, nil, sql, nil)
+	}
+
+	t.Run("rollup", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"east", "x", int64(15), int64(0)},
+				{"east", "y", int64(20), int64(0)},
+				{"west", "x", int64(7), int64(0)},
+				{"west", "y", int64(3), int64(0)},
+				{"east", nil, int64(35), int64(1)},
+				{"west", nil, int64(10), int64(1)},
+				{nil, nil, int64(45), int64(3)},
+			},
+			query(t, "SELECT region, product, SUM(amount), GROUPING(region, product) FROM sales GROUP BY ROLLUP(region, product)"),
+		)
+	})
+
+	t.Run("cube", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"east", int64(3), int64(0), int64(0)},
+				{"west", int64(2), int64(0), int64(0)},
+				{nil, int64(5), int64(1), int64(1)},
+			},
+			query(t, "SELECT region, COUNT(*), GROUPING(region), GROUPING(region) FROM sales GROUP BY CUBE(region)"),
+		)
+
+		rows := query(t, "SELECT region, product, COUNT(*) FROM sales GROUP BY CUBE(region, product)")
+		require.Len(t, rows, 4+2+2+1)
+		require.Equal(t, []interface{}{nil, "x", int64(3)}, rows[6])
+		require.Equal(t, []interface{}{nil, "y", int64(2)}, rows[7])
+	})
+
+	t.Run("grouping sets", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"east", nil, int64(35)},
+				{"west", nil, int64(10)},
+				{nil, "x", int64(22)},
+				{nil, "y", int64(23)},
+			},
+			query(t, "SELECT region, product, SUM(amount) FROM sales GROUP BY GROUPING SETS (region, product)"),
+		)
+
+		require.Equal(t,
+			[][]interface{}{
+				{"east", int64(3)},
+				{"east", int64(3)},
+				{"west", int64(2)},
+				{"west", int64(2)},
+			},
+			query(t, "SELECT region, COUNT(*) FROM sales GROUP BY GROUPING SETS (region, region) ORDER BY region"),
+		)
+	})
+
+	t.Run("having and order by", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"west", int64(10)},
+				{"east", int64(35)},
+				{nil, int64(45)},
+			},
+			query(t, `
+				SELECT region, SUM(amount) AS total
+				FROM sales
+				GROUP BY ROLLUP(region)
+				HAVING GROUPING(region) = 1 OR SUM(amount) > 0
+				ORDER BY total`),
+		)
+
+		require.Equal(t,
+			[][]interface{}{
+				{"east", int64(35)},
+				{"west", int64(10)},
+			},
+			query(t, `
+				SELECT region, SUM(amount)
+				FROM sales
+				GROUP BY ROLLUP(region, product)
+				HAVING GROUPING(region, product) = 1
+				ORDER BY region`),
+		)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{nil, int64(0), int64(1)},
+			},
+			query(t, "SELECT region, COUNT(*), GROUPING(region) FROM empty_sales GROUP BY ROLLUP(region)"),
+		)
+
+		require.Empty(t, query(t, "SELECT region, COUNT(*) FROM empty_sales GROUP BY GROUPING SETS ((region))"))
+	})
+
+	t.Run("plain group by", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{
+				{"east", int64(0)},
+				{"west", int64(0)},
+			},
+			query(t, "SELECT region, GROUPING(region) FROM sales GROUP BY region"),
+		)
+	})
+
+	t.Run("ungrouped column", func(t *testing.T) {
+		_, err := engine.queryAll(ctx, nil, "SELECT region, product, COUNT(*) FROM sales GROUP BY ROLLUP(region)", nil)
+		require.ErrorIs(t, err, ErrColumnMustAppearInGroupByOrAggregation)
+
+		_, err = engine.queryAll(ctx, nil, "SELECT GROUPING(product), COUNT(*) FROM sales GROUP BY ROLLUP(region)", nil)
+		require.ErrorIs(t, err, ErrColumnMustAppearInGroupByOrAggregation)
+	})
+}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package sql
 
-import "context"
+import (
+	"context"
+	"encoding/binary"
+)
 
 // hashGroupedRowReader implements GROUP BY aggregation using a hash map
 // rather than requiring sorted input.  It reads all inner rows in a single
@@ -26,6 +29,10 @@ import "context"
 // Used when the scan index does not provide GROUP BY order (i.e.
 // scanSpecs.groupBySortExps is non-empty), replacing the sort+groupedRowReader
 // pipeline with a single hash-aggregate phase.
+//
+// It also evaluates GROUP BY ROLLUP, CUBE and GROUPING SETS in that same
+// pass: every inner row updates one group per grouping set, and groups are
+// emitted grouping set by grouping set.
 type hashGroupedRowReader struct {
 	// Embeds groupedRowReader to reuse column-descriptor setup,
 	// aggregation init/update helpers, and all RowReader interface methods.
@@ -42,11 +49,21 @@ func newHashGroupedRowReader(
 	allAggregations bool,
 	selectors []*AggColSelector,
 	groupBy []*ColSelector,
+	groupingSets [][]*ColSelector,
 ) (*hashGroupedRowReader, error) {
 	gr, err := newGroupedRowReader(rowReader, allAggregations, selectors, groupBy)
 	if err != nil {
 		return nil, err
 	}
+
+	if groupingSets != nil {
+		gr.groupingSets = groupingSets
+
+		gr.cols, err = gr.columns()
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &hashGroupedRowReader{groupedRowReader: gr}, nil
 }
 
@@ -54,10 +71,10 @@ func newHashGroupedRowReader(
 // used as the hash-map key.  NULL values are distinguished from non-NULL by a
 // leading 0/1 byte; variable-length types carry a length prefix inside
 // EncodeValue, making the concatenation collision-free.
-func (h *hashGroupedRowReader) groupKey(row *Row) (string, error) {
+func (h *hashGroupedRowReader) groupKey(row *Row, groupByCols []*ColSelector) (string, error) {
 	tableAlias := h.rowReader.TableAlias()
 	var key []byte
-	for _, col := range h.groupByCols {
+	for _, col := range groupByCols {
 		encSel := EncodeSelector(col.resolve(tableAlias))
 		val := row.ValuesBySelector[encSel]
 		if val == nil || val.IsNull() {
@@ -76,6 +93,10 @@ func (h *hashGroupedRowReader) groupKey(row *Row) (string, error) {
 
 // buildGroups performs one full scan of the inner reader and populates h.groups.
 func (h *hashGroupedRowReader) buildGroups(ctx context.Context) error {
+	if h.groupingSets != nil {
+		return h.buildGroupingSets(ctx)
+	}
+
 	order := make([]string, 0)
 	groups := make(map[string]*Row)
 
@@ -88,7 +109,7 @@ func (h *hashGroupedRowReader) buildGroups(ctx context.Context) error {
 			return err
 		}
 
-		key, err := h.groupKey(row)
+		key, err := h.groupKey(row, h.groupByCols)
 		if err != nil {
 			return err
 		}
@@ -126,6 +147,120 @@ func (h *hashGroupedRowReader) buildGroups(ctx context.Context) error {
 	return nil
 }
 
+// buildGroupingSets is buildGroups for ROLLUP, CUBE and GROUPING SETS. Group
+// keys are prefixed with the index of their grouping set, so that equal
+// sets listed twice still produce their own groups.
+func (h *hashGroupedRowReader) buildGroupingSets(ctx context.Context) error {
+	order := make([][]string, len(h.groupingSets))
+	groups := make(map[string]*Row)
+
+	for {
+		row, err := h.rowReader.Read(ctx)
+		if err == ErrNoMoreRows {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		for i, set := range h.groupingSets {
+			setKey, err := h.groupKey(row, set)
+			if err != nil {
+				return err
+			}
+			key := string(binary.AppendUvarint(nil, uint64(i))) + setKey
+
+			if existing, ok := groups[key]; ok {
+				if err := updateRow(existing, row); err != nil {
+					return err
+				}
+				continue
+			}
+
+			groupRow := h.groupingRow(row, set)
+			if err := h.initAggregations(groupRow); err != nil {
+				return err
+			}
+			groups[key] = groupRow
+			order[i] = append(order[i], key)
+		}
+	}
+
+	h.groups = make([]*Row, 0, len(groups))
+	for i, set := range h.groupingSets {
+		// Like a query without GROUP BY, the empty grouping set yields a
+		// grand total row even when there are no rows to aggregate.
+		if len(order[i]) == 0 && len(set) == 0 {
+			zr, err := h.zeroGroupingRow(ctx)
+			if err != nil {
+				return err
+			}
+			h.groups = append(h.groups, zr)
+			continue
+		}
+
+		for _, key := range order[i] {
+			h.groups = append(h.groups, groups[key])
+		}
+	}
+	return nil
+}
+
+// groupingRow returns a copy of row to be used as the first row of a group
+// of the grouping set: grouped columns outside the set are set to NULL, and
+// the hidden GROUPING column of each grouped column records whether it was
+// aggregated away.
+func (h *hashGroupedRowReader) groupingRow(row *Row, set []*ColSelector) *Row {
+	tableAlias := h.rowReader.TableAlias()
+
+	groupRow := &Row{
+		ValuesBySelector: make(map[string]TypedValue, len(row.ValuesBySelector)+len(h.groupByCols)),
+	}
+	for sel, v := range row.ValuesBySelector {
+		groupRow.ValuesBySelector[sel] = v
+	}
+
+	for _, col := range h.groupByCols {
+		_, table, c := col.resolve(tableAlias)
+
+		if groupingSetContains(set, col, tableAlias) {
+			groupRow.ValuesBySelector[groupingSelector(table, c)] = NewInteger(0)
+			continue
+		}
+
+		encSel := EncodeSelector("", table, c)
+
+		t := AnyType
+		if v, ok := row.ValuesBySelector[encSel]; ok {
+			t = v.Type()
+		}
+
+		groupRow.ValuesBySelector[encSel] = NewNull(t)
+		groupRow.ValuesBySelector[groupingSelector(table, c)] = NewInteger(1)
+	}
+	return groupRow
+}
+
+// zeroGroupingRow returns the grand total row of the empty grouping set over
+// no rows: zero-valued aggregations with every grouped column set to NULL.
+func (h *hashGroupedRowReader) zeroGroupingRow(ctx context.Context) (*Row, error) {
+	zr, err := h.zeroRow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, col := range h.cols[len(zr.ValuesByPosition):] {
+		var v TypedValue = NewNull(col.Type)
+		if col.AggFn == groupingFn {
+			v = NewInteger(1)
+		}
+
+		zr.ValuesByPosition = append(zr.ValuesByPosition, v)
+		zr.ValuesBySelector[col.Selector()] = v
+	}
+	return zr, nil
+}
+
 // Read overrides groupedRowReader.Read.  On the first call it builds the
 // complete hash-aggregate result; subsequent calls stream the groups.
 func (h *hashGroupedRowReader) Read(ctx context.Context) (*Row, error) {
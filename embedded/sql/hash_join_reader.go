@@ -72,7 +72,7 @@ func buildJoinHashTable(
 	innerWhere ValueExp,
 	params map[string]interface{},
 ) (*hashJoinTable, error) {
-	fullScan := &SelectStmt{ds: ds, where: innerWhere}
+	fullScan := &SelectStmt{ds: ds, where: innerWhere, privilegesChecked: true, joinedScan: true}
 	reader, err := fullScan.Resolve(ctx, tx, params, nil)
 	if err != nil {
 		return nil, err
@@ -243,6 +243,31 @@ func extractEquiJoinPlanRec(
 	return false
 }
 
+// isEquiJoinCond reports whether extractEquiJoinPlan will accept cond once
+// the columns of the outer tables are reduced to values. Unqualified columns
+// are resolved against the outer table.
+func isEquiJoinCond(cond ValueExp, innerAlias string) bool {
+	pairs := 0
+
+	for _, c := range splitAndConjuncts(cond) {
+		if cmp, ok := c.(*CmpBoolExp); ok && cmp.op == EQ {
+			left, lok := cmp.left.(*ColSelector)
+			right, rok := cmp.right.(*ColSelector)
+
+			if lok && rok && (left.table == innerAlias) != (right.table == innerAlias) {
+				pairs++
+				continue
+			}
+		}
+
+		tables, hasUnqualified, safe := collectColTables(c)
+		if _, ok := tables[innerAlias]; !safe || hasUnqualified || !ok || len(tables) != 1 {
+			return false
+		}
+	}
+	return pairs > 0
+}
+
 // extractSingleEquiPair extracts a single (outerVal, innerSel) pair from a
 // CmpBoolExp with op == EQ where exactly one side is a concrete TypedValue
 // (the reduced outer column) and the other is a *ColSelector (inner column).
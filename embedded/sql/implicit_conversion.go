@@ -29,6 +29,10 @@ func mayApplyImplicitConversion(val interface{}, requiredColumnType SQLValueType
 		return nil, nil
 	}
 
+	if IsArrayType(requiredColumnType) {
+		return mayApplyImplicitArrayConversion(val, requiredColumnType)
+	}
+
 	var converter converterFunc
 	var typedVal TypedValue
 	var err error
@@ -58,6 +62,46 @@ func mayApplyImplicitConversion(val interface{}, requiredColumnType SQLValueType
 				return nil, err
 			}
 
+			typedVal = &Varchar{val: value}
+		case *Decimal:
+			converter, err = getConverter(DecimalType, Float64Type)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = value
+		}
+	case DecimalType:
+		switch value := val.(type) {
+		case *Decimal:
+			return val, nil
+		case int:
+			converter, err = getConverter(IntegerType, DecimalType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = &Integer{val: int64(value)}
+		case int64:
+			converter, err = getConverter(IntegerType, DecimalType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = &Integer{val: value}
+		case float64:
+			converter, err = getConverter(Float64Type, DecimalType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = &Float64{val: value}
+		case string:
+			converter, err = getConverter(VarcharType, DecimalType)
+			if err != nil {
+				return nil, err
+			}
+
 			typedVal = &Varchar{val: value}
 		}
 	case IntegerType:
@@ -71,6 +115,13 @@ func mayApplyImplicitConversion(val interface{}, requiredColumnType SQLValueType
 			}
 
 			typedVal = &Float64{val: value}
+		case *Decimal:
+			converter, err = getConverter(DecimalType, IntegerType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = value
 		case string:
 			converter, err = getConverter(VarcharType, IntegerType)
 			if err != nil {
@@ -108,6 +159,32 @@ func mayApplyImplicitConversion(val interface{}, requiredColumnType SQLValueType
 				return nil, err
 			}
 
+			typedVal = &Varchar{val: value}
+		}
+	case DateType:
+		switch value := val.(type) {
+		case time.Time:
+			return truncateToDate(value), nil
+		case string:
+			converter, err = getConverter(VarcharType, DateType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = &Varchar{val: value}
+		}
+	case IntervalType:
+		switch value := val.(type) {
+		case *Interval:
+			return val, nil
+		case time.Duration:
+			return intervalFromDuration(value), nil
+		case string:
+			converter, err = getConverter(VarcharType, IntervalType)
+			if err != nil {
+				return nil, err
+			}
+
 			typedVal = &Varchar{val: value}
 		}
 	case BooleanType:
@@ -150,6 +227,13 @@ func mayApplyImplicitConversion(val interface{}, requiredColumnType SQLValueType
 			}
 
 			typedVal = &Float64{val: value}
+		case *Decimal:
+			converter, err = getConverter(DecimalType, VarcharType)
+			if err != nil {
+				return nil, err
+			}
+
+			typedVal = value
 		case bool:
 			converter, err = getConverter(BooleanType, VarcharType)
 			if err != nil {
@@ -0,0 +1,455 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Indexes may be defined over expressions of the columns of a table, such as
+// lower(email) or data->>'field'. Each distinct expression is a column of the
+// table which is not stored in its rows: its value is computed from the
+// values of the other columns whenever index entries are written, and it's
+// named after the SQL text of the expression, which is how the planner
+// matches the expressions used in WHERE and ORDER BY clauses.
+
+const (
+	// ids of expression columns are taken above the ids of regular columns
+	indexExpColIDBase uint32 = 1 << 31
+
+	// MaxIndexExpLen is the max length of the values of variable sized types
+	// computed by index expressions
+	MaxIndexExpLen = 256
+)
+
+// indexElems splits the elements of an index into the names of its columns,
+// expressions being named after their SQL text, and its expressions. No
+// expressions are returned when the index is only made of columns.
+func indexElems(elems []ValueExp) (cols []string, exps []ValueExp) {
+	cols = make([]string, len(elems))
+
+	for i, elem := range elems {
+		if sel, ok := elem.(*ColSelector); ok && sel.table == "" {
+			cols[i] = sel.col
+			continue
+		}
+
+		if exps == nil {
+			exps = make([]ValueExp, len(elems))
+		}
+
+		cols[i] = elem.String()
+		exps[i] = elem
+	}
+	return cols, exps
+}
+
+func (c *Column) isIndexExp() bool {
+	return c.exp != nil
+}
+
+// refersTo reports whether exp is the column or, for expression columns, an
+// expression with the same SQL text.
+func (c *Column) refersTo(exp ValueExp, implicitTable string) bool {
+	if c.isIndexExp() {
+		return c.colName == exp.String()
+	}
+
+	sel, ok := exp.(Selector)
+	if !ok {
+		return false
+	}
+
+	aggFn, _, colName := sel.resolve(implicitTable)
+	return aggFn == "" && colName == c.colName
+}
+
+// indexExpColumn returns the column computed by exp, which is created when no
+// index of the table uses the same expression.
+func (t *Table) indexExpColumn(exp ValueExp) (*Column, error) {
+	name := exp.String()
+
+	id := indexExpColIDBase
+
+	for colID, col := range t.expCols {
+		if col.colName == name {
+			return col, nil
+		}
+		if colID >= id {
+			id = colID + 1
+		}
+	}
+	return t.newIndexExpColumn(id, exp)
+}
+
+func (t *Table) newIndexExpColumn(id uint32, exp ValueExp) (*Column, error) {
+	selectors := exp.selectors()
+	if len(selectors) == 0 {
+		return nil, fmt.Errorf("%w: %s does not use any column", ErrInvalidIndexExpression, exp)
+	}
+
+	for _, sel := range selectors {
+		var colSel *ColSelector
+
+		switch s := sel.(type) {
+		case *ColSelector:
+			colSel = s
+		case *JSONSelector:
+			colSel = s.ColSelector
+		default:
+			return nil, fmt.Errorf("%w: %s is not a column", ErrInvalidIndexExpression, sel)
+		}
+
+		if colSel.table != "" && colSel.table != t.name {
+			return nil, fmt.Errorf("%w: %s is not a column of table %s", ErrInvalidIndexExpression, sel, t.name)
+		}
+
+		col, err := t.GetColumnByName(colSel.col)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidIndexExpression, err)
+		}
+
+		if col.virtual {
+			return nil, fmt.Errorf("%w (%s)", ErrCannotIndexVirtualColumn, col.colName)
+		}
+	}
+
+	cols := make(map[string]ColDescriptor, len(t.cols))
+	for _, col := range t.cols {
+		desc := ColDescriptor{Table: t.name, Column: col.colName, Type: col.colType}
+		cols[desc.Selector()] = desc
+	}
+
+	params := make(map[string]SQLValueType)
+
+	colType, err := exp.inferType(cols, params, t.name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIndexExpression, err)
+	}
+
+	if len(params) > 0 {
+		return nil, fmt.Errorf("%w: parameters are not allowed", ErrInvalidIndexExpression)
+	}
+
+	switch {
+	case colType == JSONType:
+		return nil, fmt.Errorf("%w (%s)", ErrCannotIndexJson, exp)
+	case IsArrayType(colType):
+		return nil, fmt.Errorf("%w (%s)", ErrCannotIndexArray, exp)
+	case colType == IntervalType:
+		return nil, fmt.Errorf("%w (%s)", ErrCannotIndexInterval, exp)
+	case colType == AnyType:
+		return nil, fmt.Errorf("%w: the type of %s can not be inferred", ErrInvalidIndexExpression, exp)
+	}
+
+	col := &Column{
+		table:   t,
+		id:      id,
+		colName: exp.String(),
+		colType: colType,
+		maxLen:  MaxIndexExpLen,
+		exp:     exp,
+	}
+
+	if t.expCols == nil {
+		t.expCols = make(map[uint32]*Column)
+	}
+	t.expCols[id] = col
+
+	return col, nil
+}
+
+// indexColumnByName returns the column or the expression column with the
+// given name.
+func (t *Table) indexColumnByName(name string) (*Column, error) {
+	if col, exists := t.colsByName[name]; exists {
+		return col, nil
+	}
+
+	for _, col := range t.expCols {
+		if col.colName == name {
+			return col, nil
+		}
+	}
+	return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, name)
+}
+
+func (t *Table) indexColumnByID(id uint32) (*Column, error) {
+	if col, exists := t.expCols[id]; exists {
+		return col, nil
+	}
+	return t.GetColumnByID(id)
+}
+
+// indexExpsUsing returns the expression columns whose expressions use the
+// column with the given name.
+func (t *Table) indexExpsUsing(colName string) []*Column {
+	var cols []*Column
+
+	for _, col := range t.expCols {
+		for _, sel := range col.exp.selectors() {
+			_, _, name := sel.resolve(t.name)
+			if jsonSel, ok := sel.(*JSONSelector); ok {
+				name = jsonSel.ColSelector.col
+			}
+
+			if name == colName {
+				cols = append(cols, col)
+				break
+			}
+		}
+	}
+	return cols
+}
+
+// withIndexExpValues returns the values of the columns of a row together
+// with the values of the expression columns of the table.
+func (t *Table) withIndexExpValues(valuesByColID map[uint32]TypedValue) (map[uint32]TypedValue, error) {
+	if len(t.expCols) == 0 {
+		return valuesByColID, nil
+	}
+
+	row, err := t.valuesRow(valuesByColID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[uint32]TypedValue, len(valuesByColID)+len(t.expCols))
+	for colID, val := range valuesByColID {
+		values[colID] = val
+	}
+
+	for colID, col := range t.expCols {
+		val, err := col.exp.reduce(nil, row, t.name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: when evaluating index expression %s", err, col.colName)
+		}
+		values[colID] = val
+	}
+	return values, nil
+}
+
+// valuesRow returns the row of the table holding the given column values,
+// so that expressions over the columns of the table can be evaluated.
+func (t *Table) valuesRow(valuesByColID map[uint32]TypedValue) (*Row, error) {
+	row := &Row{ValuesBySelector: make(map[string]TypedValue, len(t.cols))}
+
+	for _, col := range t.cols {
+		val, ok := valuesByColID[col.id]
+		if !ok {
+			val = &NullValue{t: col.colType}
+		}
+
+		// values being written are converted to the type of the column
+		// only when encoded, e.g. JSON documents given as strings
+		if !val.IsNull() && val.Type() != col.colType {
+			conv, err := getConverter(val.Type(), col.colType)
+			if err != nil {
+				return nil, err
+			}
+
+			val, err = conv(val)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		row.ValuesBySelector[EncodeSelector("", t.name, col.colName)] = val
+	}
+	return row, nil
+}
+
+// indexExpRanges adds the ranges given by the comparison of an expression
+// column with a constant.
+func (t *Table) indexExpRanges(bexp *CmpBoolExp, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	if len(t.expCols) == 0 {
+		return nil
+	}
+
+	exp, c, op := bexp.left, bexp.right, bexp.op
+	if exp.isConstant() {
+		exp, c, op = bexp.right, bexp.left, mirroredCmpOperator(bexp.op)
+	}
+
+	if exp.isConstant() || !c.isConstant() {
+		return nil
+	}
+
+	for _, sel := range exp.selectors() {
+		aggFn, table, _ := sel.resolve(asTable)
+		if aggFn != "" || table != asTable {
+			return nil
+		}
+	}
+
+	for _, col := range t.expCols {
+		if !col.refersTo(exp, asTable) {
+			continue
+		}
+
+		val, err := c.substitute(params)
+		if err != nil {
+			// left to the evaluation of the WHERE clause
+			return nil
+		}
+
+		rval, err := val.reduce(nil, nil, t.name)
+		if err != nil {
+			return err
+		}
+
+		if rval.IsNull() || (IsDateTimeType(rval.Type()) && !IsDateTimeType(col.colType)) {
+			return nil
+		}
+		return updateRangeFor(col.id, rval, op, rangesByColID)
+	}
+	return nil
+}
+
+func mirroredCmpOperator(op CmpOperator) CmpOperator {
+	switch op {
+	case LT:
+		return GT
+	case LE:
+		return GE
+	case GT:
+		return LT
+	case GE:
+		return LE
+	}
+	return op
+}
+
+func persistIndexExpColumn(tx *SQLTx, table *Table, col *Column) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogIndexExpPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		EncodeID(col.id),
+	)
+	return tx.set(mappedKey, nil, []byte(col.exp.String()))
+}
+
+func (t *Table) indexUsing(colID uint32) bool {
+	for _, index := range t.indexes {
+		if index.IncludesCol(colID) {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteUnusedIndexExps deletes the expression columns no longer used by
+// any index of the table.
+func (tx *SQLTx) deleteUnusedIndexExps(ctx context.Context, table *Table) error {
+	for colID := range table.expCols {
+		if table.indexUsing(colID) {
+			continue
+		}
+
+		mappedKey := MapKey(
+			tx.sqlPrefix(),
+			catalogIndexExpPrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+			EncodeID(colID),
+		)
+		if err := tx.delete(ctx, mappedKey); err != nil {
+			return err
+		}
+
+		delete(table.expCols, colID)
+	}
+	return nil
+}
+
+func loadIndexExpColumns(ctx context.Context, dbID uint32, table *Table, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) error {
+	prefix := MapKey(sqlPrefix, catalogIndexExpPrefix, EncodeID(dbID), EncodeID(table.id))
+
+	return iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(key) != len(prefix)+EncIDLen {
+			return ErrCorruptedData
+		}
+
+		exp, err := ParseExpFromString(string(value))
+		if err != nil {
+			return err
+		}
+
+		_, err = table.newIndexExpColumn(binary.BigEndian.Uint32(key[len(prefix):]), exp)
+		if err != nil {
+			return err
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+}
+
+// validateIndexExps checks the values computed by the expression columns for
+// the rows already in the table can be indexed, as they are computed again
+// when the new index is built.
+func (tx *SQLTx) validateIndexExps(ctx context.Context, table *Table, cols []*Column) error {
+	r, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if errors.Is(err, store.ErrIndexNotFound) {
+		// the table is being created by the transaction
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+		for _, col := range table.cols {
+			valuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+		}
+
+		valuesByColID, err = table.withIndexExpValues(valuesByColID)
+		if err != nil {
+			return err
+		}
+
+		for _, col := range cols {
+			_, _, err := EncodeValueAsKey(valuesByColID[col.id], col.colType, col.MaxLen())
+			if err != nil {
+				return fmt.Errorf("%w: index expression %s", err, col.colName)
+			}
+		}
+	}
+}
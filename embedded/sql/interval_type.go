@@ -0,0 +1,529 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// intervalDaysPerMonth is the length of a month used when intervals
+	// are compared or fractional months are turned into days, as done
+	// by PostgreSQL.
+	intervalDaysPerMonth = 30
+
+	microsPerSecond = int64(time.Second / time.Microsecond)
+	microsPerDay    = 24 * 60 * 60 * microsPerSecond
+
+	// Row encoding: {months(4)}{days(4)}{microseconds(8)}
+	intervalEncLen = 16
+)
+
+// Interval is a span of time kept as separate month, day and
+// microsecond parts. Months and days are applied following the
+// calendar, so adding one month to January 31 yields the last day of
+// February and adding one day crosses a day regardless of its length.
+type Interval struct {
+	months int32
+	days   int32
+	micros int64
+}
+
+func NewInterval(months, days int32, micros int64) *Interval {
+	return &Interval{months: months, days: days, micros: micros}
+}
+
+func intervalFromDuration(d time.Duration) *Interval {
+	return &Interval{micros: d.Microseconds()}
+}
+
+// intervalFromParts builds an interval from possibly fractional parts,
+// cascading the fraction of a month into days and the fraction of a
+// day into microseconds.
+func intervalFromParts(months, days, micros float64) (*Interval, error) {
+	m := math.Trunc(months)
+	days += (months - m) * intervalDaysPerMonth
+
+	d := math.Trunc(days)
+	micros = math.Round(micros + (days-d)*float64(microsPerDay))
+
+	if m < math.MinInt32 || m > math.MaxInt32 ||
+		d < math.MinInt32 || d > math.MaxInt32 ||
+		micros < math.MinInt64 || micros >= math.MaxInt64 ||
+		math.IsNaN(micros) {
+		return nil, fmt.Errorf("%w: interval out of range", ErrNumericValueOutOfRange)
+	}
+
+	return &Interval{months: int32(m), days: int32(d), micros: int64(micros)}, nil
+}
+
+// Months returns the month part of the interval.
+func (v *Interval) Months() int32 {
+	return v.months
+}
+
+// Days returns the day part of the interval.
+func (v *Interval) Days() int32 {
+	return v.days
+}
+
+// Microseconds returns the time part of the interval.
+func (v *Interval) Microseconds() int64 {
+	return v.micros
+}
+
+func (v *Interval) Type() SQLValueType {
+	return IntervalType
+}
+
+func (v *Interval) IsNull() bool {
+	return false
+}
+
+// String returns the interval in the default output style of
+// PostgreSQL e.g. 1 year 2 mons 3 days 04:05:06.5
+func (v *Interval) String() string {
+	var parts []string
+
+	if years := v.months / 12; years != 0 {
+		parts = append(parts, intervalPart(int64(years), "year"))
+	}
+	if months := v.months % 12; months != 0 {
+		parts = append(parts, intervalPart(int64(months), "mon"))
+	}
+	if v.days != 0 {
+		parts = append(parts, intervalPart(int64(v.days), "day"))
+	}
+	if v.micros != 0 || len(parts) == 0 {
+		parts = append(parts, formatIntervalTime(v.micros))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func intervalPart(n int64, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return strconv.FormatInt(n, 10) + " " + unit + "s"
+}
+
+func formatIntervalTime(micros int64) string {
+	var sb strings.Builder
+
+	abs := uint64(micros)
+	if micros < 0 {
+		sb.WriteByte('-')
+		abs = uint64(-micros)
+	}
+
+	secs := abs / uint64(microsPerSecond)
+	fmt.Fprintf(&sb, "%02d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+
+	if frac := abs % uint64(microsPerSecond); frac != 0 {
+		sb.WriteString(strings.TrimRight(fmt.Sprintf(".%06d", frac), "0"))
+	}
+
+	return sb.String()
+}
+
+// MarshalJSON renders the value as a JSON string.
+func (v *Interval) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(v.String())), nil
+}
+
+func (v *Interval) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return IntervalType, nil
+}
+
+func (v *Interval) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if t != IntervalType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntervalType, t)
+	}
+	return nil
+}
+
+func (v *Interval) selectors() []Selector {
+	return nil
+}
+
+func (v *Interval) substitute(params map[string]interface{}) (ValueExp, error) {
+	return v, nil
+}
+
+func (v *Interval) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
+	return v, nil
+}
+
+func (v *Interval) reduceSelectors(row *Row, implicitTable string) ValueExp {
+	return v
+}
+
+func (v *Interval) isConstant() bool {
+	return true
+}
+
+func (v *Interval) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
+	return nil
+}
+
+func (v *Interval) RawValue() interface{} {
+	return v
+}
+
+func (v *Interval) Compare(val TypedValue) (int, error) {
+	if val.IsNull() {
+		return 1, nil
+	}
+
+	convVal, err := mayApplyImplicitConversion(val.RawValue(), IntervalType)
+	if err != nil {
+		return 0, err
+	}
+
+	rval, ok := convVal.(*Interval)
+	if !ok {
+		return 0, ErrNotComparableValues
+	}
+
+	return v.cmp(rval), nil
+}
+
+// cmp compares intervals by their length, counting a month as 30 days
+// and a day as 24 hours, so that '1 mon' and '30 days' are equal.
+func (v *Interval) cmp(i *Interval) int {
+	ld, lus := v.span()
+	rd, rus := i.span()
+
+	switch {
+	case ld < rd:
+		return -1
+	case ld > rd:
+		return 1
+	case lus < rus:
+		return -1
+	case lus > rus:
+		return 1
+	}
+	return 0
+}
+
+// span returns the length of the interval as whole days plus the
+// remaining, non negative, microseconds.
+func (v *Interval) span() (days int64, micros int64) {
+	days = int64(v.months)*intervalDaysPerMonth + int64(v.days) + v.micros/microsPerDay
+	micros = v.micros % microsPerDay
+
+	if micros < 0 {
+		days--
+		micros += microsPerDay
+	}
+	return days, micros
+}
+
+func (v *Interval) negate() *Interval {
+	return &Interval{months: -v.months, days: -v.days, micros: -v.micros}
+}
+
+func (v *Interval) add(i *Interval) (*Interval, error) {
+	months := int64(v.months) + int64(i.months)
+	days := int64(v.days) + int64(i.days)
+	micros := v.micros + i.micros
+
+	if months < math.MinInt32 || months > math.MaxInt32 ||
+		days < math.MinInt32 || days > math.MaxInt32 ||
+		(i.micros > 0 && micros < v.micros) || (i.micros < 0 && micros > v.micros) {
+		return nil, fmt.Errorf("%w: interval out of range", ErrNumericValueOutOfRange)
+	}
+
+	return &Interval{months: int32(months), days: int32(days), micros: micros}, nil
+}
+
+func (v *Interval) mul(f float64) (*Interval, error) {
+	return intervalFromParts(float64(v.months)*f, float64(v.days)*f, float64(v.micros)*f)
+}
+
+// addTo returns t moved forward by the interval: months first, keeping
+// the day of month within the resulting month, then days and finally
+// the time part.
+func (v *Interval) addTo(t time.Time) time.Time {
+	if v.months != 0 {
+		year, month, day := t.Date()
+
+		m := int(month) - 1 + int(v.months)
+		year += m / 12
+		m %= 12
+		if m < 0 {
+			m += 12
+			year--
+		}
+
+		if last := daysIn(time.Month(m+1), year); day > last {
+			day = last
+		}
+
+		t = time.Date(year, time.Month(m+1), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+
+	days := int64(v.days) + v.micros/microsPerDay
+
+	return t.AddDate(0, 0, int(days)).Add(time.Duration(v.micros%microsPerDay) * time.Microsecond)
+}
+
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// intervalBetween returns the interval elapsed from t2 to t1, as days
+// and time of day.
+func intervalBetween(t1, t2 time.Time) *Interval {
+	micros := TimeToInt64(t1) - TimeToInt64(t2)
+	return &Interval{days: int32(micros / microsPerDay), micros: micros % microsPerDay}
+}
+
+// ageBetween returns the symbolic interval from t2 to t1, using years,
+// months and days, as done by age().
+func ageBetween(t1, t2 time.Time) *Interval {
+	if t1.Before(t2) {
+		return ageBetween(t2, t1).negate()
+	}
+
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+
+	micros := timeOfDay(t1) - timeOfDay(t2)
+	days := d1 - d2
+	months := int(m1) - int(m2) + 12*(y1-y2)
+
+	if micros < 0 {
+		micros += microsPerDay
+		days--
+	}
+
+	if days < 0 {
+		// borrow the days of the month t2 falls in
+		days += daysIn(m2, y2)
+		months--
+	}
+
+	return &Interval{months: int32(months), days: int32(days), micros: micros}
+}
+
+func timeOfDay(t time.Time) int64 {
+	return (int64(t.Hour())*3600+int64(t.Minute())*60+int64(t.Second()))*microsPerSecond + int64(t.Nanosecond()/1000)
+}
+
+type intervalUnit struct {
+	months float64
+	days   float64
+	micros float64
+}
+
+var intervalUnits = map[string]intervalUnit{
+	"microsecond":  {micros: 1},
+	"microseconds": {micros: 1},
+	"us":           {micros: 1},
+	"usec":         {micros: 1},
+	"usecs":        {micros: 1},
+	"millisecond":  {micros: 1e3},
+	"milliseconds": {micros: 1e3},
+	"ms":           {micros: 1e3},
+	"msec":         {micros: 1e3},
+	"msecs":        {micros: 1e3},
+	"second":       {micros: 1e6},
+	"seconds":      {micros: 1e6},
+	"sec":          {micros: 1e6},
+	"secs":         {micros: 1e6},
+	"s":            {micros: 1e6},
+	"minute":       {micros: 60e6},
+	"minutes":      {micros: 60e6},
+	"min":          {micros: 60e6},
+	"mins":         {micros: 60e6},
+	"m":            {micros: 60e6},
+	"hour":         {micros: 3600e6},
+	"hours":        {micros: 3600e6},
+	"hr":           {micros: 3600e6},
+	"hrs":          {micros: 3600e6},
+	"h":            {micros: 3600e6},
+	"day":          {days: 1},
+	"days":         {days: 1},
+	"d":            {days: 1},
+	"week":         {days: 7},
+	"weeks":        {days: 7},
+	"w":            {days: 7},
+	"month":        {months: 1},
+	"months":       {months: 1},
+	"mon":          {months: 1},
+	"mons":         {months: 1},
+	"year":         {months: 12},
+	"years":        {months: 12},
+	"yr":           {months: 12},
+	"yrs":          {months: 12},
+	"y":            {months: 12},
+	"decade":       {months: 120},
+	"decades":      {months: 120},
+	"century":      {months: 1200},
+	"centuries":    {months: 1200},
+}
+
+// ParseInterval parses an interval in the PostgreSQL input format, a
+// sequence of quantities followed by their unit, optionally with a
+// time part and a trailing 'ago' e.g. '1 year 2 months', '3 days
+// 04:05:06' or '2 hours ago'. A quantity without unit is taken as
+// seconds.
+func ParseInterval(s string) (*Interval, error) {
+	fields := strings.Fields(strings.ToLower(s))
+
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: invalid interval '%s'", ErrInvalidValue, s)
+	}
+
+	var months, days, micros float64
+
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+
+		if strings.Contains(f, ":") {
+			us, err := parseIntervalTime(f)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid interval '%s'", ErrInvalidValue, s)
+			}
+			micros += us
+			continue
+		}
+
+		numLen := strings.IndexFunc(f, func(r rune) bool {
+			return !(r >= '0' && r <= '9') && r != '.' && r != '-' && r != '+'
+		})
+		if numLen < 0 {
+			numLen = len(f)
+		}
+
+		n, err := strconv.ParseFloat(f[:numLen], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid interval '%s'", ErrInvalidValue, s)
+		}
+
+		unitName := f[numLen:]
+		if unitName == "" && i+1 < len(fields) {
+			if _, ok := intervalUnits[fields[i+1]]; ok {
+				unitName = fields[i+1]
+				i++
+			}
+		}
+
+		if unitName == "" {
+			micros += n * 1e6
+			continue
+		}
+
+		unit, ok := intervalUnits[unitName]
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid interval '%s'", ErrInvalidValue, s)
+		}
+
+		months += n * unit.months
+		days += n * unit.days
+		micros += n * unit.micros
+	}
+
+	iv, err := intervalFromParts(months, days, micros)
+	if err != nil {
+		return nil, err
+	}
+
+	if ago {
+		return iv.negate(), nil
+	}
+	return iv, nil
+}
+
+// parseIntervalTime parses the time part of an interval, [-]hh:mm[:ss[.ffffff]],
+// into microseconds.
+func parseIntervalTime(s string) (float64, error) {
+	sign := 1.0
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, ErrInvalidValue
+	}
+
+	var micros float64
+
+	for i, p := range parts {
+		var n float64
+		var err error
+
+		if i == 2 {
+			n, err = strconv.ParseFloat(p, 64)
+		} else {
+			var u uint64
+			u, err = strconv.ParseUint(p, 10, 32)
+			n = float64(u)
+		}
+		if err != nil || n < 0 {
+			return 0, ErrInvalidValue
+		}
+
+		micros += n * []float64{3600e6, 60e6, 1e6}[i]
+	}
+
+	return sign * micros, nil
+}
+
+// encodeIntervalValue returns the row encoding of the value:
+// {len}{months}{days}{microseconds}
+func encodeIntervalValue(v *Interval) []byte {
+	var encv [EncLenLen + intervalEncLen]byte
+	binary.BigEndian.PutUint32(encv[:], intervalEncLen)
+	binary.BigEndian.PutUint32(encv[EncLenLen:], uint32(v.months))
+	binary.BigEndian.PutUint32(encv[EncLenLen+4:], uint32(v.days))
+	binary.BigEndian.PutUint64(encv[EncLenLen+8:], uint64(v.micros))
+	return encv[:]
+}
+
+func decodeIntervalValue(b []byte) (*Interval, error) {
+	if len(b) != intervalEncLen {
+		return nil, ErrCorruptedData
+	}
+
+	return &Interval{
+		months: int32(binary.BigEndian.Uint32(b)),
+		days:   int32(binary.BigEndian.Uint32(b[4:])),
+		micros: int64(binary.BigEndian.Uint64(b[8:])),
+	}, nil
+}
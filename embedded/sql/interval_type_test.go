@@ -0,0 +1,243 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	for _, c := range []struct {
+		in  string
+		out string
+	}{
+		{"7 days", "7 days"},
+		{"1 day", "1 day"},
+		{"1 year 2 months 3 days 04:05:06.5", "1 year 2 mons 3 days 04:05:06.5"},
+		{"2h 30m", "02:30:00"},
+		{"90 minutes", "01:30:00"},
+		{"1.5 days", "1 day 12:00:00"},
+		{"@ 3 days ago", "-3 days"},
+		{"-1 week", "-7 days"},
+		{"30", "00:00:30"},
+		{"0 seconds", "00:00:00"},
+	} {
+		iv, err := ParseInterval(c.in)
+		require.NoError(t, err, c.in)
+		require.Equal(t, c.out, iv.String(), c.in)
+
+		back, err := ParseInterval(iv.String())
+		require.NoError(t, err, c.in)
+		cmp, err := iv.Compare(back)
+		require.NoError(t, err, c.in)
+		require.Zero(t, cmp, c.in)
+	}
+
+	for _, in := range []string{"", "days", "1 fortnight", "1:2:3:4", "ago"} {
+		_, err := ParseInterval(in)
+		require.ErrorIs(t, err, ErrInvalidValue, in)
+	}
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	ts := func(s string) time.Time {
+		v, err := time.Parse(time.DateTime, s)
+		require.NoError(t, err)
+		return v
+	}
+
+	month := NewInterval(1, 0, 0)
+	require.Equal(t, ts("2024-02-29 10:00:00"), month.addTo(ts("2024-01-31 10:00:00")))
+	require.Equal(t, ts("2023-02-28 10:00:00"), NewInterval(-12, 0, 0).addTo(ts("2024-02-29 10:00:00")))
+
+	iv := intervalBetween(ts("2024-01-03 12:00:00"), ts("2024-01-01 00:00:00"))
+	require.Equal(t, "2 days 12:00:00", iv.String())
+	require.Equal(t, "-2 days -12:00:00", iv.negate().String())
+
+	age := ageBetween(ts("2024-03-01 00:00:00"), ts("2023-01-31 06:00:00"))
+	require.Equal(t, "1 year 1 mon 18:00:00", age.String())
+
+	half, err := NewInterval(1, 1, 0).mul(0.5)
+	require.NoError(t, err)
+	require.Equal(t, "15 days 12:00:00", half.String())
+
+	_, err = NewInterval(1<<30, 0, 0).add(NewInterval(1<<30, 0, 0))
+	require.ErrorIs(t, err, ErrNumericValueOutOfRange)
+}
+
+func TestIntervalValueEncoding(t *testing.T) {
+	iv := NewInterval(14, -3, 5*microsPerSecond)
+
+	enc, err := EncodeValue(iv, IntervalType, 0)
+	require.NoError(t, err)
+
+	dec, n, err := DecodeValue(enc, IntervalType)
+	require.NoError(t, err)
+	require.Equal(t, len(enc), n)
+	require.Equal(t, iv, dec)
+
+	_, _, err = DecodeValue([]byte{0, 0, 0, 4, 0, 0, 0, 0}, IntervalType)
+	require.ErrorIs(t, err, ErrCorruptedData)
+}
+
+func TestDateTimeArithmetic(t *testing.T) {
+	dir := t.TempDir()
+
+	st, err := store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+	require.NoError(t, err)
+
+	engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+	require.NoError(t, err)
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE TABLE events (
+			id INTEGER AUTO_INCREMENT,
+			ts TIMESTAMP,
+			day DATE,
+			duration INTERVAL,
+			PRIMARY KEY id
+		);
+		CREATE INDEX ON events(ts);
+		CREATE INDEX ON events(day);
+
+		INSERT INTO events (ts, day, duration) VALUES
+			('2024-01-31 10:00:00', '2024-01-31', '1 month'),
+			('2024-02-10 08:30:00', '2024-02-10 23:59:59', '2 hours 30 minutes'),
+			(NOW() - INTERVAL '2 days', CURRENT_DATE, NULL),
+			(NOW() - INTERVAL '10 days', CURRENT_DATE - 10, '-1 day')
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("values and casts", func(t *testing.T) {
+		rows := queryStrings(t, engine, "SELECT day, duration FROM events WHERE id <= 2 ORDER BY id")
+		require.Equal(t, [][]string{
+			{"2024-01-31", "1 mon"},
+			{"2024-02-10", "02:30:00"},
+		}, rows)
+
+		rows = queryStrings(t, engine, "SELECT DATE '2024-03-01', INTERVAL '1 day 2 hours', '2024-03-01'::DATE, CAST(INTERVAL '90 seconds' AS VARCHAR)")
+		require.Equal(t, [][]string{{"2024-03-01", "1 day 02:00:00", "2024-03-01", "'00:01:30'"}}, rows)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT INTERVAL 'one day'", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrUnsupportedCast)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+		rows := queryStrings(t, engine, `
+			SELECT
+				ts + duration,
+				ts - INTERVAL '1 day',
+				day + 1,
+				day - DATE '2024-01-01',
+				ts - TIMESTAMP '2024-01-30 09:00:00',
+				duration * 2,
+				day + INTERVAL '12 hours'
+			FROM events WHERE id = 1
+		`)
+		require.Equal(t, [][]string{{
+			"2024-02-29 10:00:00",
+			"2024-01-30 10:00:00",
+			"2024-02-01",
+			"30",
+			"1 day 01:00:00",
+			"2 mons",
+			"2024-01-31 12:00:00",
+		}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT duration + INTERVAL '1 hour', duration / 2 FROM events WHERE id = 2")
+		require.Equal(t, [][]string{{"03:30:00", "01:15:00"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT ts + duration FROM events WHERE id = 3")
+		require.Equal(t, [][]string{{"NULL"}}, rows)
+
+		r, err := engine.Query(context.Background(), nil, "SELECT ts + ts FROM events", nil)
+		require.NoError(t, err)
+
+		_, err = r.Read(context.Background())
+		require.ErrorIs(t, err, ErrInvalidTypes)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("functions", func(t *testing.T) {
+		rows := queryStrings(t, engine, `
+			SELECT
+				age(TIMESTAMP '2024-03-01 00:00:00', ts),
+				date_part('day', duration),
+				EXTRACT(HOUR FROM duration),
+				EXTRACT(DAY FROM day),
+				date_trunc('month', day)
+			FROM events WHERE id = 2
+		`)
+		require.Equal(t, [][]string{{"19 days 15:30:00", "0", "2", "10", "2024-02-01 00:00:00"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT day = CURRENT_DATE, ts < CURRENT_TIMESTAMP FROM events WHERE id = 3")
+		require.Equal(t, [][]string{{"true", "true"}}, rows)
+	})
+
+	t.Run("index ranges", func(t *testing.T) {
+		query := "SELECT id FROM events USE INDEX ON (ts) WHERE ts > NOW() - INTERVAL '7 days' ORDER BY id"
+
+		rows := queryStrings(t, engine, query)
+		require.Equal(t, [][]string{{"3"}}, rows)
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		stmts, err := ParseSQLString(query)
+		require.NoError(t, err)
+
+		stmt := stmts[0].(*SelectStmt)
+
+		specs, err := stmt.genScanSpecs(tx, nil)
+		require.NoError(t, err)
+		require.Equal(t, "ts", specs.Index.cols[0].colName)
+
+		tsRange := specs.rangesByColID[specs.Index.cols[0].id]
+		require.NotNil(t, tsRange)
+		require.NotNil(t, tsRange.lRange)
+		require.Equal(t, tx.Timestamp().Add(-7*24*time.Hour).Truncate(time.Microsecond).UTC(), tsRange.lRange.val.RawValue())
+
+		rows = queryStrings(t, engine, "SELECT id FROM events USE INDEX ON (day) WHERE day = DATE '2024-02-10'")
+		require.Equal(t, [][]string{{"2"}}, rows)
+
+		rows = queryStrings(t, engine, "SELECT id FROM events WHERE day >= CURRENT_DATE - 3 ORDER BY id")
+		require.Equal(t, [][]string{{"3"}}, rows)
+	})
+
+	t.Run("intervals can not be indexed", func(t *testing.T) {
+		_, _, err := engine.Exec(context.Background(), nil, "CREATE INDEX ON events(duration)", nil)
+		require.ErrorIs(t, err, ErrCannotIndexInterval)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, st.Close())
+
+		st, err = store.Open(dir, store.DefaultOptions().WithMultiIndexing(true))
+		require.NoError(t, err)
+		defer st.Close()
+
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows := queryStrings(t, engine, "SELECT day, duration FROM events WHERE id = 1")
+		require.Equal(t, [][]string{{"2024-01-31", "1 mon"}}, rows)
+
+		rows = queryStrings(t, engine, "SHOW TABLE events")
+		require.Equal(t, []string{"'day'", "'DATE'"}, rows[2][:2])
+		require.Equal(t, []string{"'duration'", "'INTERVAL'"}, rows[3][:2])
+	})
+}
@@ -47,6 +47,10 @@ type jointRowReader struct {
 	// build failed — fall back to the per-row Resolve path).
 	hashTables      []*hashJoinTable
 	hashJoinChecked []bool
+
+	// innerNodes account the inner readers of each join when the query is
+	// profiled by EXPLAIN ANALYZE, nil otherwise.
+	innerNodes []*profileNode
 }
 
 func newJointRowReader(rowReader RowReader, joins []*JoinSpec) (*jointRowReader, error) {
@@ -113,7 +117,7 @@ func (jointr *jointRowReader) colsBySelector(ctx context.Context) (map[string]Co
 		//            on jointRowReader creation,
 		// Note: We're using a dummy ScanSpec object that is only used during read, we're only interested
 		//       in column list though
-		rr, err := jspec.ds.Resolve(ctx, jointr.Tx(), nil, &ScanSpecs{Index: &Index{}})
+		rr, err := jspec.ds.Resolve(ctx, jointr.Tx(), nil, columnsScanSpecs(jspec.ds))
 		if err != nil {
 			return nil, err
 		}
@@ -140,6 +144,16 @@ func (jointr *jointRowReader) colsBySelector(ctx context.Context) (map[string]Co
 	return jointDescriptors, nil
 }
 
+// columnsScanSpecs returns the dummy scan specs used to resolve the columns
+// of a joined data source, including the pseudo-columns bound to it.
+func columnsScanSpecs(ds DataSource) *ScanSpecs {
+	scanSpecs := &ScanSpecs{Index: &Index{}}
+	if ref, ok := ds.(*tableRef); ok {
+		scanSpecs.systemCols = ref.systemCols
+	}
+	return scanSpecs
+}
+
 func (jointr *jointRowReader) colsByPos(ctx context.Context) ([]ColDescriptor, error) {
 	colDescriptors, err := jointr.rowReader.Columns(ctx)
 	if err != nil {
@@ -152,7 +166,7 @@ func (jointr *jointRowReader) colsByPos(ctx context.Context) ([]ColDescriptor, e
 		//            on jointRowReader creation,
 		// Note: We're using a dummy ScanSpec object that is only used during read, we're only interested
 		//       in column list though
-		rr, err := jspec.ds.Resolve(ctx, jointr.Tx(), nil, &ScanSpecs{Index: &Index{}})
+		rr, err := jspec.ds.Resolve(ctx, jointr.Tx(), nil, columnsScanSpecs(jspec.ds))
 		if err != nil {
 			return nil, err
 		}
@@ -302,15 +316,18 @@ func (jointr *jointRowReader) Read(ctx context.Context) (row *Row, err error) {
 			}
 
 			jointq := &SelectStmt{
-				ds:      ds,
-				where:   where,
-				indexOn: jspec.indexOn,
+				ds:                ds,
+				where:             where,
+				indexOn:           jspec.indexOn,
+				privilegesChecked: true,
+				joinedScan:        true,
 			}
 
 			reader, err := jointq.Resolve(ctx, jointr.Tx(), jointr.Parameters(), nil)
 			if err != nil {
 				return nil, err
 			}
+			reader = jointr.profileInner(i, reader, false)
 
 			r, err := reader.Read(ctx)
 			if err == ErrNoMoreRows {
@@ -394,7 +411,7 @@ func (jointr *jointRowReader) tryHashProbe(
 	ds DataSource,
 	reducedWhere ValueExp,
 ) (probed bool, reader RowReader, r *Row, err error) {
-	if jspec.lateral || jspec.natural {
+	if jspec.lateral || jspec.natural || jspec.strategy == joinStrategyIndexLoop {
 		return false, nil, nil, nil
 	}
 	if jspec.joinType != InnerJoin && jspec.joinType != LeftJoin {
@@ -418,7 +435,18 @@ func (jointr *jointRowReader) tryHashProbe(
 			return false, nil, nil, nil
 		}
 
+		if node := jointr.innerNode(i, nil, true); node != nil {
+			jointr.Tx().queryProfile.enter(node)
+		}
 		ht, buildErr := buildJoinHashTable(ctx, jointr.Tx(), ds, innerSels, innerResidual, jointr.Parameters())
+		if jointr.innerNodes != nil {
+			jointr.Tx().queryProfile.exit()
+
+			if buildErr != nil {
+				// labelled again after the readers of the fallback path
+				jointr.innerNodes[i].label = ""
+			}
+		}
 		if buildErr != nil {
 			// Build failure is non-fatal; fall back to the per-row path.
 			return false, nil, nil, nil
@@ -458,7 +486,7 @@ func (jointr *jointRowReader) tryHashProbe(
 		matched = []*Row{nullRow}
 	}
 
-	probe := newHashProbeReader(matched, ht.cols, jointr.Tx(), jointr.Parameters())
+	probe := jointr.profileInner(i, newHashProbeReader(matched, ht.cols, jointr.Tx(), jointr.Parameters()), true)
 
 	first, readErr := probe.Read(ctx)
 	if readErr != nil {
@@ -468,6 +496,48 @@ func (jointr *jointRowReader) tryHashProbe(
 	return true, probe, first, nil
 }
 
+// innerNode returns the node accounting the inner readers of the i-th join,
+// labelled after the first of them, or nil when the query is not profiled.
+func (jointr *jointRowReader) innerNode(i int, reader RowReader, hashed bool) *profileNode {
+	if jointr.innerNodes == nil {
+		return nil
+	}
+
+	node := jointr.innerNodes[i]
+	if node.label != "" {
+		return node
+	}
+
+	jspec := jointr.joins[i]
+
+	node.label = "Nested Loop Join: "
+	if hashed {
+		node.label = "Hash Join: "
+	}
+	if jspec.joinType == LeftJoin {
+		node.label = "Left " + node.label
+	}
+
+	tr, ok := jspec.ds.(*tableRef)
+	switch {
+	case !ok:
+		node.label += "Subquery Scan"
+	case reader == nil:
+		node.label += "Seq Scan on " + describeTableRef(tr)
+	default:
+		node.label += describeScanSpecs(tr, reader.ScanSpecs(), len(jspec.indexOn) > 0)
+	}
+	return node
+}
+
+func (jointr *jointRowReader) profileInner(i int, reader RowReader, hashed bool) RowReader {
+	node := jointr.innerNode(i, reader, hashed)
+	if node == nil {
+		return reader
+	}
+	return jointr.Tx().queryProfile.wrap(reader, node)
+}
+
 func (jointr *jointRowReader) Close() error {
 	merr := multierr.NewMultiErr()
 
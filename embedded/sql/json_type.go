@@ -63,10 +63,10 @@ func (v *JSON) inferType(cols map[string]ColDescriptor, params map[string]SQLVal
 func (v *JSON) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
 	ok := t == JSONType
 	switch t {
-	case IntegerType, Float64Type:
+	case IntegerType, Float64Type, DecimalType:
 		_, isInt := v.val.(int64)
 		_, isFloat := v.val.(float64)
-		ok = isInt || (isFloat && t == Float64Type)
+		ok = isInt || (isFloat && t != IntegerType)
 	case VarcharType:
 		_, ok = v.val.(string)
 	case BooleanType:
@@ -197,6 +197,7 @@ func (v *JSON) lookup(fields []string) TypedValue {
 type JSONSelector struct {
 	*ColSelector
 	fields []string
+	text   bool // ->> operator: the value is returned as text
 }
 
 func (sel *JSONSelector) substitute(params map[string]interface{}) (ValueExp, error) {
@@ -209,7 +210,45 @@ func (v *JSONSelector) resolve(implicitTable string) (string, string, string) {
 }
 
 func (v *JSONSelector) String() string {
-	return fmt.Sprintf("%s->'%s'", v.ColSelector.col, strings.Join(v.fields, "->"))
+	if !v.text {
+		return fmt.Sprintf("%s->'%s'", v.ColSelector.col, strings.Join(v.fields, "->"))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(v.ColSelector.col)
+
+	for i, field := range v.fields {
+		if i == len(v.fields)-1 {
+			sb.WriteString("->>")
+		} else {
+			sb.WriteString("->")
+		}
+		sb.WriteString("'" + field + "'")
+	}
+	return sb.String()
+}
+
+func (sel *JSONSelector) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	t, err := sel.ColSelector.inferType(cols, params, implicitTable)
+	if err != nil || !sel.text {
+		return t, err
+	}
+	return VarcharType, nil
+}
+
+func (sel *JSONSelector) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	if !sel.text {
+		return sel.ColSelector.requiresType(t, cols, params, implicitTable)
+	}
+
+	if _, err := sel.ColSelector.inferType(cols, params, implicitTable); err != nil {
+		return err
+	}
+
+	if t != VarcharType {
+		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
+	}
+	return nil
 }
 
 func (sel *JSONSelector) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -218,11 +257,35 @@ func (sel *JSONSelector) reduce(tx *SQLTx, row *Row, implicitTable string) (Type
 		return nil, err
 	}
 
+	if val.IsNull() && sel.text {
+		return NewNull(VarcharType), nil
+	}
+
 	jsonVal, ok := val.(*JSON)
 	if !ok {
 		return val, fmt.Errorf("-> operator cannot be applied on column of type %s", val.Type())
 	}
-	return jsonVal.lookup(sel.fields), nil
+	return sel.lookup(jsonVal), nil
+}
+
+func (sel *JSONSelector) lookup(jsonVal *JSON) TypedValue {
+	v := jsonVal.lookup(sel.fields)
+	if !sel.text {
+		return v
+	}
+
+	switch jv := v.(type) {
+	case *JSON:
+		if s, ok := jv.val.(string); ok {
+			return NewVarchar(s)
+		}
+		if jv.val == nil {
+			return NewNull(VarcharType)
+		}
+		return NewVarchar(jv.String())
+	default:
+		return NewNull(VarcharType)
+	}
 }
 
 func (sel *JSONSelector) selectors() []Selector {
@@ -236,5 +299,5 @@ func (sel *JSONSelector) reduceSelectors(row *Row, implicitTable string) ValueEx
 	if !ok {
 		return sel
 	}
-	return jsonVal.lookup(sel.fields)
+	return sel.lookup(jsonVal)
 }
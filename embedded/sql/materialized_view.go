@@ -0,0 +1,860 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// A materialized view is a regular table storing the rows of a query, which
+// are only recomputed by REFRESH MATERIALIZED VIEW. Its primary key is made
+// of the columns identifying each row of the query when there are such
+// columns and they can be part of a primary key, not being nullable nor of
+// unbounded length, or of an additional _row_id column otherwise.
+//
+// Views reading a single table, optionally filtered and grouped by some of
+// its columns, can be refreshed incrementally: the changes committed to the
+// table since the last refresh are read with a DIFF scan, and only the rows
+// of the view derived from the changed rows are recomputed.
+
+const matViewRowIDCol = "_row_id"
+
+// MaterializedView is the definition of a table holding the rows of a query.
+type MaterializedView struct {
+	sql   string // text of the query
+	query DataSource
+
+	// refreshedTxID is the last transaction of the source table reflected
+	// by the view, and sourceTableID the ID the source table had then. Both
+	// are zero when the view can not be refreshed incrementally.
+	refreshedTxID uint64
+	sourceTableID uint32
+}
+
+// SQL returns the text of the query defining the view.
+func (mv *MaterializedView) SQL() string {
+	return mv.sql
+}
+
+// RefreshedTxID returns the last transaction of the source table reflected
+// by the view, zero when it can not be refreshed incrementally.
+func (mv *MaterializedView) RefreshedTxID() uint64 {
+	return mv.refreshedTxID
+}
+
+// IsHidden returns whether the column is the _row_id column identifying the
+// rows of a materialized view, which is neither listed nor returned by
+// SELECT * but can still be selected explicitly.
+func (c *Column) IsHidden() bool {
+	return c.table.matView != nil && c.colName == matViewRowIDCol
+}
+
+// MaterializedView returns the definition of the materialized view stored
+// in the table, or nil for regular tables.
+func (t *Table) MaterializedView() *MaterializedView {
+	return t.matView
+}
+
+// matViewPlan describes how the rows of a view derive from the rows of its
+// single source table: each row of the view is identified by the values of
+// keyCols in the source rows it is computed from, found at keyPos in the
+// rows of the view. Views computing a single aggregated row have no keys.
+type matViewPlan struct {
+	stmt    *SelectStmt
+	source  *Table
+	alias   string
+	keyCols []*Column
+	keyPos  []int
+}
+
+// planMaterializedView returns how the rows of query, whose columns are
+// cols, can be maintained incrementally, or nil when they can not.
+func planMaterializedView(tx *SQLTx, query DataSource, cols []ColDescriptor) *matViewPlan {
+	stmt, ok := query.(*SelectStmt)
+	if !ok || stmt.distinct || len(stmt.joins) > 0 || stmt.limit != nil || stmt.offset != nil {
+		return nil
+	}
+
+	ref, ok := stmt.ds.(*tableRef)
+	if !ok || ref.history || ref.diff || ref.period.start != nil || ref.period.end != nil {
+		return nil
+	}
+
+	if (stmt.where != nil && expContainsSubquery(stmt.where)) || (stmt.having != nil && expContainsSubquery(stmt.having)) {
+		return nil
+	}
+
+	for _, t := range stmt.targets {
+		if _, isWindowFn := t.Exp.(*WindowFnExp); isWindowFn || expContainsSubquery(t.Exp) {
+			return nil
+		}
+	}
+
+	// rows of a partitioned table are stored under its partitions
+	source, err := ref.referencedTable(tx)
+	if err != nil || source.partitioning != nil {
+		return nil
+	}
+
+	plan := &matViewPlan{stmt: stmt, source: source, alias: ref.Alias()}
+
+	switch {
+	case len(stmt.groupBy) > 0:
+		for _, sel := range stmt.groupBy {
+			if sel.table != "" && sel.table != plan.alias {
+				return nil
+			}
+
+			col, err := source.GetColumnByName(sel.col)
+			if err != nil {
+				return nil
+			}
+			plan.keyCols = append(plan.keyCols, col)
+		}
+	case stmt.containsAggregations():
+		return plan
+	default:
+		plan.keyCols = source.primaryIndex.cols
+	}
+
+	if !primaryKeyCols(plan.keyCols) {
+		return nil
+	}
+
+	for _, col := range plan.keyCols {
+		pos := plan.targetPos(col, cols)
+		if pos < 0 {
+			return nil
+		}
+		plan.keyPos = append(plan.keyPos, pos)
+	}
+	return plan
+}
+
+// primaryKeyCols returns whether the values of cols can always make up the
+// primary key of the rows of a view.
+func primaryKeyCols(cols []*Column) bool {
+	keyLen := 0
+
+	for _, col := range cols {
+		nullable := col.IsNullable() && !col.table.primaryIndex.IncludesCol(col.id)
+
+		if nullable || IsArrayType(col.colType) || col.colType == JSONType || col.colType == IntervalType {
+			return false
+		}
+
+		if variableSizedType(col.colType) && (col.MaxLen() == 0 || col.MaxLen() > MaxKeyLen) {
+			return false
+		}
+		keyLen += col.MaxLen()
+	}
+	return keyLen <= MaxKeyLen
+}
+
+// targetPos returns the position of the column of the view selecting col
+// of the source table as is, or -1 when there is none.
+func (plan *matViewPlan) targetPos(col *Column, cols []ColDescriptor) int {
+	if len(plan.stmt.targets) == 0 {
+		for i, c := range cols {
+			if c.Column == col.colName {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i, t := range plan.stmt.targets {
+		sel, ok := t.Exp.(*ColSelector)
+		if ok && sel.col == col.colName && (sel.table == "" || sel.table == plan.alias) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sourceColumn returns the column of the source table selected as is by
+// the column of the view at pos, if any.
+func (plan *matViewPlan) sourceColumn(pos int, cols []ColDescriptor) *Column {
+	if plan == nil {
+		return nil
+	}
+
+	if len(plan.stmt.targets) > 0 {
+		sel, ok := plan.stmt.targets[pos].Exp.(*ColSelector)
+		if !ok || (sel.table != "" && sel.table != plan.alias) {
+			return nil
+		}
+	}
+
+	col, err := plan.source.GetColumnByName(cols[pos].Column)
+	if err != nil || plan.targetPos(col, cols) != pos {
+		return nil
+	}
+	return col
+}
+
+// storedBy returns whether the rows of table are identified as described
+// by the plan, which may not be the case anymore if the source table was
+// recreated since the view was.
+func (plan *matViewPlan) storedBy(table *Table, cols []ColDescriptor) bool {
+	pkCols := table.primaryIndex.cols
+
+	if len(plan.keyCols) == 0 {
+		return len(pkCols) == 1 && pkCols[0].colName == matViewRowIDCol
+	}
+
+	if len(pkCols) != len(plan.keyPos) {
+		return false
+	}
+
+	for i, pos := range plan.keyPos {
+		if pkCols[i].colName != cols[pos].Column {
+			return false
+		}
+	}
+	return true
+}
+
+// keyCond returns the condition selecting the source rows from which the
+// row of the view identified by key is computed.
+func (plan *matViewPlan) keyCond(key []TypedValue) ValueExp {
+	var cond ValueExp = plan.stmt.where
+
+	for i, col := range plan.keyCols {
+		eq := &CmpBoolExp{op: EQ, left: &ColSelector{table: plan.alias, col: col.colName}, right: key[i]}
+
+		if cond == nil {
+			cond = eq
+		} else {
+			cond = &BinBoolExp{op: And, left: cond, right: eq}
+		}
+	}
+	return cond
+}
+
+type CreateMaterializedViewStmt struct {
+	name        string
+	ifNotExists bool
+	query       DataSource
+	querySQL    string
+}
+
+func (stmt *CreateMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	name, err := tx.catalog.creationName(stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := tx.catalog.tablesByName[name]; exists || tx.engine.tableResolveFor(name) != nil {
+		if stmt.ifNotExists {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, stmt.name)
+	}
+
+	if stmt.querySQL == "" {
+		return nil, fmt.Errorf("%w: query of materialized view '%s' is missing", ErrIllegalArguments, stmt.name)
+	}
+
+	cols, err := queryColumns(ctx, tx, stmt.query)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := planMaterializedView(tx, stmt.query, cols)
+
+	var colsSpec []*ColSpec
+	var pkColNames PrimaryKeyConstraint
+
+	if plan == nil || len(plan.keyCols) == 0 {
+		colsSpec = append(colsSpec, &ColSpec{colName: matViewRowIDCol, colType: IntegerType})
+		pkColNames = append(pkColNames, matViewRowIDCol)
+	}
+
+	for i, col := range cols {
+		if col.Column == matViewRowIDCol {
+			return nil, fmt.Errorf("%w: column '%s' of materialized view '%s'", ErrReservedWord, col.Column, stmt.name)
+		}
+
+		if col.Type == AnyType {
+			return nil, fmt.Errorf("%w: type of column '%s' of materialized view '%s' can not be inferred", ErrInvalidTypes, col.Column, stmt.name)
+		}
+
+		spec := &ColSpec{colName: col.Column, colType: col.Type}
+
+		// columns selected from the source table keep their length, as
+		// variable-length key columns must be limited
+		if srcCol := plan.sourceColumn(i, cols); srcCol != nil {
+			spec.maxLen = srcCol.maxLen
+		}
+		colsSpec = append(colsSpec, spec)
+	}
+
+	if plan != nil {
+		for _, pos := range plan.keyPos {
+			pkColNames = append(pkColNames, cols[pos].Column)
+		}
+	}
+
+	// the view is created and looked up by its fully qualified name, as its
+	// unqualified name may refer to another table of the search path
+	qname := fullyQualifiedName(name)
+
+	createStmt := &CreateTableStmt{table: qname, colsSpec: colsSpec, pkColNames: pkColNames}
+	if _, err := createStmt.execAt(ctx, tx, params); err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(qname)
+	if err != nil {
+		return nil, err
+	}
+
+	table.matView = &MaterializedView{sql: stmt.querySQL, query: stmt.query}
+
+	if err := tx.refreshMaterializedView(ctx, table, false, true); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+type DropMaterializedViewStmt struct {
+	name     string
+	ifExists bool
+}
+
+func (stmt *DropMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if !tx.catalog.ExistTable(stmt.name) && stmt.ifExists {
+		return tx, nil
+	}
+
+	table, err := materializedViewByName(tx, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	dropStmt := &DropTableStmt{table: table.qualifiedName(), materializedView: true}
+	return dropStmt.execAt(ctx, tx, params)
+}
+
+type RefreshMaterializedViewStmt struct {
+	name          string
+	incrementally bool
+}
+
+func (stmt *RefreshMaterializedViewStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *RefreshMaterializedViewStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeInsert, SQLPrivilegeDelete}
+}
+
+func (stmt *RefreshMaterializedViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *RefreshMaterializedViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := materializedViewByName(tx, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.refreshMaterializedView(ctx, table, stmt.incrementally, false); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func materializedViewByName(tx *SQLTx, name string) (*Table, error) {
+	table, err := tx.catalog.GetTableByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if table.matView == nil {
+		return nil, fmt.Errorf("%w: '%s' is not a materialized view", ErrIllegalArguments, name)
+	}
+	return table, nil
+}
+
+// checkNotMaterializedView rejects statements writing into the rows of a
+// materialized view, which are only modified by refreshing it.
+func checkNotMaterializedView(table *Table) error {
+	if table.matView != nil {
+		return fmt.Errorf("%w (%s)", ErrMaterializedViewIsReadOnly, table.name)
+	}
+	return nil
+}
+
+// refreshMaterializedView recomputes the rows of the view stored in table.
+// When incrementally is set only the rows derived from rows of the source
+// table changed since the last refresh are recomputed. Tables just created
+// within tx are populated, as they can not be read yet.
+func (tx *SQLTx) refreshMaterializedView(ctx context.Context, table *Table, incrementally, created bool) error {
+	mv := table.matView
+
+	cols, err := queryColumns(ctx, tx, mv.query)
+	if err != nil {
+		return err
+	}
+
+	plan := planMaterializedView(tx, mv.query, cols)
+	if plan != nil && !plan.storedBy(table, cols) {
+		plan = nil
+	}
+
+	if incrementally && plan == nil {
+		return fmt.Errorf("%w (%s)", ErrNoIncrementalRefresh, table.name)
+	}
+
+	var refreshedTxID uint64
+	var sourceTableID uint32
+
+	if plan != nil {
+		refreshedTxID, err = tx.snapshotTxID(plan.source)
+		if err != nil {
+			return err
+		}
+		sourceTableID = plan.source.id
+	}
+
+	// the source table may have been recreated since the last refresh, in
+	// which case the rows deleted with it can not be found any longer
+	canDiff := plan != nil && mv.refreshedTxID > 0 && mv.sourceTableID == plan.source.id
+
+	switch {
+	case incrementally && canDiff && refreshedTxID <= mv.refreshedTxID:
+		// no changes since the last refresh
+	case incrementally && canDiff:
+		err = tx.refreshMaterializedViewSince(ctx, table, plan, mv.refreshedTxID, refreshedTxID)
+	default:
+		err = tx.refreshMaterializedViewFully(ctx, table, created)
+	}
+	if err != nil {
+		return err
+	}
+
+	table.matView = &MaterializedView{
+		sql:           mv.sql,
+		query:         mv.query,
+		refreshedTxID: refreshedTxID,
+		sourceTableID: sourceTableID,
+	}
+
+	if err := persistMaterializedView(tx, table); err != nil {
+		return err
+	}
+
+	tx.mutatedCatalog = true
+
+	return nil
+}
+
+func (tx *SQLTx) refreshMaterializedViewFully(ctx context.Context, table *Table, created bool) error {
+	var currRows map[string]map[uint32]TypedValue
+
+	if !created {
+		rows, err := tx.materializedViewRows(ctx, table)
+		if err != nil {
+			return err
+		}
+		currRows = rows
+	}
+
+	rows, err := queryRows(ctx, tx, table.matView.query)
+	if err != nil {
+		return err
+	}
+
+	refreshed := make(map[string]struct{}, len(rows))
+
+	for i, row := range rows {
+		valuesByColID, err := materializedViewValues(table, row, i+1)
+		if err != nil {
+			return err
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return err
+		}
+
+		if _, duplicated := refreshed[string(pkEncVals)]; duplicated {
+			return fmt.Errorf("%w: materialized view '%s'", store.ErrKeyAlreadyExists, table.name)
+		}
+		refreshed[string(pkEncVals)] = struct{}{}
+
+		if err := tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !created); err != nil {
+			return err
+		}
+	}
+
+	for pkEncVals, valuesByColID := range currRows {
+		if _, exists := refreshed[pkEncVals]; exists {
+			continue
+		}
+
+		if err := tx.deleteIndexEntries([]byte(pkEncVals), valuesByColID, table); err != nil {
+			return err
+		}
+		tx.updatedRows++
+	}
+	return nil
+}
+
+// refreshMaterializedViewSince recomputes the rows of the view derived from
+// rows of the source table changed after transaction sinceTxID and up to
+// untilTxID.
+func (tx *SQLTx) refreshMaterializedViewSince(ctx context.Context, table *Table, plan *matViewPlan, sinceTxID, untilTxID uint64) error {
+	keys, err := tx.changedMaterializedViewKeys(ctx, plan, sinceTxID, untilTxID)
+	if err != nil {
+		return err
+	}
+
+	if len(plan.keyCols) == 0 {
+		if len(keys) == 0 {
+			return nil
+		}
+		return tx.refreshMaterializedViewFully(ctx, table, false)
+	}
+
+	for _, key := range keys {
+		stmt := *plan.stmt
+		stmt.where = plan.keyCond(key)
+
+		rows, err := queryRows(ctx, tx, &stmt)
+		if err != nil {
+			return err
+		}
+
+		if len(rows) > 1 {
+			return fmt.Errorf("%w: materialized view '%s'", store.ErrKeyAlreadyExists, table.name)
+		}
+
+		pkValuesByColID := make(map[uint32]TypedValue, len(key))
+		for i, col := range table.primaryIndex.cols {
+			pkValuesByColID[col.id] = key[i]
+		}
+
+		if len(rows) == 1 {
+			valuesByColID, err := materializedViewValues(table, rows[0], 0)
+			if err != nil {
+				return err
+			}
+
+			pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+			if err != nil {
+				return err
+			}
+
+			if err := tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		pkEncVals, err := encodedKey(table.primaryIndex, pkValuesByColID)
+		if err != nil {
+			return err
+		}
+
+		currRow, err := tx.fetchPKRow(ctx, table, pkValuesByColID)
+		if errors.Is(err, ErrNoMoreRows) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.deleteIndexEntries(pkEncVals, rowValuesByColID(table, currRow), table); err != nil {
+			return err
+		}
+		tx.updatedRows++
+	}
+	return nil
+}
+
+// changedMaterializedViewKeys returns the keys of the rows of the view
+// derived from rows of the source table changed in the given range, both
+// before and after the changes.
+func (tx *SQLTx) changedMaterializedViewKeys(ctx context.Context, plan *matViewPlan, sinceTxID, untilTxID uint64) ([][]TypedValue, error) {
+	p := period{
+		start: &openPeriod{instant: periodInstant{exp: &Integer{val: int64(sinceTxID)}, instantType: txInstant}},
+		end:   &openPeriod{inclusive: true, instant: periodInstant{exp: &Integer{val: int64(untilTxID)}, instantType: txInstant}},
+	}
+
+	r, err := newDiffRowReader(tx, nil, plan.source, p, plan.source.name, &ScanSpecs{Index: plan.source.primaryIndex})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var keys [][]TypedValue
+	seen := make(map[string]struct{})
+
+	addKey := func(row *Row) error {
+		key := make([]TypedValue, len(plan.keyCols))
+
+		var encKey strings.Builder
+
+		for i, col := range plan.keyCols {
+			val, ok := row.ValuesBySelector[EncodeSelector("", plan.source.name, col.colName)]
+			if !ok {
+				val = &NullValue{t: col.colType}
+			}
+			key[i] = val
+
+			encVal, err := EncodeValue(val, col.colType, 0)
+			if err != nil {
+				return err
+			}
+			encKey.Write(encVal)
+		}
+
+		if _, exists := seen[encKey.String()]; !exists {
+			seen[encKey.String()] = struct{}{}
+			keys = append(keys, key)
+		}
+		return nil
+	}
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := addKey(row); err != nil {
+			return nil, err
+		}
+
+		if prev := r.previousRow(); prev != nil {
+			if err := addKey(prev); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return keys, nil
+}
+
+// materializedViewRows returns the rows currently stored in the view by
+// their encoded primary key.
+func (tx *SQLTx) materializedViewRows(ctx context.Context, table *Table) (map[string]map[uint32]TypedValue, error) {
+	r, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	rows := make(map[string]map[uint32]TypedValue)
+
+	for {
+		row, err := r.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		valuesByColID := rowValuesByColID(table, row)
+
+		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+		rows[string(pkEncVals)] = valuesByColID
+	}
+	return rows, nil
+}
+
+func rowValuesByColID(table *Table, row *Row) map[uint32]TypedValue {
+	valuesByColID := make(map[uint32]TypedValue, len(table.cols))
+	for _, col := range table.cols {
+		valuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+	}
+	return valuesByColID
+}
+
+// materializedViewValues maps a row of the query of the view to the columns
+// of table, using rowID when rows are identified by a _row_id column.
+func materializedViewValues(table *Table, row *Row, rowID int) (map[uint32]TypedValue, error) {
+	cols := table.cols
+
+	valuesByColID := make(map[uint32]TypedValue, len(cols))
+
+	if len(cols) == len(row.ValuesByPosition)+1 {
+		valuesByColID[cols[0].id] = &Integer{val: int64(rowID)}
+		cols = cols[1:]
+	}
+
+	if len(cols) != len(row.ValuesByPosition) {
+		return nil, fmt.Errorf("%w: materialized view '%s'", ErrInvalidNumberOfValues, table.name)
+	}
+
+	for i, col := range cols {
+		val, err := normalizeColumnValue(col, row.ValuesByPosition[i])
+		if err != nil {
+			return nil, err
+		}
+		valuesByColID[col.id] = val
+	}
+	return valuesByColID, nil
+}
+
+func queryColumns(ctx context.Context, tx *SQLTx, query DataSource) ([]ColDescriptor, error) {
+	r, err := query.Resolve(ctx, tx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return r.Columns(ctx)
+}
+
+func queryRows(ctx context.Context, tx *SQLTx, query DataSource) ([]*Row, error) {
+	r, err := query.Resolve(ctx, tx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ReadAllRows(ctx, r)
+}
+
+// snapshotTxID returns the last transaction visible when reading the rows
+// of table.
+func (tx *SQLTx) snapshotTxID(table *Table) (uint64, error) {
+	prefix := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id))
+	return tx.tx.SnapshotTxID(prefix)
+}
+
+func persistMaterializedView(tx *SQLTx, table *Table) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogMatViewPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+	)
+
+	mv := table.matView
+
+	// {refreshedTxID}{sourceTableID}{sql}
+	val := make([]byte, 8+EncIDLen+len(mv.sql))
+	binary.BigEndian.PutUint64(val, mv.refreshedTxID)
+	binary.BigEndian.PutUint32(val[8:], mv.sourceTableID)
+	copy(val[8+EncIDLen:], mv.sql)
+
+	return tx.set(mappedKey, nil, val)
+}
+
+func persistMaterializedViewDeletion(ctx context.Context, tx *SQLTx, table *Table) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogMatViewPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
+func loadMaterializedView(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (*MaterializedView, error) {
+	prefix := MapKey(sqlPrefix, catalogMatViewPrefix, EncodeID(dbID), EncodeID(tableID))
+
+	var mv *MaterializedView
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(value) < 8+EncIDLen {
+			return ErrCorruptedData
+		}
+
+		query, err := parseMaterializedViewQuery(string(value[8+EncIDLen:]))
+		if err != nil {
+			return err
+		}
+
+		mv = &MaterializedView{
+			sql:           string(value[8+EncIDLen:]),
+			query:         query,
+			refreshedTxID: binary.BigEndian.Uint64(value),
+			sourceTableID: binary.BigEndian.Uint32(value[8:]),
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return mv, err
+}
+
+func parseMaterializedViewQuery(sql string) (DataSource, error) {
+	stmts, err := ParseSQLString(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, ErrCorruptedData
+	}
+
+	query, ok := stmts[0].(DataSource)
+	if !ok {
+		return nil, ErrCorruptedData
+	}
+	return query, nil
+}
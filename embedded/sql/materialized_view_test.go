@@ -0,0 +1,255 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializedViewStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		CREATE MATERIALIZED VIEW IF NOT EXISTS totals AS
+			SELECT customer, SUM(amount) FROM orders GROUP BY customer -- per customer
+		;
+		REFRESH MATERIALIZED VIEW totals INCREMENTALLY;
+		REFRESH MATERIALIZED VIEW totals;
+		DROP MATERIALIZED VIEW IF EXISTS totals`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 4)
+
+	create, ok := stmts[0].(*CreateMaterializedViewStmt)
+	require.True(t, ok)
+	require.Equal(t, "totals", create.name)
+	require.True(t, create.ifNotExists)
+	require.Equal(t, "SELECT customer, SUM(amount) FROM orders GROUP BY customer -- per customer", create.querySQL)
+	require.IsType(t, &SelectStmt{}, create.query)
+
+	require.Equal(t, &RefreshMaterializedViewStmt{name: "totals", incrementally: true}, stmts[1])
+	require.Equal(t, &RefreshMaterializedViewStmt{name: "totals"}, stmts[2])
+	require.Equal(t, &DropMaterializedViewStmt{name: "totals", ifExists: true}, stmts[3])
+
+	stmts, err = ParseSQLString("CREATE MATERIALIZED VIEW v AS SELECT * FROM t")
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM t", stmts[0].(*CreateMaterializedViewStmt).querySQL)
+}
+
+func TestMaterializedViews(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	exec := func(sql string) (int, error) {
+		_, txs, err := engine.Exec(context.Background(), nil, sql, nil)
+		if err != nil {
+			return 0, err
+		}
+		return txs[len(txs)-1].UpdatedRows(), nil
+	}
+
+	_, err := exec(`
+		CREATE TABLE customers (name VARCHAR[32], country VARCHAR[2], PRIMARY KEY name);
+		CREATE TABLE orders (id INTEGER AUTO_INCREMENT, customer VARCHAR[32] NOT NULL, amount INTEGER, PRIMARY KEY id);
+
+		INSERT INTO customers (name, country) VALUES ('alice', 'it'), ('bob', 'fr');
+		INSERT INTO orders (customer, amount) VALUES ('alice', 10), ('bob', 5), ('alice', 20), ('carol', 50);
+	`)
+	require.NoError(t, err)
+
+	_, err = exec(`
+		CREATE MATERIALIZED VIEW totals AS
+			SELECT customer, COUNT(*) AS orders, SUM(amount) AS total FROM orders GROUP BY customer;
+		CREATE MATERIALIZED VIEW big_orders AS
+			SELECT id, amount FROM orders WHERE amount >= 20;
+		CREATE MATERIALIZED VIEW stats AS
+			SELECT COUNT(*) AS orders, MAX(amount) AS max_amount FROM orders;
+		CREATE MATERIALIZED VIEW by_country AS
+			SELECT c.country, SUM(o.amount) AS total FROM orders o JOIN customers c ON o.customer = c.name GROUP BY c.country;
+	`)
+	require.NoError(t, err)
+
+	totals := "SELECT customer, orders, total FROM totals ORDER BY customer"
+
+	require.Equal(t, [][]string{{"'alice'", "2", "30"}, {"'bob'", "1", "5"}, {"'carol'", "1", "50"}}, queryStrings(t, engine, totals))
+	require.Equal(t, [][]string{{"3", "20"}, {"4", "50"}}, queryStrings(t, engine, "SELECT * FROM big_orders"))
+	require.Equal(t, [][]string{{"4", "50"}}, queryStrings(t, engine, "SELECT * FROM stats"))
+	require.Equal(t, [][]string{{"'fr'", "5"}, {"'it'", "30"}}, queryStrings(t, engine, "SELECT country, total FROM by_country ORDER BY country"))
+
+	_, err = exec(`
+		INSERT INTO orders (customer, amount) VALUES ('bob', 25), ('dave', 1);
+		UPDATE orders SET customer = 'bob' WHERE id = 1;
+		DELETE FROM orders WHERE id = 4;
+	`)
+	require.NoError(t, err)
+
+	t.Run("the _row_id column is hidden", func(t *testing.T) {
+		require.Equal(t, [][]string{{"1", "4"}}, queryStrings(t, engine, "SELECT _row_id, orders FROM stats"))
+
+		require.Equal(t, [][]string{{"'orders'"}, {"'max_amount'"}}, queryStrings(t, engine, "SELECT name FROM COLUMNS('stats')"))
+		require.Len(t, queryStrings(t, engine, "SHOW TABLE stats"), 2)
+
+		_, err := exec("CREATE MATERIALIZED VIEW ids AS SELECT id AS _row_id FROM orders")
+		require.ErrorIs(t, err, ErrReservedWord)
+	})
+
+	t.Run("views are only changed by refreshing them", func(t *testing.T) {
+		require.Len(t, queryStrings(t, engine, totals), 3)
+
+		for _, sql := range []string{
+			"INSERT INTO totals (customer, orders, total) VALUES ('eve', 1, 1)",
+			"UPDATE totals SET total = 0",
+			"DELETE FROM totals",
+			"TRUNCATE TABLE totals",
+		} {
+			_, err := exec(sql)
+			require.ErrorIs(t, err, ErrMaterializedViewIsReadOnly, sql)
+		}
+
+		_, err := exec("DROP TABLE totals")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("incremental refresh", func(t *testing.T) {
+		// alice lost an order, bob got two and carol her only one
+		n, err := exec("REFRESH MATERIALIZED VIEW totals INCREMENTALLY")
+		require.NoError(t, err)
+		require.Equal(t, 4, n)
+
+		expected := [][]string{{"'alice'", "1", "20"}, {"'bob'", "3", "40"}, {"'dave'", "1", "1"}}
+		require.Equal(t, expected, queryStrings(t, engine, totals))
+		require.Equal(t, expected, queryStrings(t, engine, "SELECT customer, COUNT(*), SUM(amount) FROM orders GROUP BY customer ORDER BY customer"))
+
+		n, err = exec("REFRESH MATERIALIZED VIEW totals INCREMENTALLY")
+		require.NoError(t, err)
+		require.Zero(t, n)
+
+		_, err = exec("REFRESH MATERIALIZED VIEW big_orders INCREMENTALLY")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"3", "20"}, {"5", "25"}}, queryStrings(t, engine, "SELECT * FROM big_orders"))
+
+		_, err = exec("REFRESH MATERIALIZED VIEW stats INCREMENTALLY")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"5", "25"}}, queryStrings(t, engine, "SELECT * FROM stats"))
+
+		_, err = exec("REFRESH MATERIALIZED VIEW by_country INCREMENTALLY")
+		require.ErrorIs(t, err, ErrNoIncrementalRefresh)
+	})
+
+	t.Run("full refresh", func(t *testing.T) {
+		_, err := exec("REFRESH MATERIALIZED VIEW by_country")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"'fr'", "40"}, {"'it'", "20"}}, queryStrings(t, engine, "SELECT country, total FROM by_country ORDER BY country"))
+
+		_, err = exec("REFRESH MATERIALIZED VIEW customers")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("REFRESH MATERIALIZED VIEW unknown")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	t.Run("secondary indexes", func(t *testing.T) {
+		_, err := exec("CREATE INDEX ON totals (total)")
+		require.NoError(t, err)
+
+		_, err = exec("UPDATE orders SET amount = 100 WHERE customer = 'dave'")
+		require.NoError(t, err)
+
+		_, err = exec("REFRESH MATERIALIZED VIEW totals INCREMENTALLY")
+		require.NoError(t, err)
+
+		rows := queryStrings(t, engine, "SELECT customer FROM totals USE INDEX ON (total) WHERE total > 30 ORDER BY total")
+		require.Equal(t, [][]string{{"'bob'"}, {"'dave'"}}, rows)
+	})
+
+	t.Run("reopen, recreate source and drop", func(t *testing.T) {
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+		engine = reopened
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		table, err := tx.Catalog().GetTableByName("totals")
+		require.NoError(t, err)
+		require.NotNil(t, table.MaterializedView())
+		require.Equal(t, "SELECT customer, COUNT(*) AS orders, SUM(amount) AS total FROM orders GROUP BY customer", table.MaterializedView().SQL())
+		require.NotZero(t, table.MaterializedView().RefreshedTxID())
+
+		table, err = tx.Catalog().GetTableByName("orders")
+		require.NoError(t, err)
+		require.Nil(t, table.MaterializedView())
+
+		require.NoError(t, tx.Cancel())
+
+		_, err = exec(`
+			TRUNCATE TABLE orders;
+			INSERT INTO orders (customer, amount) VALUES ('erin', 7);
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("REFRESH MATERIALIZED VIEW totals INCREMENTALLY")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"'erin'", "1", "7"}}, queryStrings(t, engine, totals))
+
+		_, err = exec(`
+			DROP MATERIALIZED VIEW totals;
+			DROP MATERIALIZED VIEW IF EXISTS totals;
+			CREATE TABLE totals (id INTEGER, PRIMARY KEY id);
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("DROP MATERIALIZED VIEW totals")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("CREATE MATERIALIZED VIEW stats AS SELECT * FROM orders")
+		require.ErrorIs(t, err, ErrTableAlreadyExists)
+	})
+}
+
+func TestMaterializedViewsGroupedByNonKeyColumns(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE events (id INTEGER AUTO_INCREMENT, kind VARCHAR, source VARCHAR[16], PRIMARY KEY id);
+		INSERT INTO events (kind, source) VALUES ('click', 'web'), ('view', 'web'), ('click', 'app');
+	`, nil)
+	require.NoError(t, err)
+
+	// neither unbounded nor nullable columns can be part of the primary key,
+	// so the rows of the views are identified by a _row_id column instead
+	_, _, err = engine.Exec(context.Background(), nil, `
+		CREATE MATERIALIZED VIEW by_kind AS SELECT kind, COUNT(*) AS n FROM events GROUP BY kind;
+		CREATE MATERIALIZED VIEW by_source AS SELECT source, COUNT(*) AS n FROM events GROUP BY source;
+	`, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{{"'click'", "2"}, {"'view'", "1"}}, queryStrings(t, engine, "SELECT kind, n FROM by_kind ORDER BY kind"))
+	require.Equal(t, [][]string{{"'app'", "1"}, {"'web'", "2"}}, queryStrings(t, engine, "SELECT source, n FROM by_source ORDER BY source"))
+
+	_, _, err = engine.Exec(context.Background(), nil, `
+		INSERT INTO events (kind, source) VALUES (NULL, NULL), ('view', NULL);
+		CREATE MATERIALIZED VIEW by_kind_and_source AS SELECT kind, source, COUNT(*) AS n FROM events GROUP BY kind, source;
+	`, nil)
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[][]string{{"NULL", "NULL", "1"}, {"'click'", "'app'", "1"}, {"'click'", "'web'", "1"}, {"'view'", "NULL", "1"}, {"'view'", "'web'", "1"}},
+		queryStrings(t, engine, "SELECT kind, source, n FROM by_kind_and_source ORDER BY kind, source"),
+	)
+
+	for _, view := range []string{"by_kind", "by_source"} {
+		_, _, err = engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW "+view+" INCREMENTALLY", nil)
+		require.ErrorIs(t, err, ErrNoIncrementalRefresh, view)
+
+		_, _, err = engine.Exec(context.Background(), nil, "REFRESH MATERIALIZED VIEW "+view, nil)
+		require.NoError(t, err, view)
+	}
+
+	require.Equal(t, [][]string{{"NULL", "1"}, {"'click'", "2"}, {"'view'", "2"}}, queryStrings(t, engine, "SELECT kind, n FROM by_kind ORDER BY kind"))
+	require.Equal(t, [][]string{{"NULL", "2"}, {"'app'", "1"}, {"'web'", "2"}}, queryStrings(t, engine, "SELECT source, n FROM by_source ORDER BY source"))
+}
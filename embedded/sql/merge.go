@@ -0,0 +1,516 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// MERGE INTO target USING source ON cond reconciles the rows of a table with
+// the ones of a source, which may be a table, a view or a subquery. For every
+// source row, the target rows satisfying cond are its matches: each match is
+// updated or deleted by the first WHEN MATCHED action whose condition holds,
+// while a source row without matches is inserted by the first WHEN NOT
+// MATCHED action whose condition holds.
+//
+// Actions are run as the UPDATE, DELETE and INSERT statements they stand for,
+// so privileges, policies, constraints and triggers apply to them as usual,
+// and the whole merge is written by a single transaction. A target row can
+// be written at most once, and rows inserted by the merge are never matched
+// by later source rows.
+
+type MergeActionKind int
+
+const (
+	MergeUpdate MergeActionKind = iota
+	MergeDelete
+	MergeInsert
+	MergeDoNothing
+)
+
+// MergeResult is the number of rows written by each kind of MERGE action.
+type MergeResult struct {
+	Inserted int
+	Updated  int
+	Deleted  int
+}
+
+type mergeAction struct {
+	matched bool
+	cond    ValueExp // nil when the action is unconditional
+	kind    MergeActionKind
+	updates []*colUpdate // set for WHEN MATCHED THEN UPDATE
+	cols    []string     // set for WHEN NOT MATCHED THEN INSERT, nil for all columns
+	values  []ValueExp   // set for WHEN NOT MATCHED THEN INSERT
+}
+
+type MergeStmt struct {
+	target  *tableRef
+	source  DataSource
+	on      ValueExp
+	actions []*mergeAction
+}
+
+func (stmt *MergeStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *MergeStmt) requiredPrivileges() []SQLPrivilege {
+	privileges := []SQLPrivilege{SQLPrivilegeSelect}
+
+	for _, action := range stmt.actions {
+		switch action.kind {
+		case MergeUpdate:
+			privileges = append(privileges, SQLPrivilegeUpdate)
+		case MergeDelete:
+			privileges = append(privileges, SQLPrivilegeDelete)
+		case MergeInsert:
+			privileges = append(privileges, SQLPrivilegeInsert)
+		}
+	}
+	return append(privileges, stmt.source.requiredPrivileges()...)
+}
+
+func (stmt *MergeStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	err := stmt.source.inferParameters(ctx, tx, params)
+	if err != nil {
+		return err
+	}
+
+	table, err := stmt.target.referencedTable(tx)
+	if err != nil {
+		return err
+	}
+
+	reader, err := stmt.sourceQuery().Resolve(ctx, tx, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	cols, err := reader.colsBySelector(ctx)
+	if err != nil {
+		return err
+	}
+
+	targetAlias := stmt.target.Alias()
+
+	for _, c := range table.cols {
+		cols[EncodeSelector("", targetAlias, c.colName)] = ColDescriptor{
+			Table:  targetAlias,
+			Column: c.colName,
+			Type:   c.colType,
+		}
+	}
+
+	err = stmt.on.requiresType(BooleanType, cols, params, targetAlias)
+	if err != nil {
+		return err
+	}
+
+	for _, action := range stmt.actions {
+		if action.cond != nil {
+			err = action.cond.requiresType(BooleanType, cols, params, targetAlias)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, u := range action.updates {
+			col, err := table.GetColumnByName(u.col)
+			if err != nil {
+				return err
+			}
+
+			err = u.val.requiresType(col.colType, cols, params, targetAlias)
+			if err != nil {
+				return err
+			}
+		}
+
+		insertCols := stmt.insertCols(table, action)
+
+		for i, val := range action.values {
+			if i >= len(insertCols) {
+				return ErrInvalidNumberOfValues
+			}
+
+			col, err := table.GetColumnByName(insertCols[i])
+			if err != nil {
+				return err
+			}
+
+			err = val.requiresType(col.colType, cols, params, reader.TableAlias())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// sourceQuery returns the query reading the source rows, as tables are
+// scanned by the select statements reading them.
+func (stmt *MergeStmt) sourceQuery() DataSource {
+	return &SelectStmt{ds: stmt.source}
+}
+
+// insertCols returns the columns set by an INSERT action.
+func (stmt *MergeStmt) insertCols(table *Table, action *mergeAction) []string {
+	if action.kind != MergeInsert || action.cols != nil {
+		return action.cols
+	}
+
+	cols := make([]string, 0, len(table.cols))
+	for _, col := range table.cols {
+		if !col.IsGenerated() {
+			cols = append(cols, col.colName)
+		}
+	}
+	return cols
+}
+
+func (stmt *MergeStmt) validate(table *Table) error {
+	if len(stmt.actions) == 0 {
+		return fmt.Errorf("%w: MERGE requires at least one WHEN clause", ErrIllegalArguments)
+	}
+
+	for _, action := range stmt.actions {
+		if action.kind != MergeInsert {
+			continue
+		}
+
+		if len(stmt.insertCols(table, action)) != len(action.values) {
+			return ErrInvalidNumberOfValues
+		}
+	}
+	return nil
+}
+
+func (stmt *MergeStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := stmt.target.referencedTable(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stmt.validate(table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkNotMaterializedView(table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stmt.checkPrivileges(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	on, err := stmt.on.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := stmt.sourceQuery().Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	m := &merger{
+		stmt:        stmt,
+		tx:          tx,
+		params:      params,
+		table:       table,
+		sourceAlias: reader.TableAlias(),
+		written:     make(map[string]struct{}),
+		inserted:    make(map[string]struct{}),
+	}
+
+	for {
+		srcRow, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.mergeRow(ctx, on, srcRow)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.mergedRows.Inserted += m.result.Inserted
+	tx.mergedRows.Updated += m.result.Updated
+	tx.mergedRows.Deleted += m.result.Deleted
+
+	return tx, nil
+}
+
+// merger keeps the state of a MERGE statement while it runs.
+type merger struct {
+	stmt   *MergeStmt
+	tx     *SQLTx
+	params map[string]interface{}
+
+	table       *Table
+	sourceAlias string
+
+	written  map[string]struct{} // encoded primary keys of the target rows updated or deleted
+	inserted map[string]struct{} // encoded primary keys of the target rows inserted
+
+	result MergeResult
+}
+
+func (m *merger) mergeRow(ctx context.Context, on ValueExp, srcRow *Row) error {
+	targetAlias := m.stmt.target.Alias()
+
+	matches, err := m.matchingRows(ctx, on.reduceSelectors(srcRow, targetAlias), srcRow)
+	if err != nil {
+		return err
+	}
+
+	if len(matches) == 0 {
+		action, err := m.actionFor(false, srcRow, m.sourceAlias)
+		if err != nil || action == nil || action.kind == MergeDoNothing {
+			return err
+		}
+		return m.insert(ctx, action, srcRow)
+	}
+
+	for _, match := range matches {
+		action, err := m.actionFor(true, match.row, targetAlias)
+		if err != nil {
+			return err
+		}
+		if action == nil || action.kind == MergeDoNothing {
+			continue
+		}
+
+		if _, written := m.written[match.pk]; written {
+			return fmt.Errorf("%w (%s)", ErrMergeRowMatchedTwice, m.table.name)
+		}
+		m.written[match.pk] = struct{}{}
+
+		if action.kind == MergeDelete {
+			err = m.delete(ctx, match)
+		} else {
+			err = m.update(ctx, action, match, srcRow)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeMatch is a target row matched by a source row.
+type mergeMatch struct {
+	pk    string
+	where ValueExp // selects the target row by its primary key
+	row   *Row     // values of the target row together with the ones of the source row
+}
+
+// matchingRows returns the target rows satisfying on, once the values of the
+// source row have been replaced in it.
+func (m *merger) matchingRows(ctx context.Context, on ValueExp, srcRow *Row) ([]*mergeMatch, error) {
+	selectStmt := &SelectStmt{
+		ds:                m.stmt.target,
+		where:             on,
+		privilegesChecked: true,
+	}
+
+	reader, err := selectStmt.Resolve(ctx, m.tx, m.params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	alias := reader.TableAlias()
+
+	var matches []*mergeMatch
+
+	for {
+		row, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		valuesByColID := make(map[uint32]TypedValue, len(m.table.cols))
+		for _, col := range m.table.cols {
+			valuesByColID[col.id] = row.ValuesBySelector[EncodeSelector("", alias, col.colName)]
+		}
+
+		pkEncVals, err := encodedKey(m.table.primaryIndex, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, inserted := m.inserted[string(pkEncVals)]; inserted {
+			continue
+		}
+
+		var where ValueExp
+
+		for _, col := range m.table.primaryIndex.cols {
+			eq := &CmpBoolExp{
+				op:    EQ,
+				left:  &ColSelector{table: alias, col: col.colName},
+				right: valuesByColID[col.id],
+			}
+			if where == nil {
+				where = eq
+			} else {
+				where = &BinBoolExp{op: AND, left: where, right: eq}
+			}
+		}
+
+		for sel, v := range srcRow.ValuesBySelector {
+			if _, exists := row.ValuesBySelector[sel]; !exists {
+				row.ValuesBySelector[sel] = v
+			}
+		}
+
+		matches = append(matches, &mergeMatch{pk: string(pkEncVals), where: where, row: row})
+	}
+	return matches, nil
+}
+
+// actionFor returns the first action for matched or not matched rows whose
+// condition holds for row, or nil when there is none.
+func (m *merger) actionFor(matched bool, row *Row, implicitTable string) (*mergeAction, error) {
+	for _, action := range m.stmt.actions {
+		if action.matched != matched {
+			continue
+		}
+
+		if action.cond == nil {
+			return action, nil
+		}
+
+		cond, err := action.cond.substitute(m.params)
+		if err != nil {
+			return nil, err
+		}
+
+		holds, isNull, err := reduceBoolValueExp(m.tx, row, implicitTable, cond)
+		if err != nil {
+			return nil, err
+		}
+		if holds && !isNull {
+			return action, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *merger) update(ctx context.Context, action *mergeAction, match *mergeMatch, srcRow *Row) error {
+	updates := make([]*colUpdate, len(action.updates))
+
+	for i, u := range action.updates {
+		val, err := u.val.substitute(m.params)
+		if err != nil {
+			return err
+		}
+
+		updates[i] = &colUpdate{
+			col: u.col,
+			op:  u.op,
+			val: val.reduceSelectors(srcRow, m.stmt.target.Alias()),
+		}
+	}
+
+	updateStmt := &UpdateStmt{
+		tableRef: m.stmt.target,
+		where:    match.where,
+		updates:  updates,
+	}
+
+	_, err := updateStmt.execAt(ctx, m.tx, m.params)
+	if err != nil {
+		return err
+	}
+
+	m.result.Updated += len(updateStmt.returnedRows)
+
+	return nil
+}
+
+func (m *merger) delete(ctx context.Context, match *mergeMatch) error {
+	deleteStmt := &DeleteFromStmt{
+		tableRef: m.stmt.target,
+		where:    match.where,
+	}
+
+	_, err := deleteStmt.execAt(ctx, m.tx, m.params)
+	if err != nil {
+		return err
+	}
+
+	m.result.Deleted += len(deleteStmt.returnedRows)
+
+	return nil
+}
+
+func (m *merger) insert(ctx context.Context, action *mergeAction, srcRow *Row) error {
+	values := make([]ValueExp, len(action.values))
+
+	for i, v := range action.values {
+		val, err := v.substitute(m.params)
+		if err != nil {
+			return err
+		}
+
+		values[i], err = val.reduce(m.tx, srcRow, m.sourceAlias)
+		if err != nil {
+			return err
+		}
+	}
+
+	insertStmt := &UpsertIntoStmt{
+		isInsert: true,
+		tableRef: m.stmt.target,
+		cols:     m.stmt.insertCols(m.table, action),
+		ds:       &valuesDataSource{rows: []*RowSpec{{Values: values}}},
+	}
+
+	_, err := insertStmt.execAt(ctx, m.tx, m.params)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range insertStmt.returnedRows {
+		pkEncVals, err := encodedKey(m.table.primaryIndex, m.table.valuesByColID(row))
+		if err != nil {
+			return err
+		}
+		m.inserted[string(pkEncVals)] = struct{}{}
+	}
+
+	m.result.Inserted += len(insertStmt.returnedRows)
+
+	return nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeStmt(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		MERGE INTO accounts a USING staging s ON a.id = s.id
+		WHEN MATCHED AND s.deleted THEN DELETE
+		WHEN MATCHED THEN UPDATE SET balance = s.balance
+		WHEN NOT MATCHED AND NOT s.deleted THEN INSERT (id, balance) VALUES (s.id, s.balance)
+		WHEN NOT MATCHED THEN DO NOTHING`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	merge, ok := stmts[0].(*MergeStmt)
+	require.True(t, ok)
+	require.Equal(t, "accounts", merge.target.table)
+	require.Equal(t, "a", merge.target.Alias())
+	require.Equal(t, "s", merge.source.(*tableRef).Alias())
+	require.Len(t, merge.actions, 4)
+
+	require.True(t, merge.actions[0].matched)
+	require.Equal(t, MergeDelete, merge.actions[0].kind)
+	require.NotNil(t, merge.actions[0].cond)
+
+	require.Equal(t, MergeUpdate, merge.actions[1].kind)
+	require.Nil(t, merge.actions[1].cond)
+	require.Len(t, merge.actions[1].updates, 1)
+
+	require.False(t, merge.actions[2].matched)
+	require.Equal(t, MergeInsert, merge.actions[2].kind)
+	require.Equal(t, []string{"id", "balance"}, merge.actions[2].cols)
+	require.Len(t, merge.actions[2].values, 2)
+
+	require.Equal(t, MergeDoNothing, merge.actions[3].kind)
+
+	_, err = ParseSQLString("MERGE INTO accounts USING staging ON accounts.id = staging.id")
+	require.Error(t, err)
+
+	_, err = ParseSQLString("MERGE INTO accounts USING staging ON accounts.id = staging.id WHEN NOT MATCHED THEN DELETE")
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	exec := func(sql string, params map[string]interface{}) (*SQLTx, error) {
+		_, txs, err := engine.Exec(ctx, nil, sql, params)
+		if err != nil {
+			return nil, err
+		}
+		return txs[len(txs)-1], nil
+	}
+
+	query := func(sql string) [][]interface{} {
+		return queryValues(t, engine, nil, sql, nil)
+	}
+
+	_, err := exec(`
+		CREATE TABLE accounts (id INTEGER, balance INTEGER NOT NULL, updates INTEGER DEFAULT 0, PRIMARY KEY id);
+		CREATE TABLE staging (id INTEGER, balance INTEGER, closed BOOLEAN, PRIMARY KEY id);
+		CREATE TABLE audit (id INTEGER AUTO_INCREMENT, account INTEGER, op VARCHAR[8], PRIMARY KEY id);
+		CREATE TRIGGER audit_update AFTER UPDATE ON accounts FOR EACH ROW INSERT INTO audit (account, op) VALUES (NEW.id, 'update');
+
+		INSERT INTO accounts (id, balance) VALUES (1, 100), (2, 200), (3, 300);
+		INSERT INTO staging (id, balance, closed) VALUES
+			(1, 150, false),
+			(2, 200, true),
+			(4, 400, false),
+			(5, 500, true);
+	`, nil)
+	require.NoError(t, err)
+
+	t.Run("conditional actions", func(t *testing.T) {
+		_, txs, err := engine.Exec(ctx, nil, `
+			MERGE INTO accounts a USING staging s ON a.id = s.id
+			WHEN MATCHED AND s.closed THEN DELETE
+			WHEN MATCHED THEN UPDATE SET balance = s.balance, updates = a.updates + 1
+			WHEN NOT MATCHED AND NOT s.closed THEN INSERT (id, balance) VALUES (s.id, s.balance)
+		`, nil)
+		require.NoError(t, err)
+
+		// the merge and the rows written by the triggers it fired are
+		// committed by a single transaction
+		require.Len(t, txs, 1)
+		tx := txs[0]
+
+		require.Equal(t, MergeResult{Inserted: 1, Updated: 1, Deleted: 1}, tx.MergedRows())
+
+		require.Equal(t,
+			[][]interface{}{{int64(1), int64(150), int64(1)}, {int64(3), int64(300), int64(0)}, {int64(4), int64(400), int64(0)}},
+			query("SELECT id, balance, updates FROM accounts"),
+		)
+
+		require.Equal(t, [][]interface{}{{int64(1), "update"}}, query("SELECT account, op FROM audit"))
+	})
+
+	t.Run("subquery source and parameters", func(t *testing.T) {
+		merge := `
+			MERGE INTO accounts USING (SELECT id, balance FROM staging WHERE balance >= @min) AS s ON accounts.id = s.id
+			WHEN MATCHED THEN UPDATE SET balance = balance + s.balance
+			WHEN NOT MATCHED THEN INSERT VALUES (s.id, s.balance * 2, @updates)
+		`
+
+		params, err := engine.InferParameters(ctx, nil, merge)
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"min": IntegerType, "updates": IntegerType}, params)
+
+		tx, err := exec(merge, map[string]interface{}{"min": 400, "updates": 7})
+		require.NoError(t, err)
+		require.Equal(t, MergeResult{Inserted: 1, Updated: 1}, tx.MergedRows())
+
+		require.Equal(t,
+			[][]interface{}{{int64(4), int64(800)}, {int64(5), int64(1000)}},
+			query("SELECT id, balance FROM accounts WHERE id >= 4"),
+		)
+		require.Equal(t, [][]interface{}{{int64(7)}}, query("SELECT updates FROM accounts WHERE id = 5"))
+	})
+
+	t.Run("a row can be written once", func(t *testing.T) {
+		_, err := exec(`
+			MERGE INTO accounts a USING staging s ON a.id = 1
+			WHEN MATCHED THEN UPDATE SET balance = s.balance
+		`, nil)
+		require.ErrorIs(t, err, ErrMergeRowMatchedTwice)
+
+		require.Equal(t, [][]interface{}{{int64(150)}}, query("SELECT balance FROM accounts WHERE id = 1"))
+
+		// matching rows more than once is fine when they are not written
+		tx, err := exec(`
+			MERGE INTO accounts a USING staging s ON a.id = 1
+			WHEN MATCHED AND s.id = 4 THEN UPDATE SET balance = 0
+		`, nil)
+		require.NoError(t, err)
+		require.Equal(t, MergeResult{Updated: 1}, tx.MergedRows())
+	})
+
+	t.Run("rows inserted by the merge are not matched", func(t *testing.T) {
+		_, err = exec(`
+			CREATE TABLE counters (name VARCHAR[8], n INTEGER, PRIMARY KEY name);
+			CREATE TABLE events (id INTEGER, name VARCHAR[8], PRIMARY KEY id);
+			INSERT INTO events (id, name) VALUES (1, 'a'), (2, 'a'), (3, 'b');
+		`, nil)
+		require.NoError(t, err)
+
+		_, err := exec(`
+			MERGE INTO counters c USING events e ON c.name = e.name
+			WHEN MATCHED THEN UPDATE SET n = n + 1
+			WHEN NOT MATCHED THEN INSERT (name, n) VALUES (e.name, 1)
+		`, nil)
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+	})
+
+	t.Run("invalid statements", func(t *testing.T) {
+		_, err := exec(`
+			MERGE INTO accounts a USING staging s ON a.id = s.id
+			WHEN NOT MATCHED THEN INSERT (id) VALUES (s.id, s.balance)
+		`, nil)
+		require.ErrorIs(t, err, ErrInvalidNumberOfValues)
+
+		_, err = exec(`
+			MERGE INTO missing m USING staging s ON m.id = s.id
+			WHEN MATCHED THEN DELETE
+		`, nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+}
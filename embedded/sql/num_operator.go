@@ -19,9 +19,20 @@ package sql
 import (
 	"fmt"
 	"math"
+	"math/big"
+	"time"
 )
 
 func applyNumOperator(op NumOperator, vl, vr TypedValue) (TypedValue, error) {
+	if IsDateTimeType(vl.Type()) || IsDateTimeType(vr.Type()) {
+		return applyDateTimeOperator(op, vl, vr)
+	}
+
+	// DECIMAL operands keep exact arithmetic, FLOAT operands are taken
+	// at their shortest decimal representation
+	if vl.Type() == DecimalType || vr.Type() == DecimalType {
+		return applyNumOperatorDecimal(op, vl, vr)
+	}
 	if vl.Type() == Float64Type || vr.Type() == Float64Type {
 		return applyNumOperatorFloat64(op, vl, vr)
 	}
@@ -137,3 +148,216 @@ func applyNumOperatorFloat64(op NumOperator, vl, vr TypedValue) (TypedValue, err
 
 	return nil, ErrUnexpected
 }
+
+func applyNumOperatorDecimal(op NumOperator, vl, vr TypedValue) (TypedValue, error) {
+	convl, err := mayApplyImplicitConversion(vl.RawValue(), DecimalType)
+	if err != nil {
+		return nil, fmt.Errorf("%w (expecting numeric value)", err)
+	}
+
+	nl, isNumber := convl.(*Decimal)
+	if !isNumber {
+		return nil, fmt.Errorf("%w (expecting numeric value)", ErrInvalidValue)
+	}
+
+	convr, err := mayApplyImplicitConversion(vr.RawValue(), DecimalType)
+	if err != nil {
+		return nil, fmt.Errorf("%w (expecting numeric value)", err)
+	}
+
+	nr, isNumber := convr.(*Decimal)
+	if !isNumber {
+		return nil, fmt.Errorf("%w (expecting numeric value)", ErrInvalidValue)
+	}
+
+	switch op {
+	case ADDOP:
+		{
+			l, r := alignDecimals(nl, nr)
+			return &Decimal{val: new(big.Int).Add(l, r), scale: max(nl.scale, nr.scale)}, nil
+		}
+	case SUBSOP:
+		{
+			l, r := alignDecimals(nl, nr)
+			return &Decimal{val: new(big.Int).Sub(l, r), scale: max(nl.scale, nr.scale)}, nil
+		}
+	case DIVOP:
+		{
+			if nr.val.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+
+			scale := max(nl.scale, nr.scale, decimalDivScale)
+
+			// (l / 10^ls) / (r / 10^rs) = l * 10^(scale-ls+rs) / r / 10^scale
+			n := new(big.Int).Mul(nl.val, pow10(scale-nl.scale+nr.scale))
+
+			return &Decimal{val: divRound(n, nr.val), scale: scale}, nil
+		}
+	case MODOP:
+		{
+			if nr.val.Sign() == 0 {
+				return nil, ErrDivisionByZero
+			}
+
+			l, r := alignDecimals(nl, nr)
+			return &Decimal{val: new(big.Int).Rem(l, r), scale: max(nl.scale, nr.scale)}, nil
+		}
+	case MULTOP:
+		{
+			scale := nl.scale + nr.scale
+			if scale > decimalMaxScale {
+				return nil, fmt.Errorf("%w: decimal scale exceeds %d digits", ErrNumericValueOutOfRange, decimalMaxScale)
+			}
+			return &Decimal{val: new(big.Int).Mul(nl.val, nr.val), scale: scale}, nil
+		}
+	}
+
+	return nil, ErrUnexpected
+}
+
+// dateTimeOperatorType returns the type of the result of applying op to
+// operands of types tl and tr, when at least one of them is a date/time
+// type, together with the types the operands are taken as. An operand
+// of unknown type is taken as the type the other operand is most often
+// combined with e.g. INTERVAL for timestamp + @p.
+func dateTimeOperatorType(op NumOperator, tl, tr SQLValueType) (t, tleft, tright SQLValueType, ok bool) {
+	if tl == AnyType {
+		tl = dateTimeOperandType(op, tr, true)
+	}
+	if tr == AnyType {
+		tr = dateTimeOperandType(op, tl, false)
+	}
+
+	isTime := func(t SQLValueType) bool {
+		return t == TimestampType || t == DateType
+	}
+
+	switch op {
+	case ADDOP:
+		switch {
+		case isTime(tl) && tr == IntervalType, tl == IntervalType && isTime(tr):
+			return TimestampType, tl, tr, true
+		case tl == DateType && tr == IntegerType, tl == IntegerType && tr == DateType:
+			return DateType, tl, tr, true
+		case tl == IntervalType && tr == IntervalType:
+			return IntervalType, tl, tr, true
+		}
+	case SUBSOP:
+		switch {
+		case isTime(tl) && tr == IntervalType:
+			return TimestampType, tl, tr, true
+		case tl == DateType && tr == DateType:
+			// number of days between both dates
+			return IntegerType, tl, tr, true
+		case isTime(tl) && isTime(tr):
+			return IntervalType, tl, tr, true
+		case tl == DateType && tr == IntegerType:
+			return DateType, tl, tr, true
+		case tl == IntervalType && tr == IntervalType:
+			return IntervalType, tl, tr, true
+		}
+	case MULTOP:
+		if (tl == IntervalType && IsNumericType(tr)) || (IsNumericType(tl) && tr == IntervalType) {
+			return IntervalType, tl, tr, true
+		}
+	case DIVOP:
+		if tl == IntervalType && IsNumericType(tr) {
+			return IntervalType, tl, tr, true
+		}
+	}
+
+	return AnyType, tl, tr, false
+}
+
+func dateTimeOperandType(op NumOperator, other SQLValueType, left bool) SQLValueType {
+	switch other {
+	case TimestampType, DateType:
+		if op == SUBSOP && left {
+			return TimestampType
+		}
+		return IntervalType
+	case IntervalType:
+		if op == MULTOP || op == DIVOP {
+			return Float64Type
+		}
+		if left {
+			return TimestampType
+		}
+		return IntervalType
+	}
+	return other
+}
+
+func applyDateTimeOperator(op NumOperator, vl, vr TypedValue) (TypedValue, error) {
+	t, _, _, ok := dateTimeOperatorType(op, vl.Type(), vr.Type())
+	if !ok {
+		return nil, fmt.Errorf("%w: operator %s is not defined for %v and %v", ErrInvalidTypes, NumOperatorString(op), vl.Type(), vr.Type())
+	}
+
+	if vl.IsNull() || vr.IsNull() {
+		return &NullValue{t: t}, nil
+	}
+
+	switch {
+	case op == MULTOP || op == DIVOP:
+		iv, n := vl, vr
+		if vr.Type() == IntervalType {
+			iv, n = vr, vl
+		}
+
+		convN, err := mayApplyImplicitConversion(unwrapJSON(n).RawValue(), Float64Type)
+		if err != nil {
+			return nil, fmt.Errorf("%w (expecting numeric value)", err)
+		}
+
+		f, isNumber := convN.(float64)
+		if !isNumber {
+			return nil, fmt.Errorf("%w (expecting numeric value)", ErrInvalidValue)
+		}
+
+		if op == DIVOP {
+			if f == 0 {
+				return nil, ErrDivisionByZero
+			}
+			f = 1 / f
+		}
+
+		return iv.RawValue().(*Interval).mul(f)
+	case vl.Type() == IntervalType && vr.Type() == IntervalType:
+		l, r := vl.RawValue().(*Interval), vr.RawValue().(*Interval)
+		if op == SUBSOP {
+			r = r.negate()
+		}
+		return l.add(r)
+	case t == IntervalType:
+		return intervalBetween(vl.RawValue().(time.Time), vr.RawValue().(time.Time)), nil
+	case t == IntegerType:
+		micros := TimeToInt64(vl.RawValue().(time.Time)) - TimeToInt64(vr.RawValue().(time.Time))
+		return &Integer{val: micros / microsPerDay}, nil
+	case t == DateType:
+		d, n := vl, vr
+		if vl.Type() == IntegerType {
+			d, n = vr, vl
+		}
+
+		days := n.RawValue().(int64)
+		if op == SUBSOP {
+			days = -days
+		}
+		return NewDate(d.RawValue().(time.Time).AddDate(0, 0, int(days))), nil
+	}
+
+	// timestamp or date plus or minus an interval
+	ts, iv := vl, vr
+	if vl.Type() == IntervalType {
+		ts, iv = vr, vl
+	}
+
+	delta := iv.RawValue().(*Interval)
+	if op == SUBSOP {
+		delta = delta.negate()
+	}
+
+	return &Timestamp{val: delta.addTo(ts.RawValue().(time.Time))}, nil
+}
@@ -23,8 +23,10 @@ import (
 )
 
 const (
-	defaultDistinctLimit  = 1 << 20 // ~ 1mi rows
-	defaultSortBufferSize = 1024
+	defaultDistinctLimit            = 1 << 20 // ~ 1mi rows
+	defaultSortBufferSize           = 1024
+	defaultColumnMigrationBatchSize = 1000
+	defaultStmtCacheSize            = 1000
 )
 
 type Options struct {
@@ -37,6 +39,9 @@ type Options struct {
 	autocommit                    bool
 	lazyIndexConstraintValidation bool
 	parseTxMetadata               func([]byte) (map[string]interface{}, error)
+	columnMigrationBatchSize      int
+	resumeColumnMigrations        bool
+	stmtCacheSize                 int // 0 = statement cache disabled
 
 	multidbHandler MultiDBHandler
 	tableResolvers []TableResolver
@@ -44,8 +49,11 @@ type Options struct {
 
 func DefaultOptions() *Options {
 	return &Options{
-		sortBufferSize: defaultSortBufferSize,
-		distinctLimit:  defaultDistinctLimit,
+		sortBufferSize:           defaultSortBufferSize,
+		distinctLimit:            defaultDistinctLimit,
+		columnMigrationBatchSize: defaultColumnMigrationBatchSize,
+		resumeColumnMigrations:   true,
+		stmtCacheSize:            defaultStmtCacheSize,
 	}
 }
 
@@ -62,6 +70,14 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("%w: invalid SortBufferSize value", store.ErrInvalidOptions)
 	}
 
+	if opts.columnMigrationBatchSize <= 0 {
+		return fmt.Errorf("%w: invalid ColumnMigrationBatchSize value", store.ErrInvalidOptions)
+	}
+
+	if opts.stmtCacheSize < 0 {
+		return fmt.Errorf("%w: invalid StmtCacheSize value", store.ErrInvalidOptions)
+	}
+
 	// 0 means "leave the package default" (no override). Anything explicit
 	// must fit a uint16 length-prefix (the on-disk encoding ceiling) and
 	// be at least wide enough for the small system PKs.
@@ -127,6 +143,29 @@ func (opts *Options) WithMaxWindowRows(maxRows int) *Options {
 	return opts
 }
 
+// WithColumnMigrationBatchSize specifies the number of rows rewritten by each
+// of the transactions changing the type of a column in the background with
+// ALTER TABLE ... ALTER COLUMN ... TYPE. The default value is 1000.
+func (opts *Options) WithColumnMigrationBatchSize(size int) *Options {
+	opts.columnMigrationBatchSize = size
+	return opts
+}
+
+// WithResumeColumnMigrations specifies whether interrupted column migrations
+// are resumed when the engine is created. The default value is true.
+func (opts *Options) WithResumeColumnMigrations(resume bool) *Options {
+	opts.resumeColumnMigrations = resume
+	return opts
+}
+
+// WithStmtCacheSize specifies the number of SQL texts kept in the parse cache,
+// which saves parsing and parameter inference but not planning, as queries are
+// still resolved on every execution. The default value is 1000, zero disables it.
+func (opts *Options) WithStmtCacheSize(size int) *Options {
+	opts.stmtCacheSize = size
+	return opts
+}
+
 func (opts *Options) WithParseTxMetadataFunc(parseFunc func([]byte) (map[string]interface{}, error)) *Options {
 	opts.parseTxMetadata = parseFunc
 	return opts
@@ -48,5 +48,17 @@ func TestOptions(t *testing.T) {
 	opts.WithSortBufferSize(defaultSortBufferSize)
 	require.Equal(t, opts.sortBufferSize, defaultSortBufferSize)
 
+	opts.WithColumnMigrationBatchSize(0)
+	require.Error(t, opts.Validate())
+
+	opts.WithColumnMigrationBatchSize(defaultColumnMigrationBatchSize)
+	require.Equal(t, defaultColumnMigrationBatchSize, opts.columnMigrationBatchSize)
+
+	opts.WithStmtCacheSize(-1)
+	require.Error(t, opts.Validate())
+
+	opts.WithStmtCacheSize(defaultStmtCacheSize)
+	require.Equal(t, defaultStmtCacheSize, opts.stmtCacheSize)
+
 	require.NoError(t, opts.Validate())
 }
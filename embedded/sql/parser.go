@@ -76,6 +76,8 @@ var keywords = map[string]int{
 	"FROM":           FROM,
 	"UNION":          UNION,
 	"ALL":            ALL,
+	"ANY":            ANY,
+	"ARRAY":          ARRAY,
 	"EXCEPT":         EXCEPT,
 	"INTERSECT":      INTERSECT,
 	"NULLS":          NULLS,
@@ -91,12 +93,48 @@ var keywords = map[string]int{
 	"ILIKE":          ILIKE,
 	"DEFAULT":        DEFAULT,
 	"FETCH":          FETCH,
+	"DECLARE":        DECLARE,
+	"CURSOR":         CURSOR,
+	"CLOSE":          CLOSE,
 	"ROWS":           ROWS,
 	"ONLY":           ONLY,
 	"FOREIGN":        FOREIGN,
 	"REFERENCES":     REFERENCES,
 	"CASCADE":        CASCADE,
 	"SEQUENCE":       SEQUENCE,
+	"POLICY":         POLICY,
+	"MATERIALIZED":   MATERIALIZED,
+	"REFRESH":        REFRESH,
+	"INCREMENTALLY":  INCREMENTALLY,
+	"TRIGGER":        TRIGGER,
+	"EACH":           EACH,
+	"ROW":            ROW,
+	"ANALYZE":        ANALYZE,
+	"GENERATED":      GENERATED,
+	"ALWAYS":         ALWAYS,
+	"STORED":         STORED,
+	"VIRTUAL":        VIRTUAL,
+	"FULLTEXT":       FULLTEXT,
+	"SCHEMA":         SCHEMA,
+	"MERGE":          MERGE,
+	"MATCHED":        MATCHED,
+	"FUNCTION":       FUNCTION,
+	"PROCEDURE":      PROCEDURE,
+	"RETURNS":        RETURNS,
+	"LANGUAGE":       LANGUAGE,
+	"CALL":           CALL,
+	"WINDOW":         WINDOW,
+	"RANGE":          RANGE,
+	"PRECEDING":      PRECEDING,
+	"FOLLOWING":      FOLLOWING,
+	"UNBOUNDED":      UNBOUNDED,
+	"CURRENT":        CURRENT,
+	"GROUPING":       GROUPING,
+	"SETS":           SETS,
+	"ROLLUP":         ROLLUP,
+	"CUBE":           CUBE,
+	"FILTER":         FILTER,
+	"WITHIN":         WITHIN,
 	"TX":             TX,
 	"JOIN":           JOIN,
 	"HAVING":         HAVING,
@@ -157,14 +195,15 @@ var keywords = map[string]int{
 	"VARCHAR":        VARCHAR_TYPE,
 	"TIMESTAMP":      TIMESTAMP_TYPE,
 	"TIMESTAMPTZ":    TIMESTAMP_TYPE,
-	"DATE":           TIMESTAMP_TYPE,
+	"DATE":           DATE_TYPE,
+	"INTERVAL":       INTERVAL_TYPE,
 	"FLOAT":          FLOAT_TYPE,
 	"FLOAT4":         FLOAT_TYPE,
 	"FLOAT8":         FLOAT_TYPE,
 	"DOUBLE":         FLOAT_TYPE,
 	"REAL":           FLOAT_TYPE,
-	"NUMERIC":        FLOAT_TYPE,
-	"DECIMAL":        FLOAT_TYPE,
+	"NUMERIC":        DECIMAL_TYPE,
+	"DECIMAL":        DECIMAL_TYPE,
 	"BLOB":           BLOB_TYPE,
 	"BYTEA":          BLOB_TYPE,
 	"UUID":           UUID_TYPE,
@@ -176,6 +215,9 @@ var keywords = map[string]int{
 	"HOUR":           HOUR,
 	"MINUTE":         MINUTE,
 	"SECOND":         SECOND,
+
+	"CURRENT_DATE":      CURRENT_DATE,
+	"CURRENT_TIMESTAMP": CURRENT_TIMESTAMP,
 }
 
 var joinTypes = map[string]JoinType{
@@ -193,6 +235,28 @@ var aggregateFns = map[string]AggregateFn{
 	"MIN":        MIN,
 	"AVG":        AVG,
 	"STRING_AGG": STRING_AGG,
+	"ARRAY_AGG":  ARRAY_AGG,
+
+	"STDDEV":          STDDEV,
+	"STDDEV_POP":      STDDEV_POP,
+	"STDDEV_SAMP":     STDDEV_SAMP,
+	"VARIANCE":        VARIANCE,
+	"VAR_POP":         VAR_POP,
+	"VAR_SAMP":        VAR_SAMP,
+	"PERCENTILE_CONT": PERCENTILE_CONT,
+	"PERCENTILE_DISC": PERCENTILE_DISC,
+	"MODE":            MODE,
+	"BOOL_AND":        BOOL_AND,
+	"BOOL_OR":         BOOL_OR,
+	"JSON_AGG":        JSON_AGG,
+	"JSON_OBJECT_AGG": JSON_OBJECT_AGG,
+}
+
+// callOnlyAggregateFns holds the aggregate functions whose names are common
+// column names: they are only lexed as such when immediately followed by an
+// opening parenthesis.
+var callOnlyAggregateFns = map[AggregateFn]bool{
+	MODE: true,
 }
 
 var boolValues = map[string]bool{
@@ -228,6 +292,7 @@ type lexer struct {
 	namedParamsType positionalParamType
 	paramsCount     int
 	result          []SQLStmt
+	tokenStart      int // offset of the token lexed last
 }
 
 type aheadByteReader struct {
@@ -235,6 +300,7 @@ type aheadByteReader struct {
 	nextErr   error
 	r         io.ByteReader
 	readCount int
+	read      []byte // bytes read so far, see lexer.sourceText
 }
 
 func newAheadByteReader(r io.ByteReader) *aheadByteReader {
@@ -244,6 +310,10 @@ func newAheadByteReader(r io.ByteReader) *aheadByteReader {
 }
 
 func (ar *aheadByteReader) ReadByte() (byte, error) {
+	if ar.nextErr == nil {
+		ar.read = append(ar.read, ar.nextChar)
+	}
+
 	defer func() {
 		if ar.nextErr == nil {
 			ar.nextChar, ar.nextErr = ar.r.ReadByte()
@@ -294,6 +364,20 @@ func newLexer(r io.ByteReader) *lexer {
 	}
 }
 
+// sourceText returns the text read from offset from up to the start of
+// the token lexed last.
+func (l *lexer) sourceText(from int) string {
+	return l.sourceTextUntil(from, l.tokenStart)
+}
+
+// sourceTextUntil returns the text read from offset from up to offset to.
+func (l *lexer) sourceTextUntil(from, to int) string {
+	if from < 0 || from > to || to > len(l.r.read) {
+		return ""
+	}
+	return strings.TrimSpace(string(l.r.read[from:to]))
+}
+
 func (l *lexer) Lex(lval *yySymType) int {
 	var ch byte
 	var err error
@@ -301,6 +385,7 @@ func (l *lexer) Lex(lval *yySymType) int {
 	for {
 		ch, err = l.r.ReadByte()
 		if err == io.EOF {
+			l.tokenStart = len(l.r.read)
 			return 0
 		}
 		if err != nil {
@@ -363,15 +448,28 @@ func (l *lexer) Lex(lval *yySymType) int {
 		}
 	}
 
+	l.tokenStart = len(l.r.read) - 1
+	lval.pos = l.tokenStart
+
 	if isSeparator(ch) {
 		return STMT_SEPARATOR
 	}
 
 	if ch == '-' && l.r.nextChar == '>' {
 		l.r.ReadByte()
+
+		if l.r.nextChar == '>' {
+			l.r.ReadByte()
+			return ARROW_TEXT
+		}
 		return ARROW
 	}
 
+	if ch == '@' && l.r.nextChar == '>' {
+		l.r.ReadByte()
+		return CONTAINS_OP
+	}
+
 	if isBLOBPrefix(ch) && isQuote(l.r.nextChar) {
 		l.r.ReadByte() // consume starting quote
 
@@ -408,6 +506,10 @@ func (l *lexer) Lex(lval *yySymType) int {
 		}
 
 		afn, ok := aggregateFns[tid]
+		if ok && callOnlyAggregateFns[afn] {
+			nextCh, _ := l.r.NextByte()
+			ok = nextCh == '('
+		}
 		if ok {
 			lval.aggFn = afn
 			return AGGREGATE_FUNC
@@ -565,6 +667,17 @@ func (l *lexer) Lex(lval *yySymType) int {
 		return NPARAM
 	}
 
+	if ch == '$' && l.r.nextChar == '$' {
+		str, err := l.readDollarQuotedString()
+		if err != nil {
+			lval.err = err
+			return ERROR
+		}
+
+		lval.str = str
+		return VARCHAR_LIT
+	}
+
 	if ch == '$' {
 		if l.namedParamsType == UnnamedParamType {
 			lval.err = ErrEitherNamedOrUnnamedParams
@@ -657,10 +770,14 @@ var tokenFriendlyNames = strings.NewReplacer(
 	"BOOLEAN_TYPE", "BOOLEAN",
 	"BLOB_TYPE", "BLOB",
 	"FLOAT_TYPE", "FLOAT",
+	"DECIMAL_TYPE", "DECIMAL",
 	"TIMESTAMP_TYPE", "TIMESTAMP",
+	"DATE_TYPE", "DATE",
+	"INTERVAL_TYPE", "INTERVAL",
 	"UUID_TYPE", "UUID",
 	"JSON_TYPE", "JSON",
 	"AGGREGATE_FUNC", "aggregate function",
+	"CONTAINS_OP", "'@>'",
 	"STMT_SEPARATOR", "';'",
 )
 
@@ -703,6 +820,31 @@ func (l *lexer) readString() (string, error) {
 	return b.String(), nil
 }
 
+// readDollarQuotedString reads the content of a string quoted as $$...$$,
+// which is taken as it is, without escapes.
+func (l *lexer) readDollarQuotedString() (string, error) {
+	l.r.ReadByte() // consume second dollar
+
+	var b bytes.Buffer
+
+	for {
+		ch, err := l.r.ReadByte()
+		if err == io.EOF {
+			return "", fmt.Errorf("unterminated dollar-quoted string")
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if ch == '$' && l.r.nextChar == '$' {
+			l.r.ReadByte() // consume closing dollar
+			return b.String(), nil
+		}
+
+		b.WriteByte(ch)
+	}
+}
+
 func (l *lexer) readComparison() (string, error) {
 	return l.readWhile(func(ch byte) bool {
 		return isComparison(ch)
@@ -779,12 +921,18 @@ func newCreateTableStmt(
 ) *CreateTableStmt {
 	colsSpecs := make([]*ColSpec, 0, 5)
 	var checks []CheckConstraint
+	var foreignKeys []*ForeignKeyConstraint
 
 	var pk PrimaryKeyConstraint
 	for _, e := range elems {
 		switch c := e.(type) {
 		case *ColSpec:
 			colsSpecs = append(colsSpecs, c)
+
+			if c.references != nil {
+				c.references.cols = []string{c.colName}
+				foreignKeys = append(foreignKeys, c.references)
+			}
 		case PrimaryKeyConstraint:
 			pk = c
 		case CheckConstraint:
@@ -792,6 +940,8 @@ func newCreateTableStmt(
 				checks = make([]CheckConstraint, 0, 5)
 			}
 			checks = append(checks, c)
+		case *ForeignKeyConstraint:
+			foreignKeys = append(foreignKeys, c)
 		}
 	}
 
@@ -801,5 +951,6 @@ func newCreateTableStmt(
 		colsSpec:    colsSpecs,
 		pkColNames:  pk,
 		checks:      checks,
+		foreignKeys: foreignKeys,
 	}
 }
@@ -59,17 +59,18 @@ func TestCommentOnlyInput(t *testing.T) {
 
 func TestDateTypedLiteral(t *testing.T) {
 	// PostgreSQL / SQL-92 typed literal syntax: `DATE '…'`, `TIMESTAMP '…'`,
-	// `TIMESTAMPTZ '…'`. Lexer maps all three keywords to TIMESTAMP_TYPE,
-	// so a single grammar production (val: TIMESTAMP_TYPE VARCHAR_LIT)
-	// covers them. The production lowers to the same AST as
-	// `CAST('…' AS TIMESTAMP)` — the engine's VarcharType→TimestampType
-	// converter does the actual parsing at execution time.
+	// `TIMESTAMPTZ '…'` and `INTERVAL '…'`. TIMESTAMP and TIMESTAMPTZ map to
+	// TIMESTAMP_TYPE while DATE and INTERVAL have their own tokens. Each
+	// production lowers to the same AST as `CAST('…' AS <type>)` — the
+	// engine's VarcharType converters do the actual parsing at execution time.
 	testCases := []string{
 		"SELECT DATE '2025-01-01'",
 		"SELECT TIMESTAMP '2025-01-01 12:00:00'",
 		"SELECT TIMESTAMPTZ '2025-01-01 12:00:00+02'",
+		"SELECT INTERVAL '7 days'",
 		"INSERT INTO t (dob) VALUES (DATE '2025-01-01')",
 		"SELECT * FROM t WHERE dob = DATE '2025-01-01'",
+		"SELECT * FROM t WHERE ts > NOW() - INTERVAL '1 hour' AND dob < CURRENT_DATE",
 	}
 
 	for _, input := range testCases {
@@ -357,7 +358,7 @@ func TestCreateTableStmt(t *testing.T) {
 		{
 			input:          "CREATE TABLE table1",
 			expectedOutput: []SQLStmt{&CreateTableStmt{table: "table1"}},
-			expectedError:  errors.New("syntax error: unexpected $end, expecting '(' at position 20"),
+			expectedError:  errors.New("syntax error: unexpected $end, expecting PARTITION or '(' at position 20"),
 		},
 		{
 			input:          "CREATE TABLE table1()",
@@ -507,7 +508,7 @@ func TestAlterTable(t *testing.T) {
 		{
 			input:          "ALTER TABLE table1 COLUMN title VARCHAR",
 			expectedOutput: nil,
-			expectedError:  errors.New("syntax error: unexpected COLUMN, expecting DROP or ALTER or ADD or RENAME at position 25"),
+			expectedError:  errors.New("syntax error: unexpected COLUMN at position 25"),
 		},
 		{
 			input: "ALTER TABLE table1 RENAME COLUMN title TO newtitle",
@@ -1029,6 +1030,53 @@ func TestSelectStmt(t *testing.T) {
 				}},
 			expectedError: nil,
 		},
+		{
+			input: "SELECT id FROM table1 AS OF TIMESTAMP '2026-01-01' AS t1",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					targets: []TargetEntry{
+						{Exp: &ColSelector{col: "id"}},
+					},
+					ds: &tableRef{
+						table: "table1",
+						as:    "t1",
+						period: period{
+							end: &openPeriod{
+								inclusive: true,
+								instant:   periodInstant{instantType: timeInstant, exp: &Varchar{val: "2026-01-01"}},
+							},
+						},
+					},
+				}},
+		},
+		{
+			input: "SELECT id FROM table1 FOR SYSTEM_TIME BETWEEN @from AND @to",
+			expectedOutput: []SQLStmt{
+				&SelectStmt{
+					targets: []TargetEntry{
+						{Exp: &ColSelector{col: "id"}},
+					},
+					ds: &tableRef{
+						table: "table1",
+						period: period{
+							start: &openPeriod{
+								inclusive: true,
+								instant:   periodInstant{instantType: timeInstant, exp: &Param{id: "from"}},
+							},
+							end: &openPeriod{
+								inclusive: true,
+								instant:   periodInstant{instantType: timeInstant, exp: &Param{id: "to"}},
+							},
+							systemTime: true,
+						},
+					},
+				}},
+		},
+		{
+			input:          "SELECT id FROM table1 FOR SYSTEM_VERSION BETWEEN @from AND @to",
+			expectedOutput: nil,
+			expectedError:  errors.New("expected SYSTEM_TIME keyword at position 63"),
+		},
 		{
 			input: "SELECT t1.id, title FROM table1 t1",
 			expectedOutput: []SQLStmt{
@@ -2153,6 +2201,36 @@ func TestGrantRevokeStmt(t *testing.T) {
 				privileges: allPrivileges,
 			},
 		},
+		{
+			text: "GRANT SELECT (id, name), DELETE ON TABLE mytable TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table: "mytable",
+				user:  "immudb",
+				privileges: []*privilegeSpec{
+					{privilege: SQLPrivilegeDelete},
+					{privilege: SQLPrivilegeSelect, cols: []string{"id", "name"}},
+				},
+				isGrant: true,
+			},
+		},
+		{
+			text: "REVOKE UPDATE (name) ON mytable FROM USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table: "mytable",
+				user:  "immudb",
+				privileges: []*privilegeSpec{
+					{privilege: SQLPrivilegeUpdate, cols: []string{"name"}},
+				},
+			},
+		},
+		{
+			text: "GRANT ALL PRIVILEGES ON TABLE mytable TO USER immudb",
+			expectedStmt: &AlterTablePrivilegesStmt{
+				table:   "mytable",
+				user:    "immudb",
+				isGrant: true,
+			},
+		},
 	}
 
 	for i, tc := range cases {
@@ -2163,6 +2241,9 @@ func TestGrantRevokeStmt(t *testing.T) {
 			require.Equal(t, tc.expectedStmt, stmts[0])
 		})
 	}
+
+	_, err := ParseSQLString("GRANT SELECT (id) ON DATABASE defaultdb TO USER immudb")
+	require.ErrorContains(t, err, "column privileges can only be granted on tables")
 }
 
 func TestExpString(t *testing.T) {
@@ -0,0 +1,696 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// partitionKey is the column the rows of a partitioned table are
+// distributed by. A partitioned table holds no rows of its own, they are
+// stored in its partitions.
+type partitionKey struct {
+	colID uint32
+}
+
+// partitionBound is the range of values of the partitioning column held
+// by a partition, from inclusive to exclusive.
+type partitionBound struct {
+	parentID uint32
+	colID    uint32
+	from     TypedValue
+	to       TypedValue
+}
+
+// partitionSpec is the PARTITION OF clause of CREATE TABLE.
+type partitionSpec struct {
+	table string
+	from  ValueExp
+	to    ValueExp
+}
+
+// partitioned reports whether t is a partitioned table or one of its
+// partitions.
+func (t *Table) partitioned() bool {
+	return t.partitioning != nil || t.partitionOf != nil
+}
+
+func checkNotPartitioned(table *Table) error {
+	if table.partitioning != nil {
+		return fmt.Errorf("%w: columns of partitioned table '%s' can not be altered", ErrIllegalArguments, table.name)
+	}
+
+	if table.partitionOf != nil {
+		return fmt.Errorf("%w: columns of partition '%s' can not be altered", ErrIllegalArguments, table.name)
+	}
+	return nil
+}
+
+// partitionByRange makes t a table partitioned by the ranges of values of
+// the column colName, which must be part of the primary key so that a row
+// never moves from one partition to another.
+func (t *Table) partitionByRange(colName string) error {
+	col, err := t.GetColumnByName(colName)
+	if err != nil {
+		return err
+	}
+
+	switch col.colType {
+	case IntegerType, TimestampType, DateType:
+	default:
+		return fmt.Errorf("%w: column '%s' of type %s can not partition a table", ErrInvalidPartition, col.colName, col.colType)
+	}
+
+	if _, isPK := t.primaryIndex.colsByID[col.id]; !isPK {
+		return fmt.Errorf("%w: partitioning column '%s' must be part of the primary key", ErrInvalidPartition, col.colName)
+	}
+
+	for _, c := range t.cols {
+		if c.autoIncrement {
+			return fmt.Errorf("%w: partitioned tables can not have auto-incremental columns", ErrInvalidPartition)
+		}
+	}
+
+	t.partitioning = &partitionKey{colID: col.id}
+
+	return nil
+}
+
+// partitions returns the partitions of t ordered by their bounds.
+func (t *Table) partitions() []*Table {
+	return t.partitionList
+}
+
+// setPartitionOf attaches t to the partitioned table of bound, or detaches
+// it from its current one when bound is nil.
+func (t *Table) setPartitionOf(bound *partitionBound) {
+	prev := t.partitionOf
+	t.partitionOf = bound
+
+	if prev != nil {
+		t.catalog.refreshPartitions(prev.parentID)
+	}
+	if bound != nil {
+		t.catalog.refreshPartitions(bound.parentID)
+	}
+}
+
+// refreshPartitions rebuilds the ordered list of the partitions of the table
+// with the given id, so rows are routed without scanning the catalog. The
+// catalog is only changed by the transaction owning it, while the lists are
+// read concurrently when the catalog is shared, so they are rebuilt eagerly
+// whenever a partition is attached, detached or dropped.
+func (catlg *Catalog) refreshPartitions(tableID uint32) {
+	t, exists := catlg.tablesByID[tableID]
+	if !exists || t.partitioning == nil {
+		return
+	}
+
+	var partitions []*Table
+
+	for _, p := range catlg.tables {
+		if p.partitionOf != nil && p.partitionOf.parentID == t.id {
+			partitions = append(partitions, p)
+		}
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		cmp, _ := partitions[i].partitionOf.from.Compare(partitions[j].partitionOf.from)
+		return cmp < 0
+	})
+
+	t.partitionList = partitions
+}
+
+// refreshAllPartitions rebuilds the partition lists of every partitioned
+// table, once the tables of the catalog are loaded or cloned.
+func (catlg *Catalog) refreshAllPartitions() {
+	for _, t := range catlg.tables {
+		if t.partitioning != nil {
+			catlg.refreshPartitions(t.id)
+		}
+	}
+}
+
+// prunedPartitions returns the partitions of t which may hold rows within
+// the ranges of values of the partitioning column.
+func (t *Table) prunedPartitions(rangesByColID map[uint32]*typedValueRange) []*Table {
+	partitions := t.partitions()
+
+	pruned := make([]*Table, 0, len(partitions))
+
+	colRange := rangesByColID[t.partitioning.colID]
+
+	for _, p := range partitions {
+		if colRange == nil || p.partitionOf.overlaps(colRange) {
+			pruned = append(pruned, p)
+		}
+	}
+	return pruned
+}
+
+// partitionFor returns the table storing the row with the given values:
+// the partition holding it when t is partitioned, t itself otherwise.
+func (t *Table) partitionFor(valuesByColID map[uint32]TypedValue) (*Table, error) {
+	if t.partitionOf != nil {
+		if !t.partitionOf.contains(valuesByColID[t.partitionOf.colID]) {
+			return nil, fmt.Errorf("%w: row does not fit in the bounds of partition '%s'", ErrPartitionConstraintViolation, t.name)
+		}
+		return t, nil
+	}
+
+	if t.partitioning == nil {
+		return t, nil
+	}
+
+	val := valuesByColID[t.partitioning.colID]
+
+	for _, p := range t.partitions() {
+		if p.partitionOf.contains(val) {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no partition of table '%s' holds the row", ErrPartitionConstraintViolation, t.name)
+}
+
+// indexLike returns the index of the partition t matching the given index
+// of its partitioned table.
+func (t *Table) indexLike(index *Index) (*Index, error) {
+	if index.IsPrimary() {
+		return t.primaryIndex, nil
+	}
+
+	for _, idx := range t.indexes {
+		if idx.unique != index.unique || len(idx.cols) != len(index.cols) {
+			continue
+		}
+
+		matches := true
+		for i, col := range idx.cols {
+			matches = matches && col.id == index.cols[i].id
+		}
+
+		if matches {
+			return idx, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: index %s of partition '%s'", ErrIndexNotFound, describeIndex(index), t.name)
+}
+
+func (b *partitionBound) contains(val TypedValue) bool {
+	if val == nil {
+		return false
+	}
+
+	cmp, err := b.compare(val, b.from)
+	if err != nil || cmp < 0 {
+		return false
+	}
+
+	cmp, err = b.compare(val, b.to)
+	return err == nil && cmp < 0
+}
+
+// overlaps reports whether the partition may hold values within the range.
+// Values not comparable with the bounds are assumed to overlap them.
+func (b *partitionBound) overlaps(r *typedValueRange) bool {
+	if r.hRange != nil {
+		cmp, err := b.compare(r.hRange.val, b.from)
+		if err == nil && (cmp < 0 || (cmp == 0 && !r.hRange.inclusive)) {
+			return false
+		}
+	}
+
+	if r.lRange != nil {
+		cmp, err := b.compare(r.lRange.val, b.to)
+		if err == nil && cmp >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *partitionBound) compare(val, bound TypedValue) (int, error) {
+	if val.IsNull() {
+		return 0, ErrInvalidValue
+	}
+
+	if val.Type() != bound.Type() {
+		conv, err := getConverter(val.Type(), bound.Type())
+		if err != nil {
+			return 0, err
+		}
+
+		val, err = conv(val)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return val.Compare(bound)
+}
+
+// execPartitionOfAt creates a partition of a partitioned table. The
+// partition gets the columns, with the same ids, the primary key and the
+// indexes of the partitioned table.
+func (stmt *CreateTableStmt) execPartitionOfAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	tableName, err := tx.catalog.creationName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := tx.catalog.tablesByName[tableName]; exists && stmt.ifNotExists {
+		return tx, nil
+	}
+
+	parent, err := tx.catalog.GetTableByName(stmt.partitionOf.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.partitioning == nil {
+		return nil, fmt.Errorf("%w: table '%s' is not partitioned", ErrInvalidPartition, parent.name)
+	}
+
+	col := parent.colsByID[parent.partitioning.colID]
+
+	from, err := partitionBoundValue(tx, params, stmt.partitionOf.from, col)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := partitionBoundValue(tx, params, stmt.partitionOf.to, col)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmp, err := from.Compare(to); err != nil || cmp >= 0 {
+		return nil, fmt.Errorf("%w: lower bound of partition '%s' must be less than its upper bound", ErrInvalidPartition, tableName)
+	}
+
+	bound := &partitionBound{parentID: parent.id, colID: col.id, from: from, to: to}
+
+	for _, p := range parent.partitions() {
+		fromCmp, _ := from.Compare(p.partitionOf.to)
+		toCmp, _ := to.Compare(p.partitionOf.from)
+
+		if fromCmp < 0 && toCmp > 0 {
+			return nil, fmt.Errorf("%w: partition '%s' overlaps partition '%s'", ErrInvalidPartition, tableName, p.name)
+		}
+	}
+
+	colsSpec := make([]*ColSpec, 0, len(parent.cols))
+	for _, c := range parent.cols {
+		colsSpec = append(colsSpec, &ColSpec{
+			colName:      c.colName,
+			colType:      c.colType,
+			maxLen:       c.maxLen,
+			notNull:      c.notNull,
+			defaultValue: c.defaultValue,
+			generated:    c.generated,
+			virtual:      c.virtual,
+		})
+	}
+
+	pkColNames := make(PrimaryKeyConstraint, 0, len(parent.primaryIndex.cols))
+	for _, c := range parent.primaryIndex.cols {
+		pkColNames = append(pkColNames, c.colName)
+	}
+
+	create := &CreateTableStmt{table: tableName, colsSpec: colsSpec, pkColNames: pkColNames}
+	if _, err := create.execAt(ctx, tx, params); err != nil {
+		return nil, err
+	}
+
+	partition, err := tx.catalog.GetTableByName(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range parent.indexes {
+		if index.IsPrimary() {
+			continue
+		}
+
+		cols := make([]string, len(index.cols))
+		for i, c := range index.cols {
+			cols[i] = c.colName
+		}
+
+		idxStmt := &CreateIndexStmt{unique: index.unique, table: tableName, cols: cols, partitionIndex: true}
+		if _, err := idxStmt.execAt(ctx, tx, params); err != nil {
+			return nil, err
+		}
+	}
+
+	partition.setPartitionOf(bound)
+
+	if err := persistPartitioning(tx, partition); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func partitionBoundValue(tx *SQLTx, params map[string]interface{}, exp ValueExp, col *Column) (TypedValue, error) {
+	exp, err := exp.substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := exp.reduce(tx, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if val.IsNull() {
+		return nil, fmt.Errorf("%w: partition bounds can not be NULL", ErrInvalidPartition)
+	}
+
+	if val.Type() != col.colType {
+		conv, err := getConverter(val.Type(), col.colType)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPartition, err)
+		}
+
+		val, err = conv(val)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidPartition, err)
+		}
+	}
+	return val, nil
+}
+
+// checkPartitioning validates an index about to be created on table, when
+// it is a partitioned table or one of its partitions.
+func (stmt *CreateIndexStmt) checkPartitioning(table *Table) error {
+	if table.partitionOf != nil && !stmt.partitionIndex {
+		return fmt.Errorf("%w: indexes of partition '%s' follow those of its partitioned table", ErrIllegalArguments, table.name)
+	}
+
+	if table.partitioning == nil {
+		return nil
+	}
+
+	if stmt.fullText || stmt.predicate != nil {
+		return fmt.Errorf("%w: fulltext and partial indexes are not supported on partitioned tables", ErrIllegalArguments)
+	}
+
+	for _, exp := range stmt.exps {
+		if exp != nil {
+			return fmt.Errorf("%w: expression indexes are not supported on partitioned tables", ErrIllegalArguments)
+		}
+	}
+
+	if !stmt.unique {
+		return nil
+	}
+
+	// uniqueness is enforced within each partition
+	partitionCol := table.colsByID[table.partitioning.colID]
+	for _, colName := range stmt.cols {
+		if colName == partitionCol.colName {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unique indexes of partitioned table '%s' must include column '%s'", ErrInvalidPartition, table.name, partitionCol.colName)
+}
+
+// value={parentTableID}{colID}({lowerBound}{upperBound})?, the parent table
+// id is zero for the partitioned table itself.
+func persistPartitioning(tx *SQLTx, table *Table) error {
+	var value []byte
+
+	if table.partitioning != nil {
+		value = append(EncodeID(0), EncodeID(table.partitioning.colID)...)
+	} else {
+		bound := table.partitionOf
+		col := table.colsByID[bound.colID]
+
+		from, err := EncodeValue(bound.from, col.colType, col.MaxLen())
+		if err != nil {
+			return err
+		}
+
+		to, err := EncodeValue(bound.to, col.colType, col.MaxLen())
+		if err != nil {
+			return err
+		}
+
+		value = append(EncodeID(bound.parentID), EncodeID(bound.colID)...)
+		value = append(value, from...)
+		value = append(value, to...)
+	}
+
+	key := MapKey(tx.sqlPrefix(), catalogPartitionPrefix, EncodeID(DatabaseID), EncodeID(table.id))
+
+	return tx.set(key, nil, value)
+}
+
+func persistPartitioningDeletion(ctx context.Context, tx *SQLTx, table *Table) error {
+	key := MapKey(tx.sqlPrefix(), catalogPartitionPrefix, EncodeID(DatabaseID), EncodeID(table.id))
+
+	return tx.delete(ctx, key)
+}
+
+func loadPartitioning(ctx context.Context, dbID uint32, table *Table, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (*partitionKey, *partitionBound, error) {
+	prefix := MapKey(sqlPrefix, catalogPartitionPrefix, EncodeID(dbID), EncodeID(table.id))
+
+	var key *partitionKey
+	var bound *partitionBound
+
+	err := iteratePrefix(ctx, tx, prefix, func(k, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(value) < 2*EncIDLen {
+			return ErrCorruptedData
+		}
+
+		parentID := binary.BigEndian.Uint32(value)
+		colID := binary.BigEndian.Uint32(value[EncIDLen:])
+
+		col, exists := table.colsByID[colID]
+		if !exists {
+			return ErrCorruptedData
+		}
+
+		if parentID == 0 {
+			key = &partitionKey{colID: colID}
+		} else {
+			from, n, err := DecodeValue(value[2*EncIDLen:], col.colType)
+			if err != nil {
+				return err
+			}
+
+			to, _, err := DecodeValue(value[2*EncIDLen+n:], col.colType)
+			if err != nil {
+				return err
+			}
+
+			bound = &partitionBound{parentID: parentID, colID: colID, from: from, to: to}
+		}
+
+		if copyToTx {
+			return tx.Set(k, nil, value)
+		}
+		return nil
+	})
+	return key, bound, err
+}
+
+// DetachPartitionStmt turns a partition into a table of its own, e.g. to
+// drop it once its rows are no longer needed.
+type DetachPartitionStmt struct {
+	table     string
+	partition string
+}
+
+func (stmt *DetachPartitionStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DetachPartitionStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeAlter}
+}
+
+func (stmt *DetachPartitionStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DetachPartitionStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	partition, err := tx.catalog.GetTableByName(stmt.partition)
+	if err != nil {
+		return nil, err
+	}
+
+	if partition.partitionOf == nil || partition.partitionOf.parentID != table.id {
+		return nil, fmt.Errorf("%w: table '%s' is not a partition of table '%s'", ErrInvalidPartition, partition.name, table.name)
+	}
+
+	if err := persistPartitioningDeletion(ctx, tx, partition); err != nil {
+		return nil, err
+	}
+
+	partition.setPartitionOf(nil)
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// partitionedRowReader reads the rows of a partitioned table from its
+// partitions, one after the other.
+type partitionedRowReader struct {
+	*rawRowReader // reader of the current partition
+
+	params     map[string]interface{}
+	period     period
+	tableAlias string
+	scanSpecs  *ScanSpecs
+
+	partitions []*Table
+	next       int
+
+	onCloseCallback func()
+}
+
+func newPartitionedRowReader(tx *SQLTx, params map[string]interface{}, table *Table, period period, tableAlias string, scanSpecs *ScanSpecs) (RowReader, error) {
+	if scanSpecs == nil || scanSpecs.Index == nil {
+		return nil, ErrIllegalArguments
+	}
+
+	partitions := scanSpecs.partitions
+	if partitions == nil {
+		partitions = table.prunedPartitions(scanSpecs.rangesByColID)
+	}
+
+	// the partitioned table itself holds no rows
+	if len(partitions) == 0 {
+		return newRawRowReader(tx, params, table, period, tableAlias, scanSpecs)
+	}
+
+	if scanSpecs.DescOrder {
+		reversed := make([]*Table, len(partitions))
+		for i, p := range partitions {
+			reversed[len(partitions)-1-i] = p
+		}
+		partitions = reversed
+	}
+
+	if tableAlias == "" {
+		tableAlias = table.name
+	}
+
+	r := &partitionedRowReader{
+		params:     params,
+		period:     period,
+		tableAlias: tableAlias,
+		scanSpecs:  scanSpecs,
+		partitions: partitions,
+	}
+
+	reader, err := r.openPartition(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rawRowReader = reader
+
+	return r, nil
+}
+
+func (r *partitionedRowReader) openPartition(tx *SQLTx) (*rawRowReader, error) {
+	partition := r.partitions[r.next]
+
+	index, err := partition.indexLike(r.scanSpecs.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	scanSpecs := *r.scanSpecs
+	scanSpecs.Index = index
+	scanSpecs.partitions = nil
+
+	reader, err := newRawRowReader(tx, r.params, partition, r.period, r.tableAlias, &scanSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	r.next++
+
+	return reader, nil
+}
+
+func (r *partitionedRowReader) ScanSpecs() *ScanSpecs {
+	return r.scanSpecs
+}
+
+func (r *partitionedRowReader) onClose(callback func()) {
+	r.onCloseCallback = callback
+}
+
+func (r *partitionedRowReader) Read(ctx context.Context) (*Row, error) {
+	for {
+		row, err := r.rawRowReader.Read(ctx)
+		if !errors.Is(err, ErrNoMoreRows) || r.next == len(r.partitions) {
+			return row, err
+		}
+
+		if err := r.rawRowReader.Close(); err != nil {
+			return nil, err
+		}
+
+		reader, err := r.openPartition(r.rawRowReader.tx)
+		if err != nil {
+			return nil, err
+		}
+		r.rawRowReader = reader
+	}
+}
+
+func (r *partitionedRowReader) Close() error {
+	if r.onCloseCallback != nil {
+		defer r.onCloseCallback()
+	}
+
+	return r.rawRowReader.Close()
+}
+
+func describePartitions(partitions []*Table) string {
+	if len(partitions) == 0 {
+		return "none"
+	}
+
+	names := make([]string, len(partitions))
+	for i, p := range partitions {
+		names[i] = p.name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionStmts(t *testing.T) {
+	stmts, err := ParseSQLString("CREATE TABLE t (id INTEGER, ts TIMESTAMP, PRIMARY KEY (ts, id)) PARTITION BY RANGE (ts)")
+	require.NoError(t, err)
+	require.Equal(t, "ts", stmts[0].(*CreateTableStmt).partitionBy)
+
+	stmts, err = ParseSQLString("CREATE TABLE IF NOT EXISTS t_2026 PARTITION OF t FOR VALUES FROM ('2026-01-01') TO ('2027-01-01')")
+	require.NoError(t, err)
+
+	stmt := stmts[0].(*CreateTableStmt)
+	require.True(t, stmt.ifNotExists)
+	require.Equal(t, "t", stmt.partitionOf.table)
+	require.Equal(t, "'2026-01-01'", stmt.partitionOf.from.String())
+	require.Equal(t, "'2027-01-01'", stmt.partitionOf.to.String())
+
+	stmts, err = ParseSQLString("ALTER TABLE t DETACH PARTITION t_2026")
+	require.NoError(t, err)
+	require.Equal(t, &DetachPartitionStmt{table: "t", partition: "t_2026"}, stmts[0])
+
+	_, err = ParseSQLString("ALTER TABLE t ATTACH PARTITION t_2026")
+	require.ErrorContains(t, err, "expected DETACH keyword")
+}
+
+func TestPartitionedTable(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	ctx := context.Background()
+
+	exec := func(sql string) error {
+		_, _, err := engine.Exec(ctx, nil, sql, nil)
+		return err
+	}
+
+	query := func(sql string) [][]interface{} {
+		return queryValues(t, engine, nil, sql, nil)
+	}
+
+	plan := func(sql string) string {
+		var lines []string
+		for _, row := range query("EXPLAIN " + sql) {
+			lines = append(lines, row[0].(string))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	t.Run("invalid partitioning", func(t *testing.T) {
+		err := exec("CREATE TABLE p (id INTEGER, v VARCHAR, PRIMARY KEY id) PARTITION BY RANGE (v)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE p (id INTEGER, n INTEGER, PRIMARY KEY id) PARTITION BY RANGE (n)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE p (id INTEGER AUTO_INCREMENT, PRIMARY KEY id) PARTITION BY RANGE (id)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE p (id INTEGER, PRIMARY KEY id) PARTITION BY RANGE (missing)")
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+
+		err = exec("CREATE TABLE p PARTITION OF missing FOR VALUES FROM (1) TO (2)")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+	})
+
+	err := exec(`
+		CREATE TABLE events (id INTEGER, n INTEGER NOT NULL, title VARCHAR[32], PRIMARY KEY (n, id)) PARTITION BY RANGE (n);
+		CREATE INDEX ON events(title);
+		CREATE TABLE events_0 PARTITION OF events FOR VALUES FROM (0) TO (100);
+		CREATE TABLE events_1 PARTITION OF events FOR VALUES FROM (100) TO (200);
+	`)
+	require.NoError(t, err)
+
+	t.Run("invalid partitions", func(t *testing.T) {
+		err := exec("CREATE TABLE events_x PARTITION OF events FOR VALUES FROM (150) TO (250)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE events_x PARTITION OF events FOR VALUES FROM (300) TO (300)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE events_x PARTITION OF events FOR VALUES FROM (NULL) TO (300)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE events_x PARTITION OF events_0 FOR VALUES FROM (0) TO (10)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE IF NOT EXISTS events_0 PARTITION OF events FOR VALUES FROM (0) TO (10)")
+		require.NoError(t, err)
+	})
+
+	err = exec(`
+		INSERT INTO events (id, n, title) VALUES (1, 10, 'a'), (2, 150, 'b'), (3, 99, 'c'), (4, 100, 'd');
+	`)
+	require.NoError(t, err)
+
+	t.Run("rows are routed to their partition", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{{int64(1), int64(10), "a"}, {int64(3), int64(99), "c"}, {int64(4), int64(100), "d"}, {int64(2), int64(150), "b"}},
+			query("SELECT * FROM events"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(1), int64(10), "a"}, {int64(3), int64(99), "c"}},
+			query("SELECT * FROM events_0"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(4)}, {int64(2)}},
+			query("SELECT id FROM events_1"),
+		)
+
+		err := exec("INSERT INTO events (id, n, title) VALUES (5, 200, 'e')")
+		require.ErrorIs(t, err, ErrPartitionConstraintViolation)
+
+		err = exec("INSERT INTO events_0 (id, n, title) VALUES (5, 100, 'e')")
+		require.ErrorIs(t, err, ErrPartitionConstraintViolation)
+
+		err = exec("INSERT INTO events (id, n, title) VALUES (1, 10, 'x')")
+		require.ErrorIs(t, err, store.ErrKeyAlreadyExists)
+	})
+
+	t.Run("updates and deletes", func(t *testing.T) {
+		err := exec("UPDATE events SET title = 'bb' WHERE id = 2")
+		require.NoError(t, err)
+
+		err = exec("UPSERT INTO events (id, n, title) VALUES (3, 99, 'cc')")
+		require.NoError(t, err)
+
+		err = exec("DELETE FROM events WHERE title = 'a'")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(3), "cc"}, {int64(4), "d"}, {int64(2), "bb"}},
+			query("SELECT id, title FROM events"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(2), "bb"}},
+			query("SELECT id, title FROM events WHERE title = 'bb'"),
+		)
+	})
+
+	t.Run("pruning", func(t *testing.T) {
+		require.Contains(t, plan("SELECT * FROM events WHERE n >= 100"), "Index Scan using (n, id) on events (partitions: events_1)")
+		require.Contains(t, plan("SELECT * FROM events WHERE n < 100"), "Index Scan using (n, id) on events (partitions: events_0)")
+		require.Contains(t, plan("SELECT * FROM events WHERE n > 500"), "Index Scan using (n, id) on events (partitions: none)")
+		require.Contains(t, plan("SELECT * FROM events"), "Seq Scan on events (partitions: events_0, events_1)")
+
+		require.Equal(t,
+			[][]interface{}{{int64(4)}, {int64(2)}},
+			query("SELECT id FROM events WHERE n >= 100"),
+		)
+		require.Empty(t, query("SELECT id FROM events WHERE n > 500"))
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		require.Equal(t,
+			[][]interface{}{{int64(2)}, {int64(4)}, {int64(3)}},
+			query("SELECT id FROM events ORDER BY n DESC"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(2)}, {int64(3)}, {int64(4)}},
+			query("SELECT id FROM events ORDER BY id"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(2)}, {int64(3)}, {int64(4)}},
+			query("SELECT id FROM events ORDER BY title"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(3)}},
+			query("SELECT COUNT(*) FROM events"),
+		)
+	})
+
+	t.Run("schema changes", func(t *testing.T) {
+		err := exec("ALTER TABLE events ADD COLUMN extra INTEGER")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec("ALTER TABLE events_0 RENAME COLUMN title TO name")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec("CREATE INDEX ON events_0(id)")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		err = exec("CREATE UNIQUE INDEX ON events(title)")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("CREATE TABLE refs (id INTEGER, n INTEGER, PRIMARY KEY id, FOREIGN KEY (id, n) REFERENCES events(id, n))")
+		require.ErrorIs(t, err, ErrInvalidForeignKey)
+
+		err = exec("CREATE INDEX ON events(id)")
+		require.NoError(t, err)
+
+		require.Contains(t, plan("SELECT * FROM events WHERE id = 4"), "Index Scan using (id) on events (partitions: events_0, events_1)")
+		require.Equal(t, [][]interface{}{{"d"}}, query("SELECT title FROM events WHERE id = 4"))
+
+		err = exec("DROP INDEX ON events(id)")
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(ctx, nil)
+		require.NoError(t, err)
+
+		partition, err := catalog.GetTableByName("events_1")
+		require.NoError(t, err)
+		require.Len(t, partition.GetIndexes(), 2)
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		err := exec("TRUNCATE TABLE events_0")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(4)}, {int64(2)}},
+			query("SELECT id FROM events"),
+		)
+
+		err = exec("INSERT INTO events (id, n, title) VALUES (6, 50, 'f')")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]interface{}{{int64(6)}}, query("SELECT id FROM events_0"))
+	})
+
+	t.Run("detach", func(t *testing.T) {
+		err := exec("ALTER TABLE events DETACH PARTITION events")
+		require.ErrorIs(t, err, ErrInvalidPartition)
+
+		err = exec("ALTER TABLE events DETACH PARTITION events_0")
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{int64(4)}, {int64(2)}},
+			query("SELECT id FROM events"),
+		)
+
+		err = exec("INSERT INTO events (id, n, title) VALUES (7, 50, 'g')")
+		require.ErrorIs(t, err, ErrPartitionConstraintViolation)
+
+		// a detached partition is a table of its own
+		err = exec("INSERT INTO events_0 (id, n, title) VALUES (7, 500, 'g')")
+		require.NoError(t, err)
+
+		err = exec("DROP TABLE events_0")
+		require.NoError(t, err)
+	})
+
+	t.Run("reopening", func(t *testing.T) {
+		engine, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(ctx, nil, "SELECT id FROM events WHERE n >= 100", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO events (id, n, title) VALUES (8, 120, 'h')", nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		err := exec("DROP TABLE events")
+		require.NoError(t, err)
+
+		catalog, err := engine.Catalog(ctx, nil)
+		require.NoError(t, err)
+		require.False(t, catalog.ExistTable("events_1"))
+	})
+}
+
+func TestPartitionedTableByTimestamp(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE readings (sensor INTEGER, ts TIMESTAMP, value FLOAT, PRIMARY KEY (sensor, ts)) PARTITION BY RANGE (ts);
+		CREATE TABLE readings_jan PARTITION OF readings FOR VALUES FROM ('2026-01-01') TO ('2026-02-01');
+		CREATE TABLE readings_feb PARTITION OF readings FOR VALUES FROM ('2026-02-01') TO ('2026-03-01');
+		INSERT INTO readings (sensor, ts, value) VALUES
+			(1, '2026-01-10 10:00:00', 1.5),
+			(1, '2026-02-10 10:00:00', 2.5),
+			(2, '2026-01-31 23:59:59', 3.5);
+	`, nil)
+	require.NoError(t, err)
+
+	rows, err := engine.queryAll(ctx, nil, "SELECT sensor, value FROM readings WHERE ts >= CAST('2026-02-01' AS TIMESTAMP)", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, 2.5, rows[0].ValuesByPosition[1].RawValue())
+
+	rows, err = engine.queryAll(ctx, nil, "EXPLAIN SELECT sensor FROM readings WHERE ts >= CAST('2026-02-01' AS TIMESTAMP)", nil)
+	require.NoError(t, err)
+	require.Contains(t, rows[0].ValuesByPosition[0].RawValue(), "(partitions: readings_feb)")
+
+	rows, err = engine.queryAll(ctx, nil, "SELECT sensor FROM readings WHERE sensor = 1", nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	_, _, err = engine.Exec(ctx, nil, "INSERT INTO readings (sensor, ts, value) VALUES (1, '2026-03-01', 0.5)", nil)
+	require.ErrorIs(t, err, ErrPartitionConstraintViolation)
+}
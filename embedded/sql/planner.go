@@ -0,0 +1,843 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"math"
+)
+
+// The cost model only kicks in for tables analyzed with ANALYZE, queries
+// over tables without statistics keep being planned by the rule-based
+// choices of genScanSpecs and jointRowReader.
+//
+// Costs are expressed in rows read from the store. Estimates assume the
+// values of a column to be uniformly distributed and the columns to be
+// independent from each other.
+const (
+	// defaultEqualitySelectivity is the fraction of rows matching an
+	// equality over a column without statistics
+	defaultEqualitySelectivity = 0.1
+
+	// defaultRangeSelectivity is the fraction of rows matching a range or
+	// any other predicate which can not be estimated
+	defaultRangeSelectivity = 1.0 / 3
+
+	// indexSeekCost is the cost of positioning a reader over an index
+	indexSeekCost = 4.0
+
+	// secondaryIndexRowCost is the additional cost of reading a row through
+	// a secondary index
+	secondaryIndexRowCost = 0.2
+
+	// sortRowCost is the cost per row and per comparison of sorting rows
+	sortRowCost = 0.05
+
+	// hashBuildRowCost and hashProbeRowCost are the costs per row of
+	// building and probing the hash table of a hash join
+	hashBuildRowCost = 0.5
+	hashProbeRowCost = 0.2
+)
+
+type joinStrategy int
+
+const (
+	joinStrategyDefault joinStrategy = iota
+	joinStrategyHash
+	joinStrategyIndexLoop
+)
+
+// queryPlan is the plan chosen by the planner for the joins of a query.
+type queryPlan struct {
+	query     *SelectStmt // the query with its joins in execution order
+	reordered bool
+	aliases   []string // table aliases in the order of the original query
+	scanRows  float64
+	scanCost  float64
+	steps     []*joinPlanStep // one per join of query
+}
+
+type joinPlanStep struct {
+	strategy    joinStrategy
+	lookupIndex *Index // index seeked for each outer row, if any
+	rows        float64
+	cost        float64
+}
+
+type plannedTable struct {
+	ref   *tableRef
+	table *Table
+	alias string
+
+	// filter holds the predicates which reference only this table
+	filter []ValueExp
+}
+
+func tableRows(table *Table) float64 {
+	if table.stats == nil {
+		return 1000
+	}
+	return math.Max(float64(table.stats.rows), 1)
+}
+
+func equalitySelectivity(table *Table, colID uint32) float64 {
+	if table.stats == nil {
+		return defaultEqualitySelectivity
+	}
+
+	colStats := table.stats.cols[colID]
+	if colStats == nil {
+		return defaultEqualitySelectivity
+	}
+	return 1 / math.Max(float64(colStats.distinct), 1)
+}
+
+func rangeSelectivity(table *Table, colID uint32, r *typedValueRange) float64 {
+	if r.unitary() {
+		return equalitySelectivity(table, colID)
+	}
+	return defaultRangeSelectivity
+}
+
+// filteredRows estimates the rows of table satisfying all the ranges.
+func filteredRows(table *Table, rangesByColID map[uint32]*typedValueRange) float64 {
+	rows := tableRows(table)
+	for colID, r := range rangesByColID {
+		rows *= rangeSelectivity(table, colID, r)
+	}
+	return math.Max(rows, 1)
+}
+
+// estimateScan estimates the rows read and the cost of scanning index,
+// restricted by the ranges over its leading columns. The columns in eqCols
+// are assumed to be restricted by an equality to a value which is unknown
+// at planning time, as done by the lookups of index nested loop joins.
+func estimateScan(index *Index, rangesByColID map[uint32]*typedValueRange, eqCols map[uint32]struct{}) (rows, cost float64) {
+	table := index.table
+	rows = tableRows(table)
+
+	for _, col := range index.cols {
+		if _, ok := eqCols[col.id]; ok {
+			rows *= equalitySelectivity(table, col.id)
+			continue
+		}
+
+		r, ok := rangesByColID[col.id]
+		if !ok {
+			break
+		}
+
+		rows *= rangeSelectivity(table, col.id, r)
+
+		if !r.unitary() {
+			break
+		}
+	}
+
+	rows = math.Max(rows, 1)
+
+	cost = indexSeekCost + rows
+	if !index.IsPrimary() {
+		cost += rows * secondaryIndexRowCost
+	}
+	return rows, cost
+}
+
+func sortCost(rows float64) float64 {
+	return rows * math.Log2(rows+1) * sortRowCost
+}
+
+// cheapestIndex returns the index of table with the lowest estimated cost to
+// read the rows within the ranges, including the cost of sorting them when
+// the index does not provide the requested order.
+func cheapestIndex(table *Table, rangesByColID map[uint32]*typedValueRange, groupByCols, orderByCols []*OrdExp) (best *Index, bestCost float64) {
+	sortCols := groupByCols
+	if len(sortCols) == 0 {
+		sortCols = orderByCols
+	}
+
+	sortRows := filteredRows(table, rangesByColID)
+
+	for _, index := range table.indexes {
+		_, cost := estimateScan(index, rangesByColID, nil)
+
+		if len(sortCols) > 0 && !index.coversOrdCols(sortCols, rangesByColID) {
+			cost += sortCost(sortRows)
+		}
+
+		if best == nil || cost < bestCost {
+			best = index
+			bestCost = cost
+		}
+	}
+	return best, bestCost
+}
+
+// estimateScanSpecs estimates the rows returned and the cost of a scan of
+// table with the given specs.
+func estimateScanSpecs(table *Table, scanSpecs *ScanSpecs) (rows, cost float64) {
+	_, cost = estimateScan(scanSpecs.Index, scanSpecs.rangesByColID, nil)
+
+	rows = filteredRows(table, scanSpecs.rangesByColID)
+
+	if len(scanSpecs.groupBySortExps) > 0 || len(scanSpecs.orderBySortExps) > 0 {
+		cost += sortCost(rows)
+	}
+	return rows, cost
+}
+
+func (t *plannedTable) ranges(params map[string]interface{}) map[uint32]*typedValueRange {
+	rangesByColID := make(map[uint32]*typedValueRange)
+	if len(t.filter) == 0 {
+		return rangesByColID
+	}
+
+	err := andConjuncts(t.filter).selectorRanges(t.table, t.alias, params, rangesByColID)
+	if err != nil {
+		// e.g. parameters not provided when explaining a query
+		return make(map[uint32]*typedValueRange)
+	}
+	return rangesByColID
+}
+
+func (t *plannedTable) estimateScan(params map[string]interface{}) (rows, cost float64) {
+	rangesByColID := t.ranges(params)
+
+	_, cost = cheapestIndex(t.table, rangesByColID, nil, nil)
+
+	return filteredRows(t.table, rangesByColID), cost
+}
+
+// equiJoinPair is a predicate `inner.col = outer.col` of a join.
+type equiJoinPair struct {
+	innerCol *Column
+	outer    *plannedTable
+	outerCol *Column
+}
+
+// estimateJoin estimates the rows produced by joining inner to outerRows
+// rows of the joined tables and chooses how to do it. conds are the
+// predicates of the join, which may only reference inner and the joined
+// tables.
+func estimateJoin(joinType JoinType, inner *plannedTable, conds []ValueExp, joined map[string]*plannedTable, outerRows float64, params map[string]interface{}) *joinPlanStep {
+	innerOnly := &plannedTable{table: inner.table, alias: inner.alias, filter: append([]ValueExp(nil), inner.filter...)}
+
+	var pairs []equiJoinPair
+	otherSelectivity := 1.0
+	hashable := joinType == InnerJoin || joinType == LeftJoin
+
+	for _, cond := range conds {
+		tables, hasUnqualified, safe := collectColTables(cond)
+
+		if _, isInner := tables[inner.alias]; safe && !hasUnqualified && isInner && len(tables) == 1 {
+			innerOnly.filter = append(innerOnly.filter, cond)
+			continue
+		}
+
+		if pair, ok := equiJoinPairOf(cond, inner, joined); ok {
+			pairs = append(pairs, pair)
+			continue
+		}
+
+		if b, ok := cond.(*Bool); ok && b.val {
+			continue
+		}
+
+		otherSelectivity *= defaultRangeSelectivity
+		hashable = false
+	}
+
+	rangesByColID := innerOnly.ranges(params)
+	innerRows := filteredRows(inner.table, rangesByColID)
+
+	joinSelectivity := otherSelectivity
+	eqCols := make(map[uint32]struct{}, len(pairs))
+
+	for _, pair := range pairs {
+		joinSelectivity *= math.Min(
+			equalitySelectivity(inner.table, pair.innerCol.id),
+			equalitySelectivity(pair.outer.table, pair.outerCol.id),
+		)
+		eqCols[pair.innerCol.id] = struct{}{}
+	}
+
+	step := &joinPlanStep{
+		strategy: joinStrategyIndexLoop,
+		rows:     math.Max(outerRows*innerRows*joinSelectivity, 1),
+	}
+
+	if joinType == LeftJoin {
+		step.rows = math.Max(step.rows, outerRows)
+	}
+
+	// index nested loop: the inner table is looked up for each outer row
+	var lookupCost float64
+
+	for _, index := range inner.table.indexes {
+		_, cost := estimateScan(index, rangesByColID, eqCols)
+
+		if step.lookupIndex == nil || cost < lookupCost {
+			step.lookupIndex = index
+			lookupCost = cost
+		}
+	}
+
+	if !leadsWithAny(step.lookupIndex, eqCols) {
+		step.lookupIndex = nil
+	}
+
+	step.cost = outerRows * lookupCost
+
+	// hash join: the inner table is read once to build the hash table
+	if hashable && len(pairs) > 0 {
+		_, scanCost := cheapestIndex(inner.table, rangesByColID, nil, nil)
+
+		hashCost := scanCost + innerRows*hashBuildRowCost + outerRows*hashProbeRowCost
+
+		if hashCost <= step.cost {
+			step.strategy = joinStrategyHash
+			step.lookupIndex = nil
+			step.cost = hashCost
+		}
+	}
+
+	return step
+}
+
+// leadsWithAny reports whether the leading column of index is one of cols.
+func leadsWithAny(index *Index, cols map[uint32]struct{}) bool {
+	if index == nil || len(index.cols) == 0 {
+		return false
+	}
+	_, ok := cols[index.cols[0].id]
+	return ok
+}
+
+func equiJoinPairOf(cond ValueExp, inner *plannedTable, joined map[string]*plannedTable) (equiJoinPair, bool) {
+	cmp, ok := cond.(*CmpBoolExp)
+	if !ok || cmp.op != EQ {
+		return equiJoinPair{}, false
+	}
+
+	left, ok := cmp.left.(*ColSelector)
+	if !ok {
+		return equiJoinPair{}, false
+	}
+
+	right, ok := cmp.right.(*ColSelector)
+	if !ok {
+		return equiJoinPair{}, false
+	}
+
+	if right.table == inner.alias {
+		left, right = right, left
+	}
+
+	outer, ok := joined[right.table]
+	if left.table != inner.alias || !ok {
+		return equiJoinPair{}, false
+	}
+
+	innerCol, err := inner.table.GetColumnByName(left.col)
+	if err != nil {
+		return equiJoinPair{}, false
+	}
+
+	outerCol, err := outer.table.GetColumnByName(right.col)
+	if err != nil {
+		return equiJoinPair{}, false
+	}
+
+	return equiJoinPair{innerCol: innerCol, outer: outer, outerCol: outerCol}, true
+}
+
+func plannedTableOf(tx *SQLTx, ds DataSource) (*plannedTable, bool) {
+	ref, ok := ds.(*tableRef)
+	if !ok || ref.history || ref.diff {
+		return nil, false
+	}
+
+	table, err := ref.referencedTable(tx)
+	if err != nil || table.stats == nil {
+		return nil, false
+	}
+
+	return &plannedTable{ref: ref, table: table, alias: ref.Alias()}, true
+}
+
+// planJoins chooses the order and the strategy of the joins of the query.
+// It returns nil when any of the joined tables was not analyzed or the
+// joins are not supported by the planner.
+func (stmt *SelectStmt) planJoins(tx *SQLTx, params map[string]interface{}) *queryPlan {
+	if len(stmt.joins) == 0 {
+		return nil
+	}
+
+	first, ok := plannedTableOf(tx, stmt.ds)
+	if !ok {
+		return nil
+	}
+
+	tables := []*plannedTable{first}
+	byAlias := map[string]*plannedTable{first.alias: first}
+
+	for _, jspec := range stmt.joins {
+		if jspec.lateral || jspec.natural || (jspec.joinType != InnerJoin && jspec.joinType != LeftJoin) {
+			return nil
+		}
+
+		t, ok := plannedTableOf(tx, jspec.ds)
+		if !ok {
+			return nil
+		}
+
+		if _, duplicated := byAlias[t.alias]; duplicated {
+			return nil
+		}
+
+		tables = append(tables, t)
+		byAlias[t.alias] = t
+	}
+
+	if plan := stmt.reorderJoins(params, tables, byAlias); plan != nil {
+		return plan
+	}
+
+	return stmt.planJoinsInOrder(params, tables)
+}
+
+// planJoinsInOrder chooses the strategy of each join keeping the order of
+// the query.
+func (stmt *SelectStmt) planJoinsInOrder(params map[string]interface{}, tables []*plannedTable) *queryPlan {
+	where, joins := pushdownInnerOnlyConjuncts(stmt.where, stmt.joins)
+
+	var whereConds []ValueExp
+	for _, c := range splitAndConjuncts(where) {
+		ctables, hasUnqualified, safe := collectColTables(c)
+		if safe && !hasUnqualified && len(ctables) == 1 {
+			if _, ok := ctables[tables[0].alias]; ok {
+				whereConds = append(whereConds, c)
+			}
+		}
+	}
+
+	first := &plannedTable{table: tables[0].table, alias: tables[0].alias, filter: whereConds}
+
+	plan := &queryPlan{query: stmt}
+	plan.scanRows, plan.scanCost = first.estimateScan(params)
+
+	rows, cost := plan.scanRows, plan.scanCost
+	joined := map[string]*plannedTable{first.alias: first}
+
+	for i, jspec := range joins {
+		step := estimateJoin(jspec.joinType, tables[i+1], splitAndConjuncts(jspec.cond), joined, rows, params)
+
+		rows = step.rows
+		cost += step.cost
+		step.cost = cost
+
+		plan.steps = append(plan.steps, step)
+		joined[tables[i+1].alias] = tables[i+1]
+	}
+
+	plan.query = stmt.withJoinStrategies(plan.steps)
+
+	return plan
+}
+
+func (stmt *SelectStmt) withJoinStrategies(steps []*joinPlanStep) *SelectStmt {
+	q := *stmt
+	q.joins = make([]*JoinSpec, len(stmt.joins))
+
+	for i, jspec := range stmt.joins {
+		cp := *jspec
+		cp.strategy = steps[i].strategy
+		q.joins[i] = &cp
+	}
+	return &q
+}
+
+// reorderJoins looks for a cheaper order of a sequence of inner joins. As
+// the predicates of inner joins can be freely moved between the joins and
+// the WHERE clause, they are pooled and assigned to the first join where all
+// the tables they reference are available.
+//
+// Starting from the table with the fewest rows, tables are added greedily,
+// each time picking the table with the cheapest join with the tables joined
+// so far.
+func (stmt *SelectStmt) reorderJoins(params map[string]interface{}, tables []*plannedTable, byAlias map[string]*plannedTable) *queryPlan {
+	if len(stmt.indexOn) > 0 {
+		return nil
+	}
+
+	var pool []ValueExp
+
+	for _, jspec := range stmt.joins {
+		if jspec.joinType != InnerJoin || len(jspec.indexOn) > 0 {
+			return nil
+		}
+
+		for _, c := range splitAndConjuncts(jspec.cond) {
+			if b, ok := c.(*Bool); ok && b.val {
+				continue
+			}
+			pool = append(pool, c)
+		}
+	}
+
+	for _, c := range pool {
+		ctables, hasUnqualified, safe := collectColTables(c)
+		if !safe || hasUnqualified || !allPlanned(ctables, byAlias) {
+			return nil
+		}
+	}
+
+	var where []ValueExp
+
+	for _, c := range splitAndConjuncts(stmt.where) {
+		ctables, hasUnqualified, safe := collectColTables(c)
+		if !safe || hasUnqualified || !allPlanned(ctables, byAlias) {
+			return nil
+		}
+
+		if len(ctables) > 1 {
+			pool = append(pool, c)
+		} else {
+			where = append(where, c)
+		}
+	}
+
+	filters := make(map[string][]ValueExp)
+	for _, c := range append(where, pool...) {
+		ctables, _, _ := collectColTables(c)
+		if len(ctables) == 1 {
+			for alias := range ctables {
+				filters[alias] = append(filters[alias], c)
+			}
+		}
+	}
+
+	withFilters := make([]*plannedTable, len(tables))
+	for i, t := range tables {
+		withFilters[i] = &plannedTable{ref: t.ref, table: t.table, alias: t.alias, filter: filters[t.alias]}
+	}
+
+	orderCost := func(order []*plannedTable) (float64, []*joinPlanStep, [][]ValueExp) {
+		rows, cost := order[0].estimateScan(params)
+
+		joined := map[string]*plannedTable{order[0].alias: order[0]}
+		steps := make([]*joinPlanStep, 0, len(order)-1)
+		conds := make([][]ValueExp, 0, len(order)-1)
+
+		for _, t := range order[1:] {
+			stepConds := joinConds(pool, t, joined)
+
+			step := estimateJoin(InnerJoin, t, stepConds, joined, rows, params)
+
+			rows = step.rows
+			cost += step.cost
+			step.cost = cost
+
+			steps = append(steps, step)
+			conds = append(conds, stepConds)
+			joined[t.alias] = t
+		}
+		return cost, steps, conds
+	}
+
+	originalCost, _, _ := orderCost(withFilters)
+
+	order := stmt.greedyJoinOrder(params, withFilters, pool)
+
+	cost, steps, conds := orderCost(order)
+	if cost >= originalCost {
+		return nil
+	}
+
+	q := *stmt
+	q.ds = order[0].ref
+	q.joins = make([]*JoinSpec, len(steps))
+
+	for i, step := range steps {
+		cond := andConjuncts(append(tableConds(pool, order[i+1]), conds[i]...))
+		if cond == nil {
+			cond = NewBool(true)
+		}
+
+		q.joins[i] = &JoinSpec{
+			joinType: InnerJoin,
+			ds:       order[i+1].ref,
+			cond:     cond,
+			strategy: step.strategy,
+		}
+	}
+
+	// predicates over the first table alone, or over no table at all
+	for _, c := range pool {
+		ctables, _, _ := collectColTables(c)
+		if _, ok := ctables[order[0].alias]; len(ctables) == 0 || (ok && len(ctables) == 1) {
+			where = append(where, c)
+		}
+	}
+	q.where = andConjuncts(where)
+
+	plan := &queryPlan{
+		query:     &q,
+		reordered: true,
+		steps:     steps,
+	}
+
+	for _, t := range tables {
+		plan.aliases = append(plan.aliases, t.alias)
+	}
+
+	plan.scanRows, plan.scanCost = order[0].estimateScan(params)
+
+	return plan
+}
+
+func (stmt *SelectStmt) greedyJoinOrder(params map[string]interface{}, tables []*plannedTable, pool []ValueExp) []*plannedTable {
+	remaining := append([]*plannedTable(nil), tables...)
+
+	// the first table is kept when its index provides the order
+	start := -1
+
+	groupByCols := stmt.groupByOrdExps()
+	if len(groupByCols) > 0 || len(stmt.orderBy) > 0 {
+		if stmt.selectSortingIndex(groupByCols, stmt.orderBy, tables[0].table, tables[0].ranges(params)) != nil {
+			start = 0
+		}
+	}
+
+	if start < 0 {
+		var minRows float64
+		for i, t := range remaining {
+			rows, _ := t.estimateScan(params)
+			if start < 0 || rows < minRows {
+				start = i
+				minRows = rows
+			}
+		}
+	}
+
+	order := []*plannedTable{remaining[start]}
+	remaining = append(remaining[:start], remaining[start+1:]...)
+
+	rows, _ := order[0].estimateScan(params)
+	joined := map[string]*plannedTable{order[0].alias: order[0]}
+
+	for len(remaining) > 0 {
+		next := -1
+		var nextStep *joinPlanStep
+		var nextConnected bool
+
+		for i, t := range remaining {
+			conds := joinConds(pool, t, joined)
+			step := estimateJoin(InnerJoin, t, conds, joined, rows, params)
+
+			// cross products are only used when no other join is possible
+			connected := false
+			for _, c := range conds {
+				if _, ok := equiJoinPairOf(c, t, joined); ok {
+					connected = true
+					break
+				}
+			}
+
+			better := next < 0 ||
+				(connected && !nextConnected) ||
+				(connected == nextConnected && step.cost+step.rows < nextStep.cost+nextStep.rows)
+
+			if better {
+				next, nextStep, nextConnected = i, step, connected
+			}
+		}
+
+		t := remaining[next]
+		order = append(order, t)
+		remaining = append(remaining[:next], remaining[next+1:]...)
+
+		rows = nextStep.rows
+		joined[t.alias] = t
+	}
+
+	return order
+}
+
+// joinConds returns the predicates of pool relating t to the joined tables.
+// Predicates over t alone are already part of its filter.
+func joinConds(pool []ValueExp, t *plannedTable, joined map[string]*plannedTable) []ValueExp {
+	var conds []ValueExp
+
+	for _, c := range pool {
+		ctables, _, _ := collectColTables(c)
+
+		if _, ok := ctables[t.alias]; !ok || len(ctables) == 1 {
+			continue
+		}
+
+		available := true
+		for alias := range ctables {
+			if _, ok := joined[alias]; !ok && alias != t.alias {
+				available = false
+				break
+			}
+		}
+
+		if available {
+			conds = append(conds, c)
+		}
+	}
+	return conds
+}
+
+// tableConds returns the predicates of pool over t alone.
+func tableConds(pool []ValueExp, t *plannedTable) []ValueExp {
+	var conds []ValueExp
+
+	for _, c := range pool {
+		ctables, _, _ := collectColTables(c)
+
+		if _, ok := ctables[t.alias]; ok && len(ctables) == 1 {
+			conds = append(conds, c)
+		}
+	}
+	return conds
+}
+
+func allPlanned(tables map[string]struct{}, byAlias map[string]*plannedTable) bool {
+	for alias := range tables {
+		if _, ok := byAlias[alias]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// joinOrderRowReader restores the column order of the query on top of joins
+// executed in a different order.
+type joinOrderRowReader struct {
+	rowReader  RowReader
+	tableAlias string
+	aliases    []string
+
+	cols      []ColDescriptor
+	positions []int // positions of the columns of rowReader, in query order
+}
+
+func newJoinOrderRowReader(rowReader RowReader, aliases []string) *joinOrderRowReader {
+	return &joinOrderRowReader{
+		rowReader:  rowReader,
+		tableAlias: aliases[0],
+		aliases:    aliases,
+	}
+}
+
+func (r *joinOrderRowReader) onClose(callback func()) {
+	r.rowReader.onClose(callback)
+}
+
+func (r *joinOrderRowReader) Tx() *SQLTx {
+	return r.rowReader.Tx()
+}
+
+func (r *joinOrderRowReader) TableAlias() string {
+	return r.tableAlias
+}
+
+func (r *joinOrderRowReader) Parameters() map[string]interface{} {
+	return r.rowReader.Parameters()
+}
+
+func (r *joinOrderRowReader) OrderBy() []ColDescriptor {
+	return r.rowReader.OrderBy()
+}
+
+func (r *joinOrderRowReader) ScanSpecs() *ScanSpecs {
+	return r.rowReader.ScanSpecs()
+}
+
+func (r *joinOrderRowReader) InferParameters(ctx context.Context, params map[string]SQLValueType) error {
+	return r.rowReader.InferParameters(ctx, params)
+}
+
+func (r *joinOrderRowReader) colsBySelector(ctx context.Context) (map[string]ColDescriptor, error) {
+	return r.rowReader.colsBySelector(ctx)
+}
+
+func (r *joinOrderRowReader) Columns(ctx context.Context) ([]ColDescriptor, error) {
+	if r.cols != nil {
+		return r.cols, nil
+	}
+
+	cols, err := r.rowReader.Columns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.positions = make([]int, 0, len(cols))
+
+	for _, alias := range r.aliases {
+		for i, col := range cols {
+			if col.Table == alias {
+				r.positions = append(r.positions, i)
+			}
+		}
+	}
+
+	if len(r.positions) != len(cols) {
+		// columns not belonging to any of the tables, keep the order
+		r.positions = r.positions[:0]
+		for i := range cols {
+			r.positions = append(r.positions, i)
+		}
+	}
+
+	r.cols = make([]ColDescriptor, len(cols))
+	for i, pos := range r.positions {
+		r.cols[i] = cols[pos]
+	}
+	return r.cols, nil
+}
+
+func (r *joinOrderRowReader) Read(ctx context.Context) (*Row, error) {
+	row, err := r.rowReader.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.positions == nil {
+		if _, err := r.Columns(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(row.ValuesByPosition) != len(r.positions) {
+		return row, nil
+	}
+
+	values := make([]TypedValue, len(row.ValuesByPosition))
+	for i, pos := range r.positions {
+		values[i] = row.ValuesByPosition[pos]
+	}
+
+	return &Row{ValuesByPosition: values, ValuesBySelector: row.ValuesBySelector}, nil
+}
+
+func (r *joinOrderRowReader) Close() error {
+	return r.rowReader.Close()
+}
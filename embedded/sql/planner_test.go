@@ -0,0 +1,193 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeStmt(t *testing.T) {
+	stmts, err := ParseSQLString("ANALYZE; ANALYZE orders")
+	require.NoError(t, err)
+	require.Equal(t, []SQLStmt{&AnalyzeStmt{}, &AnalyzeStmt{table: "orders"}}, stmts)
+}
+
+func TestDistinctSketch(t *testing.T) {
+	sketch := newDistinctSketch()
+	for i := 0; i < 500; i++ {
+		sketch.add(NewInteger(int64(i % 100)))
+	}
+	require.Equal(t, uint64(100), sketch.estimate())
+
+	sketch = newDistinctSketch()
+	for i := 0; i < 50_000; i++ {
+		sketch.add(NewVarchar(fmt.Sprintf("v%d", i%20_000)))
+	}
+	require.InEpsilon(t, 20_000, sketch.estimate(), 0.1)
+}
+
+func TestCostBasedPlanner(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	exec := func(sql string) {
+		_, _, err := engine.Exec(context.Background(), nil, sql, nil)
+		require.NoError(t, err, sql)
+	}
+
+	explain := func(sql string) []string {
+		var lines []string
+		for _, row := range queryStrings(t, engine, "EXPLAIN "+sql) {
+			lines = append(lines, strings.Trim(row[0], "'"))
+		}
+		return lines
+	}
+
+	exec(`
+		CREATE TABLE customers (id INTEGER, name VARCHAR[32], country VARCHAR[2], PRIMARY KEY id);
+		CREATE TABLE orders (id INTEGER AUTO_INCREMENT, customer_id INTEGER, amount INTEGER, note VARCHAR[16], PRIMARY KEY id);
+		CREATE INDEX ON orders (amount);
+		CREATE INDEX ON orders (customer_id);
+	`)
+
+	var sql strings.Builder
+
+	sql.WriteString("INSERT INTO customers (id, name, country) VALUES ")
+	for i := 0; i < 200; i++ {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		fmt.Fprintf(&sql, "(%d, 'customer%d', '%c')", i, i, 'a'+i%5)
+	}
+	exec(sql.String())
+
+	sql.Reset()
+	sql.WriteString("INSERT INTO orders (customer_id, amount, note) VALUES ")
+	for i := 0; i < 1000; i++ {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		note := "NULL"
+		if i%4 == 0 {
+			note = "'gift'"
+		}
+		fmt.Fprintf(&sql, "(%d, %d, %s)", i%200, i%50, note)
+	}
+	exec(sql.String())
+
+	queries := []string{
+		"SELECT o.id, c.name FROM orders o JOIN customers c ON o.customer_id = c.id WHERE c.id = 7",
+		"SELECT * FROM orders o JOIN customers c ON o.customer_id = c.id WHERE c.id = 7",
+		"SELECT c.country, COUNT(*) FROM customers c JOIN orders o ON o.customer_id = c.id GROUP BY c.country",
+		"SELECT id, amount FROM orders WHERE customer_id = 3 ORDER BY amount",
+	}
+
+	results := make([][][]string, len(queries))
+	for i, q := range queries {
+		results[i] = queryStrings(t, engine, q)
+		require.NotEmpty(t, results[i])
+	}
+
+	// without statistics the index providing the order is preferred
+	require.Equal(t, "-> Index Scan using (amount) on orders", explain(queries[3])[0])
+
+	exec("ANALYZE")
+
+	t.Run("statistics", func(t *testing.T) {
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		table, err := tx.Catalog().GetTableByName("orders")
+		require.NoError(t, err)
+
+		stats := table.Stats()
+		require.NotNil(t, stats)
+		require.Equal(t, uint64(1000), stats.Rows())
+
+		for col, expected := range map[string][2]uint64{
+			"id":          {1000, 0},
+			"customer_id": {200, 0},
+			"amount":      {50, 0},
+			"note":        {1, 750},
+		} {
+			c, err := table.GetColumnByName(col)
+			require.NoError(t, err)
+			require.Equal(t, expected[0], stats.Column(c.id).DistinctValues(), col)
+			require.Equal(t, expected[1], stats.Column(c.id).NullValues(), col)
+		}
+	})
+
+	t.Run("plans are chosen by cost", func(t *testing.T) {
+		for i, q := range queries {
+			// joins may be reordered, changing the order of the rows
+			require.ElementsMatch(t, results[i], queryStrings(t, engine, q), q)
+		}
+
+		// the selective equality beats the index providing the order
+		require.Contains(t, explain(queries[3])[0], "Index Scan using (customer_id) on orders (rows=5")
+
+		// the only customer is read first and its orders looked up by index
+		plan := explain(queries[0])
+		require.Len(t, plan, 5)
+		require.Contains(t, plan[0], "-> Index Scan using (id) on customers c (rows=1 ")
+		require.Contains(t, plan[2], "  -> Nested Loop Join (rows=5 ")
+		require.Equal(t, "    -> Index Scan using (customer_id) on orders o", plan[4])
+
+		// all the orders are needed, hashing them is cheaper than looking
+		// them up for each customer
+		plan = explain(queries[2])
+		require.Contains(t, plan[0], "-> Seq Scan on customers c (rows=200 ")
+		require.Contains(t, plan[1], "  -> Hash Join (rows=1000 ")
+		require.Equal(t, "    -> Seq Scan on orders o", plan[3])
+	})
+
+	t.Run("columns keep the order of the query", func(t *testing.T) {
+		r, err := engine.Query(context.Background(), nil, queries[1], nil)
+		require.NoError(t, err)
+		defer r.Close()
+
+		cols, err := r.Columns(context.Background())
+		require.NoError(t, err)
+		require.Len(t, cols, 7)
+		require.Equal(t, "o", cols[0].Table)
+		require.Equal(t, "c", cols[6].Table)
+	})
+
+	t.Run("reopen and drop", func(t *testing.T) {
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+		engine = reopened
+
+		require.Contains(t, explain(queries[3])[0], "(rows=5 ")
+
+		_, _, err = engine.Exec(context.Background(), nil, "ANALYZE unknown", nil)
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		exec(`
+			DROP TABLE orders;
+			CREATE TABLE orders (id INTEGER AUTO_INCREMENT, customer_id INTEGER, PRIMARY KEY id);
+		`)
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		table, err := tx.Catalog().GetTableByName("orders")
+		require.NoError(t, err)
+		require.Nil(t, table.Stats())
+
+		table, err = tx.Catalog().GetTableByName("customers")
+		require.NoError(t, err)
+		require.Equal(t, uint64(200), table.Stats().Rows())
+	})
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Row-level security policies restrict the rows of a table that SELECT,
+// UPDATE and DELETE statements can see. The USING expression of every
+// policy applying to a statement is injected as an extra predicate into
+// the row reader built for the table: a row is visible when any of them
+// evaluates to true. Tables without policies are not restricted.
+//
+// UPDATE and DELETE statements only access the rows visible to SELECT
+// statements, as their WHERE clauses could otherwise probe the hidden ones,
+// further restricted by their own policies, if any.
+//
+// UPDATE policies are checked against the new version of each updated row
+// as well, so rows can not be moved out of reach of the user updating them.
+//
+// Policies do not apply to ADMIN and SYSADMIN users, who can manage them.
+
+// Policy is a row-level security policy defined on a table.
+type Policy struct {
+	name    string
+	command SQLPrivilege // SELECT, UPDATE or DELETE, empty for all of them
+	exp     ValueExp
+}
+
+func (p *Policy) Name() string {
+	return p.name
+}
+
+// Command returns the statement the policy applies to, or an empty string
+// when it applies to all of them.
+func (p *Policy) Command() SQLPrivilege {
+	return p.command
+}
+
+func (p *Policy) Expression() string {
+	return p.exp.String()
+}
+
+func (p *Policy) appliesTo(command SQLPrivilege) bool {
+	return p.command == "" || p.command == command
+}
+
+// GetPolicies returns the policies defined on the table sorted by name.
+func (t *Table) GetPolicies() []*Policy {
+	policies := make([]*Policy, 0, len(t.policies))
+	for _, p := range t.policies {
+		policies = append(policies, p)
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].name < policies[j].name
+	})
+	return policies
+}
+
+func (t *Table) newPolicy(name string, command SQLPrivilege, exp ValueExp) (*Policy, error) {
+	if _, exists := t.policies[name]; exists {
+		return nil, fmt.Errorf("%w (%s)", ErrPolicyAlreadyExists, name)
+	}
+
+	if t.systemScan != nil {
+		return nil, fmt.Errorf("%w: policies can not be defined on system tables", ErrIllegalArguments)
+	}
+
+	if command != "" && command != SQLPrivilegeSelect && command != SQLPrivilegeUpdate && command != SQLPrivilegeDelete {
+		return nil, fmt.Errorf("%w: unsupported command %s", ErrInvalidPolicy, command)
+	}
+
+	cols := make(map[string]ColDescriptor, len(t.cols))
+	for _, col := range t.cols {
+		desc := ColDescriptor{Table: t.name, Column: col.colName, Type: col.colType}
+		cols[desc.Selector()] = desc
+	}
+
+	params := make(map[string]SQLValueType)
+
+	err := exp.requiresType(BooleanType, cols, params, t.name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	if len(params) > 0 {
+		return nil, fmt.Errorf("%w: parameters are not allowed", ErrInvalidPolicy)
+	}
+
+	p := &Policy{name: name, command: command, exp: exp}
+
+	if t.policies == nil {
+		t.policies = make(map[string]*Policy)
+	}
+	t.policies[name] = p
+
+	return p, nil
+}
+
+// policyCondition returns the condition the rows of table must satisfy to
+// be accessed by a statement running command, or nil when they are not
+// restricted.
+func (tx *SQLTx) policyCondition(table *Table, command SQLPrivilege) ValueExp {
+	if len(table.policies) == 0 {
+		return nil
+	}
+
+	if tx.user != nil && (tx.user.Permission() == PermissionAdmin || tx.user.Permission() == PermissionSysAdmin) {
+		return nil
+	}
+
+	cond := table.policiesCondition(command)
+
+	if command == SQLPrivilegeUpdate || command == SQLPrivilegeDelete {
+		selectCond := table.policiesCondition(SQLPrivilegeSelect)
+
+		if cond == nil {
+			return selectCond
+		}
+
+		if selectCond != nil {
+			return &BinBoolExp{op: And, left: cond, right: selectCond}
+		}
+	}
+	return cond
+}
+
+// policiesCondition returns the disjunction of the policies of t applying
+// to command, or nil when there is none.
+func (t *Table) policiesCondition(command SQLPrivilege) ValueExp {
+	var cond ValueExp
+
+	for _, p := range t.GetPolicies() {
+		if !p.appliesTo(command) {
+			continue
+		}
+
+		if cond == nil {
+			cond = p.exp
+		} else {
+			cond = &BinBoolExp{op: Or, left: cond, right: p.exp}
+		}
+	}
+	return cond
+}
+
+// rowsCommand returns the command whose policies filter the rows read by
+// the statement.
+func (stmt *SelectStmt) rowsCommand() SQLPrivilege {
+	if stmt.policyCommand == "" {
+		return SQLPrivilegeSelect
+	}
+	return stmt.policyCommand
+}
+
+// applyPolicies filters the rows read from table by the policies applying
+// to command.
+func (tx *SQLTx) applyPolicies(table *Table, command SQLPrivilege, rowReader RowReader) RowReader {
+	cond := tx.policyCondition(table, command)
+	if cond == nil {
+		return rowReader
+	}
+	return newConditionalRowReader(rowReader, cond)
+}
+
+// checkPolicies verifies that row, as written by a statement running
+// command, is accepted by the policies applying to it.
+func (tx *SQLTx) checkPolicies(table *Table, command SQLPrivilege, row *Row) error {
+	accepted, err := tx.policiesAccept(table, command, row)
+	if err != nil {
+		return err
+	}
+
+	if !accepted {
+		return fmt.Errorf("%w: new row of table '%s' is not accepted by its %s policies", ErrPolicyViolation, table.name, command)
+	}
+	return nil
+}
+
+// checkOverwrittenRowPolicies verifies that row, overwritten by an UPSERT
+// or an INSERT ... ON CONFLICT DO UPDATE, could be updated by the user.
+func (tx *SQLTx) checkOverwrittenRowPolicies(table *Table, row *Row) error {
+	accepted, err := tx.policiesAccept(table, SQLPrivilegeUpdate, row)
+	if err != nil {
+		return err
+	}
+
+	if !accepted {
+		return fmt.Errorf("%w: existing row of table '%s' is not accessible by its %s policies", ErrPolicyViolation, table.name, SQLPrivilegeUpdate)
+	}
+	return nil
+}
+
+func (tx *SQLTx) policiesAccept(table *Table, command SQLPrivilege, row *Row) (bool, error) {
+	cond := tx.policyCondition(table, command)
+	if cond == nil {
+		return true, nil
+	}
+
+	val, err := cond.reduce(tx, row, table.name)
+	if err != nil {
+		return false, err
+	}
+
+	satisfies, ok := val.(*Bool)
+	return ok && satisfies.val, nil
+}
+
+// checkPolicyManager verifies that the user running tx can manage policies.
+// Policies are permissive, so any user allowed to create or drop them could
+// grant themselves access to every row.
+func checkPolicyManager(tx *SQLTx) error {
+	if tx.user != nil && tx.user.Permission() != PermissionAdmin && tx.user.Permission() != PermissionSysAdmin {
+		return fmt.Errorf("%w: statement requires %s permission", ErrAccessDenied, PermissionAdmin)
+	}
+	return nil
+}
+
+type CreatePolicyStmt struct {
+	name    string
+	table   string
+	command SQLPrivilege
+	exp     ValueExp
+}
+
+func (stmt *CreatePolicyStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreatePolicyStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreatePolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreatePolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if err := checkPolicyManager(tx); err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := table.newPolicy(stmt.name, stmt.command, stmt.exp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistPolicy(tx, table, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+type DropPolicyStmt struct {
+	name     string
+	table    string
+	ifExists bool
+}
+
+func (stmt *DropPolicyStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropPolicyStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropPolicyStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropPolicyStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if err := checkPolicyManager(tx); err != nil {
+		return nil, err
+	}
+
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := table.policies[stmt.name]; !exists {
+		if stmt.ifExists {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w (%s)", ErrPolicyDoesNotExist, stmt.name)
+	}
+
+	delete(table.policies, stmt.name)
+
+	err = persistPolicyDeletion(ctx, tx, table, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func persistPolicy(tx *SQLTx, table *Table, policy *Policy) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogPolicyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		[]byte(policy.name),
+	)
+
+	// {commandLen}{command}{expText}
+	val := []byte{byte(len(policy.command))}
+	val = append(val, []byte(policy.command)...)
+	val = append(val, []byte(policy.exp.String())...)
+
+	return tx.set(mappedKey, nil, val)
+}
+
+func persistPolicyDeletion(ctx context.Context, tx *SQLTx, table *Table, name string) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogPolicyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		[]byte(name),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
+// copyPolicies re-creates on table the policies defined on src, which may
+// be a previous incarnation of the same table.
+func (tx *SQLTx) copyPolicies(src, table *Table) error {
+	for _, p := range src.GetPolicies() {
+		policy, err := table.newPolicy(p.name, p.command, p.exp)
+		if err != nil {
+			return err
+		}
+
+		err = persistPolicy(tx, table, policy)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadPolicies(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]*Policy, error) {
+	prefix := MapKey(sqlPrefix, catalogPolicyPrefix, EncodeID(dbID), EncodeID(tableID))
+	policies := make(map[string]*Policy)
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		policy, err := parsePolicy(sqlPrefix, key, value)
+		if err != nil {
+			return err
+		}
+		policies[policy.name] = policy
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return policies, err
+}
+
+func parsePolicy(prefix, key, value []byte) (*Policy, error) {
+	encKey, err := trimPrefix(prefix, key, []byte(catalogPolicyPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encKey) <= 2*EncIDLen || len(value) < 1 || len(value) < 1+int(value[0]) {
+		return nil, ErrCorruptedData
+	}
+
+	cmdLen := int(value[0])
+
+	exp, err := ParseExpFromString(string(value[1+cmdLen:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		name:    string(encKey[2*EncIDLen:]),
+		command: SQLPrivilege(value[1 : 1+cmdLen]),
+		exp:     exp,
+	}, nil
+}
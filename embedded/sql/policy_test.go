@@ -0,0 +1,251 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyStmts(t *testing.T) {
+	exp, err := ParseExpFromString("owner = CURRENT_USER()")
+	require.NoError(t, err)
+
+	stmts, err := ParseSQLString("CREATE POLICY own_docs ON docs USING (owner = CURRENT_USER())")
+	require.NoError(t, err)
+	require.Equal(t, []SQLStmt{&CreatePolicyStmt{name: "own_docs", table: "docs", exp: exp}}, stmts)
+
+	stmts, err = ParseSQLString("CREATE POLICY own_docs ON docs FOR UPDATE USING (owner = CURRENT_USER())")
+	require.NoError(t, err)
+	require.Equal(t, []SQLStmt{&CreatePolicyStmt{name: "own_docs", table: "docs", command: SQLPrivilegeUpdate, exp: exp}}, stmts)
+
+	stmts, err = ParseSQLString("DROP POLICY IF EXISTS own_docs ON docs")
+	require.NoError(t, err)
+	require.Equal(t, []SQLStmt{&DropPolicyStmt{name: "own_docs", table: "docs", ifExists: true}}, stmts)
+
+	_, err = ParseSQLString("CREATE POLICY own_docs ON docs FOR INSERT USING (true)")
+	require.Error(t, err)
+}
+
+func TestRowLevelSecurity(t *testing.T) {
+	admin := &mockUser{username: "dba", permission: PermissionAdmin, sqlPrivileges: allPrivileges}
+	alice := &mockUser{username: "alice", permission: PermissionReadWrite, sqlPrivileges: allPrivileges}
+	bob := &mockUser{username: "bob", permission: PermissionReadWrite, sqlPrivileges: allPrivileges}
+
+	handler := &multidbHandlerMock{user: admin, users: []*mockUser{admin, alice, bob}}
+
+	engine, st := setupCommonTestWithEngineOptions(t, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler))
+
+	exec := func(user *mockUser, sql string) (int, error) {
+		handler.user = user
+
+		_, txs, err := engine.Exec(context.Background(), nil, sql, nil)
+		if err != nil {
+			return 0, err
+		}
+		return txs[len(txs)-1].UpdatedRows(), nil
+	}
+
+	query := func(user *mockUser, sql string) [][]string {
+		handler.user = user
+		return queryStrings(t, engine, sql)
+	}
+
+	_, err := exec(admin, `
+		CREATE TABLE docs (id INTEGER AUTO_INCREMENT, owner VARCHAR, title VARCHAR, PRIMARY KEY id);
+		CREATE TABLE tags (doc_id INTEGER, tag VARCHAR[16], PRIMARY KEY (doc_id, tag));
+		INSERT INTO docs (owner, title) VALUES ('alice', 'a1'), ('bob', 'b1'), ('alice', 'a2'), ('carol', 'public c1');
+		INSERT INTO tags (doc_id, tag) VALUES (1, 'x'), (2, 'x'), (4, 'x');
+		CREATE POLICY own_docs ON docs FOR SELECT USING (owner = CURRENT_USER());
+	`)
+	require.NoError(t, err)
+
+	t.Run("select", func(t *testing.T) {
+		require.Equal(t, [][]string{{"1"}, {"3"}}, query(alice, "SELECT id FROM docs ORDER BY id"))
+		require.Equal(t, [][]string{{"2"}}, query(bob, "SELECT id FROM docs WHERE title LIKE '%1'"))
+		require.Equal(t, [][]string{{"2"}}, query(alice, "SELECT COUNT(*) FROM docs"))
+		require.Equal(t, [][]string{{"'alice'"}}, query(alice, "SELECT CURRENT_USER()"))
+
+		rows := query(bob, "SELECT d.id, t.tag FROM tags t JOIN docs d ON d.id = t.doc_id")
+		require.Equal(t, [][]string{{"2", "'x'"}}, rows)
+
+		rows = query(alice, "SELECT doc_id FROM tags WHERE doc_id IN (SELECT id FROM docs) ORDER BY doc_id")
+		require.Equal(t, [][]string{{"1"}}, rows)
+
+		// admins are not restricted by policies
+		require.Len(t, query(admin, "SELECT id FROM docs"), 4)
+	})
+
+	t.Run("update and delete restricted by select policies", func(t *testing.T) {
+		n, err := exec(bob, "UPDATE docs SET title = 'hijacked' WHERE owner = 'carol'")
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		n, err = exec(bob, "DELETE FROM docs WHERE owner = 'alice'")
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		// rows updated by the user must still be visible to them
+		_, err = exec(bob, "UPDATE docs SET owner = 'carol' WHERE id = 2")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		require.Equal(t, [][]string{{"'public c1'"}}, query(admin, "SELECT title FROM docs WHERE id = 4"))
+		require.Len(t, query(admin, "SELECT id FROM docs"), 4)
+	})
+
+	t.Run("permissive policies", func(t *testing.T) {
+		_, err := exec(admin, "CREATE POLICY public_docs ON docs USING (title LIKE 'public%')")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]string{{"1"}, {"3"}, {"4"}}, query(alice, "SELECT id FROM docs ORDER BY id"))
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		table, err := tx.Catalog().GetTableByName("docs")
+		require.NoError(t, err)
+
+		policies := table.GetPolicies()
+		require.Len(t, policies, 2)
+		require.Equal(t, "public_docs", policies[1].Name())
+		require.Empty(t, policies[1].Command())
+		require.Equal(t, "(title LIKE 'public%')", policies[1].Expression())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		// without UPDATE policies the SELECT and ALL ones apply
+		n, err := exec(bob, "UPDATE docs SET title = 'public c2' WHERE id > 0")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		_, err = exec(admin, "CREATE POLICY own_docs_update ON docs FOR UPDATE USING (owner = CURRENT_USER())")
+		require.NoError(t, err)
+
+		n, err = exec(alice, "UPDATE docs SET title = 'edited' WHERE id IN (1, 2, 3)")
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		require.Equal(t, [][]string{{"'b1'"}}, query(bob, "SELECT title FROM docs WHERE id = 2"))
+
+		// updated rows must still be accepted by the policies
+		_, err = exec(alice, "UPDATE docs SET owner = 'bob' WHERE id = 1")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		// overwritten rows must be accessible by the UPDATE policies
+		_, err := exec(alice, "UPSERT INTO docs (id, owner, title) VALUES (2, 'bob', 'hijacked')")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		_, err = exec(alice, "INSERT INTO docs (id, owner, title) VALUES (2, 'bob', 'b1') ON CONFLICT DO UPDATE SET title = 'hijacked2'")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		require.Equal(t, [][]string{{"'b1'"}}, query(bob, "SELECT title FROM docs WHERE id = 2"))
+
+		// and the rows replacing them must still be accepted by the policies
+		_, err = exec(alice, "UPSERT INTO docs (id, owner, title) VALUES (1, 'bob', 'given')")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		_, err = exec(alice, "INSERT INTO docs (id, owner, title) VALUES (1, 'alice', 'a1') ON CONFLICT DO UPDATE SET owner = 'bob'")
+		require.ErrorIs(t, err, ErrPolicyViolation)
+
+		n, err := exec(alice, "UPSERT INTO docs (id, owner, title) VALUES (1, 'alice', 'a1')")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		n, err = exec(alice, "INSERT INTO docs (id, owner, title) VALUES (3, 'alice', 'a2') ON CONFLICT DO UPDATE SET title = 'a2'")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		require.Equal(t, [][]string{{"'a1'"}, {"'a2'"}}, query(alice, "SELECT title FROM docs WHERE id IN (1, 3) ORDER BY id"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := exec(admin, "CREATE POLICY own_docs_delete ON docs FOR DELETE USING (owner = CURRENT_USER())")
+		require.NoError(t, err)
+
+		n, err := exec(bob, "DELETE FROM docs WHERE id = 1")
+		require.NoError(t, err)
+		require.Equal(t, 0, n)
+
+		n, err = exec(alice, "DELETE FROM docs WHERE owner IN ('alice', 'bob')")
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		require.Equal(t, [][]string{{"2"}, {"4"}}, query(admin, "SELECT id FROM docs ORDER BY id"))
+	})
+
+	t.Run("policies managed by admins only", func(t *testing.T) {
+		_, err := exec(alice, "CREATE POLICY mine ON docs USING (true)")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		_, err = exec(alice, "DROP POLICY own_docs ON docs")
+		require.ErrorIs(t, err, ErrAccessDenied)
+
+		require.Equal(t, [][]string{{"4"}}, query(alice, "SELECT id FROM docs ORDER BY id"))
+	})
+
+	t.Run("invalid policies", func(t *testing.T) {
+		for _, sql := range []string{
+			"CREATE POLICY p ON docs USING (unknown = 1)",
+			"CREATE POLICY p ON docs USING (title)",
+			"CREATE POLICY p ON docs USING (owner = @owner)",
+		} {
+			_, err := exec(admin, sql)
+			require.ErrorIs(t, err, ErrInvalidPolicy, sql)
+		}
+
+		_, err := exec(admin, "CREATE POLICY own_docs ON docs USING (true)")
+		require.ErrorIs(t, err, ErrPolicyAlreadyExists)
+
+		_, err = exec(admin, "CREATE POLICY p ON unknown USING (true)")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		_, err = exec(admin, "DROP POLICY p ON docs")
+		require.ErrorIs(t, err, ErrPolicyDoesNotExist)
+
+		_, err = exec(admin, "DROP POLICY IF EXISTS p ON docs")
+		require.NoError(t, err)
+
+		_, err = exec(admin, "ALTER TABLE docs DROP COLUMN owner")
+		require.ErrorIs(t, err, ErrCannotDropColumn)
+	})
+
+	t.Run("truncate, reopen and drop", func(t *testing.T) {
+		_, err := exec(admin, `
+			TRUNCATE TABLE docs;
+			INSERT INTO docs (owner, title) VALUES ('alice', 'a3'), ('bob', 'b3');
+		`)
+		require.NoError(t, err)
+
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix).WithMultiDBHandler(handler))
+		require.NoError(t, err)
+		engine = reopened
+
+		require.Equal(t, [][]string{{"'a3'"}}, query(alice, "SELECT title FROM docs"))
+
+		_, err = exec(admin, `
+			DROP POLICY own_docs ON docs;
+			DROP POLICY public_docs ON docs;
+		`)
+		require.NoError(t, err)
+
+		require.Len(t, query(alice, "SELECT title FROM docs"), 2)
+
+		_, err = exec(admin, `
+			DROP TABLE docs;
+			CREATE TABLE docs (id INTEGER AUTO_INCREMENT, owner VARCHAR, PRIMARY KEY id);
+			INSERT INTO docs (owner) VALUES ('bob');
+		`)
+		require.NoError(t, err)
+
+		require.Len(t, query(alice, "SELECT id FROM docs"), 1)
+	})
+}
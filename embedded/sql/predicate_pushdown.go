@@ -58,8 +58,9 @@ func andConjuncts(parts []ValueExp) ValueExp {
 //
 // Recognized node types cover the bulk of WHERE/ON predicates seen in
 // practice: comparisons, AND/OR/NOT, LIKE, IN-list, arithmetic, casts,
-// column refs, parameters, and concrete TypedValues. Subqueries, EXISTS,
-// function calls and aggregates intentionally fall through to safe=false.
+// column refs, parameters, transaction-stable functions such as NOW(), and
+// concrete TypedValues. Subqueries, EXISTS, other function calls and
+// aggregates intentionally fall through to safe=false.
 func collectColTables(e ValueExp) (tables map[string]struct{}, hasUnqualified bool, safe bool) {
 	tables = make(map[string]struct{})
 	safe = collectColTablesRec(e, tables, &hasUnqualified)
@@ -103,6 +104,18 @@ func collectColTablesRec(e ValueExp, tables map[string]struct{}, hasUnqualified
 			}
 		}
 		return true
+	case *FnCall:
+		// functions evaluating to the same value for the whole
+		// transaction behave as constants
+		if !isTxStableFnCall(n) {
+			return false
+		}
+		for _, p := range n.params {
+			if !collectColTablesRec(p, tables, hasUnqualified) {
+				return false
+			}
+		}
+		return true
 	case *Param:
 		return true
 	case TypedValue:
@@ -212,3 +225,83 @@ func pushdownInnerOnlyConjuncts(where ValueExp, joins []*JoinSpec) (whereOut Val
 
 	return andConjuncts(residual), joinsOut
 }
+
+// isTxStableFnCall reports whether the call always evaluates to the same
+// value within a transaction: NOW(), CURRENT_TIMESTAMP and CURRENT_DATE
+// are all bound to the transaction timestamp.
+func isTxStableFnCall(v *FnCall) bool {
+	fn, err := v.resolveFunc()
+	if err != nil || len(v.params) > 0 {
+		return false
+	}
+
+	switch fn.(type) {
+	case *NowFn, *currentDateFn:
+		return true
+	}
+	return false
+}
+
+// foldTxStableExps returns a copy of e in which transaction-stable function
+// calls, and the arithmetic and casts built only on top of them and on
+// constants, are replaced by their values. Predicates such as
+// `ts > NOW() - INTERVAL '7 days'` can then be turned into index ranges by
+// selectorRanges. The original expression is never mutated and any
+// sub-expression that can not be evaluated is kept as is.
+func foldTxStableExps(e ValueExp, tx *SQLTx) ValueExp {
+	switch n := e.(type) {
+	case *BinBoolExp:
+		left := foldTxStableExps(n.left, tx)
+		right := foldTxStableExps(n.right, tx)
+		if left == n.left && right == n.right {
+			return n
+		}
+		return &BinBoolExp{op: n.op, left: left, right: right}
+	case *CmpBoolExp:
+		left := foldTxStableExps(n.left, tx)
+		right := foldTxStableExps(n.right, tx)
+		if left == n.left && right == n.right {
+			return n
+		}
+		return &CmpBoolExp{op: n.op, left: left, right: right}
+	case *NumExp:
+		left := foldTxStableExps(n.left, tx)
+		right := foldTxStableExps(n.right, tx)
+		if left == n.left && right == n.right {
+			return n
+		}
+		return reduceFolded(&NumExp{op: n.op, left: left, right: right}, tx, left, right)
+	case *Cast:
+		val := foldTxStableExps(n.val, tx)
+		if val == n.val {
+			return n
+		}
+		return reduceFolded(&Cast{val: val, t: n.t, typeMod: n.typeMod}, tx, val)
+	case *FnCall:
+		if !isTxStableFnCall(n) {
+			return n
+		}
+		v, err := n.reduce(tx, nil, "")
+		if err != nil {
+			return n
+		}
+		return v
+	}
+	return e
+}
+
+// reduceFolded evaluates e when all its operands have already been folded
+// into values, otherwise e is returned unchanged.
+func reduceFolded(e ValueExp, tx *SQLTx, operands ...ValueExp) ValueExp {
+	for _, op := range operands {
+		if _, isValue := op.(TypedValue); !isValue {
+			return e
+		}
+	}
+
+	v, err := e.reduce(tx, nil, "")
+	if err != nil {
+		return e
+	}
+	return v
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// A query run by EXPLAIN ANALYZE is profiled: while it is resolved, every
+// row reader of its plan is wrapped into a profiledRowReader counting the
+// rows it returns and the time spent reading them. Index entries, tree nodes
+// and spilled bytes are accounted to the reader whose Read is running when
+// they are read or written, so that readers resolved while the query runs
+// (e.g. the inner side of a nested loop join) are accounted to the reader
+// using them.
+
+type queryProfile struct {
+	building bool           // readers are wrapped only while the plan is resolved
+	running  []*profileNode // nodes whose Read is in progress, innermost last
+}
+
+type profileNode struct {
+	label    string
+	children []*profileNode
+
+	loops        uint64 // times the reader was resolved
+	rowsOut      uint64
+	entriesRead  uint64 // index entries read by the node itself
+	nodesRead    uint64
+	spilledBytes uint64
+	elapsed      time.Duration
+}
+
+func newQueryProfile() *queryProfile {
+	return &queryProfile{building: true}
+}
+
+// profile wraps rowReader into a node of the plan of the query being
+// profiled, whose inputs are the nodes of the given readers. The reader is
+// returned unchanged when no query is being profiled.
+func (tx *SQLTx) profile(rowReader RowReader, label string, inputs ...RowReader) RowReader {
+	if tx == nil || tx.queryProfile == nil || !tx.queryProfile.building {
+		return rowReader
+	}
+	if _, ok := rowReader.(*profiledRowReader); ok {
+		return rowReader
+	}
+
+	node := &profileNode{label: label}
+	for _, input := range inputs {
+		if pr, ok := input.(*profiledRowReader); ok {
+			node.children = append(node.children, pr.node)
+		}
+	}
+	return tx.queryProfile.wrap(rowReader, node)
+}
+
+// profileScan wraps the reader of the rows of ds, unless it was already
+// profiled when ds is a query.
+func (tx *SQLTx) profileScan(rowReader RowReader, ds DataSource, indexOn bool) RowReader {
+	label := "Scan"
+	if tr, ok := ds.(*tableRef); ok {
+		label = describeScanSpecs(tr, rowReader.ScanSpecs(), indexOn)
+	}
+	return tx.profile(rowReader, label)
+}
+
+// profileJoin wraps the reader of the joins of a query, whose inner readers
+// are resolved while the query runs and accounted to a node for each join.
+func (tx *SQLTx) profileJoin(jointr *jointRowReader, outer RowReader) RowReader {
+	rowReader := tx.profile(jointr, "Join", outer)
+
+	pr, ok := rowReader.(*profiledRowReader)
+	if !ok {
+		return rowReader
+	}
+
+	jointr.innerNodes = make([]*profileNode, len(jointr.joins))
+	for i := range jointr.joins {
+		jointr.innerNodes[i] = &profileNode{}
+		pr.node.children = append(pr.node.children, jointr.innerNodes[i])
+	}
+	return rowReader
+}
+
+func (tx *SQLTx) addSpilledBytes(n uint64) {
+	if tx == nil || tx.queryProfile == nil {
+		return
+	}
+	if node := tx.queryProfile.current(); node != nil {
+		node.spilledBytes += n
+	}
+}
+
+// enter makes node the one whose reads are accounted until exit is called.
+func (p *queryProfile) enter(node *profileNode) {
+	p.running = append(p.running, node)
+}
+
+func (p *queryProfile) exit() {
+	p.running = p.running[:len(p.running)-1]
+}
+
+func (p *queryProfile) current() *profileNode {
+	if len(p.running) == 0 {
+		return nil
+	}
+	return p.running[len(p.running)-1]
+}
+
+func (p *queryProfile) wrap(rowReader RowReader, node *profileNode) RowReader {
+	node.loops++
+	return &profiledRowReader{RowReader: rowReader, profile: p, node: node}
+}
+
+func (n *profileNode) rowsIn() uint64 {
+	if len(n.children) == 0 {
+		return n.entriesRead
+	}
+
+	var rows uint64
+	for _, child := range n.children {
+		rows += child.rowsOut
+	}
+	return rows
+}
+
+func (n *profileNode) describe(indent int) []string {
+	lines := []string{
+		fmt.Sprintf("%s-> %s (rows_in=%d rows_out=%d loops=%d time=%.3fms nodes_read=%d spill_bytes=%d)",
+			strings.Repeat("  ", indent),
+			n.label,
+			n.rowsIn(),
+			n.rowsOut,
+			n.loops,
+			float64(n.elapsed.Microseconds())/1000,
+			n.nodesRead,
+			n.spilledBytes,
+		),
+	}
+
+	for _, child := range n.children {
+		lines = append(lines, child.describe(indent+1)...)
+	}
+	return lines
+}
+
+type profiledRowReader struct {
+	RowReader
+
+	profile *queryProfile
+	node    *profileNode
+}
+
+func (r *profiledRowReader) Read(ctx context.Context) (*Row, error) {
+	r.profile.enter(r.node)
+	defer r.profile.exit()
+
+	start := time.Now()
+	row, err := r.RowReader.Read(ctx)
+	r.node.elapsed += time.Since(start)
+
+	if err == nil {
+		r.node.rowsOut++
+	}
+	return row, err
+}
+
+// unprofiled returns the reader wrapped by EXPLAIN ANALYZE, if any.
+func unprofiled(rowReader RowReader) RowReader {
+	if pr, ok := rowReader.(*profiledRowReader); ok {
+		return pr.RowReader
+	}
+	return rowReader
+}
+
+// profiledKeyReader accounts the index entries and nodes read to the node
+// running when they are read.
+type profiledKeyReader struct {
+	store.KeyReader
+
+	profile   *queryProfile
+	nodesRead uint64 // already accounted
+}
+
+func (r *profiledKeyReader) Read(ctx context.Context) (key []byte, val store.ValueRef, err error) {
+	key, val, err = r.KeyReader.Read(ctx)
+	r.account(err == nil)
+	return key, val, err
+}
+
+func (r *profiledKeyReader) ReadBetween(ctx context.Context, initialTxID uint64, finalTxID uint64) (key []byte, val store.ValueRef, err error) {
+	key, val, err = r.KeyReader.ReadBetween(ctx, initialTxID, finalTxID)
+	r.account(err == nil)
+	return key, val, err
+}
+
+// nodesRead returns the number of index nodes visited by reader, or zero
+// when the reader does not report them.
+func nodesRead(reader store.KeyReader) uint64 {
+	if nr, ok := reader.(store.NodesReader); ok {
+		return nr.NodesRead()
+	}
+	return 0
+}
+
+func (r *profiledKeyReader) account(read bool) {
+	n := nodesRead(r.KeyReader)
+	delta := n - r.nodesRead
+	r.nodesRead = n
+
+	node := r.profile.current()
+	if node == nil {
+		return
+	}
+
+	node.nodesRead += delta
+
+	if read {
+		node.entriesRead++
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var profileLineRe = regexp.MustCompile(`^( *)-> (.+) \(rows_in=(\d+) rows_out=(\d+) loops=(\d+) time=[0-9.]+ms nodes_read=(\d+) spill_bytes=(\d+)\)$`)
+
+type profileLine struct {
+	indent  int
+	label   string
+	rowsIn  int
+	rowsOut int
+	loops   int
+	nodes   int
+	spilled int
+}
+
+func TestExplainAnalyzeStmt(t *testing.T) {
+	stmts, err := ParseSQLString("EXPLAIN ANALYZE SELECT id FROM t")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	stmt, ok := stmts[0].(*ExplainStmt)
+	require.True(t, ok)
+	require.True(t, stmt.analyze)
+}
+
+func TestExplainAnalyze(t *testing.T) {
+	engine, _ := setupCommonTestWithEngineOptions(t, DefaultOptions().WithPrefix(sqlPrefix).WithSortBufferSize(16))
+
+	_, _, err := engine.Exec(context.Background(), nil, `
+		CREATE TABLE customers (id INTEGER, name VARCHAR[32], PRIMARY KEY id);
+		CREATE TABLE orders (id INTEGER AUTO_INCREMENT, customer_id INTEGER, amount INTEGER, PRIMARY KEY id);
+		CREATE INDEX ON orders (customer_id);
+	`, nil)
+	require.NoError(t, err)
+
+	var sql strings.Builder
+
+	sql.WriteString("INSERT INTO customers (id, name) VALUES ")
+	for i := 0; i < 10; i++ {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		fmt.Fprintf(&sql, "(%d, 'customer%d')", i, i)
+	}
+	sql.WriteString("; INSERT INTO orders (customer_id, amount) VALUES ")
+	for i := 0; i < 100; i++ {
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		fmt.Fprintf(&sql, "(%d, %d)", i%10, i)
+	}
+
+	_, _, err = engine.Exec(context.Background(), nil, sql.String(), nil)
+	require.NoError(t, err)
+
+	explain := func(query string) []profileLine {
+		var lines []profileLine
+
+		for _, row := range queryStrings(t, engine, "EXPLAIN ANALYZE "+query) {
+			m := profileLineRe.FindStringSubmatch(strings.Trim(row[0], "'"))
+			require.NotNil(t, m, row[0])
+
+			atoi := func(s string) int {
+				n, err := strconv.Atoi(s)
+				require.NoError(t, err)
+				return n
+			}
+
+			lines = append(lines, profileLine{
+				indent:  len(m[1]) / 2,
+				label:   m[2],
+				rowsIn:  atoi(m[3]),
+				rowsOut: atoi(m[4]),
+				loops:   atoi(m[5]),
+				nodes:   atoi(m[6]),
+				spilled: atoi(m[7]),
+			})
+		}
+		return lines
+	}
+
+	t.Run("filter, sort and limit", func(t *testing.T) {
+		plan := explain("SELECT id, amount FROM orders WHERE amount >= 40 ORDER BY amount DESC LIMIT 5")
+
+		require.Equal(t, []string{"Limit", "Projection", "Sort", "Filter", "Seq Scan on orders"}, labels(plan))
+
+		require.Equal(t, 5, plan[0].rowsOut)
+		require.Equal(t, 60, plan[2].rowsIn)
+		require.Equal(t, 100, plan[3].rowsIn)
+		require.Equal(t, 60, plan[3].rowsOut)
+
+		scan := plan[4]
+		require.Equal(t, 4, scan.indent)
+		require.Equal(t, 100, scan.rowsIn)
+		require.Equal(t, 100, scan.rowsOut)
+		require.Equal(t, 1, scan.loops)
+		require.Positive(t, scan.nodes)
+
+		// the top 5 rows are kept in memory
+		require.Zero(t, plan[2].spilled)
+	})
+
+	t.Run("spilled sort", func(t *testing.T) {
+		plan := explain("SELECT id, amount FROM orders ORDER BY amount DESC")
+
+		require.Equal(t, []string{"Projection", "Sort", "Seq Scan on orders"}, labels(plan))
+		require.Equal(t, 100, plan[0].rowsOut)
+
+		// more rows than the sort buffer are written to temporary files
+		require.Positive(t, plan[1].spilled)
+		require.Zero(t, plan[2].spilled)
+	})
+
+	t.Run("nested loop join", func(t *testing.T) {
+		// with statistics the few customers are joined by index lookups
+		_, _, err := engine.Exec(context.Background(), nil, "ANALYZE", nil)
+		require.NoError(t, err)
+
+		plan := explain("SELECT c.name, o.amount FROM customers c JOIN orders o ON o.customer_id = c.id WHERE c.id < 3")
+
+		require.Equal(t, []string{
+			"Projection",
+			"Filter",
+			"Join",
+			"Index Scan using (id) on customers c",
+			"Nested Loop Join: Index Scan using (customer_id) on orders o",
+		}, labels(plan))
+
+		require.Equal(t, 30, plan[0].rowsOut)
+
+		// the range of the scan includes its bound, filtered afterwards
+		outer := plan[3]
+		require.Equal(t, 4, outer.rowsOut)
+
+		inner := plan[4]
+		require.Equal(t, 4, inner.loops)
+		require.Equal(t, 40, inner.rowsIn)
+		require.Equal(t, 40, inner.rowsOut)
+
+		require.Equal(t, 44, plan[2].rowsIn)
+		require.Equal(t, 40, plan[1].rowsIn)
+		require.Positive(t, inner.nodes)
+	})
+
+	t.Run("hash join", func(t *testing.T) {
+		plan := explain("SELECT c.name, COUNT(*) FROM customers c JOIN orders o ON o.customer_id = c.id GROUP BY c.name")
+
+		require.Equal(t, []string{
+			"Projection",
+			"Hash Aggregate",
+			"Join",
+			"Seq Scan on customers c",
+			"Hash Join: Seq Scan on orders o",
+		}, labels(plan))
+
+		require.Equal(t, 10, plan[0].rowsOut)
+		require.Equal(t, 100, plan[2].rowsOut)
+
+		// the inner table is read once to build the hash table
+		inner := plan[4]
+		require.Equal(t, 100, inner.rowsIn)
+		require.Equal(t, 100, inner.rowsOut)
+		require.Equal(t, 10, inner.loops)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		plan := explain("SELECT id FROM customers UNION ALL SELECT id FROM orders WHERE id <= 10")
+
+		require.Equal(t, []string{
+			"Append",
+			"Projection",
+			"Seq Scan on customers",
+			"Projection",
+			"Filter",
+			"Index Scan using (id) on orders",
+		}, labels(plan))
+		require.Equal(t, 20, plan[0].rowsOut)
+		require.Equal(t, 10, plan[5].rowsIn)
+	})
+
+	t.Run("query is not run without analyze", func(t *testing.T) {
+		rows := queryStrings(t, engine, "EXPLAIN SELECT id FROM orders")
+		require.NotContains(t, rows[0][0], "rows_out")
+	})
+}
+
+func labels(plan []profileLine) []string {
+	labels := make([]string, len(plan))
+	for i, line := range plan {
+		labels[i] = line.label
+	}
+	return labels
+}
@@ -28,7 +28,7 @@ type projectedRowReader struct {
 	targets []TargetEntry
 }
 
-func newProjectedRowReader(ctx context.Context, rowReader RowReader, tableAlias string, targets []TargetEntry) (*projectedRowReader, error) {
+func newProjectedRowReader(ctx context.Context, rowReader RowReader, tableAlias string, targets []TargetEntry, hiddenCol func(ColDescriptor) bool) (*projectedRowReader, error) {
 	// case: SELECT *
 	if len(targets) == 0 {
 		cols, err := rowReader.Columns(ctx)
@@ -41,6 +41,10 @@ func newProjectedRowReader(ctx context.Context, rowReader RowReader, tableAlias
 		}
 
 		for _, col := range cols {
+			if hiddenCol(col) {
+				continue
+			}
+
 			targets = append(targets, TargetEntry{
 				Exp: &ColSelector{
 					table: col.Table,
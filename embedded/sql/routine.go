@@ -0,0 +1,571 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Routines are the functions and procedures defined in SQL with
+// CREATE FUNCTION and CREATE PROCEDURE. Their definitions are kept in the
+// catalog, so they are part of the history of the database as any other
+// schema change.
+//
+// The body of a function is a query: the function returns the first column
+// of its first row, converted to the return type of the function, or NULL
+// when there is none. The query is resolved when the function is created, so
+// it can only reference existing tables and declared arguments. Stored
+// functions can be called wherever builtin ones can, builtin functions
+// taking precedence.
+//
+// The body of a procedure is a list of INSERT, UPSERT, UPDATE, DELETE, MERGE
+// and CALL statements, run by CALL within the transaction of the caller.
+//
+// Arguments are referenced within the body either by name, as @arg, or by
+// position, as $1. Statements run by routines check the privileges of the
+// user calling them.
+
+type RoutineKind string
+
+const (
+	RoutineFunction  RoutineKind = "FUNCTION"
+	RoutineProcedure RoutineKind = "PROCEDURE"
+)
+
+const maxRoutineDepth = 16
+
+type routineArg struct {
+	name string // empty for arguments only referenced by position
+	t    SQLValueType
+}
+
+// Routine is a stored function or procedure.
+type Routine struct {
+	kind    RoutineKind
+	name    string
+	args    []routineArg
+	returns SQLValueType // functions only
+	body    string
+
+	query DataSource // body of functions
+	stmts []SQLStmt  // body of procedures
+}
+
+func newRoutine(kind RoutineKind, name string, args []routineArg, returns SQLValueType, body string) (*Routine, error) {
+	names := make(map[string]struct{}, len(args))
+
+	for _, arg := range args {
+		if arg.name == "" {
+			continue
+		}
+
+		if _, exists := names[arg.name]; exists {
+			return nil, fmt.Errorf("%w: duplicated argument '%s'", ErrInvalidRoutine, arg.name)
+		}
+		names[arg.name] = struct{}{}
+	}
+
+	stmts, err := ParseSQLString(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRoutine, err)
+	}
+
+	r := &Routine{kind: kind, name: name, args: args, returns: returns, body: body}
+
+	if kind == RoutineFunction {
+		if len(stmts) != 1 {
+			return nil, fmt.Errorf("%w: the body of a function must be a single query", ErrInvalidRoutine)
+		}
+
+		query, ok := stmts[0].(DataSource)
+		if !ok {
+			return nil, fmt.Errorf("%w: the body of a function must be a query", ErrInvalidRoutine)
+		}
+
+		r.query = query
+		return r, nil
+	}
+
+	for _, stmt := range stmts {
+		switch stmt.(type) {
+		case *UpsertIntoStmt, *UpdateStmt, *DeleteFromStmt, *MergeStmt, *CallStmt:
+		default:
+			return nil, fmt.Errorf("%w: only INSERT, UPSERT, UPDATE, DELETE, MERGE and CALL statements are allowed in procedures", ErrInvalidRoutine)
+		}
+	}
+
+	r.stmts = stmts
+
+	return r, nil
+}
+
+func (r *Routine) Name() string {
+	return r.name
+}
+
+func (r *Routine) Kind() RoutineKind {
+	return r.kind
+}
+
+// ArgNames returns the names of the arguments of the routine, empty for
+// the arguments without a name.
+func (r *Routine) ArgNames() []string {
+	names := make([]string, len(r.args))
+	for i, arg := range r.args {
+		names[i] = arg.name
+	}
+	return names
+}
+
+func (r *Routine) ArgTypes() []SQLValueType {
+	types := make([]SQLValueType, len(r.args))
+	for i, arg := range r.args {
+		types[i] = arg.t
+	}
+	return types
+}
+
+// ReturnType returns the type of the values returned by a function, empty
+// for procedures.
+func (r *Routine) ReturnType() SQLValueType {
+	return r.returns
+}
+
+// Body returns the SQL text of the body of the routine.
+func (r *Routine) Body() string {
+	return r.body
+}
+
+// sql returns the statement creating the routine, as persisted in the
+// catalog.
+func (r *Routine) sql() string {
+	args := make([]string, len(r.args))
+	for i, arg := range r.args {
+		args[i] = strings.TrimSpace(arg.name + " " + string(arg.t))
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "CREATE %s %s(%s)", r.kind, r.name, strings.Join(args, ", "))
+
+	if r.kind == RoutineFunction {
+		fmt.Fprintf(&b, " RETURNS %s", r.returns)
+	}
+
+	fmt.Fprintf(&b, " AS '%s'", strings.ReplaceAll(r.body, "'", "''"))
+
+	return b.String()
+}
+
+// checkQuery resolves the body of a function against the catalog, so that
+// references to unknown columns or arguments are reported on creation.
+func (r *Routine) checkQuery(ctx context.Context, tx *SQLTx) error {
+	params := make(map[string]SQLValueType)
+
+	err := r.query.inferParameters(ctx, tx, params)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidRoutine, err)
+	}
+
+	declared := make(map[string]struct{}, 2*len(r.args))
+	for i, arg := range r.args {
+		declared[arg.name] = struct{}{}
+		declared[fmt.Sprintf("param%d", i+1)] = struct{}{}
+	}
+
+	for name := range params {
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("%w: unknown argument '%s'", ErrInvalidRoutine, name)
+		}
+	}
+	return nil
+}
+
+// bindArgs returns the parameters the body of the routine is run with for
+// the values passed to it.
+func (r *Routine) bindArgs(values []TypedValue) (map[string]interface{}, error) {
+	if len(values) != len(r.args) {
+		return nil, fmt.Errorf("%w: %s '%s' expects %d arguments but %d were provided", ErrIllegalArguments, strings.ToLower(string(r.kind)), r.name, len(r.args), len(values))
+	}
+
+	params := make(map[string]interface{}, 2*len(r.args))
+
+	for i, arg := range r.args {
+		val, err := convertToType(values[i], arg.t)
+		if err != nil {
+			return nil, fmt.Errorf("%w: argument %d of %s '%s'", err, i+1, strings.ToLower(string(r.kind)), r.name)
+		}
+
+		if arg.name != "" {
+			params[arg.name] = val
+		}
+		params[fmt.Sprintf("param%d", i+1)] = val
+	}
+	return params, nil
+}
+
+// convertToType converts val into a value of type t, NULL values included
+func convertToType(val TypedValue, t SQLValueType) (TypedValue, error) {
+	if val.IsNull() {
+		return &NullValue{t: t}, nil
+	}
+
+	conv, err := getConverter(val.Type(), t)
+	if err != nil {
+		return nil, err
+	}
+	return conv(val)
+}
+
+// enterRoutine accounts for a nested call of r, the returned function must
+// be called once it completes.
+func (tx *SQLTx) enterRoutine(r *Routine) (func(), error) {
+	if tx.routineDepth >= maxRoutineDepth {
+		return nil, fmt.Errorf("%w (%s)", ErrMaxRoutineDepthExceeded, r.name)
+	}
+
+	tx.routineDepth++
+
+	return func() { tx.routineDepth-- }, nil
+}
+
+func (tx *SQLTx) callFunction(ctx context.Context, r *Routine, values []TypedValue) (TypedValue, error) {
+	params, err := r.bindArgs(values)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := tx.enterRoutine(r)
+	if err != nil {
+		return nil, err
+	}
+	defer exit()
+
+	reader, err := r.query.Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("function %s: %w", r.name, err)
+	}
+	defer reader.Close()
+
+	row, err := reader.Read(ctx)
+	if errors.Is(err, ErrNoMoreRows) {
+		return &NullValue{t: r.returns}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("function %s: %w", r.name, err)
+	}
+
+	if len(row.ValuesByPosition) == 0 {
+		return &NullValue{t: r.returns}, nil
+	}
+
+	val, err := convertToType(row.ValuesByPosition[0], r.returns)
+	if err != nil {
+		return nil, fmt.Errorf("function %s: %w", r.name, err)
+	}
+	return val, nil
+}
+
+func (tx *SQLTx) callProcedure(ctx context.Context, r *Routine, values []TypedValue) error {
+	params, err := r.bindArgs(values)
+	if err != nil {
+		return err
+	}
+
+	exit, err := tx.enterRoutine(r)
+	if err != nil {
+		return err
+	}
+	defer exit()
+
+	for _, stmt := range r.stmts {
+		_, err := triggerStmt(stmt).execAt(ctx, tx, params)
+		if err != nil {
+			return fmt.Errorf("procedure %s: %w", r.name, err)
+		}
+	}
+	return nil
+}
+
+// storedFunction calls the stored function with the given name. Its
+// definition is only known to the catalog of the transaction calling it, so
+// the type of the values it returns is not inferred.
+type storedFunction struct {
+	name string
+}
+
+func (f *storedFunction) InferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	return AnyType, nil
+}
+
+func (f *storedFunction) RequiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
+	return nil
+}
+
+func (f *storedFunction) Apply(tx *SQLTx, params []TypedValue) (TypedValue, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("%w: unknown function %s", ErrIllegalArguments, f.name)
+	}
+
+	r, exists := tx.catalog.routines[f.name]
+	if !exists || r.kind != RoutineFunction {
+		return nil, fmt.Errorf("%w: unknown function %s", ErrIllegalArguments, f.name)
+	}
+	return tx.callFunction(context.Background(), r, params)
+}
+
+// GetRoutines returns the stored functions and procedures sorted by name.
+func (catlg *Catalog) GetRoutines() []*Routine {
+	routines := make([]*Routine, 0, len(catlg.routines))
+	for _, r := range catlg.routines {
+		routines = append(routines, r)
+	}
+
+	sort.Slice(routines, func(i, j int) bool {
+		return routines[i].name < routines[j].name
+	})
+	return routines
+}
+
+func (catlg *Catalog) GetRoutineByName(name string) (*Routine, error) {
+	r, exists := catlg.routines[name]
+	if !exists {
+		return nil, fmt.Errorf("%w (%s)", ErrRoutineDoesNotExist, name)
+	}
+	return r, nil
+}
+
+func routineKey(tx *SQLTx, name string) []byte {
+	return MapKey(tx.sqlPrefix(), catalogRoutinePrefix, EncodeID(DatabaseID), []byte(name))
+}
+
+func loadRoutines(ctx context.Context, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]*Routine, error) {
+	prefix := MapKey(sqlPrefix, catalogRoutinePrefix, EncodeID(DatabaseID))
+	routines := make(map[string]*Routine)
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(key) <= len(prefix) {
+			return ErrCorruptedData
+		}
+
+		stmts, err := ParseSQLString(string(value))
+		if err != nil {
+			return err
+		}
+
+		if len(stmts) != 1 {
+			return ErrCorruptedData
+		}
+
+		stmt, ok := stmts[0].(*CreateRoutineStmt)
+		if !ok {
+			return ErrCorruptedData
+		}
+
+		r, err := newRoutine(stmt.kind, string(key[len(prefix):]), stmt.args, stmt.returns, stmt.body)
+		if err != nil {
+			return err
+		}
+		routines[r.name] = r
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return routines, err
+}
+
+type CreateRoutineStmt struct {
+	kind      RoutineKind
+	name      string
+	args      []routineArg
+	returns   SQLValueType
+	body      string
+	orReplace bool
+}
+
+func (stmt *CreateRoutineStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateRoutineStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateRoutineStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateRoutineStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if _, builtin := builtinFunctions[strings.ToUpper(stmt.name)]; builtin {
+		return nil, fmt.Errorf("%w: '%s' is a builtin function", ErrIllegalArguments, stmt.name)
+	}
+
+	if curr, exists := tx.catalog.routines[stmt.name]; exists {
+		if !stmt.orReplace {
+			return nil, fmt.Errorf("%w (%s)", ErrRoutineAlreadyExists, stmt.name)
+		}
+
+		if curr.kind != stmt.kind {
+			return nil, fmt.Errorf("%w: '%s' is not a %s", ErrIllegalArguments, stmt.name, strings.ToLower(string(stmt.kind)))
+		}
+	}
+
+	r, err := newRoutine(stmt.kind, stmt.name, stmt.args, stmt.returns, stmt.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.kind == RoutineFunction {
+		err = r.checkQuery(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.set(routineKey(tx, r.name), nil, []byte(r.sql()))
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.catalog.routines == nil {
+		tx.catalog.routines = make(map[string]*Routine)
+	}
+	tx.catalog.routines[r.name] = r
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+type DropRoutineStmt struct {
+	kind     RoutineKind
+	name     string
+	ifExists bool
+}
+
+func (stmt *DropRoutineStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropRoutineStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropRoutineStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropRoutineStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	r, exists := tx.catalog.routines[stmt.name]
+	if !exists {
+		if stmt.ifExists {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w (%s)", ErrRoutineDoesNotExist, stmt.name)
+	}
+
+	if r.kind != stmt.kind {
+		return nil, fmt.Errorf("%w: '%s' is not a %s", ErrIllegalArguments, stmt.name, strings.ToLower(string(stmt.kind)))
+	}
+
+	delete(tx.catalog.routines, stmt.name)
+
+	err := tx.delete(ctx, routineKey(tx, stmt.name))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// CallStmt runs a stored procedure.
+type CallStmt struct {
+	name   string
+	params []ValueExp
+}
+
+func (stmt *CallStmt) readOnly() bool {
+	return false
+}
+
+// requiredPrivileges returns no privilege, as the statements run by the
+// procedure check their own ones.
+func (stmt *CallStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *CallStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	r, exists := tx.catalog.routines[stmt.name]
+	if !exists || len(r.args) != len(stmt.params) {
+		for _, p := range stmt.params {
+			_, err := p.inferType(nil, params, "")
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i, p := range stmt.params {
+		err := p.requiresType(r.args[i].t, nil, params, "")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (stmt *CallStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	r, exists := tx.catalog.routines[stmt.name]
+	if !exists || r.kind != RoutineProcedure {
+		return nil, fmt.Errorf("%w (%s)", ErrRoutineDoesNotExist, stmt.name)
+	}
+
+	values := make([]TypedValue, len(stmt.params))
+
+	for i, p := range stmt.params {
+		exp, err := p.substitute(params)
+		if err != nil {
+			return nil, err
+		}
+
+		values[i], err = exp.reduce(tx, nil, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := tx.callProcedure(ctx, r, values)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
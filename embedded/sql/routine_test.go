@@ -0,0 +1,251 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutineStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		CREATE FUNCTION balance_of(account INTEGER, VARCHAR[8]) RETURNS FLOAT AS $$
+			SELECT balance FROM accounts WHERE id = @account AND currency = $2
+		$$ LANGUAGE sql;
+		CREATE OR REPLACE PROCEDURE transfer(src INTEGER, dst INTEGER) AS $$
+			UPDATE accounts SET balance = balance - 1 WHERE id = @src;
+			UPDATE accounts SET balance = balance + 1 WHERE id = @dst
+		$$;
+		CREATE FUNCTION answer() RETURNS INTEGER AS 'SELECT 42';
+		CALL transfer(1, 2);
+		DROP FUNCTION IF EXISTS balance_of(INTEGER, VARCHAR);
+		DROP PROCEDURE transfer
+	`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 6)
+
+	fn, ok := stmts[0].(*CreateRoutineStmt)
+	require.True(t, ok)
+	require.Equal(t, RoutineFunction, fn.kind)
+	require.Equal(t, "balance_of", fn.name)
+	require.Equal(t, []routineArg{{name: "account", t: IntegerType}, {t: VarcharType}}, fn.args)
+	require.Equal(t, Float64Type, fn.returns)
+	require.Contains(t, fn.body, "currency = $2")
+	require.False(t, fn.orReplace)
+
+	proc, ok := stmts[1].(*CreateRoutineStmt)
+	require.True(t, ok)
+	require.Equal(t, RoutineProcedure, proc.kind)
+	require.True(t, proc.orReplace)
+	require.Contains(t, proc.body, "UPDATE accounts SET balance = balance + 1")
+
+	require.Equal(t, "SELECT 42", stmts[2].(*CreateRoutineStmt).body)
+
+	call, ok := stmts[3].(*CallStmt)
+	require.True(t, ok)
+	require.Equal(t, "transfer", call.name)
+	require.Len(t, call.params, 2)
+
+	require.Equal(t, &DropRoutineStmt{kind: RoutineFunction, name: "balance_of", ifExists: true}, stmts[4])
+	require.Equal(t, &DropRoutineStmt{kind: RoutineProcedure, name: "transfer"}, stmts[5])
+
+	_, err = ParseSQLString("CREATE FUNCTION f() RETURNS INTEGER AS $$ SELECT 1")
+	require.Error(t, err)
+
+	_, err = ParseSQLString("CREATE FUNCTION f() RETURNS INTEGER AS $$ SELECT 1 $$ LANGUAGE plpgsql")
+	require.Error(t, err)
+
+	_, err = ParseSQLString("CREATE OR UPDATE FUNCTION f() RETURNS INTEGER AS $$ SELECT 1 $$")
+	require.Error(t, err)
+}
+
+func TestRoutines(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	ctx := context.Background()
+
+	exec := func(sql string) ([]*SQLTx, error) {
+		_, txs, err := engine.Exec(ctx, nil, sql, nil)
+		return txs, err
+	}
+
+	query := func(engine *Engine, sql string) [][]interface{} {
+		return queryValues(t, engine, nil, sql, nil)
+	}
+
+	_, err := exec(`
+		CREATE TABLE accounts (id INTEGER, owner VARCHAR[16], balance INTEGER, PRIMARY KEY id);
+		CREATE TABLE transfers (id INTEGER AUTO_INCREMENT, src INTEGER, dst INTEGER, amount INTEGER, PRIMARY KEY id);
+		INSERT INTO accounts (id, owner, balance) VALUES (1, 'alice', 100), (2, 'bob', 50);
+	`)
+	require.NoError(t, err)
+
+	t.Run("functions", func(t *testing.T) {
+		_, err := exec(`
+			CREATE FUNCTION balance_of(account INTEGER) RETURNS INTEGER AS $$
+				SELECT balance FROM accounts WHERE id = @account
+			$$;
+			CREATE FUNCTION half(INTEGER) RETURNS FLOAT AS $$ SELECT $1 / 2.0 $$;
+			CREATE FUNCTION greeting(name VARCHAR) RETURNS VARCHAR AS 'SELECT CONCAT(''hello '', @name)';
+		`)
+		require.NoError(t, err)
+
+		require.Equal(t,
+			[][]interface{}{{"alice", int64(100), float64(50)}, {"bob", int64(50), float64(25)}},
+			query(engine, "SELECT owner, balance_of(id), half(balance_of(id)) FROM accounts"),
+		)
+
+		require.Equal(t,
+			[][]interface{}{{"bob"}},
+			query(engine, "SELECT owner FROM accounts WHERE balance_of(id) < 100"),
+		)
+
+		// no rows and NULL arguments are NULL
+		require.Equal(t,
+			[][]interface{}{{nil, nil, "hello carol"}},
+			query(engine, "SELECT balance_of(3), balance_of(NULL), greeting('carol')"),
+		)
+
+		_, err = engine.queryAll(ctx, nil, "SELECT balance_of(1, 2)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = engine.queryAll(ctx, nil, "SELECT missing(1)", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+
+	t.Run("procedures", func(t *testing.T) {
+		_, err := exec(`
+			CREATE PROCEDURE log_transfer(src INTEGER, dst INTEGER, amount INTEGER) AS $$
+				INSERT INTO transfers (src, dst, amount) VALUES (@src, @dst, @amount)
+			$$;
+			CREATE PROCEDURE transfer(src INTEGER, dst INTEGER, amount INTEGER) AS $$
+				UPDATE accounts SET balance = balance - @amount WHERE id = @src;
+				UPDATE accounts SET balance = balance + @amount WHERE id = @dst;
+				CALL log_transfer(@src, @dst, @amount)
+			$$;
+		`)
+		require.NoError(t, err)
+
+		txs, err := exec("CALL transfer(1, 2, balance_of(1) / 4)")
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+
+		require.Equal(t,
+			[][]interface{}{{int64(1), int64(75)}, {int64(2), int64(75)}},
+			query(engine, "SELECT id, balance FROM accounts"),
+		)
+		require.Equal(t,
+			[][]interface{}{{int64(1), int64(2), int64(25)}},
+			query(engine, "SELECT src, dst, amount FROM transfers"),
+		)
+
+		params, err := engine.InferParameters(ctx, nil, "CALL transfer(@a, 2, 1)")
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"a": IntegerType}, params)
+
+		_, err = exec("CALL missing()")
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		_, err = exec("CALL balance_of(1)")
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		_, err = exec("CALL transfer(1, 2)")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("CREATE PROCEDURE loop() AS 'CALL loop()'")
+		require.NoError(t, err)
+
+		_, err = exec("CALL loop()")
+		require.ErrorIs(t, err, ErrMaxRoutineDepthExceeded)
+	})
+
+	t.Run("catalog", func(t *testing.T) {
+		_, err := exec("CREATE FUNCTION half(INTEGER) RETURNS INTEGER AS 'SELECT 1'")
+		require.ErrorIs(t, err, ErrRoutineAlreadyExists)
+
+		_, err = exec("CREATE OR REPLACE FUNCTION half(INTEGER) RETURNS INTEGER AS 'SELECT $1 / 2'")
+		require.NoError(t, err)
+		require.Equal(t, [][]interface{}{{int64(5)}}, query(engine, "SELECT half(11)"))
+
+		_, err = exec("CREATE OR REPLACE PROCEDURE half(INTEGER) AS 'DELETE FROM transfers'")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("CREATE FUNCTION upper(VARCHAR) RETURNS VARCHAR AS 'SELECT @param1'")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("CREATE FUNCTION f() RETURNS INTEGER AS 'DELETE FROM transfers'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE PROCEDURE p() AS 'SELECT 1'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE FUNCTION f(a INTEGER, a INTEGER) RETURNS INTEGER AS 'SELECT @a'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		// bodies are resolved against the declared arguments and the catalog
+		_, err = exec("CREATE FUNCTION f(account INTEGER) RETURNS INTEGER AS 'SELECT balance FROM accounts WHERE id = account'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE FUNCTION f(account INTEGER) RETURNS INTEGER AS 'SELECT balance FROM accounts WHERE id = @acount'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE FUNCTION f(INTEGER) RETURNS INTEGER AS 'SELECT $2'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE FUNCTION f(account INTEGER) RETURNS INTEGER AS 'SELECT missing FROM accounts WHERE id = @account'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("CREATE FUNCTION f() RETURNS INTEGER AS 'SELECT 1 FROM missing'")
+		require.ErrorIs(t, err, ErrInvalidRoutine)
+
+		_, err = exec("DROP FUNCTION transfer")
+		require.ErrorIs(t, err, ErrIllegalArguments)
+
+		_, err = exec("DROP PROCEDURE loop; DROP FUNCTION greeting(VARCHAR); DROP FUNCTION IF EXISTS greeting")
+		require.NoError(t, err)
+
+		_, err = exec("DROP FUNCTION greeting")
+		require.ErrorIs(t, err, ErrRoutineDoesNotExist)
+
+		// routines created by rolled back transactions are discarded
+		_, err = exec("BEGIN; CREATE FUNCTION discarded() RETURNS INTEGER AS 'SELECT 1'; ROLLBACK;")
+		require.NoError(t, err)
+
+		names := func(routines []*Routine) []string {
+			var names []string
+			for _, r := range routines {
+				names = append(names, r.Name())
+			}
+			return names
+		}
+
+		tx, err := engine.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		require.Equal(t, []string{"balance_of", "half", "log_transfer", "transfer"}, names(tx.Catalog().GetRoutines()))
+		require.NoError(t, tx.Cancel())
+
+		// routines are persisted in the catalog
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+
+		tx, err = reopened.NewTx(ctx, DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		r, err := tx.Catalog().GetRoutineByName("half")
+		require.NoError(t, err)
+		require.Equal(t, RoutineFunction, r.Kind())
+		require.Equal(t, []SQLValueType{IntegerType}, r.ArgTypes())
+		require.Equal(t, IntegerType, r.ReturnType())
+		require.Equal(t, "SELECT $1 / 2", r.Body())
+
+		require.Equal(t, [][]interface{}{{int64(75)}}, query(reopened, "SELECT balance_of(2)"))
+	})
+}
@@ -17,12 +17,14 @@ limitations under the License.
 package sql
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/store"
 )
@@ -54,6 +56,18 @@ type ScanSpecs struct {
 	// Columns absent from the map are skipped (offset advanced, no allocation).
 	// nil means decode all columns (backward-compatible default).
 	neededColIDs map[uint32]bool
+	// fullText, when non-nil, reads the rows found in a fulltext index
+	// instead of scanning Index.
+	fullText *fullTextScan
+	// seekAfter, when non-nil, resumes an ascending scan after the given
+	// mapped key.
+	seekAfter []byte
+	// partitions, when non-nil, are the partitions left to read after
+	// pruning, ordered by their bounds.
+	partitions []*Table
+	// systemCols are the pseudo-columns referenced by the query, laid out
+	// after the other synthetic columns.
+	systemCols []string
 }
 
 func (s *ScanSpecs) extraCols() int {
@@ -69,7 +83,7 @@ func (s *ScanSpecs) extraCols() int {
 	if s.IncludeTxMetadata {
 		n++
 	}
-	return n
+	return n + len(s.systemCols)
 }
 
 type Row struct {
@@ -152,6 +166,9 @@ type rawRowReader struct {
 
 	reader          store.KeyReader
 	onCloseCallback func()
+
+	// header of the last tx whose timestamp was read
+	lastTxHdr *store.TxHeader
 }
 
 type txRange struct {
@@ -208,6 +225,20 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 	// that has no entries.
 	if table.systemScan != nil {
 		r = &emptyKeyReader{}
+	} else if scanSpecs.fullText != nil {
+		r = newFullTextKeyReader(tx, scanSpecs)
+	} else if period.systemTime {
+		// the validity of a version ends with the next version of its key,
+		// deleted ones included, so the whole history is read unfiltered
+		filters := rSpec.Filters
+		rSpec.IncludeHistory = true
+
+		r, err = tx.newKeyReader(*rSpec)
+		if err != nil {
+			return nil, err
+		}
+
+		r = newSystemTimeKeyReader(r, filters, tx.Timestamp())
 	} else {
 		r, err = tx.newKeyReader(*rSpec)
 		if err != nil {
@@ -249,6 +280,18 @@ func newRawRowReader(tx *SQLTx, params map[string]interface{}, table *Table, per
 		off++
 	}
 
+	for _, col := range scanSpecs.systemCols {
+		colDescriptor := ColDescriptor{
+			Table:  tableAlias,
+			Column: col,
+			Type:   systemColType(col),
+		}
+
+		colsByPos[off] = colDescriptor
+		colsBySel[colDescriptor.Selector()] = colDescriptor
+		off++
+	}
+
 	for i, c := range table.cols {
 		colDescriptor := ColDescriptor{
 			Table:  tableAlias,
@@ -327,14 +370,18 @@ func keyReaderSpecFrom(sqlPrefix []byte, table *Table, scanSpecs *ScanSpecs) (sp
 
 	seekKey := loKey
 	endKey := hiKey
+	inclusiveSeek := true
 
 	if scanSpecs.DescOrder {
 		seekKey, endKey = endKey, seekKey
+	} else if scanSpecs.seekAfter != nil && bytes.Compare(scanSpecs.seekAfter, seekKey) >= 0 {
+		seekKey = scanSpecs.seekAfter
+		inclusiveSeek = false
 	}
 
 	return &store.KeyReaderSpec{
 		SeekKey:        seekKey,
-		InclusiveSeek:  true,
+		InclusiveSeek:  inclusiveSeek,
 		EndKey:         endKey,
 		InclusiveEnd:   true,
 		Prefix:         prefix,
@@ -425,7 +472,16 @@ func (r *rawRowReader) reduceTxRange() (err error) {
 		finalTxID:   uint64(math.MaxUint64),
 	}
 
-	if r.period.start != nil {
+	if r.period.systemTime {
+		// a version is still valid at the start of the period unless it was
+		// replaced by then, so the range starts with the first tx after it
+		txRange.initialTxID, err = r.period.start.instant.resolve(r.tx, r.params, true, false)
+		if errors.Is(err, store.ErrTxNotFound) {
+			txRange.initialTxID = math.MaxUint64
+		} else if err != nil {
+			return err
+		}
+	} else if r.period.start != nil {
 		txRange.initialTxID, err = r.period.start.instant.resolve(r.tx, r.params, true, r.period.start.inclusive)
 		if err != nil {
 			return err
@@ -499,13 +555,23 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		var val TypedValue
 
 		switch col.Column {
-		case revCol:
+		case revCol, revisionCol:
 			val = &Integer{val: int64(vref.HC())}
 		case txMetadataCol:
 			val, err = r.parseTxMetadata(vref.TxMetadata())
 			if err != nil {
 				return nil, err
 			}
+		case txIDCol:
+			val = &NullValue{t: IntegerType}
+			if vref.Tx() > 0 {
+				val = &Integer{val: int64(vref.Tx())}
+			}
+		case txTimestampCol:
+			val, err = r.txTimestamp(vref.Tx())
+			if err != nil {
+				return nil, err
+			}
 		default:
 			val = &NullValue{t: col.Type}
 		}
@@ -573,7 +639,12 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		}
 
 		if pos == len(r.table.cols) || r.table.cols[pos].id != colID {
-			return nil, ErrCorruptedData
+			// a column whose type was changed keeps its position under a
+			// newer id, so its value may be found out of order
+			pos = r.table.colPosition(colID)
+			if pos < 0 {
+				return nil, ErrCorruptedData
+			}
 		}
 
 		valuesByPosition[pos+extraCols] = val
@@ -587,6 +658,13 @@ func (r *rawRowReader) Read(ctx context.Context) (*Row, error) {
 		return nil, ErrCorruptedData
 	}
 
+	if r.table.hasVirtualColumns() {
+		err = r.evalVirtualColumns(valuesByPosition, valuesBySelector, extraCols)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Row{ValuesByPosition: valuesByPosition, ValuesBySelector: valuesBySelector}, nil
 }
 
@@ -736,6 +814,24 @@ func (r *rawRowReader) parseTxMetadata(txmd *store.TxMetadata) (TypedValue, erro
 	return &NullValue{t: JSONType}, nil
 }
 
+// txTimestamp returns the commit time of the given tx, or NULL for rows
+// written by the ongoing transaction. Rows tend to come in runs of the
+// same tx, so the last header read is kept around.
+func (r *rawRowReader) txTimestamp(txID uint64) (TypedValue, error) {
+	if txID == 0 {
+		return &NullValue{t: TimestampType}, nil
+	}
+
+	if r.lastTxHdr == nil || r.lastTxHdr.ID != txID {
+		hdr, err := r.tx.engine.store.ReadTxHeader(txID, true, true)
+		if err != nil {
+			return nil, err
+		}
+		r.lastTxHdr = hdr
+	}
+	return &Timestamp{val: time.Unix(r.lastTxHdr.Ts, 0).UTC()}, nil
+}
+
 func (r *rawRowReader) Close() error {
 	if r.onCloseCallback != nil {
 		defer r.onCloseCallback()
@@ -0,0 +1,399 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Schemas are namespaces for the tables, views and sequences of a database.
+// Objects of a schema other than public are kept in the catalog under their
+// qualified "schema.name" names, while public objects keep their bare names,
+// so databases created before schemas existed need no migration.
+//
+// Unqualified names are resolved through the search path of the
+// transaction: the first schema of the path holding an object with that name
+// wins, and new objects are created in the first existing schema of the path.
+// System tables are always visible, as if pg_catalog was implicitly searched
+// first.
+
+const (
+	PublicSchema            = "public"
+	PgCatalogSchema         = "pg_catalog"
+	InformationSchemaSchema = "information_schema"
+)
+
+// systemSchemas are the schemas every database has. They can neither be
+// created nor dropped.
+var systemSchemas = []string{PgCatalogSchema, PublicSchema, InformationSchemaSchema}
+
+// SplitTableName splits the catalog name of a table, view or sequence into
+// its schema and its unqualified name.
+func SplitTableName(name string) (schema, table string) {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return PublicSchema, name
+}
+
+// schemaQualifiedName returns the catalog name of the object name of schema.
+func schemaQualifiedName(schema, name string) string {
+	if schema == PublicSchema || schema == PgCatalogSchema {
+		return name
+	}
+	return schema + "." + name
+}
+
+// fullyQualifiedName returns the schema-qualified form of a catalog name,
+// which refers to the object whatever the search path is.
+func fullyQualifiedName(name string) string {
+	schema, obj := SplitTableName(name)
+	return schema + "." + obj
+}
+
+// qualifiedName returns the fully qualified name of the table.
+func (t *Table) qualifiedName() string {
+	return fullyQualifiedName(t.name)
+}
+
+func isQualifiedName(name string) bool {
+	return strings.IndexByte(name, '.') >= 0
+}
+
+func isSystemSchema(name string) bool {
+	for _, s := range systemSchemas {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSchemas returns the names of the schemas of the database, system
+// schemas first.
+func (catlg *Catalog) GetSchemas() []string {
+	schemas := make([]string, 0, len(systemSchemas)+len(catlg.schemas))
+
+	for name := range catlg.schemas {
+		schemas = append(schemas, name)
+	}
+	sort.Strings(schemas)
+
+	return append(append([]string{}, systemSchemas...), schemas...)
+}
+
+func (catlg *Catalog) ExistSchema(name string) bool {
+	if isSystemSchema(name) {
+		return true
+	}
+	_, exists := catlg.schemas[name]
+	return exists
+}
+
+// SearchPath returns the schemas searched for unqualified names.
+func (catlg *Catalog) SearchPath() []string {
+	if catlg.searchPath == nil {
+		return []string{PublicSchema}
+	}
+	return catlg.searchPath
+}
+
+// CurrentSchema returns the first existing schema of the search path, the
+// one new objects are created in, or an empty string if there is none.
+func (catlg *Catalog) CurrentSchema() string {
+	for _, schema := range catlg.SearchPath() {
+		if schema != PgCatalogSchema && schema != InformationSchemaSchema && catlg.ExistSchema(schema) {
+			return schema
+		}
+	}
+	return ""
+}
+
+// withSearchPath returns a shallow copy of the catalog resolving unqualified
+// names through searchPath. Used to share a read-only catalog between
+// transactions with different search paths.
+func (catlg *Catalog) withSearchPath(searchPath []string) *Catalog {
+	if equalSearchPaths(catlg.searchPath, searchPath) {
+		return catlg
+	}
+
+	cp := *catlg
+	cp.searchPath = searchPath
+	return &cp
+}
+
+func equalSearchPaths(a, b []string) bool {
+	if len(a) != len(b) || (a == nil) != (b == nil) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveName returns the catalog name of the object referenced by name for
+// which exists holds. Qualified names are taken as they are, unqualified ones
+// are looked up in the schemas of the search path.
+func (catlg *Catalog) resolveName(name string, exists func(string) bool) (string, bool) {
+	if isQualifiedName(name) {
+		qname := schemaQualifiedName(SplitTableName(name))
+		return qname, exists(qname)
+	}
+
+	if catlg.searchPath == nil {
+		return name, exists(name)
+	}
+
+	for _, schema := range catlg.searchPath {
+		if !catlg.ExistSchema(schema) {
+			continue
+		}
+
+		qname := schemaQualifiedName(schema, name)
+		if exists(qname) {
+			return qname, true
+		}
+	}
+	return name, false
+}
+
+// creationName returns the catalog name a new object referenced by name is
+// created with.
+func (catlg *Catalog) creationName(name string) (string, error) {
+	if isQualifiedName(name) {
+		schema, obj := SplitTableName(name)
+
+		if !catlg.ExistSchema(schema) {
+			return "", fmt.Errorf("%w (%s)", ErrSchemaDoesNotExist, schema)
+		}
+
+		if schema == PgCatalogSchema || schema == InformationSchemaSchema {
+			return "", fmt.Errorf("%w: objects can not be created in system schema '%s'", ErrIllegalArguments, schema)
+		}
+
+		return schemaQualifiedName(schema, obj), nil
+	}
+
+	schema := catlg.CurrentSchema()
+	if schema == "" {
+		return "", ErrNoSchemaSelected
+	}
+	return schemaQualifiedName(schema, name), nil
+}
+
+func (catlg *Catalog) lookupTable(name string) (*Table, bool) {
+	if !isQualifiedName(name) {
+		// system tables are visible whatever the search path is
+		if table, exists := catlg.tablesByName[name]; exists && (table.systemScan != nil || catlg.searchPath == nil) {
+			return table, true
+		}
+	}
+
+	qname, exists := catlg.resolveName(name, func(qname string) bool {
+		_, exists := catlg.tablesByName[qname]
+		return exists
+	})
+	if !exists {
+		return nil, false
+	}
+	return catlg.tablesByName[qname], true
+}
+
+// resolveSequenceName returns the name the sequence referenced by name is
+// registered with.
+func (tx *SQLTx) resolveSequenceName(name string) string {
+	qname, _ := tx.catalog.resolveName(name, func(qname string) bool {
+		_, exists := tx.engine.sequences[qname]
+		return exists
+	})
+	return qname
+}
+
+// resolveViewName returns the name the view referenced by name is
+// registered with.
+func (tx *SQLTx) resolveViewName(name string) string {
+	qname, _ := tx.catalog.resolveName(name, func(qname string) bool {
+		return tx.engine.tableResolveFor(qname) != nil
+	})
+	return qname
+}
+
+// SetSearchPath changes the schemas searched for unqualified names for the
+// rest of the transaction. A nil path only searches the public schema.
+func (tx *SQLTx) SetSearchPath(searchPath []string) {
+	tx.opts.SearchPath = searchPath
+
+	if tx.opts.ReadOnly {
+		// read-only transactions may share their catalog
+		tx.catalog = tx.catalog.withSearchPath(searchPath)
+		return
+	}
+	tx.catalog.searchPath = searchPath
+}
+
+func (catlg *Catalog) newSchema(name string) error {
+	if catlg.ExistSchema(name) {
+		return fmt.Errorf("%w (%s)", ErrSchemaAlreadyExists, name)
+	}
+
+	if catlg.schemas == nil {
+		catlg.schemas = make(map[string]struct{})
+	}
+	catlg.schemas[name] = struct{}{}
+
+	return nil
+}
+
+func loadSchemas(ctx context.Context, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]struct{}, error) {
+	prefix := MapKey(sqlPrefix, catalogSchemaPrefix, EncodeID(DatabaseID))
+	schemas := make(map[string]struct{})
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(key) <= len(prefix) {
+			return ErrCorruptedData
+		}
+		schemas[string(key[len(prefix):])] = struct{}{}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return schemas, err
+}
+
+func schemaKey(tx *SQLTx, name string) []byte {
+	return MapKey(tx.sqlPrefix(), catalogSchemaPrefix, EncodeID(DatabaseID), []byte(name))
+}
+
+type CreateSchemaStmt struct {
+	name        string
+	ifNotExists bool
+}
+
+func (stmt *CreateSchemaStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateSchemaStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateSchemaStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateSchemaStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.ifNotExists && tx.catalog.ExistSchema(stmt.name) {
+		return tx, nil
+	}
+
+	err := tx.catalog.newSchema(stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.set(schemaKey(tx, stmt.name), nil, []byte(stmt.name))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+type DropSchemaStmt struct {
+	name     string
+	ifExists bool
+}
+
+func (stmt *DropSchemaStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropSchemaStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropSchemaStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropSchemaStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if isSystemSchema(stmt.name) {
+		return nil, fmt.Errorf("%w: system schema '%s' can not be dropped", ErrIllegalArguments, stmt.name)
+	}
+
+	if !tx.catalog.ExistSchema(stmt.name) {
+		if stmt.ifExists {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w (%s)", ErrSchemaDoesNotExist, stmt.name)
+	}
+
+	if obj, found := tx.schemaObject(stmt.name); found {
+		return nil, fmt.Errorf("%w: schema '%s' contains '%s'", ErrSchemaNotEmpty, stmt.name, obj)
+	}
+
+	delete(tx.catalog.schemas, stmt.name)
+
+	err := tx.delete(ctx, schemaKey(tx, stmt.name))
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+// schemaObject returns the name of any table, view or sequence of schema.
+func (tx *SQLTx) schemaObject(schema string) (string, bool) {
+	prefix := schema + "."
+
+	for _, t := range tx.catalog.tables {
+		if strings.HasPrefix(t.name, prefix) {
+			return t.name, true
+		}
+	}
+
+	for name := range tx.engine.tableResolvers {
+		if strings.HasPrefix(name, prefix) {
+			return name, true
+		}
+	}
+
+	for name := range tx.engine.sequences {
+		if strings.HasPrefix(name, prefix) {
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		CREATE SCHEMA sales;
+		CREATE SCHEMA IF NOT EXISTS sales;
+		DROP SCHEMA sales;
+		DROP SCHEMA IF EXISTS sales;
+		CREATE TABLE sales.orders (id INTEGER, PRIMARY KEY id);
+		SELECT sales.orders.id FROM sales.orders;
+		DROP INDEX sales.orders.id;
+		DROP TABLE public.customers`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 8)
+
+	require.Equal(t, &CreateSchemaStmt{name: "sales"}, stmts[0])
+	require.Equal(t, &CreateSchemaStmt{name: "sales", ifNotExists: true}, stmts[1])
+	require.Equal(t, &DropSchemaStmt{name: "sales"}, stmts[2])
+	require.Equal(t, &DropSchemaStmt{name: "sales", ifExists: true}, stmts[3])
+	require.Equal(t, "sales.orders", stmts[4].(*CreateTableStmt).table)
+
+	sel := stmts[5].(*SelectStmt)
+	require.Equal(t, "sales.orders", sel.ds.(*tableRef).table)
+	require.Equal(t, "orders", sel.ds.(*tableRef).Alias())
+	require.Equal(t, &ColSelector{table: "orders", col: "id"}, sel.targets[0].Exp)
+
+	require.Equal(t, "sales.orders", stmts[6].(*DropIndexStmt).table)
+	require.Equal(t, "public.customers", stmts[7].(*DropTableStmt).table)
+}
+
+func TestSchemas(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	ctx := context.Background()
+
+	// exec runs sql resolving unqualified names through searchPath
+	exec := func(searchPath []string, sql string) error {
+		tx, err := engine.NewTx(ctx, DefaultTxOptions().WithSearchPath(searchPath))
+		if err != nil {
+			return err
+		}
+		_, _, err = engine.Exec(ctx, tx, sql, nil)
+		return err
+	}
+
+	query := func(searchPath []string, sql string) [][]string {
+		tx, err := engine.NewTx(ctx, DefaultTxOptions().WithReadOnly(true).WithSearchPath(searchPath))
+		require.NoError(t, err)
+		defer tx.Cancel()
+
+		return queryRowsAs(t, engine, tx, sql, nil, TypedValue.String)
+	}
+
+	err := exec(nil, `
+		CREATE TABLE orders (id INTEGER, amount INTEGER, PRIMARY KEY id);
+		INSERT INTO orders (id, amount) VALUES (1, 10);
+		CREATE SCHEMA sales;
+		CREATE TABLE sales.orders (id INTEGER AUTO_INCREMENT, amount INTEGER, PRIMARY KEY id);
+		CREATE INDEX ON sales.orders (amount);
+		INSERT INTO sales.orders (amount) VALUES (100), (200);
+	`)
+	require.NoError(t, err)
+
+	t.Run("schemas must exist and system schemas are reserved", func(t *testing.T) {
+		require.ErrorIs(t, exec(nil, "CREATE SCHEMA sales"), ErrSchemaAlreadyExists)
+		require.ErrorIs(t, exec(nil, "CREATE SCHEMA public"), ErrSchemaAlreadyExists)
+		require.NoError(t, exec(nil, "CREATE SCHEMA IF NOT EXISTS sales"))
+
+		require.ErrorIs(t, exec(nil, "CREATE TABLE missing.t (id INTEGER, PRIMARY KEY id)"), ErrSchemaDoesNotExist)
+		require.ErrorIs(t, exec(nil, "CREATE TABLE pg_catalog.t (id INTEGER, PRIMARY KEY id)"), ErrIllegalArguments)
+		require.ErrorIs(t, exec([]string{"missing"}, "CREATE TABLE t (id INTEGER, PRIMARY KEY id)"), ErrNoSchemaSelected)
+
+		require.ErrorIs(t, exec(nil, "DROP SCHEMA public"), ErrIllegalArguments)
+		require.ErrorIs(t, exec(nil, "DROP SCHEMA missing"), ErrSchemaDoesNotExist)
+		require.NoError(t, exec(nil, "DROP SCHEMA IF EXISTS missing"))
+		require.ErrorIs(t, exec(nil, "DROP SCHEMA sales"), ErrSchemaNotEmpty)
+	})
+
+	t.Run("qualified names", func(t *testing.T) {
+		require.Equal(t, [][]string{{"1", "10"}}, query(nil, "SELECT id, amount FROM orders"))
+		require.Equal(t, [][]string{{"1", "10"}}, query(nil, "SELECT id, amount FROM public.orders"))
+		require.Equal(t, [][]string{{"2", "200"}}, query(nil, "SELECT orders.id, sales.orders.amount FROM sales.orders WHERE amount > 100"))
+
+		require.Equal(t,
+			[][]string{{"1", "10", "100"}},
+			query(nil, "SELECT p.id, p.amount, s.amount FROM orders p JOIN sales.orders s ON p.id = s.id"),
+		)
+
+		require.NoError(t, exec(nil, "UPDATE sales.orders SET amount = amount + 1 WHERE id = 1"))
+		require.NoError(t, exec(nil, "DELETE FROM sales.orders WHERE amount = 200"))
+		require.Equal(t, [][]string{{"1", "101"}}, query(nil, "SELECT id, amount FROM sales.orders"))
+		require.Equal(t, [][]string{{"1", "10"}}, query(nil, "SELECT id, amount FROM orders"))
+	})
+
+	t.Run("search path", func(t *testing.T) {
+		salesFirst := []string{"sales", "public"}
+
+		require.Equal(t, [][]string{{"1", "101"}}, query(salesFirst, "SELECT id, amount FROM orders"))
+		require.Equal(t, [][]string{{"1", "10"}}, query(salesFirst, "SELECT id, amount FROM public.orders"))
+		require.Equal(t, [][]string{{"'sales'"}}, query(salesFirst, "SELECT current_schema()"))
+		require.Equal(t, [][]string{{"'public'"}}, query(nil, "SELECT current_schema()"))
+
+		// tables not found in the first schema are looked up in the next ones
+		require.NoError(t, exec(nil, "CREATE TABLE customers (name VARCHAR[16], PRIMARY KEY name)"))
+		require.NoError(t, exec(salesFirst, "INSERT INTO customers (name) VALUES ('alice')"))
+		require.Equal(t, [][]string{{"'alice'"}}, query(nil, "SELECT name FROM customers"))
+
+		// new objects are created in the first schema
+		require.NoError(t, exec(salesFirst, `
+			CREATE TABLE items (id INTEGER, PRIMARY KEY id);
+			INSERT INTO items (id) VALUES (1);
+			INSERT INTO orders (amount) VALUES (300);
+		`))
+		require.Equal(t, [][]string{{"1"}}, query(nil, "SELECT id FROM sales.items"))
+		// the deleted row id 2 is not reused
+		require.Equal(t, [][]string{{"3", "300"}}, query(nil, "SELECT id, amount FROM sales.orders WHERE amount = 300"))
+
+		tx, err := engine.NewTx(ctx, DefaultTxOptions().WithSearchPath(salesFirst))
+		require.NoError(t, err)
+
+		r, err := engine.Query(ctx, tx, "DELETE FROM orders WHERE id = 3 RETURNING id, amount", nil)
+		require.NoError(t, err)
+
+		row, err := r.Read(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []TypedValue{NewInteger(3), NewInteger(300)}, row.ValuesByPosition)
+		require.NoError(t, r.Close())
+		require.NoError(t, tx.Cancel())
+
+		tables := query(nil, "SELECT name FROM tables() ORDER BY name")
+		require.Contains(t, tables, []string{"'sales.items'"})
+		require.NotContains(t, tables, []string{"'items'"})
+
+		// schemas not in the search path are not searched
+		require.ErrorIs(t, exec([]string{"public"}, "INSERT INTO items (id) VALUES (2)"), ErrTableDoesNotExist)
+	})
+
+	t.Run("views and sequences", func(t *testing.T) {
+		require.NoError(t, exec(nil, `
+			CREATE VIEW sales.big AS SELECT id, amount FROM sales.orders WHERE amount > 200;
+			CREATE SEQUENCE sales.ids;
+			CREATE TABLE sales.tags (id INTEGER, PRIMARY KEY id);
+		`))
+
+		require.Equal(t, [][]string{{"3", "300"}}, query(nil, "SELECT id, amount FROM sales.big"))
+		require.Equal(t, [][]string{{"3", "300"}}, query([]string{"sales"}, "SELECT id, amount FROM big"))
+
+		require.NoError(t, exec(nil, "INSERT INTO sales.tags (id) VALUES (NEXTVAL('sales.ids'))"))
+		require.NoError(t, exec([]string{"sales"}, "INSERT INTO tags (id) VALUES (NEXTVAL('ids'))"))
+		require.Equal(t, [][]string{{"1"}, {"2"}}, query(nil, "SELECT id FROM sales.tags"))
+
+		require.NoError(t, exec([]string{"sales"}, "DROP VIEW big"))
+		require.Error(t, exec(nil, "DROP VIEW sales.big"))
+	})
+
+	t.Run("rename keeps the schema", func(t *testing.T) {
+		require.NoError(t, exec(nil, "ALTER TABLE sales.items RENAME TO products"))
+		require.Equal(t, [][]string{{"1"}}, query(nil, "SELECT id FROM sales.products"))
+		require.ErrorIs(t, exec(nil, "ALTER TABLE sales.products RENAME TO public.products"), ErrIllegalArguments)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+		engine = reopened
+
+		require.Equal(t, [][]string{{"1", "101"}, {"3", "300"}}, query(nil, "SELECT id, amount FROM sales.orders"))
+		require.Equal(t, [][]string{{"1", "10"}}, query([]string{"sales"}, "SELECT id, amount FROM public.orders"))
+		require.ErrorIs(t, exec(nil, "CREATE SCHEMA sales"), ErrSchemaAlreadyExists)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		require.NoError(t, exec(nil, "TRUNCATE TABLE sales.products"))
+		require.Empty(t, query(nil, "SELECT id FROM sales.products"))
+
+		require.NoError(t, exec(nil, `
+			DROP SEQUENCE sales.ids;
+			DROP TABLE sales.products;
+			DROP TABLE sales.tags;
+		`))
+		require.ErrorIs(t, exec(nil, "DROP SCHEMA sales"), ErrSchemaNotEmpty)
+
+		require.NoError(t, exec([]string{"sales"}, "DROP TABLE orders"))
+		require.NoError(t, exec(nil, "DROP SCHEMA sales"))
+		require.Equal(t, [][]string{{"1", "10"}}, query(nil, "SELECT id, amount FROM orders"))
+		require.ErrorIs(t, exec(nil, "CREATE TABLE sales.t (id INTEGER, PRIMARY KEY id)"), ErrSchemaDoesNotExist)
+	})
+}
@@ -26,6 +26,18 @@ func setResult(l yyLexer, stmts []SQLStmt) {
     l.(*lexer).result = stmts
 }
 
+// sourceText returns the SQL text starting at offset from up to the token
+// being looked ahead, i.e. the text of the rule just reduced
+func sourceText(l yyLexer, from int) string {
+    return l.(*lexer).sourceText(from)
+}
+
+// sourceTextUntil returns the SQL text between offsets from and to, for
+// rules ending with a token, which are reduced without looking ahead
+func sourceTextUntil(l yyLexer, from, to int) string {
+    return l.(*lexer).sourceTextUntil(from, to)
+}
+
 func buildUsingCond(cols []string) ValueExp {
     if len(cols) == 0 {
         return &Bool{val: true}
@@ -46,6 +58,26 @@ func buildUsingCond(cols []string) ValueExp {
     return cond
 }
 
+// typeSpec is a column or cast type together with its type modifier
+// e.g. VARCHAR[64], DECIMAL(10,2) or INTEGER[]
+type typeSpec struct {
+    t       SQLValueType
+    typeMod int
+}
+
+func newTypeSpec(t SQLValueType, args []uint64, isArray bool) (typeSpec, error) {
+    typeMod, err := typeModFromArgs(t, args)
+    if err != nil {
+        return typeSpec{}, err
+    }
+
+    if isArray {
+        t = ArrayTypeOf(t)
+    }
+
+    return typeSpec{t: t, typeMod: typeMod}, nil
+}
+
 func aggFnName(fn AggregateFn) string {
     switch fn {
     case COUNT:
@@ -76,12 +108,14 @@ func aggFnName(fn AggregateFn) string {
     value ValueExp
     id string
     integer uint64
+    typeArgs []uint64
     float float64
     str string
     boolean bool
     blob []byte
     keyword string
     sqlType SQLValueType
+    typeSpec typeSpec
     aggFn AggregateFn
     colNames []string
     col *ColSelector
@@ -114,24 +148,51 @@ func aggFnName(fn AggregateFn) string {
     cteDefs []*CTEDef
     permission Permission
     sqlPrivilege SQLPrivilege
-    sqlPrivileges []SQLPrivilege
+    privilegeSpec *privilegeSpec
+    sqlPrivileges []*privilegeSpec
     whenThenClauses []whenThenClause
     tableElem TableElem
     tableElems []TableElem
+    fk *ForeignKeyConstraint
+    mergeAction *mergeAction
+    mergeActions []*mergeAction
+    createRoutine *CreateRoutineStmt
+    routineArg routineArg
+    routineArgs []routineArg
+    windowFn *WindowFnExp
+    windowDefs map[string]*WindowFnExp
+    frame *windowFrame
+    frameMode windowFrameMode
+    frameBound frameBound
+    groupBy groupByClause
+    aggSel *AggColSelector
+    groupingSets [][]*ColSelector
+    refAction ReferentialAction
     timestampField TimestampFieldType
+    pos int
 }
 
 %token <keyword> CREATE DROP TRUNCATE USE DATABASE USER WITH PASSWORD READ READWRITE ADMIN SNAPSHOT HISTORY DIFF SINCE AFTER BEFORE UNTIL TX OF
-%token <keyword> INTEGER_TYPE BOOLEAN_TYPE VARCHAR_TYPE UUID_TYPE BLOB_TYPE TIMESTAMP_TYPE FLOAT_TYPE JSON_TYPE
+%token <keyword> INTEGER_TYPE BOOLEAN_TYPE VARCHAR_TYPE UUID_TYPE BLOB_TYPE TIMESTAMP_TYPE FLOAT_TYPE DECIMAL_TYPE JSON_TYPE DATE_TYPE INTERVAL_TYPE
 %token <keyword> TABLE UNIQUE INDEX ON ALTER ADD RENAME TO COLUMN CONSTRAINT PRIMARY KEY CHECK GRANT REVOKE GRANTS FOR PRIVILEGES
 %token <keyword> BEGIN TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
 %token <keyword> INSERT UPSERT INTO VALUES DELETE UPDATE SET CONFLICT DO NOTHING RETURNING
 %token <keyword> SELECT DISTINCT FROM JOIN HAVING WHERE GROUP BY LIMIT OFFSET ORDER ASC DESC AS UNION ALL CASE WHEN THEN ELSE END EXCEPT INTERSECT NULLS FIRST LAST
 %token <keyword> NOT LIKE ILIKE IF EXISTS IN IS OVER PARTITION EXPLAIN RECURSIVE NATURAL USING FETCH ROWS ONLY LATERAL
 %token <keyword> AUTO_INCREMENT NULL CAST SCAST DEFAULT
-%token <keyword> SHOW DATABASES TABLES USERS VIEW FOREIGN REFERENCES SEQUENCE CASCADE
+%token <keyword> SHOW DATABASES TABLES USERS VIEW FOREIGN REFERENCES SEQUENCE CASCADE POLICY MATERIALIZED REFRESH INCREMENTALLY TRIGGER EACH ROW ANALYZE
+%token <keyword> GENERATED ALWAYS STORED VIRTUAL
+%token <keyword> FULLTEXT SCHEMA
+%token <keyword> MERGE MATCHED
+%token <keyword> FUNCTION PROCEDURE RETURNS LANGUAGE CALL
+%token <keyword> WINDOW RANGE PRECEDING FOLLOWING UNBOUNDED CURRENT
+%token <keyword> GROUPING SETS ROLLUP CUBE
+%token <keyword> FILTER WITHIN
 %token <keyword> BETWEEN
 %token <keyword> EXTRACT YEAR MONTH DAY HOUR MINUTE SECOND
+%token <keyword> ARRAY ANY
+%token <keyword> CURRENT_DATE CURRENT_TIMESTAMP
+%token <keyword> DECLARE CURSOR CLOSE
 
 %token <id> NPARAM
 %token <pparam> PPARAM
@@ -139,6 +200,7 @@ func aggFnName(fn AggregateFn) string {
 %token <logicOp> AND OR
 %token <cmpOp> CMPOP
 %token NOT_MATCHES_OP
+%token CONTAINS_OP
 %token <id> IDENTIFIER
 %token <integer> INTEGER_LIT
 %token <float> FLOAT_LIT
@@ -149,6 +211,7 @@ func aggFnName(fn AggregateFn) string {
 %token <err> ERROR
 %token <dot> DOT
 %token <arrow> ARROW
+%token ARROW_TEXT
 
 %left  ','
 %right AS
@@ -160,7 +223,7 @@ func aggFnName(fn AggregateFn) string {
 
 %right NOT
 
-%nonassoc CMPOP LIKE ILIKE NOT_MATCHES_OP IS
+%nonassoc CMPOP LIKE ILIKE NOT_MATCHES_OP CONTAINS_OP IS
 
 %left '+' '-'
 %left '*' '/' '%'
@@ -169,13 +232,14 @@ func aggFnName(fn AggregateFn) string {
 %right STMT_SEPARATOR
 
 %type <stmts> sql sqlstmts
-%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt
+%type <stmt> sqlstmt ddlstmt dmlstmt dqlstmt select_stmt trigger_stmt
+%type <stmts> trigger_body trigger_stmts
 %type <colSpec> colSpec
 %type <colNames> col_names insert_cols one_or_more_col_names
 %type <cols> cols
 %type <rows> rows
 %type <row> row
-%type <values> values opt_values
+%type <values> values opt_values index_elems
 %type <value> val fnCall
 %type <sel> selector
 %type <jsonFields> jsonFields
@@ -183,9 +247,9 @@ func aggFnName(fn AggregateFn) string {
 %type <distinct> opt_distinct opt_all
 %type <ds> ds values_or_query
 %type <tableRef> tableRef
-%type <period> opt_period
-%type <openPeriod> opt_period_start
-%type <openPeriod> opt_period_end
+%type <period> opt_period period
+%type <openPeriod> opt_period_start period_start
+%type <openPeriod> opt_period_end period_end
 %type <periodInstant> period_instant
 %type <joins> opt_joins joins
 %type <join> join
@@ -193,12 +257,30 @@ func aggFnName(fn AggregateFn) string {
 %type <check> check
 %type <tableElem> tableElem
 %type <tableElems> tableElems
+%type <fk> opt_ref_actions opt_references
+%type <mergeAction> merge_action
+%type <mergeActions> merge_actions
+%type <createRoutine> routine_def
+%type <routineArg> routine_arg
+%type <routineArgs> routine_args opt_routine_args
+%type <windowFn> over_clause window_spec
+%type <windowDefs> opt_window window_defs
+%type <frame> opt_frame
+%type <frameMode> frame_mode
+%type <frameBound> frame_bound
+%type <value> frame_offset
+%type <refAction> ref_action
+%type <colNames> opt_ref_cols
 %type <exp> exp opt_exp opt_where opt_having boundexp opt_else orExp andExp cmpExp primaryBool addExp notExp opt_join_cond
 mulExp unaryExp primary
-%type <cols> opt_groupby
-%type <exp> opt_limit opt_offset case_when_exp
+%type <groupBy> opt_groupby grouping_elems
+%type <groupingSets> grouping_elem grouping_sets
+%type <cols> grouping_set
+%type <aggSel> agg_call
+%type <exp> agg_fraction opt_agg_filter
+%type <exp> opt_limit opt_offset case_when_exp index_elem opt_merge_cond opt_using_exp
 %type <targets> opt_targets targets opt_returning
-%type <integer> opt_max_len
+%type <typeArgs> type_args
 %type <id> opt_as
 %type <ordexps> ordexps opt_orderby
 %type <opt_ord> opt_ord
@@ -208,18 +290,20 @@ mulExp unaryExp primary
 %type <values> opt_partition
 %type <exp> opt_default
 %type <colNames> opt_indexon
-%type <boolean> opt_if_not_exists opt_auto_increment opt_not_null opt_not opt_primary_key
+%type <boolean> opt_if_not_exists opt_incrementally opt_auto_increment opt_not_null opt_not opt_primary_key opt_virtual
 %type <update> update
 %type <updates> updates
 %type <onConflict> opt_on_conflict
 %type <permission> permission
 %type <sqlPrivilege> sqlPrivilege
+%type <privilegeSpec> privilegeSpec
 %type <sqlPrivileges> sqlPrivileges
 %type <whenThenClauses> when_then_clauses
 %type <timestampField> timestamp_field
 %type <sqlType> sql_type
+%type <typeSpec> type_spec
 %type <keyword> unreserved_keyword colNameKeyword
-%type <str> qualifiedName tableName col_name
+%type <str> qualifiedName tableName col_name opt_partition_by grant_table policy_command trigger_timing trigger_event
 
 %start sql
 
@@ -291,6 +375,36 @@ ddlstmt:
     {
         $$ = &RollbackToSavepointStmt{name: $3}
     }
+|
+    DECLARE IDENTIFIER CURSOR FOR dqlstmt
+    {
+        $$ = &DeclareCursorStmt{name: $2, query: $5.(DataSource)}
+    }
+|
+    FETCH FROM IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $3, count: &Integer{val: 1}}
+    }
+|
+    FETCH exp FROM IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $4, count: $2}
+    }
+|
+    FETCH ALL FROM IDENTIFIER
+    {
+        $$ = &FetchStmt{cursor: $4}
+    }
+|
+    CLOSE IDENTIFIER
+    {
+        $$ = &CloseCursorStmt{name: $2}
+    }
+|
+    CLOSE ALL
+    {
+        $$ = &CloseCursorStmt{all: true}
+    }
 |
     CREATE DATABASE IF NOT EXISTS IDENTIFIER
     {
@@ -317,105 +431,259 @@ ddlstmt:
         $$ = &UseSnapshotStmt{period: $3}
     }
 |
-    CREATE TABLE IF NOT EXISTS tableName '(' tableElems ')'
+    CREATE TABLE IF NOT EXISTS tableName '(' tableElems ')' opt_partition_by
     {
-        $$ = newCreateTableStmt($6, $8, true)
+        stmt := newCreateTableStmt($6, $8, true)
+        stmt.partitionBy = $10
+        $$ = stmt
     }
 |
-    CREATE TABLE tableName '(' tableElems ')'
+    CREATE TABLE tableName '(' tableElems ')' opt_partition_by
     {
-       $$ = newCreateTableStmt($3, $5, false)
+        stmt := newCreateTableStmt($3, $5, false)
+        stmt.partitionBy = $7
+        $$ = stmt
     }
 |
-    DROP TABLE qualifiedName
+    CREATE TABLE IF NOT EXISTS tableName PARTITION OF tableName FOR VALUES FROM '(' exp ')' TO '(' exp ')'
+    {
+        $$ = &CreateTableStmt{table: $6, ifNotExists: true, partitionOf: &partitionSpec{table: $9, from: $14, to: $18}}
+    }
+|
+    CREATE TABLE tableName PARTITION OF tableName FOR VALUES FROM '(' exp ')' TO '(' exp ')'
+    {
+        $$ = &CreateTableStmt{table: $3, partitionOf: &partitionSpec{table: $6, from: $11, to: $15}}
+    }
+|
+    DROP TABLE tableName
     {
         $$ = &DropTableStmt{table: $3}
     }
 |
-    DROP TABLE IF EXISTS qualifiedName
+    DROP TABLE IF EXISTS tableName
     {
         $$ = &DropTableStmt{table: $5, ifExists: true}
     }
 |
-    DROP TABLE qualifiedName CASCADE
+    DROP TABLE tableName CASCADE
     {
         $$ = &DropTableStmt{table: $3, cascade: true}
     }
 |
-    DROP TABLE IF EXISTS qualifiedName CASCADE
+    DROP TABLE IF EXISTS tableName CASCADE
     {
         $$ = &DropTableStmt{table: $5, ifExists: true, cascade: true}
     }
 |
-    TRUNCATE TABLE qualifiedName
+    TRUNCATE TABLE tableName
     {
         $$ = &TruncateTableStmt{table: $3}
     }
 |
-    CREATE VIEW IF NOT EXISTS IDENTIFIER AS dqlstmt
+    CREATE SCHEMA qualifiedName
+    {
+        $$ = &CreateSchemaStmt{name: $3}
+    }
+|
+    CREATE SCHEMA IF NOT EXISTS qualifiedName
+    {
+        $$ = &CreateSchemaStmt{name: $6, ifNotExists: true}
+    }
+|
+    DROP SCHEMA qualifiedName
+    {
+        $$ = &DropSchemaStmt{name: $3}
+    }
+|
+    DROP SCHEMA IF EXISTS qualifiedName
+    {
+        $$ = &DropSchemaStmt{name: $5, ifExists: true}
+    }
+|
+    CREATE routine_def
+    {
+        $$ = $2
+    }
+|
+    CREATE or_replace routine_def
+    {
+        $3.orReplace = true
+        $$ = $3
+    }
+|
+    DROP FUNCTION IDENTIFIER opt_routine_signature
+    {
+        $$ = &DropRoutineStmt{kind: RoutineFunction, name: $3}
+    }
+|
+    DROP FUNCTION IF EXISTS IDENTIFIER opt_routine_signature
+    {
+        $$ = &DropRoutineStmt{kind: RoutineFunction, name: $5, ifExists: true}
+    }
+|
+    DROP PROCEDURE IDENTIFIER opt_routine_signature
+    {
+        $$ = &DropRoutineStmt{kind: RoutineProcedure, name: $3}
+    }
+|
+    DROP PROCEDURE IF EXISTS IDENTIFIER opt_routine_signature
+    {
+        $$ = &DropRoutineStmt{kind: RoutineProcedure, name: $5, ifExists: true}
+    }
+|
+    CREATE VIEW IF NOT EXISTS tableName AS dqlstmt
     {
         $$ = &CreateViewStmt{viewName: $6, ifNotExists: true, query: $8.(DataSource)}
     }
 |
-    CREATE VIEW IDENTIFIER AS dqlstmt
+    CREATE VIEW tableName AS dqlstmt
     {
         $$ = &CreateViewStmt{viewName: $3, query: $5.(DataSource)}
     }
 |
-    DROP VIEW IF EXISTS IDENTIFIER
+    DROP VIEW IF EXISTS tableName
     {
         $$ = &DropViewStmt{viewName: $5, ifExists: true}
     }
 |
-    DROP VIEW IDENTIFIER
+    DROP VIEW tableName
     {
         $$ = &DropViewStmt{viewName: $3}
     }
 |
-    CREATE SEQUENCE IDENTIFIER
+    CREATE SEQUENCE tableName
     {
         $$ = &CreateSequenceStmt{name: $3, startValue: 1, increment: 1}
     }
 |
-    DROP SEQUENCE IDENTIFIER
+    DROP SEQUENCE tableName
     {
         $$ = &DropSequenceStmt{name: $3}
     }
 |
-    DROP SEQUENCE IF EXISTS IDENTIFIER
+    DROP SEQUENCE IF EXISTS tableName
     {
         $$ = &DropSequenceStmt{name: $5, ifExists: true}
     }
 |
-    CREATE INDEX opt_if_not_exists ON tableName '(' col_names ')'
+    CREATE MATERIALIZED VIEW tableName AS dqlstmt
+    {
+        $$ = &CreateMaterializedViewStmt{name: $4, query: $6.(DataSource), querySQL: sourceText(yylex, $<pos>5+len($5))}
+    }
+|
+    CREATE MATERIALIZED VIEW IF NOT EXISTS tableName AS dqlstmt
+    {
+        $$ = &CreateMaterializedViewStmt{name: $7, ifNotExists: true, query: $9.(DataSource), querySQL: sourceText(yylex, $<pos>8+len($8))}
+    }
+|
+    DROP MATERIALIZED VIEW tableName
+    {
+        $$ = &DropMaterializedViewStmt{name: $4}
+    }
+|
+    DROP MATERIALIZED VIEW IF EXISTS tableName
+    {
+        $$ = &DropMaterializedViewStmt{name: $6, ifExists: true}
+    }
+|
+    ANALYZE
+    {
+        $$ = &AnalyzeStmt{}
+    }
+|
+    ANALYZE tableName
+    {
+        $$ = &AnalyzeStmt{table: $2}
+    }
+|
+    REFRESH MATERIALIZED VIEW tableName opt_incrementally
+    {
+        $$ = &RefreshMaterializedViewStmt{name: $4, incrementally: $5}
+    }
+|
+    CREATE POLICY IDENTIFIER ON tableName policy_command USING '(' exp ')'
+    {
+        $$ = &CreatePolicyStmt{name: $3, table: $5, command: SQLPrivilege($6), exp: $9}
+    }
+|
+    DROP POLICY IDENTIFIER ON tableName
+    {
+        $$ = &DropPolicyStmt{name: $3, table: $5}
+    }
+|
+    DROP POLICY IF EXISTS IDENTIFIER ON tableName
+    {
+        $$ = &DropPolicyStmt{name: $5, table: $7, ifExists: true}
+    }
+|
+    CREATE TRIGGER IDENTIFIER trigger_timing trigger_event ON tableName FOR EACH ROW trigger_body
+    {
+        sql := sourceText(yylex, $<pos>1)
+        if $<pos>11 > 0 {
+            sql = sourceTextUntil(yylex, $<pos>1, $<pos>11)
+        }
+        $$ = &CreateTriggerStmt{name: $3, timing: TriggerTiming($4), event: SQLPrivilege($5), table: $7, body: $11, sql: sql}
+    }
+|
+    DROP TRIGGER IDENTIFIER ON tableName
+    {
+        $$ = &DropTriggerStmt{name: $3, table: $5}
+    }
+|
+    DROP TRIGGER IF EXISTS IDENTIFIER ON tableName
+    {
+        $$ = &DropTriggerStmt{name: $5, table: $7, ifExists: true}
+    }
+|
+    CREATE INDEX opt_if_not_exists ON tableName '(' index_elems ')'
+    {
+        cols, exps := indexElems($7)
+        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: cols, exps: exps}
+    }
+|
+    CREATE INDEX opt_if_not_exists ON tableName '(' index_elems ')' WHERE exp
+    {
+        cols, exps := indexElems($7)
+        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: cols, exps: exps, predicate: $10}
+    }
+|
+    CREATE INDEX opt_if_not_exists ON tableName USING FULLTEXT '(' col_name ')'
     {
-        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: $7}
+        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: []string{$9}, fullText: true}
     }
 |
-    CREATE INDEX opt_if_not_exists ON tableName '(' col_names ')' WHERE exp
+    CREATE UNIQUE INDEX opt_if_not_exists ON tableName '(' index_elems ')'
     {
-        $$ = &CreateIndexStmt{ifNotExists: $3, table: $5, cols: $7, predicate: $10}
+        cols, exps := indexElems($8)
+        $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: cols, exps: exps}
     }
 |
-    CREATE UNIQUE INDEX opt_if_not_exists ON tableName '(' col_names ')'
+    CREATE UNIQUE INDEX opt_if_not_exists ON tableName '(' index_elems ')' WHERE exp
     {
-        $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: $8}
+        cols, exps := indexElems($8)
+        $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: cols, exps: exps, predicate: $11}
     }
 |
-    CREATE UNIQUE INDEX opt_if_not_exists ON tableName '(' col_names ')' WHERE exp
+    DROP INDEX ON tableName '(' index_elems ')'
     {
-        $$ = &CreateIndexStmt{unique: true, ifNotExists: $4, table: $6, cols: $8, predicate: $11}
+        cols, _ := indexElems($6)
+        $$ = &DropIndexStmt{table: $4, cols: cols}
     }
 |
-    DROP INDEX ON tableName '(' col_names ')'
+    DROP INDEX ON tableName USING FULLTEXT '(' col_name ')'
     {
-        $$ = &DropIndexStmt{table: $4, cols: $6}
+        $$ = &DropIndexStmt{table: $4, cols: []string{$8}, fullText: true}
     }
 |
-    DROP INDEX tableName DOT col_name
+    DROP INDEX qualifiedName DOT col_name
     {
         $$ = &DropIndexStmt{table: $3, cols: []string{$5}}
     }
+|
+    DROP INDEX qualifiedName DOT qualifiedName DOT col_name
+    {
+        $$ = &DropIndexStmt{table: $3 + "." + $5, cols: []string{$7}}
+    }
 |
     ALTER TABLE tableName ADD COLUMN colSpec
     {
@@ -452,13 +720,22 @@ ddlstmt:
         $$ = &AlterColumnStmt{table: $3, colName: $6, action: AlterColumnDropNotNull}
     }
 |
-    ALTER TABLE tableName ALTER COLUMN col_name IDENTIFIER sql_type
+    ALTER TABLE tableName ALTER COLUMN col_name IDENTIFIER type_spec opt_using_exp
     {
         if strings.ToUpper($7) != "TYPE" {
             yylex.Error("expected TYPE keyword")
             goto ret1
         }
-        $$ = &AlterColumnStmt{table: $3, colName: $6, action: AlterColumnSetType, newType: $8}
+        $$ = &AlterColumnStmt{table: $3, colName: $6, action: AlterColumnSetType, newType: $8.t, typeMod: $8.typeMod, using: $9}
+    }
+|
+    ALTER TABLE tableName IDENTIFIER PARTITION tableName
+    {
+        if strings.ToUpper($4) != "DETACH" {
+            yylex.Error("expected DETACH keyword")
+            goto ret1
+        }
+        $$ = &DetachPartitionStmt{table: $3, partition: $6}
     }
 |
     CREATE USER IDENTIFIER WITH PASSWORD VARCHAR_LIT permission
@@ -478,30 +755,164 @@ ddlstmt:
 |
     GRANT sqlPrivileges ON DATABASE qualifiedName TO USER IDENTIFIER
     {
-        $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: $2, isGrant: true}
+        privileges, err := databasePrivileges($2)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+        $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: privileges, isGrant: true}
+    }
+|
+    REVOKE sqlPrivileges ON DATABASE qualifiedName revoke_from USER IDENTIFIER
+    {
+        privileges, err := databasePrivileges($2)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+        $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: privileges}
+    }
+|
+    GRANT sqlPrivileges ON grant_table TO USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $4, user: $7, privileges: $2, isGrant: true}
+    }
+|
+    REVOKE sqlPrivileges ON grant_table revoke_from USER IDENTIFIER
+    {
+        $$ = &AlterTablePrivilegesStmt{table: $4, user: $7, privileges: $2}
     }
+;
+
+grant_table:
+    tableName
+|
+    TABLE tableName
+    {
+        $$ = $2
+    }
+;
+
+revoke_from: TO | FROM;
+
+trigger_timing:
+    BEFORE
+    {
+        $$ = string(TriggerBefore)
+    }
+|
+    AFTER
+    {
+        $$ = string(TriggerAfter)
+    }
+;
+
+trigger_event:
+    INSERT
+    {
+        $$ = string(SQLPrivilegeInsert)
+    }
+|
+    UPDATE
+    {
+        $$ = string(SQLPrivilegeUpdate)
+    }
+|
+    DELETE
+    {
+        $$ = string(SQLPrivilegeDelete)
+    }
+;
+
+// the position of trigger_body is set to where it ends when known
+trigger_body:
+    trigger_stmt
+    {
+        $$ = []SQLStmt{$1}
+        $<pos>$ = 0
+    }
+|
+    BEGIN trigger_stmts opt_separator END
+    {
+        $$ = $2
+        $<pos>$ = $<pos>4 + len($4)
+    }
+;
+
+trigger_stmts:
+    trigger_stmt
+    {
+        $$ = []SQLStmt{$1}
+    }
+|
+    trigger_stmts STMT_SEPARATOR trigger_stmt
+    {
+        $$ = append($1, $3)
+    }
+;
+
+trigger_stmt:
+    dmlstmt
 |
-    REVOKE sqlPrivileges ON DATABASE qualifiedName TO USER IDENTIFIER
+    SET col_name DOT col_name CMPOP exp
     {
-        $$ = &AlterPrivilegesStmt{database: $5, user: $8, privileges: $2}
+        $$ = &SetNewValueStmt{row: $2, col: $4, op: $5, exp: $6}
+    }
+;
+
+policy_command:
+    {
+        $$ = ""
+    }
+|
+    FOR ALL
+    {
+        $$ = ""
+    }
+|
+    FOR SELECT
+    {
+        $$ = string(SQLPrivilegeSelect)
+    }
+|
+    FOR UPDATE
+    {
+        $$ = string(SQLPrivilegeUpdate)
+    }
+|
+    FOR DELETE
+    {
+        $$ = string(SQLPrivilegeDelete)
     }
 ;
 
 sqlPrivileges:
     ALL PRIVILEGES
     {
-        $$ = allPrivileges
+        $$ = nil
     }
 |
-    sqlPrivilege
+    privilegeSpec
     {
-        $$ = []SQLPrivilege{$1}
+        $$ = []*privilegeSpec{$1}
     }
 |
-    sqlPrivilege ',' sqlPrivileges
+    privilegeSpec ',' sqlPrivileges
     {
         $$ = append($3, $1)
     }
+;
+
+privilegeSpec:
+    sqlPrivilege
+    {
+        $$ = &privilegeSpec{privilege: $1}
+    }
+|
+    sqlPrivilege '(' col_names ')'
+    {
+        $$ = &privilegeSpec{privilege: $1, cols: $3}
+    }
 
 sqlPrivilege:
     SELECT
@@ -572,6 +983,17 @@ opt_if_not_exists:
     }
 ;
 
+opt_incrementally:
+    {
+        $$ = false
+    }
+|
+    INCREMENTALLY
+    {
+        $$ = true
+    }
+;
+
 dmlstmt:
     INSERT INTO tableRef insert_cols values_or_query opt_on_conflict opt_returning
     {
@@ -612,6 +1034,136 @@ dmlstmt:
             $$ = stmt
         }
     }
+|
+    MERGE INTO tableRef opt_as USING ds ON exp merge_actions
+    {
+        $3.as = $4
+        $$ = &MergeStmt{target: $3, source: $6, on: $8, actions: $9}
+    }
+|
+    CALL IDENTIFIER '(' opt_values ')'
+    {
+        $$ = &CallStmt{name: $2, params: $4}
+    }
+
+merge_actions:
+    merge_action
+    {
+        $$ = []*mergeAction{$1}
+    }
+|
+    merge_actions merge_action
+    {
+        $$ = append($1, $2)
+    }
+
+merge_action:
+    WHEN MATCHED opt_merge_cond THEN UPDATE SET updates
+    {
+        $$ = &mergeAction{matched: true, cond: $3, kind: MergeUpdate, updates: $7}
+    }
+|
+    WHEN MATCHED opt_merge_cond THEN DELETE
+    {
+        $$ = &mergeAction{matched: true, cond: $3, kind: MergeDelete}
+    }
+|
+    WHEN MATCHED opt_merge_cond THEN DO NOTHING
+    {
+        $$ = &mergeAction{matched: true, cond: $3, kind: MergeDoNothing}
+    }
+|
+    WHEN NOT MATCHED opt_merge_cond THEN INSERT insert_cols VALUES '(' values ')'
+    {
+        $$ = &mergeAction{cond: $4, kind: MergeInsert, cols: $7, values: $10}
+    }
+|
+    WHEN NOT MATCHED opt_merge_cond THEN DO NOTHING
+    {
+        $$ = &mergeAction{cond: $4, kind: MergeDoNothing}
+    }
+
+routine_def:
+    FUNCTION IDENTIFIER '(' opt_routine_args ')' RETURNS type_spec AS VARCHAR_LIT opt_language
+    {
+        $$ = &CreateRoutineStmt{kind: RoutineFunction, name: $2, args: $4, returns: $7.t, body: $9}
+    }
+|
+    PROCEDURE IDENTIFIER '(' opt_routine_args ')' AS VARCHAR_LIT opt_language
+    {
+        $$ = &CreateRoutineStmt{kind: RoutineProcedure, name: $2, args: $4, body: $7}
+    }
+
+or_replace:
+    OR IDENTIFIER
+    {
+        if $2 != "replace" {
+            yylex.Error("syntax error: REPLACE expected")
+            goto ret1
+        }
+    }
+
+opt_routine_args:
+    {
+        $$ = nil
+    }
+|
+    routine_args
+    {
+        $$ = $1
+    }
+
+routine_args:
+    routine_arg
+    {
+        $$ = []routineArg{$1}
+    }
+|
+    routine_args ',' routine_arg
+    {
+        $$ = append($1, $3)
+    }
+
+routine_arg:
+    IDENTIFIER type_spec
+    {
+        $$ = routineArg{name: $1, t: $2.t}
+    }
+|
+    type_spec
+    {
+        $$ = routineArg{t: $1.t}
+    }
+
+opt_routine_signature:
+    {
+    }
+|
+    '(' opt_routine_args ')'
+    {
+    }
+
+opt_language:
+    {
+    }
+|
+    LANGUAGE IDENTIFIER
+    {
+        if $2 != "sql" {
+            yylex.Error(fmt.Sprintf("unsupported language %s", $2))
+            goto ret1
+        }
+    }
+
+opt_merge_cond:
+    {
+        $$ = nil
+    }
+|
+    AND exp
+    {
+        $$ = $2
+    }
 
 values_or_query:
 	VALUES rows
@@ -747,114 +1299,374 @@ val:
         $$ = &Blob{val: $1}
     }
 |
-    CAST '(' exp AS sql_type ')'
+    CAST '(' exp AS type_spec ')'
     {
-        $$ = &Cast{val: $3, t: $5}
+        $$ = &Cast{val: $3, t: $5.t, typeMod: $5.typeMod}
     }
 |
     TIMESTAMP_TYPE VARCHAR_LIT
     {
-        $$ = &Cast{val: &Varchar{val: $2}, t: TimestampType}
+        $$ = &Cast{val: &Varchar{val: $2}, t: TimestampType}
+    }
+|
+    DATE_TYPE VARCHAR_LIT
+    {
+        $$ = &Cast{val: &Varchar{val: $2}, t: DateType}
+    }
+|
+    INTERVAL_TYPE VARCHAR_LIT
+    {
+        $$ = &Cast{val: &Varchar{val: $2}, t: IntervalType}
+    }
+|
+    CURRENT_DATE
+    {
+        $$ = &FnCall{fn: CurrentDateFnCall}
+    }
+|
+    CURRENT_TIMESTAMP
+    {
+        $$ = &FnCall{fn: NowFnCall}
+    }
+|
+    fnCall
+    {
+        $$ = $1
+    }
+|
+    AGGREGATE_FUNC '(' '*' ')' OVER over_clause
+    {
+        $6.fnName = aggFnName($1)
+        $$ = $6
+    }
+|
+    AGGREGATE_FUNC '(' col ')' OVER over_clause
+    {
+        $6.fnName = aggFnName($1)
+        $6.params = []ValueExp{&ColSelector{table: $3.table, col: $3.col}}
+        $$ = $6
+    }
+|
+    NPARAM
+    {
+        $$ = &Param{id: $1}
+    }
+|
+    PPARAM
+    {
+        $$ = &Param{id: fmt.Sprintf("param%d", $1), pos: $1}
+    }
+|
+    NULL
+    {
+        $$ = &NullValue{t: AnyType}
+    }
+|
+    ARRAY '[' opt_values ']'
+    {
+        $$ = &ArrayExp{elems: $3}
+    }
+;
+
+sql_type:
+    INTEGER_TYPE { $$ = IntegerType }
+    | BOOLEAN_TYPE { $$ = BooleanType }
+    | VARCHAR_TYPE { $$ = VarcharType }
+    | UUID_TYPE { $$ = UUIDType }
+    | BLOB_TYPE  { $$ = BLOBType }
+    | TIMESTAMP_TYPE { $$ = TimestampType }
+    | FLOAT_TYPE { $$ = Float64Type }
+    | DECIMAL_TYPE { $$ = DecimalType }
+    | JSON_TYPE { $$ = JSONType }
+    | DATE_TYPE { $$ = DateType }
+    | INTERVAL_TYPE { $$ = IntervalType }
+;
+
+fnCall:
+    IDENTIFIER '(' opt_values ')'
+    {
+        $$ = &FnCall{fn: $1, params: $3}
+    }
+|
+    IDENTIFIER '(' opt_values ')' OVER over_clause
+    {
+        $6.fnName = strings.ToUpper($1)
+        $6.params = $3
+        $$ = $6
+    }
+
+over_clause:
+    window_spec
+    {
+        $$ = $1
+    }
+|
+    IDENTIFIER
+    {
+        $$ = &WindowFnExp{window: $1}
+    }
+;
+
+window_spec:
+    '(' opt_partition opt_orderby opt_frame ')'
+    {
+        $$ = &WindowFnExp{partitionBy: $2, orderBy: $3, frame: $4}
+    }
+;
+
+opt_frame:
+    {
+        $$ = nil
+    }
+|
+    frame_mode frame_bound
+    {
+        $$ = &windowFrame{mode: $1, start: $2, end: frameBound{kind: currentRow}}
+    }
+|
+    frame_mode BETWEEN frame_bound AND frame_bound
+    {
+        $$ = &windowFrame{mode: $1, start: $3, end: $5}
+    }
+;
+
+frame_mode:
+    ROWS
+    {
+        $$ = frameRows
+    }
+|
+    RANGE
+    {
+        $$ = frameRange
+    }
+;
+
+frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = frameBound{kind: unboundedPreceding}
+    }
+|
+    UNBOUNDED FOLLOWING
+    {
+        $$ = frameBound{kind: unboundedFollowing}
+    }
+|
+    CURRENT ROW
+    {
+        $$ = frameBound{kind: currentRow}
+    }
+|
+    frame_offset PRECEDING
+    {
+        $$ = frameBound{kind: offsetPreceding, offset: $1.(TypedValue)}
+    }
+|
+    frame_offset FOLLOWING
+    {
+        $$ = frameBound{kind: offsetFollowing, offset: $1.(TypedValue)}
+    }
+;
+
+frame_offset:
+    INTEGER_LIT
+    {
+        $$ = &Integer{val: int64($1)}
+    }
+|
+    FLOAT_LIT
+    {
+        $$ = &Float64{val: float64($1)}
+    }
+;
+
+opt_window:
+    {
+        $$ = nil
+    }
+|
+    WINDOW window_defs
+    {
+        $$ = $2
+    }
+;
+
+window_defs:
+    IDENTIFIER AS window_spec
+    {
+        $$ = map[string]*WindowFnExp{$1: $3}
+    }
+|
+    window_defs ',' IDENTIFIER AS window_spec
+    {
+        if _, exists := $1[$3]; exists {
+            yylex.Error(fmt.Sprintf("window %s is already defined", $3))
+            goto ret1
+        }
+        $1[$3] = $5
+        $$ = $1
+    }
+;
+
+tableElems:
+    tableElem
+    {
+        $$ = []TableElem{$1}
+    }
+|
+    tableElems ',' tableElem
+    {
+        $$ = append($1, $3)
+    }
+
+tableElem:
+   colSpec
+   {
+        $$ = $1
+   }
+|
+    check
+    {
+        $$ = $1
+    }
+|
+    PRIMARY KEY one_or_more_col_names
+    {
+        $$ = PrimaryKeyConstraint($3)
+    }
+|
+    FOREIGN KEY '(' col_names ')' REFERENCES tableName opt_ref_cols opt_ref_actions
+    {
+        $9.cols = $4
+        $9.refTable = $7
+        $9.refCols = $8
+        $$ = $9
+    }
+|
+    CONSTRAINT IDENTIFIER FOREIGN KEY '(' col_names ')' REFERENCES tableName opt_ref_cols opt_ref_actions
+    {
+        $11.name = $2
+        $11.cols = $6
+        $11.refTable = $9
+        $11.refCols = $10
+        $$ = $11
+    }
+;
+
+opt_ref_cols:
+    {
+        $$ = nil
+    }
+|
+    '(' col_names ')'
+    {
+        $$ = $2
+    }
+;
+
+opt_ref_actions:
+    {
+        $$ = &ForeignKeyConstraint{}
     }
 |
-    fnCall
+    opt_ref_actions ON DELETE ref_action
     {
+        $1.onDelete = $4
         $$ = $1
     }
 |
-    AGGREGATE_FUNC '(' '*' ')' OVER '(' opt_partition opt_orderby ')'
+    opt_ref_actions ON UPDATE ref_action
     {
-        $$ = &WindowFnExp{fnName: aggFnName($1), partitionBy: $7, orderBy: $8}
+        $1.onUpdate = $4
+        $$ = $1
     }
-|
-    AGGREGATE_FUNC '(' col ')' OVER '(' opt_partition opt_orderby ')'
+;
+
+ref_action:
+    CASCADE
     {
-        $$ = &WindowFnExp{fnName: aggFnName($1), params: []ValueExp{&ColSelector{table: $3.table, col: $3.col}}, partitionBy: $7, orderBy: $8}
+        $$ = ReferentialCascade
     }
 |
-    NPARAM
+    SET NULL
     {
-        $$ = &Param{id: $1}
+        $$ = ReferentialSetNull
     }
 |
-    PPARAM
+    IDENTIFIER
     {
-        $$ = &Param{id: fmt.Sprintf("param%d", $1), pos: $1}
+        if strings.ToUpper($1) != "RESTRICT" {
+            yylex.Error("expected CASCADE, SET NULL, RESTRICT or NO ACTION")
+            goto ret1
+        }
+        $$ = ReferentialRestrict
     }
 |
-    NULL
+    IDENTIFIER IDENTIFIER
     {
-        $$ = &NullValue{t: AnyType}
+        if strings.ToUpper($1) != "NO" || strings.ToUpper($2) != "ACTION" {
+            yylex.Error("expected CASCADE, SET NULL, RESTRICT or NO ACTION")
+            goto ret1
+        }
+        $$ = ReferentialNoAction
     }
 ;
 
-sql_type:
-    INTEGER_TYPE { $$ = IntegerType }
-    | BOOLEAN_TYPE { $$ = BooleanType }
-    | VARCHAR_TYPE { $$ = VarcharType }
-    | UUID_TYPE { $$ = UUIDType }
-    | BLOB_TYPE  { $$ = BLOBType }
-    | TIMESTAMP_TYPE { $$ = TimestampType }
-    | FLOAT_TYPE { $$ = Float64Type }
-    | JSON_TYPE { $$ = JSONType }
-;
-
-fnCall:
-    IDENTIFIER '(' opt_values ')'
+colSpec:
+    col_name type_spec opt_not_null opt_default opt_auto_increment opt_primary_key opt_references
     {
-        $$ = &FnCall{fn: $1, params: $3}
+        $$ = &ColSpec{
+            colName: $1,
+            colType: $2.t,
+            maxLen: $2.typeMod,
+            notNull: $3 || $6,
+            defaultValue: $4,
+            autoIncrement: $5,
+            primaryKey: $6,
+            references: $7,
+        }
     }
 |
-    IDENTIFIER '(' opt_values ')' OVER '(' opt_partition opt_orderby ')'
+    col_name type_spec GENERATED ALWAYS AS '(' exp ')' opt_virtual opt_not_null
     {
-        $$ = &WindowFnExp{fnName: strings.ToUpper($1), params: $3, partitionBy: $7, orderBy: $8}
+        $$ = &ColSpec{
+            colName: $1,
+            colType: $2.t,
+            maxLen: $2.typeMod,
+            notNull: $10,
+            generated: $7,
+            virtual: $9,
+        }
     }
+;
 
-tableElems:
-    tableElem
+opt_virtual:
     {
-        $$ = []TableElem{$1}
+        $$ = true
     }
 |
-    tableElems ',' tableElem
+    VIRTUAL
     {
-        $$ = append($1, $3)
+        $$ = true
     }
-
-tableElem:
-   colSpec
-   {
-        $$ = $1
-   }
 |
-    check
+    STORED
     {
-        $$ = $1
+        $$ = false
     }
-|
-    PRIMARY KEY one_or_more_col_names
+;
+
+opt_references:
     {
-        $$ = PrimaryKeyConstraint($3)
+        $$ = nil
     }
 |
-    FOREIGN KEY '(' col_names ')' REFERENCES tableName '(' col_names ')'
-    {
-        $$ = &ForeignKeyConstraint{cols: $4, refTable: $7, refCols: $9}
-    }
-;
-
-colSpec:
-    col_name sql_type opt_max_len opt_not_null opt_default opt_auto_increment opt_primary_key
+    REFERENCES tableName opt_ref_cols opt_ref_actions
     {
-        $$ = &ColSpec{
-            colName: $1,
-            colType: $2,
-            maxLen: int($3),
-            notNull: $4 || $7,
-            defaultValue: $5,
-            autoIncrement: $6,
-            primaryKey: $7,
-        }
+        $4.refTable = $2
+        $4.refCols = $3
+        $$ = $4
     }
 ;
 
@@ -869,19 +1681,52 @@ opt_primary_key:
     }
 ;
 
-opt_max_len:
+type_spec:
+    sql_type
+    {
+        $$ = typeSpec{t: $1}
+    }
+|
+    sql_type type_args
+    {
+        ts, err := newTypeSpec($1, $2, false)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+        $$ = ts
+    }
+|
+    sql_type '[' ']'
     {
-        $$ = 0
+        $$ = typeSpec{t: ArrayTypeOf($1)}
     }
 |
+    sql_type type_args '[' ']'
+    {
+        ts, err := newTypeSpec($1, $2, true)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+        $$ = ts
+    }
+;
+
+type_args:
     '[' INTEGER_LIT ']'
     {
-        $$ = $2
+        $$ = []uint64{$2}
     }
 |
     '(' INTEGER_LIT ')'
     {
-        $$ = $2
+        $$ = []uint64{$2}
+    }
+|
+    '(' INTEGER_LIT ',' INTEGER_LIT ')'
+    {
+        $$ = []uint64{$2, $4}
     }
 
 opt_default:
@@ -1022,9 +1867,19 @@ dqlstmt:
     {
         $$ = &ExplainStmt{query: $2.(DataSource)}
     }
+|
+    EXPLAIN ANALYZE dqlstmt
+    {
+        $$ = &ExplainStmt{query: $3.(DataSource), analyze: true}
+    }
 
-select_stmt: SELECT opt_distinct opt_targets FROM ds opt_indexon opt_joins opt_where opt_groupby opt_having opt_orderby opt_limit opt_offset
+select_stmt: SELECT opt_distinct opt_targets FROM ds opt_indexon opt_joins opt_where opt_groupby opt_having opt_window opt_orderby opt_limit opt_offset
     {
+        if err := resolveWindowRefs($3, $11); err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+
         $$ = &SelectStmt{
                 distinct: $2,
                 targets: $3,
@@ -1032,11 +1887,12 @@ select_stmt: SELECT opt_distinct opt_targets FROM ds opt_indexon opt_joins opt_w
                 indexOn: $6,
                 joins: $7,
                 where: $8,
-                groupBy: $9,
+                groupBy: $9.cols,
+                groupingSets: $9.sets,
                 having: $10,
-                orderBy: $11,
-                limit: $12,
-                offset: $13,
+                orderBy: $12,
+                limit: $13,
+                offset: $14,
             }
     }
 |
@@ -1103,6 +1959,29 @@ selector:
         $$ = &JSONSelector{ColSelector: $1, fields: $2}
     }
 |
+    col ARROW_TEXT VARCHAR_LIT
+    {
+        $$ = &JSONSelector{ColSelector: $1, fields: []string{$3}, text: true}
+    }
+|
+    col jsonFields ARROW_TEXT VARCHAR_LIT
+    {
+        $$ = &JSONSelector{ColSelector: $1, fields: append($2, $4), text: true}
+    }
+|
+    agg_call opt_agg_filter
+    {
+        $1.filter = $2
+
+        if err := $1.validate(); err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+
+        $$ = $1
+    }
+
+agg_call:
     AGGREGATE_FUNC '(' '*' ')'
     {
         $$ = &AggColSelector{aggFn: $1, col: "*"}
@@ -1131,6 +2010,62 @@ selector:
     {
         $$ = &AggColSelector{aggFn: $1, table: $3.table, col: $3.col, separator: $5}
     }
+|
+    AGGREGATE_FUNC '(' DISTINCT col ',' VARCHAR_LIT ')'
+    {
+        $$ = &AggColSelector{aggFn: $1, table: $4.table, col: $4.col, separator: $6, distinct: true}
+    }
+|
+    AGGREGATE_FUNC '(' col ',' col ')'
+    {
+        $$ = &AggColSelector{aggFn: $1, keySel: $3, table: $5.table, col: $5.col}
+    }
+|
+    AGGREGATE_FUNC '(' DISTINCT col ',' col ')'
+    {
+        $$ = &AggColSelector{aggFn: $1, keySel: $4, table: $6.table, col: $6.col, distinct: true}
+    }
+|
+    AGGREGATE_FUNC '(' ')' WITHIN GROUP '(' ORDER BY col opt_ord ')'
+    {
+        $$ = &AggColSelector{aggFn: $1, table: $9.table, col: $9.col, withinGroup: true, desc: $10}
+    }
+|
+    AGGREGATE_FUNC '(' agg_fraction ')' WITHIN GROUP '(' ORDER BY col opt_ord ')'
+    {
+        $$ = &AggColSelector{aggFn: $1, fraction: $3, table: $10.table, col: $10.col, withinGroup: true, desc: $11}
+    }
+
+agg_fraction:
+    INTEGER_LIT
+    {
+        $$ = &Integer{val: int64($1)}
+    }
+|
+    FLOAT_LIT
+    {
+        $$ = &Float64{val: $1}
+    }
+|
+    NPARAM
+    {
+        $$ = &Param{id: $1}
+    }
+|
+    PPARAM
+    {
+        $$ = &Param{id: fmt.Sprintf("param%d", $1), pos: $1}
+    }
+
+opt_agg_filter:
+    {
+        $$ = nil
+    }
+|
+    FILTER '(' WHERE exp ')'
+    {
+        $$ = $4
+    }
 
 jsonFields:
     ARROW VARCHAR_LIT
@@ -1153,9 +2088,21 @@ col:
     {
         $$ = &ColSelector{table: $1, col: $3}
     }
+|
+    col_name DOT col_name DOT col_name
+    {
+        $$ = &ColSelector{table: $3, col: $5}
+    }
 ;
 
-tableName: qualifiedName;
+tableName:
+    qualifiedName
+|
+    qualifiedName DOT qualifiedName
+    {
+        $$ = $1 + "." + $3
+    }
+;
 
 col_name:
     qualifiedName
@@ -1169,6 +2116,18 @@ col_names:
     col_names ',' col_name { $$ = append($1, $3) }  
 ;
 
+index_elems:
+    index_elem { $$ = []ValueExp{$1} }
+|
+    index_elems ',' index_elem { $$ = append($1, $3) }
+;
+
+index_elem:
+    col_name { $$ = &ColSelector{col: $1} }
+|
+    '(' exp ')' { $$ = $2 }
+;
+
 one_or_more_col_names:
     col_name
     {
@@ -1195,9 +2154,12 @@ colNameKeyword:
     | EXISTS
     | EXTRACT
     | FLOAT_TYPE
+    | DECIMAL_TYPE
     | INTEGER_TYPE
     | JSON_TYPE
     | TIMESTAMP_TYPE
+    | DATE_TYPE
+    | INTERVAL_TYPE
     | VALUES
     | VARCHAR_TYPE
 ;
@@ -1209,6 +2171,10 @@ qualifiedName:
 
 unreserved_keyword:
     ADMIN
+    | WITHIN
+    | SETS
+    | ROLLUP
+    | CUBE
     | OF
     | DROP
     | DATABASE
@@ -1240,15 +2206,67 @@ unreserved_keyword:
     | MINUTE
     | SECOND
     | USERS
+    | MATERIALIZED
+    | REFRESH
+    | INCREMENTALLY
+    | TRIGGER
+    | EACH
+    | ROW
+    | ANALYZE
+    | GENERATED
+    | ALWAYS
+    | STORED
+    | VIRTUAL
+    | FULLTEXT
+    | SCHEMA
+    | MATCHED
+    | FUNCTION
+    | PROCEDURE
+    | RETURNS
+    | LANGUAGE
+    | CALL
+    | RANGE
+    | PRECEDING
+    | FOLLOWING
+    | UNBOUNDED
+    | CURRENT
 ;
 
 ds:
-    tableRef opt_period opt_as
+    tableRef opt_as
+    {
+        $1.as = $2
+        $$ = $1
+    }
+|
+    tableRef period opt_as
     {
         $1.period = $2
         $1.as = $3
         $$ = $1
     }
+|
+    tableRef AS OF TIMESTAMP_TYPE addExp opt_as
+    {
+        $1.period = period{end: &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: $5}}}
+        $1.as = $6
+        $$ = $1
+    }
+|
+    tableRef FOR IDENTIFIER BETWEEN addExp AND addExp opt_as
+    {
+        if strings.ToUpper($3) != "SYSTEM_TIME" {
+            yylex.Error("expected SYSTEM_TIME keyword")
+            goto ret1
+        }
+        $1.period = period{
+            start: &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: $5}},
+            end:   &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: $7}},
+            systemTime: true,
+        }
+        $1.as = $8
+        $$ = $1
+    }
 |
     '(' VALUES rows ')'
     {
@@ -1295,18 +2313,18 @@ ds:
         $$ = &FnDataSourceStmt{fnCall: $1.(*FnCall), as: $2}
     }
 |
-    '(' HISTORY OF IDENTIFIER ')' opt_as
+    '(' HISTORY OF tableName ')' opt_as
     {
         $$ = &tableRef{table: $4, history: true, as: $6}
     }
 |
-    '(' DIFF OF IDENTIFIER ')' opt_period opt_as
+    '(' DIFF OF tableName ')' opt_period opt_as
     {
         $$ = &tableRef{table: $4, diff: true, period: $6, as: $7}
     }
 
 tableRef:
-    qualifiedName
+    tableName
     {
         $$ = &tableRef{table: $1}
     }
@@ -1317,11 +2335,25 @@ opt_period:
         $$ = period{start: $1, end: $2}
     }
 
+period:
+    period_start opt_period_end
+    {
+        $$ = period{start: $1, end: $2}
+    }
+|
+    period_end
+    {
+        $$ = period{end: $1}
+    }
+
 opt_period_start:
     {
         $$ = nil
     }
 |
+    period_start
+
+period_start:
     SINCE period_instant
     {
         $$ = &openPeriod{inclusive: true, instant: $2}
@@ -1337,6 +2369,9 @@ opt_period_end:
         $$ = nil
     }
 |
+    period_end
+
+period_end:
     UNTIL period_instant
     {
         $$ = &openPeriod{inclusive: true, instant: $2}
@@ -1460,14 +2495,84 @@ opt_where:
 
 opt_groupby:
     {
-        $$ = nil
+        $$ = groupByClause{}
     }
 |
-    GROUP BY cols
+    GROUP BY grouping_elems
     {
+        if err := $3.expand(); err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+
         $$ = $3
     }
 
+grouping_elems:
+    grouping_elem
+    {
+        $$ = groupByClause{elems: [][][]*ColSelector{$1}}
+    }
+|
+    grouping_elems ',' grouping_elem
+    {
+        $$ = groupByClause{elems: append($1.elems, $3)}
+    }
+
+grouping_elem:
+    col
+    {
+        $$ = [][]*ColSelector{{$1}}
+    }
+|
+    ROLLUP '(' cols ')'
+    {
+        $$ = rollupSets($3)
+    }
+|
+    CUBE '(' cols ')'
+    {
+        sets, err := cubeSets($3)
+        if err != nil {
+            yylex.Error(err.Error())
+            goto ret1
+        }
+
+        $$ = sets
+    }
+|
+    GROUPING SETS '(' grouping_sets ')'
+    {
+        $$ = $4
+    }
+
+grouping_sets:
+    grouping_set
+    {
+        $$ = [][]*ColSelector{$1}
+    }
+|
+    grouping_sets ',' grouping_set
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_set:
+    col
+    {
+        $$ = []*ColSelector{$1}
+    }
+|
+    '(' ')'
+    {
+        $$ = []*ColSelector{}
+    }
+|
+    '(' cols ')'
+    {
+        $$ = $2
+    }
+
 opt_having:
     {
         $$ = nil
@@ -1478,6 +2583,26 @@ opt_having:
         $$ = $2
     }
 
+opt_partition_by:
+    {
+        $$ = ""
+    }
+|
+    PARTITION BY RANGE '(' col_name ')'
+    {
+        $$ = $5
+    }
+
+opt_using_exp:
+    {
+        $$ = nil
+    }
+|
+    USING exp
+    {
+        $$ = $2
+    }
+
 opt_limit:
     {
         $$ = nil
@@ -1738,6 +2863,9 @@ cmpExp
     | addExp ILIKE addExp           { $$ = &LikeBoolExp{val: $1, caseInsensitive: true, pattern: $3} }
     | addExp NOT ILIKE addExp       { $$ = &LikeBoolExp{val: $1, notLike: true, caseInsensitive: true, pattern: $4} }
     | addExp NOT_MATCHES_OP addExp  { $$ = &LikeBoolExp{val: $1, notLike: true, pattern: $3} }
+    | addExp CMPOP ANY '(' exp ')'  { $$ = &AnyAllExp{left: $1, op: $2, right: $5} }
+    | addExp CMPOP ALL '(' exp ')'  { $$ = &AnyAllExp{left: $1, op: $2, all: true, right: $5} }
+    | addExp CONTAINS_OP addExp     { $$ = &ArrayContainsExp{left: $1, right: $3} }
     | primaryBool
     ;
 
@@ -1806,15 +2934,20 @@ boundexp:
         $$ = $1
     }
 |
-    boundexp SCAST sql_type
+    boundexp SCAST type_spec
     {
-        $$ = &Cast{val: $1, t: $3}
+        $$ = &Cast{val: $1, t: $3.t, typeMod: $3.typeMod}
     }
 |
     EXTRACT '(' timestamp_field FROM exp ')'
     {
         $$ = &ExtractFromTimestampExp{Field: $3, Exp: $5}
     }
+|
+    GROUPING '(' cols ')'
+    {
+        $$ = &GroupingExp{cols: $3}
+    }
 ;
 
 opt_not:
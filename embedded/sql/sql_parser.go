@@ -12,6 +12,18 @@ func setResult(l yyLexer, stmts []SQLStmt) {
 	l.(*lexer).result = stmts
 }
 
+// sourceText returns the SQL text starting at offset from up to the token
+// being looked ahead, i.e. the text of the rule just reduced
+func sourceText(l yyLexer, from int) string {
+	return l.(*lexer).sourceText(from)
+}
+
+// sourceTextUntil returns the SQL text between offsets from and to, for
+// rules ending with a token, which are reduced without looking ahead
+func sourceTextUntil(l yyLexer, from, to int) string {
+	return l.(*lexer).sourceTextUntil(from, to)
+}
+
 func buildUsingCond(cols []string) ValueExp {
 	if len(cols) == 0 {
 		return &Bool{val: true}
@@ -32,6 +44,26 @@ func buildUsingCond(cols []string) ValueExp {
 	return cond
 }
 
+// typeSpec is a column or cast type together with its type modifier
+// e.g. VARCHAR[64], DECIMAL(10,2) or INTEGER[]
+type typeSpec struct {
+	t       SQLValueType
+	typeMod int
+}
+
+func newTypeSpec(t SQLValueType, args []uint64, isArray bool) (typeSpec, error) {
+	typeMod, err := typeModFromArgs(t, args)
+	if err != nil {
+		return typeSpec{}, err
+	}
+
+	if isArray {
+		t = ArrayTypeOf(t)
+	}
+
+	return typeSpec{t: t, typeMod: typeMod}, nil
+}
+
 func aggFnName(fn AggregateFn) string {
 	switch fn {
 	case COUNT:
@@ -62,12 +94,14 @@ type yySymType struct {
 	value           ValueExp
 	id              string
 	integer         uint64
+	typeArgs        []uint64
 	float           float64
 	str             string
 	boolean         bool
 	blob            []byte
 	keyword         string
 	sqlType         SQLValueType
+	typeSpec        typeSpec
 	aggFn           AggregateFn
 	colNames        []string
 	col             *ColSelector
@@ -100,11 +134,28 @@ type yySymType struct {
 	cteDefs         []*CTEDef
 	permission      Permission
 	sqlPrivilege    SQLPrivilege
-	sqlPrivileges   []SQLPrivilege
+	privilegeSpec   *privilegeSpec
+	sqlPrivileges   []*privilegeSpec
 	whenThenClauses []whenThenClause
 	tableElem       TableElem
 	tableElems      []TableElem
+	fk              *ForeignKeyConstraint
+	mergeAction     *mergeAction
+	mergeActions    []*mergeAction
+	createRoutine   *CreateRoutineStmt
+	routineArg      routineArg
+	routineArgs     []routineArg
+	windowFn        *WindowFnExp
+	windowDefs      map[string]*WindowFnExp
+	frame           *windowFrame
+	frameMode       windowFrameMode
+	frameBound      frameBound
+	groupBy         groupByClause
+	aggSel          *AggColSelector
+	groupingSets    [][]*ColSelector
+	refAction       ReferentialAction
 	timestampField  TimestampFieldType
+	pos             int
 }
 
 const CREATE = 57346
@@ -134,125 +185,170 @@ const UUID_TYPE = 57369
 const BLOB_TYPE = 57370
 const TIMESTAMP_TYPE = 57371
 const FLOAT_TYPE = 57372
-const JSON_TYPE = 57373
-const TABLE = 57374
-const UNIQUE = 57375
-const INDEX = 57376
-const ON = 57377
-const ALTER = 57378
-const ADD = 57379
-const RENAME = 57380
-const TO = 57381
-const COLUMN = 57382
-const CONSTRAINT = 57383
-const PRIMARY = 57384
-const KEY = 57385
-const CHECK = 57386
-const GRANT = 57387
-const REVOKE = 57388
-const GRANTS = 57389
-const FOR = 57390
-const PRIVILEGES = 57391
-const BEGIN = 57392
-const TRANSACTION = 57393
-const COMMIT = 57394
-const ROLLBACK = 57395
-const SAVEPOINT = 57396
-const RELEASE = 57397
-const INSERT = 57398
-const UPSERT = 57399
-const INTO = 57400
-const VALUES = 57401
-const DELETE = 57402
-const UPDATE = 57403
-const SET = 57404
-const CONFLICT = 57405
-const DO = 57406
-const NOTHING = 57407
-const RETURNING = 57408
-const SELECT = 57409
-const DISTINCT = 57410
-const FROM = 57411
-const JOIN = 57412
-const HAVING = 57413
-const WHERE = 57414
-const GROUP = 57415
-const BY = 57416
-const LIMIT = 57417
-const OFFSET = 57418
-const ORDER = 57419
-const ASC = 57420
-const DESC = 57421
-const AS = 57422
-const UNION = 57423
-const ALL = 57424
-const CASE = 57425
-const WHEN = 57426
-const THEN = 57427
-const ELSE = 57428
-const END = 57429
-const EXCEPT = 57430
-const INTERSECT = 57431
-const NULLS = 57432
-const FIRST = 57433
-const LAST = 57434
-const NOT = 57435
-const LIKE = 57436
-const ILIKE = 57437
-const IF = 57438
-const EXISTS = 57439
-const IN = 57440
-const IS = 57441
-const OVER = 57442
-const PARTITION = 57443
-const EXPLAIN = 57444
-const RECURSIVE = 57445
-const NATURAL = 57446
-const USING = 57447
-const FETCH = 57448
-const ROWS = 57449
-const ONLY = 57450
-const LATERAL = 57451
-const AUTO_INCREMENT = 57452
-const NULL = 57453
-const CAST = 57454
-const SCAST = 57455
-const DEFAULT = 57456
-const SHOW = 57457
-const DATABASES = 57458
-const TABLES = 57459
-const USERS = 57460
-const VIEW = 57461
-const FOREIGN = 57462
-const REFERENCES = 57463
-const SEQUENCE = 57464
-const CASCADE = 57465
-const BETWEEN = 57466
-const EXTRACT = 57467
-const YEAR = 57468
-const MONTH = 57469
-const DAY = 57470
-const HOUR = 57471
-const MINUTE = 57472
-const SECOND = 57473
-const NPARAM = 57474
-const PPARAM = 57475
-const JOINTYPE = 57476
-const AND = 57477
-const OR = 57478
-const CMPOP = 57479
-const NOT_MATCHES_OP = 57480
-const IDENTIFIER = 57481
-const INTEGER_LIT = 57482
-const FLOAT_LIT = 57483
-const VARCHAR_LIT = 57484
-const BOOLEAN_LIT = 57485
-const BLOB_LIT = 57486
-const AGGREGATE_FUNC = 57487
-const ERROR = 57488
-const DOT = 57489
-const ARROW = 57490
-const STMT_SEPARATOR = 57491
+const DECIMAL_TYPE = 57373
+const JSON_TYPE = 57374
+const DATE_TYPE = 57375
+const INTERVAL_TYPE = 57376
+const TABLE = 57377
+const UNIQUE = 57378
+const INDEX = 57379
+const ON = 57380
+const ALTER = 57381
+const ADD = 57382
+const RENAME = 57383
+const TO = 57384
+const COLUMN = 57385
+const CONSTRAINT = 57386
+const PRIMARY = 57387
+const KEY = 57388
+const CHECK = 57389
+const GRANT = 57390
+const REVOKE = 57391
+const GRANTS = 57392
+const FOR = 57393
+const PRIVILEGES = 57394
+const BEGIN = 57395
+const TRANSACTION = 57396
+const COMMIT = 57397
+const ROLLBACK = 57398
+const SAVEPOINT = 57399
+const RELEASE = 57400
+const INSERT = 57401
+const UPSERT = 57402
+const INTO = 57403
+const VALUES = 57404
+const DELETE = 57405
+const UPDATE = 57406
+const SET = 57407
+const CONFLICT = 57408
+const DO = 57409
+const NOTHING = 57410
+const RETURNING = 57411
+const SELECT = 57412
+const DISTINCT = 57413
+const FROM = 57414
+const JOIN = 57415
+const HAVING = 57416
+const WHERE = 57417
+const GROUP = 57418
+const BY = 57419
+const LIMIT = 57420
+const OFFSET = 57421
+const ORDER = 57422
+const ASC = 57423
+const DESC = 57424
+const AS = 57425
+const UNION = 57426
+const ALL = 57427
+const CASE = 57428
+const WHEN = 57429
+const THEN = 57430
+const ELSE = 57431
+const END = 57432
+const EXCEPT = 57433
+const INTERSECT = 57434
+const NULLS = 57435
+const FIRST = 57436
+const LAST = 57437
+const NOT = 57438
+const LIKE = 57439
+const ILIKE = 57440
+const IF = 57441
+const EXISTS = 57442
+const IN = 57443
+const IS = 57444
+const OVER = 57445
+const PARTITION = 57446
+const EXPLAIN = 57447
+const RECURSIVE = 57448
+const NATURAL = 57449
+const USING = 57450
+const FETCH = 57451
+const ROWS = 57452
+const ONLY = 57453
+const LATERAL = 57454
+const AUTO_INCREMENT = 57455
+const NULL = 57456
+const CAST = 57457
+const SCAST = 57458
+const DEFAULT = 57459
+const SHOW = 57460
+const DATABASES = 57461
+const TABLES = 57462
+const USERS = 57463
+const VIEW = 57464
+const FOREIGN = 57465
+const REFERENCES = 57466
+const SEQUENCE = 57467
+const CASCADE = 57468
+const POLICY = 57469
+const MATERIALIZED = 57470
+const REFRESH = 57471
+const INCREMENTALLY = 57472
+const TRIGGER = 57473
+const EACH = 57474
+const ROW = 57475
+const ANALYZE = 57476
+const GENERATED = 57477
+const ALWAYS = 57478
+const STORED = 57479
+const VIRTUAL = 57480
+const FULLTEXT = 57481
+const SCHEMA = 57482
+const MERGE = 57483
+const MATCHED = 57484
+const FUNCTION = 57485
+const PROCEDURE = 57486
+const RETURNS = 57487
+const LANGUAGE = 57488
+const CALL = 57489
+const WINDOW = 57490
+const RANGE = 57491
+const PRECEDING = 57492
+const FOLLOWING = 57493
+const UNBOUNDED = 57494
+const CURRENT = 57495
+const GROUPING = 57496
+const SETS = 57497
+const ROLLUP = 57498
+const CUBE = 57499
+const FILTER = 57500
+const WITHIN = 57501
+const BETWEEN = 57502
+const EXTRACT = 57503
+const YEAR = 57504
+const MONTH = 57505
+const DAY = 57506
+const HOUR = 57507
+const MINUTE = 57508
+const SECOND = 57509
+const ARRAY = 57510
+const ANY = 57511
+const CURRENT_DATE = 57512
+const CURRENT_TIMESTAMP = 57513
+const DECLARE = 57514
+const CURSOR = 57515
+const CLOSE = 57516
+const NPARAM = 57517
+const PPARAM = 57518
+const JOINTYPE = 57519
+const AND = 57520
+const OR = 57521
+const CMPOP = 57522
+const NOT_MATCHES_OP = 57523
+const CONTAINS_OP = 57524
+const IDENTIFIER = 57525
+const INTEGER_LIT = 57526
+const FLOAT_LIT = 57527
+const VARCHAR_LIT = 57528
+const BOOLEAN_LIT = 57529
+const BLOB_LIT = 57530
+const AGGREGATE_FUNC = 57531
+const ERROR = 57532
+const DOT = 57533
+const ARROW = 57534
+const ARROW_TEXT = 57535
+const STMT_SEPARATOR = 57536
 
 var yyToknames = [...]string{
 	"$end",
@@ -285,7 +381,10 @@ var yyToknames = [...]string{
 	"BLOB_TYPE",
 	"TIMESTAMP_TYPE",
 	"FLOAT_TYPE",
+	"DECIMAL_TYPE",
 	"JSON_TYPE",
+	"DATE_TYPE",
+	"INTERVAL_TYPE",
 	"TABLE",
 	"UNIQUE",
 	"INDEX",
@@ -378,6 +477,39 @@ var yyToknames = [...]string{
 	"REFERENCES",
 	"SEQUENCE",
 	"CASCADE",
+	"POLICY",
+	"MATERIALIZED",
+	"REFRESH",
+	"INCREMENTALLY",
+	"TRIGGER",
+	"EACH",
+	"ROW",
+	"ANALYZE",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
+	"FULLTEXT",
+	"SCHEMA",
+	"MERGE",
+	"MATCHED",
+	"FUNCTION",
+	"PROCEDURE",
+	"RETURNS",
+	"LANGUAGE",
+	"CALL",
+	"WINDOW",
+	"RANGE",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
+	"GROUPING",
+	"SETS",
+	"ROLLUP",
+	"CUBE",
+	"FILTER",
+	"WITHIN",
 	"BETWEEN",
 	"EXTRACT",
 	"YEAR",
@@ -386,6 +518,13 @@ var yyToknames = [...]string{
 	"HOUR",
 	"MINUTE",
 	"SECOND",
+	"ARRAY",
+	"ANY",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"DECLARE",
+	"CURSOR",
+	"CLOSE",
 	"NPARAM",
 	"PPARAM",
 	"JOINTYPE",
@@ -393,6 +532,7 @@ var yyToknames = [...]string{
 	"OR",
 	"CMPOP",
 	"NOT_MATCHES_OP",
+	"CONTAINS_OP",
 	"IDENTIFIER",
 	"INTEGER_LIT",
 	"FLOAT_LIT",
@@ -403,6 +543,7 @@ var yyToknames = [...]string{
 	"ERROR",
 	"DOT",
 	"ARROW",
+	"ARROW_TEXT",
 	"','",
 	"'+'",
 	"'-'",
@@ -427,686 +568,1389 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 177,
-	94, 343,
-	98, 343,
-	-2, 326,
-	-1, 450,
-	70, 258,
-	-2, 248,
-	-1, 524,
-	70, 258,
-	-2, 250,
+	-1, 50,
+	97, 553,
+	101, 553,
+	-2, 535,
+	-1, 745,
+	73, 449,
+	-2, 439,
+	-1, 855,
+	73, 449,
+	-2, 441,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 3361
+const yyLast = 8467
 
 var yyAct = [...]int16{
-	231, 696, 204, 691, 514, 667, 440, 191, 405, 263,
-	339, 336, 252, 653, 134, 631, 438, 523, 484, 434,
-	124, 525, 381, 170, 206, 299, 250, 495, 433, 404,
-	300, 158, 177, 253, 182, 301, 173, 174, 229, 200,
-	179, 333, 612, 207, 490, 23, 489, 67, 107, 436,
-	676, 675, 436, 436, 436, 436, 410, 481, 694, 348,
-	125, 689, 615, 608, 607, 606, 596, 509, 145, 138,
-	436, 148, 125, 672, 147, 125, 587, 436, 476, 565,
-	634, 629, 72, 628, 436, 73, 499, 475, 67, 67,
-	67, 70, 74, 437, 613, 410, 604, 603, 602, 599,
-	71, 212, 210, 215, 409, 209, 309, 211, 213, 595,
-	593, 75, 575, 76, 77, 78, 171, 550, 79, 537,
-	80, 535, 81, 82, 534, 531, 83, 84, 85, 86,
-	87, 480, 474, 88, 467, 449, 214, 89, 90, 380,
-	91, 379, 677, 669, 605, 601, 600, 435, 494, 487,
-	482, 162, 465, 459, 458, 457, 456, 417, 322, 296,
-	293, 292, 289, 281, 259, 237, 248, 219, 29, 125,
-	278, 279, 280, 92, 285, 247, 232, 276, 277, 251,
-	274, 690, 273, 463, 283, 526, 270, 286, 27, 481,
-	52, 680, 93, 343, 94, 101, 264, 397, 276, 277,
-	262, 208, 308, 95, 96, 97, 98, 99, 100, 62,
-	258, 271, 257, 268, 509, 528, 68, 152, 291, 284,
-	295, 238, 305, 502, 269, 275, 549, 473, 428, 562,
-	527, 318, 287, 419, 486, 398, 294, 276, 277, 42,
-	561, 323, 143, 312, 140, 106, 43, 125, 118, 126,
-	121, 337, 341, 254, 590, 589, 343, 572, 571, 319,
-	266, 536, 426, 356, 416, 415, 316, 317, 407, 331,
-	163, 332, 321, 320, 260, 108, 216, 166, 149, 342,
-	501, 108, 146, 133, 132, 142, 67, 141, 345, 128,
-	357, 338, 127, 122, 402, 120, 32, 406, 403, 344,
-	400, 355, 362, 360, 365, 361, 368, 414, 370, 358,
-	267, 528, 129, 373, 374, 375, 420, 371, 372, 408,
-	233, 111, 636, 125, 48, 337, 24, 305, 413, 424,
-	425, 249, 427, 119, 421, 418, 113, 610, 288, 125,
-	24, 617, 638, 245, 616, 446, 423, 44, 611, 47,
-	125, 560, 462, 444, 643, 620, 340, 503, 632, 450,
-	422, 34, 40, 441, 451, 460, 461, 553, 548, 559,
-	41, 391, 392, 393, 394, 395, 396, 445, 448, 471,
-	264, 264, 547, 27, 105, 35, 39, 38, 366, 334,
-	364, 130, 367, 313, 442, 310, 298, 27, 297, 464,
-	236, 235, 466, 234, 369, 109, 110, 112, 363, 477,
-	699, 700, 518, 305, 485, 569, 568, 223, 26, 220,
-	337, 218, 217, 697, 542, 469, 378, 470, 597, 493,
-	545, 25, 26, 159, 45, 346, 406, 46, 256, 511,
-	171, 483, 516, 492, 224, 25, 692, 693, 507, 515,
-	102, 520, 479, 6, 648, 221, 504, 103, 104, 165,
-	654, 529, 673, 513, 655, 510, 406, 646, 623, 641,
-	543, 544, 36, 546, 540, 37, 264, 614, 251, 645,
-	114, 530, 554, 626, 583, 305, 551, 472, 261, 337,
-	337, 164, 563, 65, 24, 116, 538, 337, 556, 557,
-	454, 455, 552, 567, 439, 573, 566, 619, 555, 349,
-	508, 618, 642, 157, 64, 63, 577, 33, 30, 578,
-	151, 167, 485, 491, 678, 580, 570, 500, 412, 574,
-	411, 576, 66, 660, 327, 328, 325, 326, 579, 329,
-	324, 588, 582, 452, 591, 592, 598, 594, 584, 581,
-	241, 27, 67, 430, 429, 31, 264, 160, 161, 264,
-	264, 668, 264, 519, 432, 314, 222, 355, 153, 150,
-	56, 60, 443, 154, 155, 156, 131, 51, 49, 2,
-	533, 242, 239, 240, 532, 330, 26, 586, 382, 383,
-	384, 385, 386, 387, 388, 389, 315, 621, 630, 25,
-	50, 243, 61, 228, 227, 136, 137, 225, 67, 117,
-	67, 639, 255, 506, 505, 640, 246, 633, 496, 497,
-	498, 635, 57, 355, 244, 355, 59, 58, 340, 28,
-	69, 390, 377, 55, 54, 647, 431, 659, 651, 272,
-	558, 637, 661, 624, 652, 627, 664, 656, 53, 657,
-	67, 67, 609, 264, 666, 662, 406, 658, 663, 684,
-	670, 125, 671, 488, 674, 355, 355, 168, 181, 681,
-	679, 622, 337, 185, 685, 311, 683, 264, 178, 176,
-	337, 682, 172, 468, 687, 649, 650, 187, 688, 686,
-	644, 695, 282, 302, 524, 522, 226, 135, 115, 698,
-	701, 335, 290, 335, 188, 72, 189, 665, 73, 5,
-	24, 4, 3, 347, 70, 74, 1, 0, 0, 0,
-	0, 0, 0, 71, 212, 210, 215, 0, 209, 199,
-	211, 213, 0, 0, 75, 376, 76, 77, 78, 0,
-	0, 79, 0, 80, 0, 81, 82, 0, 0, 83,
-	84, 85, 86, 87, 0, 0, 88, 0, 0, 214,
-	89, 90, 0, 91, 0, 0, 0, 27, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 175, 0, 0, 92, 180, 0, 447,
-	0, 0, 26, 0, 453, 0, 0, 0, 0, 0,
-	0, 203, 198, 0, 0, 541, 0, 94, 101, 0,
-	0, 0, 0, 0, 208, 190, 95, 96, 97, 98,
-	99, 100, 201, 202, 0, 0, 0, 0, 0, 205,
-	193, 194, 195, 196, 197, 192, 0, 0, 0, 72,
-	0, 184, 73, 0, 0, 0, 0, 186, 70, 74,
-	0, 0, 0, 0, 0, 0, 0, 71, 212, 210,
-	215, 0, 209, 199, 211, 213, 0, 0, 75, 0,
-	76, 77, 78, 0, 0, 79, 0, 80, 0, 81,
-	82, 0, 0, 83, 84, 85, 86, 87, 0, 0,
-	88, 0, 521, 214, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 539,
-	0, 0, 0, 0, 0, 0, 517, 183, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 175, 0, 0,
-	92, 180, 0, 0, 0, 0, 564, 0, 0, 0,
-	0, 0, 0, 0, 0, 203, 198, 0, 0, 93,
-	0, 94, 101, 0, 0, 0, 0, 0, 208, 190,
-	95, 96, 97, 98, 99, 100, 201, 202, 0, 0,
-	0, 0, 0, 205, 193, 194, 195, 196, 197, 192,
-	72, 0, 0, 73, 0, 184, 0, 0, 0, 70,
-	74, 186, 0, 0, 0, 0, 0, 0, 71, 212,
-	210, 215, 0, 209, 199, 211, 213, 0, 0, 75,
-	0, 76, 77, 78, 0, 0, 79, 0, 80, 0,
-	81, 82, 0, 0, 83, 84, 85, 86, 87, 0,
-	0, 88, 0, 0, 214, 89, 90, 0, 91, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 0,
-	0, 92, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 203, 198, 0, 0,
-	93, 0, 94, 101, 0, 0, 0, 0, 0, 208,
-	190, 95, 96, 97, 98, 99, 100, 201, 202, 0,
-	0, 0, 0, 0, 205, 193, 194, 195, 196, 197,
-	192, 72, 0, 0, 73, 0, 184, 512, 0, 0,
-	70, 74, 186, 0, 0, 0, 0, 0, 230, 71,
-	212, 210, 215, 0, 209, 199, 211, 213, 0, 0,
-	75, 0, 76, 77, 78, 0, 0, 79, 0, 80,
-	0, 81, 82, 0, 0, 83, 84, 85, 86, 87,
-	0, 0, 88, 0, 0, 214, 89, 90, 0, 91,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	0, 0, 92, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 203, 198, 0,
-	0, 93, 0, 94, 101, 0, 0, 0, 0, 0,
-	208, 190, 95, 96, 97, 98, 99, 100, 201, 202,
-	0, 0, 0, 0, 0, 205, 193, 194, 195, 196,
-	197, 192, 72, 0, 0, 73, 0, 184, 0, 0,
-	0, 70, 74, 186, 0, 0, 0, 0, 0, 0,
-	71, 212, 210, 215, 0, 209, 199, 211, 213, 0,
-	0, 75, 0, 76, 77, 78, 0, 0, 79, 0,
-	80, 0, 81, 82, 0, 0, 83, 84, 85, 86,
-	87, 0, 0, 88, 0, 0, 214, 89, 90, 0,
-	91, 0, 0, 0, 27, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 0, 0, 92, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 203, 198,
-	0, 0, 93, 0, 94, 101, 0, 0, 0, 0,
-	0, 208, 190, 95, 96, 97, 98, 99, 100, 201,
-	202, 0, 0, 0, 0, 0, 205, 193, 194, 195,
-	196, 197, 192, 72, 0, 0, 73, 0, 184, 0,
-	0, 0, 70, 74, 186, 0, 0, 0, 0, 0,
-	0, 71, 212, 210, 215, 0, 209, 199, 211, 213,
-	0, 0, 75, 0, 76, 77, 78, 0, 0, 79,
-	0, 80, 0, 81, 82, 0, 0, 83, 84, 85,
-	86, 87, 0, 0, 88, 0, 0, 214, 89, 90,
-	0, 91, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 175, 0, 0, 92, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 203,
-	198, 0, 0, 93, 0, 94, 101, 0, 0, 0,
-	0, 0, 208, 190, 95, 96, 97, 98, 99, 100,
-	201, 202, 0, 0, 0, 0, 0, 205, 193, 194,
-	195, 196, 197, 192, 72, 0, 0, 73, 0, 184,
-	169, 0, 0, 70, 74, 186, 0, 0, 0, 0,
-	0, 0, 71, 212, 210, 215, 0, 209, 199, 211,
-	213, 0, 0, 75, 0, 76, 77, 78, 0, 0,
-	79, 0, 80, 0, 81, 82, 0, 0, 83, 84,
-	85, 86, 87, 0, 0, 88, 0, 0, 214, 89,
-	90, 0, 91, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 0, 0, 92, 180, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	203, 198, 0, 0, 93, 0, 94, 101, 0, 0,
-	0, 0, 0, 208, 190, 95, 96, 97, 98, 99,
-	100, 201, 202, 0, 0, 0, 0, 0, 205, 193,
-	194, 195, 196, 197, 192, 72, 0, 0, 73, 0,
-	184, 0, 0, 0, 70, 74, 186, 0, 0, 0,
-	0, 0, 0, 71, 212, 210, 215, 0, 209, 199,
-	211, 213, 0, 0, 75, 0, 76, 77, 78, 0,
-	0, 79, 0, 80, 0, 81, 82, 0, 0, 83,
-	84, 85, 86, 87, 0, 0, 88, 0, 0, 214,
-	89, 90, 0, 91, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 285, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 203, 198, 0, 0, 93, 0, 94, 101, 0,
-	0, 0, 0, 0, 208, 190, 95, 96, 97, 98,
-	99, 100, 201, 202, 0, 0, 0, 0, 0, 205,
-	193, 194, 195, 196, 197, 192, 72, 0, 0, 73,
-	0, 184, 0, 0, 0, 70, 74, 186, 0, 0,
-	0, 0, 0, 0, 71, 212, 210, 215, 0, 209,
-	309, 211, 213, 0, 0, 75, 0, 76, 77, 78,
-	0, 0, 79, 0, 80, 0, 81, 82, 0, 0,
-	83, 84, 85, 86, 87, 0, 0, 88, 0, 0,
-	214, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 92, 285, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 0, 94, 101,
-	0, 0, 0, 0, 0, 208, 308, 95, 96, 97,
-	98, 99, 100, 0, 0, 0, 72, 0, 0, 73,
-	68, 0, 0, 0, 0, 70, 74, 0, 0, 0,
-	0, 0, 0, 0, 71, 212, 210, 215, 478, 209,
-	309, 211, 213, 0, 0, 75, 0, 76, 77, 78,
-	0, 0, 79, 0, 80, 0, 81, 82, 0, 0,
-	83, 84, 85, 86, 87, 0, 0, 88, 0, 0,
-	214, 89, 90, 0, 91, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 92, 285, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 0, 94, 101,
-	0, 0, 0, 0, 0, 208, 308, 95, 96, 97,
-	98, 99, 100, 72, 0, 0, 73, 0, 0, 0,
-	68, 0, 70, 74, 0, 0, 0, 0, 0, 0,
-	0, 71, 0, 399, 0, 12, 14, 15, 13, 0,
-	353, 24, 75, 0, 76, 77, 78, 0, 0, 79,
-	0, 80, 0, 81, 82, 0, 0, 83, 84, 85,
-	86, 87, 0, 0, 88, 0, 0, 16, 89, 90,
-	0, 91, 0, 0, 0, 0, 17, 18, 0, 0,
-	0, 7, 0, 8, 9, 10, 11, 19, 20, 0,
-	0, 21, 22, 0, 0, 0, 0, 0, 27, 0,
-	0, 0, 0, 0, 92, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 625, 0, 0,
-	0, 0, 0, 93, 351, 352, 354, 0, 0, 0,
-	0, 0, 0, 26, 95, 96, 97, 98, 99, 100,
-	72, 0, 0, 73, 0, 0, 25, 205, 0, 70,
-	74, 0, 0, 0, 0, 0, 0, 0, 71, 0,
-	0, 0, 0, 0, 0, 350, 0, 353, 0, 75,
-	0, 76, 77, 78, 0, 0, 79, 0, 80, 0,
-	81, 82, 0, 0, 83, 84, 85, 86, 87, 0,
-	0, 88, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
-	93, 351, 352, 354, 0, 0, 0, 0, 0, 0,
-	0, 95, 96, 97, 98, 99, 100, 72, 0, 0,
-	73, 0, 0, 0, 205, 0, 70, 74, 0, 0,
-	0, 0, 0, 0, 0, 71, 0, 0, 0, 0,
-	0, 0, 350, 0, 353, 0, 75, 0, 76, 77,
-	78, 0, 0, 79, 0, 80, 0, 81, 82, 0,
-	0, 83, 84, 85, 86, 87, 0, 0, 88, 0,
-	0, 0, 89, 90, 0, 91, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 92, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 351, 352,
-	354, 0, 0, 0, 0, 0, 0, 0, 95, 96,
-	97, 98, 99, 100, 0, 0, 0, 72, 0, 0,
-	73, 205, 0, 0, 0, 0, 70, 74, 0, 0,
-	0, 0, 0, 0, 0, 71, 212, 210, 215, 350,
-	209, 309, 211, 213, 0, 0, 75, 0, 76, 77,
-	78, 0, 0, 79, 0, 80, 0, 81, 82, 0,
-	0, 83, 84, 85, 86, 87, 0, 0, 88, 0,
-	0, 214, 89, 90, 0, 91, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 92, 285,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 93, 0, 94,
-	101, 0, 0, 0, 0, 0, 208, 308, 95, 96,
-	97, 98, 99, 100, 72, 0, 0, 73, 0, 0,
-	0, 68, 0, 70, 74, 0, 0, 359, 0, 0,
-	0, 0, 71, 212, 210, 215, 0, 209, 309, 211,
-	213, 0, 0, 75, 0, 76, 77, 78, 0, 0,
-	307, 303, 80, 306, 81, 82, 0, 0, 83, 84,
-	85, 86, 87, 0, 0, 88, 0, 0, 214, 89,
-	90, 0, 91, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 92, 285, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 93, 0, 94, 101, 0, 304,
-	0, 0, 0, 208, 308, 95, 96, 97, 98, 99,
-	100, 72, 0, 0, 73, 0, 0, 0, 68, 0,
-	70, 74, 0, 0, 0, 0, 0, 0, 0, 71,
-	212, 210, 215, 0, 209, 309, 211, 213, 0, 0,
-	75, 0, 76, 77, 78, 0, 0, 79, 0, 80,
-	0, 81, 82, 0, 0, 83, 84, 85, 86, 87,
-	0, 0, 88, 0, 0, 214, 89, 90, 0, 91,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 92, 285, 0, 0, 0, 0, 0, 0,
-	72, 0, 0, 73, 0, 0, 0, 0, 0, 70,
-	74, 93, 0, 94, 101, 0, 0, 0, 71, 0,
-	208, 308, 95, 96, 97, 98, 99, 100, 0, 75,
-	0, 76, 77, 78, 0, 68, 79, 0, 80, 0,
-	81, 82, 0, 0, 83, 84, 85, 86, 87, 0,
-	0, 88, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 72,
-	0, 0, 73, 0, 0, 0, 0, 0, 70, 74,
-	93, 0, 94, 101, 0, 0, 0, 71, 0, 0,
-	0, 95, 96, 97, 98, 99, 100, 0, 75, 144,
-	76, 77, 78, 0, 68, 79, 0, 80, 0, 81,
-	82, 0, 0, 83, 84, 85, 86, 87, 0, 0,
-	88, 0, 0, 0, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 0, 0, 0, 0, 72, 0,
-	0, 73, 0, 0, 0, 0, 0, 70, 74, 93,
-	0, 94, 101, 0, 0, 0, 71, 0, 0, 0,
-	95, 96, 97, 98, 99, 100, 0, 75, 0, 76,
-	77, 78, 0, 68, 79, 0, 80, 0, 81, 82,
-	0, 0, 83, 84, 85, 86, 87, 0, 0, 88,
-	0, 0, 0, 89, 90, 0, 91, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
-	0, 0, 0, 0, 0, 0, 0, 72, 0, 0,
-	73, 0, 0, 0, 0, 0, 70, 74, 93, 0,
-	94, 101, 0, 0, 0, 71, 0, 0, 0, 95,
-	96, 97, 98, 99, 100, 0, 75, 0, 76, 77,
-	78, 0, 68, 79, 0, 80, 0, 81, 82, 0,
-	0, 83, 84, 85, 86, 87, 0, 0, 88, 0,
-	0, 0, 89, 90, 0, 91, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 72, 0, 0, 73,
-	0, 0, 0, 0, 0, 70, 74, 93, 0, 94,
-	101, 0, 0, 0, 71, 0, 0, 0, 95, 96,
-	97, 98, 99, 100, 0, 75, 0, 76, 77, 78,
-	0, 68, 79, 0, 80, 0, 81, 82, 0, 0,
-	83, 84, 85, 86, 87, 0, 0, 88, 0, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 93, 0, 94, 101,
-	0, 0, 0, 0, 0, 0, 0, 95, 96, 97,
-	98, 99, 100, 0, 0, 0, 0, 0, 0, 0,
-	68,
+	427, 426, 65, 1162, 1181, 404, 1111, 776, 1049, 492,
+	497, 844, 1115, 1068, 43, 799, 1063, 1035, 734, 937,
+	1086, 35, 960, 1056, 856, 300, 972, 1026, 790, 732,
+	854, 622, 729, 793, 495, 78, 577, 50, 708, 707,
+	549, 728, 449, 636, 354, 6, 87, 575, 488, 500,
+	425, 775, 815, 450, 550, 341, 498, 258, 302, 614,
+	261, 617, 551, 221, 30, 460, 484, 453, 55, 197,
+	292, 47, 219, 52, 573, 46, 657, 769, 768, 228,
+	543, 528, 544, 527, 637, 530, 655, 1177, 276, 1198,
+	530, 1199, 530, 208, 1191, 104, 1176, 656, 105, 1160,
+	612, 1159, 612, 1186, 98, 106, 612, 825, 612, 1144,
+	612, 1137, 212, 103, 678, 1042, 958, 946, 1166, 945,
+	242, 1113, 1091, 942, 262, 641, 259, 107, 840, 108,
+	109, 110, 825, 1090, 111, 1085, 112, 928, 113, 114,
+	1082, 897, 115, 116, 117, 118, 119, 1025, 825, 120,
+	771, 544, 612, 121, 122, 678, 123, 824, 668, 770,
+	765, 731, 612, 538, 677, 530, 1004, 667, 1001, 983,
+	982, 611, 537, 962, 531, 936, 934, 913, 86, 903,
+	878, 877, 876, 871, 869, 868, 865, 784, 789, 124,
+	781, 780, 774, 777, 773, 772, 764, 763, 192, 762,
+	744, 701, 977, 699, 696, 632, 192, 693, 125, 639,
+	640, 642, 778, 595, 546, 542, 536, 521, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 355, 147, 148, 149, 150, 151, 152, 383, 153,
+	154, 155, 156, 157, 433, 100, 101, 102, 382, 99,
+	1195, 1192, 127, 128, 129, 130, 131, 132, 778, 493,
+	1148, 1087, 1136, 343, 344, 1114, 1103, 1102, 405, 1070,
+	1000, 999, 415, 85, 994, 351, 947, 730, 417, 898,
+	895, 362, 880, 367, 345, 370, 788, 372, 373, 827,
+	814, 796, 638, 347, 335, 336, 337, 84, 785, 339,
+	384, 692, 379, 759, 455, 455, 758, 594, 757, 756,
+	461, 519, 515, 361, 514, 508, 360, 448, 447, 414,
+	340, 333, 376, 377, 378, 374, 375, 278, 275, 429,
+	248, 271, 247, 265, 264, 256, 244, 1124, 36, 1022,
+	192, 283, 432, 517, 192, 192, 494, 253, 254, 255,
+	251, 252, 857, 496, 34, 1125, 251, 252, 192, 307,
+	251, 252, 192, 192, 626, 445, 1131, 323, 1101, 192,
+	1080, 544, 456, 511, 192, 840, 697, 463, 411, 410,
+	712, 332, 525, 268, 267, 545, 474, 504, 192, 192,
+	192, 503, 326, 192, 245, 277, 1006, 901, 720, 1028,
+	1029, 491, 487, 513, 695, 512, 518, 1027, 533, 520,
+	506, 532, 507, 413, 243, 249, 250, 412, 274, 273,
+	831, 539, 859, 272, 1028, 1029, 873, 479, 177, 251,
+	252, 1031, 1032, 1183, 658, 178, 233, 220, 321, 858,
+	386, 387, 388, 389, 390, 391, 392, 393, 394, 395,
+	396, 1194, 455, 455, 159, 581, 1031, 1032, 499, 160,
+	172, 480, 477, 478, 319, 1157, 312, 1109, 192, 310,
+	1100, 1007, 626, 279, 910, 909, 870, 862, 346, 837,
+	830, 835, 561, 717, 681, 675, 161, 171, 170, 593,
+	591, 586, 585, 509, 1182, 222, 192, 624, 359, 358,
+	356, 192, 349, 329, 324, 475, 192, 299, 298, 192,
+	297, 296, 644, 192, 222, 645, 646, 295, 501, 579,
+	580, 649, 320, 578, 652, 653, 291, 290, 237, 235,
+	659, 215, 625, 660, 501, 661, 584, 41, 619, 39,
+	619, 666, 620, 669, 1171, 672, 643, 163, 318, 630,
+	311, 1184, 158, 309, 635, 647, 621, 280, 683, 1057,
+	627, 633, 234, 240, 241, 648, 859, 236, 927, 671,
+	541, 270, 662, 165, 1104, 428, 166, 944, 168, 167,
+	1078, 1079, 169, 398, 399, 400, 401, 402, 403, 1075,
+	1076, 162, 1060, 682, 173, 174, 986, 819, 832, 576,
+	961, 173, 174, 176, 1050, 481, 1058, 813, 687, 711,
+	24, 25, 1013, 698, 26, 27, 1052, 890, 192, 24,
+	25, 802, 192, 26, 27, 1052, 1121, 1120, 740, 1077,
+	175, 489, 1002, 955, 599, 987, 194, 192, 583, 801,
+	31, 700, 1088, 457, 192, 192, 1044, 995, 192, 798,
+	192, 702, 703, 597, 31, 767, 327, 738, 1012, 317,
+	800, 289, 608, 713, 643, 192, 889, 225, 745, 613,
+	263, 783, 741, 724, 1010, 1193, 964, 802, 963, 742,
+	31, 629, 227, 739, 753, 366, 891, 746, 516, 192,
+	743, 751, 28, 760, 761, 801, 750, 949, 29, 623,
+	34, 28, 969, 365, 966, 806, 618, 29, 628, 791,
+	875, 826, 285, 735, 34, 606, 779, 31, 674, 664,
+	663, 688, 589, 754, 755, 797, 787, 192, 570, 560,
+	556, 559, 812, 792, 355, 33, 846, 192, 685, 192,
+	34, 548, 368, 547, 736, 820, 369, 472, 32, 33,
+	192, 223, 224, 226, 470, 466, 838, 850, 465, 464,
+	462, 459, 32, 458, 229, 293, 371, 872, 192, 752,
+	1140, 1141, 596, 833, 907, 33, 906, 34, 843, 563,
+	192, 841, 444, 435, 434, 192, 431, 430, 32, 852,
+	848, 489, 1112, 863, 864, 630, 216, 860, 189, 887,
+	1018, 1149, 1129, 217, 218, 651, 523, 192, 524, 1095,
+	810, 809, 33, 766, 654, 973, 501, 808, 381, 342,
+	634, 505, 501, 501, 179, 32, 188, 1175, 1132, 881,
+	1036, 1037, 807, 959, 951, 894, 821, 804, 562, 535,
+	436, 348, 938, 673, 884, 885, 915, 941, 879, 916,
+	893, 845, 919, 1016, 899, 991, 900, 988, 940, 939,
+	883, 975, 918, 786, 902, 1015, 670, 192, 1005, 908,
+	192, 956, 496, 912, 914, 534, 978, 922, 615, 1084,
+	952, 529, 923, 917, 192, 192, 921, 213, 920, 510,
+	950, 239, 709, 238, 643, 211, 954, 231, 556, 733,
+	715, 716, 925, 718, 911, 1127, 1126, 191, 616, 1128,
+	723, 183, 1174, 1153, 184, 929, 186, 185, 932, 933,
+	187, 935, 1154, 839, 968, 214, 501, 629, 967, 180,
+	1155, 566, 181, 182, 1152, 568, 567, 1167, 1168, 210,
+	953, 989, 1009, 990, 338, 1188, 1039, 892, 970, 209,
+	40, 37, 202, 206, 331, 993, 896, 1003, 643, 803,
+	643, 690, 357, 350, 980, 981, 976, 1008, 979, 1045,
+	886, 992, 680, 679, 998, 605, 556, 794, 603, 604,
+	601, 602, 600, 1180, 1122, 829, 721, 207, 609, 1034,
+	709, 38, 1143, 1069, 1038, 851, 996, 849, 1011, 727,
+	1046, 1047, 706, 705, 691, 571, 1053, 203, 1017, 1024,
+	828, 205, 204, 643, 643, 471, 1061, 469, 201, 1064,
+	1055, 1019, 1020, 443, 439, 334, 330, 1051, 5, 1054,
+	1021, 737, 1023, 198, 294, 190, 2, 192, 926, 196,
+	882, 867, 866, 558, 501, 192, 192, 501, 501, 282,
+	501, 1071, 1081, 287, 288, 1074, 1083, 607, 1096, 1093,
+	1072, 192, 452, 451, 5, 195, 572, 305, 442, 441,
+	1105, 314, 315, 232, 1073, 303, 304, 482, 325, 1098,
+	446, 1110, 1097, 328, 623, 1107, 556, 816, 817, 818,
+	836, 834, 725, 489, 489, 722, 164, 565, 440, 689,
+	88, 192, 385, 192, 1064, 405, 405, 397, 1134, 1135,
+	1118, 380, 709, 199, 1123, 1142, 200, 1130, 1133, 1106,
+	726, 1119, 1108, 709, 997, 904, 246, 905, 1139, 948,
+	598, 1146, 888, 1150, 874, 1147, 1116, 1138, 1145, 1163,
+	1158, 1033, 1156, 526, 352, 965, 1165, 794, 54, 1170,
+	269, 420, 66, 1161, 1062, 974, 192, 192, 1169, 501,
+	58, 501, 51, 1172, 1173, 49, 45, 405, 522, 60,
+	1179, 1014, 1187, 1185, 192, 257, 1030, 437, 985, 984,
+	1163, 1190, 1099, 1189, 1059, 574, 971, 1043, 552, 855,
+	853, 301, 747, 1196, 230, 266, 957, 1197, 61, 62,
+	1092, 1048, 4, 501, 3, 467, 1, 0, 0, 0,
+	473, 0, 0, 0, 0, 476, 0, 0, 485, 0,
+	0, 0, 485, 192, 386, 387, 388, 389, 390, 391,
+	392, 393, 394, 395, 396, 15, 17, 18, 16, 0,
+	0, 31, 0, 0, 0, 489, 0, 0, 0, 0,
+	0, 501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 0, 0,
+	21, 0, 0, 0, 0, 0, 0, 0, 0, 22,
+	23, 0, 0, 0, 7, 0, 8, 9, 10, 11,
+	24, 25, 1040, 0, 26, 27, 0, 0, 0, 0,
+	0, 34, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 564, 0, 0,
+	0, 569, 0, 0, 0, 0, 33, 0, 0, 0,
+	13, 0, 0, 0, 0, 0, 582, 0, 0, 32,
+	1094, 0, 0, 587, 588, 0, 0, 590, 0, 592,
+	20, 0, 0, 0, 0, 19, 0, 0, 489, 0,
+	0, 0, 28, 0, 610, 0, 0, 0, 29, 0,
+	0, 0, 0, 0, 0, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 12, 0, 14, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1151, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 676, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 684, 0, 686, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 694,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 704, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 104, 0, 714,
+	105, 0, 31, 0, 719, 0, 98, 106, 0, 0,
+	0, 0, 0, 0, 0, 103, 94, 91, 97, 0,
+	90, 73, 92, 93, 95, 74, 75, 0, 0, 107,
+	0, 108, 109, 110, 0, 0, 111, 0, 112, 0,
+	113, 114, 0, 0, 115, 116, 117, 118, 119, 0,
+	0, 120, 0, 0, 96, 121, 122, 0, 123, 0,
+	0, 0, 34, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 56, 0,
+	0, 0, 0, 0, 0, 0, 805, 0, 48, 811,
+	0, 124, 53, 0, 0, 0, 0, 33, 0, 0,
+	0, 0, 0, 822, 823, 0, 82, 72, 0, 0,
+	650, 0, 126, 133, 0, 0, 0, 0, 0, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 0, 147, 148, 149, 150, 151, 152,
+	0, 153, 154, 155, 156, 157, 64, 100, 101, 102,
+	0, 99, 89, 63, 127, 128, 129, 130, 131, 132,
+	83, 0, 76, 77, 0, 0, 0, 80, 81, 0,
+	0, 0, 0, 0, 0, 85, 67, 68, 69, 70,
+	71, 79, 104, 0, 0, 105, 0, 0, 57, 0,
+	0, 98, 106, 0, 59, 0, 0, 0, 0, 0,
+	103, 94, 91, 97, 0, 90, 73, 92, 93, 95,
+	74, 75, 0, 0, 107, 0, 108, 109, 110, 0,
+	0, 111, 0, 112, 0, 113, 114, 0, 0, 115,
+	116, 117, 118, 119, 0, 0, 120, 0, 0, 96,
+	121, 122, 0, 123, 0, 0, 0, 0, 0, 42,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 44, 56, 930, 931, 0, 0, 0, 0,
+	0, 0, 0, 48, 0, 0, 124, 53, 0, 0,
+	943, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 82, 72, 0, 0, 125, 0, 126, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 0, 147,
+	148, 149, 150, 151, 152, 0, 153, 154, 155, 156,
+	157, 64, 100, 101, 102, 0, 99, 89, 63, 127,
+	128, 129, 130, 131, 132, 83, 0, 76, 77, 0,
+	0, 0, 80, 81, 0, 0, 0, 0, 0, 0,
+	85, 67, 68, 69, 70, 71, 79, 0, 0, 0,
+	0, 0, 0, 57, 0, 0, 0, 0, 104, 59,
+	0, 105, 0, 0, 0, 0, 0, 98, 106, 0,
+	0, 0, 0, 1041, 0, 0, 103, 94, 91, 97,
+	0, 90, 73, 92, 93, 95, 74, 75, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 1089, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 847, 56,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 48,
+	0, 0, 124, 53, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1117, 82, 72, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 64, 100, 101,
+	102, 0, 99, 89, 63, 127, 128, 129, 130, 131,
+	132, 83, 0, 76, 77, 0, 0, 0, 80, 81,
+	0, 0, 0, 0, 0, 0, 85, 67, 68, 69,
+	70, 71, 79, 104, 0, 0, 105, 0, 0, 57,
+	0, 0, 98, 106, 0, 59, 0, 0, 0, 0,
+	0, 103, 94, 91, 97, 0, 90, 73, 92, 93,
+	95, 74, 75, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	96, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 56, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 48, 0, 0, 124, 53, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 72, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 64, 100, 101, 102, 0, 99, 89, 63,
+	127, 128, 129, 130, 131, 132, 83, 0, 76, 77,
+	0, 0, 0, 80, 81, 0, 0, 0, 0, 0,
+	0, 85, 67, 68, 69, 70, 71, 79, 104, 0,
+	0, 105, 0, 0, 57, 842, 0, 98, 106, 0,
+	59, 0, 0, 0, 0, 454, 103, 94, 91, 97,
+	0, 90, 73, 92, 93, 95, 74, 75, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 56,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 48,
+	0, 0, 124, 53, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 72, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 64, 100, 101,
+	102, 0, 99, 89, 63, 127, 128, 129, 130, 131,
+	132, 83, 0, 76, 77, 0, 0, 0, 80, 81,
+	0, 0, 0, 0, 0, 0, 85, 67, 68, 69,
+	70, 71, 79, 104, 0, 0, 105, 0, 0, 57,
+	0, 0, 98, 106, 0, 59, 0, 0, 0, 0,
+	0, 103, 94, 91, 97, 0, 90, 73, 92, 93,
+	95, 74, 75, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	96, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 56, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 48, 0, 0, 124, 53, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 72, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 64, 100, 101, 102, 0, 99, 89, 63,
+	127, 128, 129, 130, 131, 132, 83, 0, 76, 77,
+	0, 0, 0, 80, 81, 0, 0, 0, 0, 0,
+	0, 85, 67, 68, 69, 70, 71, 79, 104, 0,
+	0, 105, 0, 0, 57, 353, 0, 98, 106, 0,
+	59, 0, 0, 0, 0, 0, 103, 94, 91, 97,
+	0, 90, 73, 92, 93, 95, 74, 75, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 56,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 48,
+	0, 0, 124, 53, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 72, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 64, 100, 101,
+	102, 0, 99, 89, 63, 127, 128, 129, 130, 131,
+	132, 83, 0, 76, 77, 0, 0, 0, 80, 81,
+	0, 0, 0, 0, 0, 0, 85, 67, 68, 69,
+	70, 71, 79, 104, 0, 0, 105, 0, 0, 57,
+	0, 0, 98, 106, 0, 59, 0, 0, 0, 0,
+	0, 103, 94, 91, 97, 0, 90, 73, 92, 93,
+	95, 74, 75, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	96, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 56, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 48, 0, 0, 124, 53, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 72, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 64, 100, 101, 102, 0, 99, 89, 63,
+	127, 128, 129, 130, 131, 132, 83, 0, 76, 77,
+	0, 0, 0, 80, 81, 0, 0, 0, 0, 0,
+	0, 85, 67, 68, 69, 70, 71, 79, 104, 0,
+	0, 105, 0, 0, 57, 0, 0, 98, 106, 0,
+	59, 0, 0, 0, 0, 0, 103, 94, 91, 97,
+	0, 90, 73, 92, 93, 95, 74, 75, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 364, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 82, 72, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 64, 100, 101,
+	102, 0, 99, 89, 63, 127, 128, 129, 130, 131,
+	132, 83, 363, 76, 77, 0, 0, 0, 80, 81,
+	0, 0, 0, 0, 0, 0, 85, 67, 68, 69,
+	70, 71, 79, 104, 0, 0, 105, 0, 0, 57,
+	0, 0, 98, 106, 0, 59, 0, 0, 0, 0,
+	0, 103, 94, 91, 97, 0, 90, 73, 92, 93,
+	95, 74, 75, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	96, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 260, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 82, 72, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 64, 100, 101, 102, 0, 99, 89, 63,
+	127, 128, 129, 130, 131, 132, 83, 0, 76, 77,
+	0, 0, 0, 80, 81, 0, 0, 0, 0, 0,
+	0, 85, 67, 68, 69, 70, 71, 79, 104, 0,
+	0, 105, 0, 0, 57, 0, 0, 98, 106, 0,
+	59, 0, 0, 0, 0, 0, 103, 94, 91, 97,
+	0, 90, 407, 92, 93, 95, 408, 409, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 0, 418, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 0, 100, 101,
+	102, 0, 99, 89, 406, 127, 128, 129, 130, 131,
+	132, 0, 0, 0, 0, 0, 0, 0, 423, 424,
+	0, 0, 0, 0, 0, 0, 193, 421, 422, 104,
+	0, 0, 105, 0, 0, 0, 0, 0, 98, 106,
+	416, 0, 0, 0, 0, 0, 419, 103, 94, 91,
+	97, 0, 90, 407, 92, 93, 95, 408, 409, 0,
+	0, 107, 0, 108, 109, 110, 0, 0, 111, 0,
+	112, 0, 113, 114, 0, 0, 115, 116, 117, 118,
+	119, 0, 0, 120, 0, 0, 96, 121, 122, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 124, 260, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 126, 133, 0, 0, 0, 0,
+	0, 0, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 0, 147, 148, 149, 150,
+	151, 152, 0, 153, 154, 155, 156, 157, 0, 100,
+	101, 102, 0, 99, 89, 406, 127, 128, 129, 130,
+	131, 132, 0, 0, 104, 0, 0, 105, 0, 0,
+	0, 0, 0, 98, 106, 0, 0, 193, 0, 0,
+	0, 0, 103, 94, 91, 97, 0, 90, 407, 92,
+	93, 95, 408, 409, 0, 0, 107, 1178, 108, 109,
+	110, 0, 0, 111, 0, 112, 0, 113, 114, 0,
+	0, 115, 116, 117, 118, 119, 0, 0, 120, 0,
+	0, 96, 121, 122, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 260,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 126,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	0, 147, 148, 149, 150, 151, 152, 0, 153, 154,
+	155, 156, 157, 0, 100, 101, 102, 0, 99, 89,
+	406, 127, 128, 129, 130, 131, 132, 0, 104, 0,
+	0, 105, 0, 0, 0, 0, 0, 98, 106, 0,
+	0, 0, 193, 0, 0, 0, 103, 94, 91, 97,
+	0, 90, 407, 92, 93, 95, 408, 409, 0, 0,
+	107, 1164, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 0, 100, 101,
+	102, 0, 99, 89, 406, 127, 128, 129, 130, 131,
+	132, 0, 104, 0, 0, 105, 0, 0, 0, 0,
+	0, 98, 106, 0, 0, 0, 193, 0, 0, 0,
+	103, 94, 91, 97, 0, 90, 407, 92, 93, 95,
+	408, 409, 0, 0, 107, 795, 108, 109, 110, 0,
+	0, 111, 0, 112, 0, 113, 114, 0, 0, 115,
+	116, 117, 118, 119, 0, 0, 120, 0, 0, 96,
+	121, 122, 0, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 126, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 0, 147,
+	148, 149, 150, 151, 152, 0, 153, 154, 155, 156,
+	157, 0, 100, 101, 102, 0, 99, 89, 406, 127,
+	128, 129, 130, 131, 132, 0, 104, 0, 0, 105,
+	0, 0, 0, 0, 0, 98, 106, 0, 0, 0,
+	193, 0, 0, 0, 103, 94, 91, 97, 0, 90,
+	407, 92, 93, 95, 408, 409, 0, 0, 107, 710,
+	108, 109, 110, 0, 0, 111, 0, 112, 0, 113,
+	114, 0, 0, 115, 116, 117, 118, 119, 0, 0,
+	120, 0, 0, 96, 121, 122, 0, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 260, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 126, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 0, 147, 148, 149, 150, 151, 152, 0,
+	153, 154, 155, 156, 157, 0, 100, 101, 102, 0,
+	99, 89, 406, 127, 128, 129, 130, 131, 132, 0,
+	104, 0, 0, 105, 0, 0, 0, 0, 0, 98,
+	106, 0, 0, 0, 193, 0, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	641, 0, 107, 540, 108, 109, 110, 0, 0, 111,
+	0, 112, 0, 113, 114, 0, 0, 115, 116, 117,
+	118, 119, 0, 0, 120, 0, 0, 0, 121, 122,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 924, 0, 0,
+	0, 0, 0, 125, 639, 640, 642, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 0, 147, 148, 149,
+	150, 151, 152, 0, 153, 154, 155, 156, 157, 0,
+	100, 101, 102, 0, 99, 0, 0, 127, 128, 129,
+	130, 131, 132, 0, 104, 0, 0, 105, 0, 0,
+	0, 0, 0, 98, 106, 0, 0, 0, 85, 0,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 641, 0, 107, 638, 108, 109,
+	110, 0, 0, 111, 0, 112, 0, 113, 114, 0,
+	0, 115, 116, 117, 118, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 124, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 639, 640,
+	642, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	0, 147, 148, 149, 150, 151, 152, 0, 153, 154,
+	155, 156, 157, 0, 100, 101, 102, 0, 99, 0,
+	0, 127, 128, 129, 130, 131, 132, 0, 104, 0,
+	0, 105, 0, 0, 0, 0, 0, 98, 106, 0,
+	0, 0, 85, 0, 0, 0, 861, 94, 91, 97,
+	0, 90, 407, 92, 93, 95, 408, 409, 0, 0,
+	107, 638, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 96, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 0, 100, 101,
+	102, 0, 99, 89, 406, 127, 128, 129, 130, 131,
+	132, 0, 0, 0, 0, 104, 0, 0, 105, 0,
+	0, 0, 0, 0, 98, 106, 193, 0, 0, 0,
+	0, 0, 631, 103, 94, 91, 97, 0, 90, 407,
+	92, 93, 95, 408, 409, 0, 0, 107, 0, 108,
+	109, 110, 0, 0, 111, 0, 112, 0, 113, 114,
+	0, 0, 115, 116, 117, 118, 119, 0, 0, 120,
+	0, 0, 96, 121, 122, 0, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
+	260, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	126, 133, 0, 0, 0, 0, 0, 0, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 0, 147, 148, 149, 150, 151, 152, 0, 153,
+	154, 155, 156, 157, 0, 100, 101, 102, 0, 99,
+	89, 406, 127, 128, 129, 130, 131, 132, 0, 0,
+	0, 0, 104, 0, 0, 105, 0, 0, 0, 0,
+	0, 98, 106, 193, 0, 0, 0, 0, 0, 631,
+	103, 94, 91, 97, 0, 90, 407, 92, 93, 95,
+	408, 409, 0, 0, 107, 0, 108, 109, 110, 0,
+	0, 111, 0, 112, 0, 113, 114, 0, 0, 115,
+	116, 117, 118, 119, 0, 0, 120, 0, 0, 96,
+	121, 122, 0, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 126, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 0, 147,
+	148, 149, 150, 151, 152, 0, 153, 154, 155, 156,
+	157, 0, 100, 101, 102, 0, 99, 89, 406, 127,
+	128, 129, 130, 131, 132, 0, 104, 0, 0, 105,
+	0, 0, 0, 0, 0, 98, 106, 0, 0, 0,
+	193, 0, 0, 782, 103, 94, 91, 97, 0, 90,
+	407, 92, 93, 95, 408, 409, 0, 0, 107, 0,
+	108, 109, 110, 0, 0, 111, 0, 112, 0, 113,
+	114, 0, 0, 115, 116, 117, 118, 119, 0, 0,
+	120, 0, 0, 96, 121, 122, 0, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 260, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 126, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 0, 147, 148, 149, 150, 151, 152, 0,
+	153, 154, 155, 156, 157, 0, 100, 101, 102, 0,
+	99, 89, 406, 127, 128, 129, 130, 131, 132, 0,
+	104, 0, 0, 105, 0, 0, 0, 0, 0, 98,
+	106, 0, 0, 0, 193, 0, 0, 665, 103, 94,
+	91, 97, 0, 90, 407, 92, 93, 95, 408, 409,
+	0, 0, 107, 0, 108, 109, 110, 0, 0, 555,
+	553, 112, 557, 113, 114, 0, 0, 115, 116, 117,
+	118, 119, 0, 0, 120, 0, 0, 96, 121, 122,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 126, 133, 0, 554, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 0, 147, 148, 149,
+	150, 151, 152, 0, 153, 154, 155, 156, 157, 0,
+	100, 101, 102, 0, 99, 89, 406, 127, 128, 129,
+	130, 131, 132, 104, 0, 0, 105, 0, 0, 0,
+	0, 0, 98, 106, 0, 0, 0, 0, 193, 0,
+	0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	0, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 502, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 124, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 0, 100, 101, 102, 0, 99, 0, 0,
+	127, 128, 129, 130, 131, 132, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 104, 0, 0,
+	105, 193, 0, 0, 0, 0, 98, 106, 0, 0,
+	0, 0, 0, 251, 252, 103, 94, 91, 97, 0,
+	90, 407, 92, 93, 95, 408, 409, 0, 0, 107,
+	0, 108, 109, 110, 0, 0, 111, 0, 112, 0,
+	113, 114, 0, 0, 115, 116, 117, 118, 119, 0,
+	0, 120, 0, 0, 96, 121, 122, 0, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 124, 260, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 126, 133, 0, 0, 0, 0, 0, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 0, 147, 148, 149, 150, 151, 152,
+	0, 153, 154, 155, 156, 157, 1067, 100, 1065, 1066,
+	0, 99, 89, 406, 127, 128, 129, 130, 131, 132,
+	104, 0, 0, 105, 0, 0, 0, 0, 0, 98,
+	106, 0, 0, 0, 0, 193, 0, 0, 103, 94,
+	91, 97, 0, 90, 407, 92, 93, 95, 408, 409,
+	0, 0, 107, 0, 108, 109, 110, 0, 0, 111,
+	0, 112, 0, 113, 114, 0, 0, 115, 116, 117,
+	118, 119, 0, 0, 120, 0, 0, 96, 121, 122,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 124, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 126, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 0, 147, 148, 149,
+	150, 151, 152, 0, 153, 154, 155, 156, 157, 0,
+	100, 101, 102, 0, 99, 89, 406, 127, 128, 129,
+	130, 131, 132, 0, 0, 0, 104, 0, 0, 105,
+	0, 0, 0, 0, 0, 98, 106, 0, 193, 303,
+	304, 452, 451, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	108, 109, 110, 0, 0, 111, 0, 112, 0, 113,
+	114, 0, 749, 115, 116, 117, 118, 119, 0, 0,
+	120, 0, 0, 0, 121, 122, 0, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 748, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	124, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 126, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 0, 147, 148, 149, 150, 151, 152, 0,
+	153, 154, 155, 156, 157, 0, 100, 101, 102, 0,
+	99, 0, 0, 127, 128, 129, 130, 131, 132, 104,
+	0, 0, 105, 0, 0, 0, 0, 0, 98, 106,
+	0, 0, 0, 0, 193, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 108, 109, 110, 0, 0, 111, 0,
+	112, 0, 113, 114, 0, 0, 115, 116, 117, 118,
+	119, 0, 0, 120, 0, 0, 0, 121, 122, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 502, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 124, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 126, 133, 0, 0, 0, 0,
+	0, 0, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 0, 147, 148, 149, 150,
+	151, 152, 0, 153, 154, 155, 156, 157, 0, 100,
+	101, 102, 0, 99, 0, 0, 127, 128, 129, 130,
+	131, 132, 104, 0, 0, 490, 0, 0, 0, 0,
+	0, 98, 106, 0, 0, 0, 0, 193, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 486, 0, 107, 0, 108, 109, 110, 0,
+	0, 111, 0, 112, 0, 113, 114, 0, 0, 115,
+	116, 117, 118, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 0, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 126, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 0, 147,
+	148, 149, 150, 151, 152, 0, 153, 154, 155, 156,
+	157, 0, 100, 101, 102, 0, 99, 0, 0, 127,
+	128, 129, 130, 131, 132, 104, 0, 0, 483, 0,
+	0, 0, 0, 0, 98, 106, 0, 0, 0, 0,
+	193, 0, 0, 103, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 486, 0, 107, 0, 108,
+	109, 110, 0, 0, 111, 0, 112, 0, 113, 114,
+	0, 0, 115, 116, 117, 118, 119, 0, 0, 120,
+	0, 0, 0, 121, 122, 0, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 124,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	126, 133, 0, 0, 0, 0, 0, 0, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 0, 147, 148, 149, 150, 151, 152, 0, 153,
+	154, 155, 156, 157, 0, 100, 101, 102, 0, 99,
+	0, 0, 127, 128, 129, 130, 131, 132, 104, 0,
+	0, 105, 0, 0, 0, 0, 0, 98, 106, 0,
+	0, 0, 0, 193, 0, 0, 103, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 322, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 124, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 0, 100, 101,
+	102, 0, 99, 0, 0, 127, 128, 129, 130, 131,
+	132, 104, 0, 0, 105, 0, 0, 0, 0, 0,
+	98, 106, 0, 0, 0, 0, 193, 0, 0, 103,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 107, 0, 108, 109, 110, 0, 0,
+	111, 0, 112, 0, 113, 114, 0, 0, 115, 116,
+	117, 118, 119, 0, 0, 120, 0, 0, 0, 121,
+	122, 0, 123, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 124, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 125, 0, 126, 133, 0, 0,
+	0, 0, 0, 0, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 143, 144, 145, 146, 0, 147, 148,
+	149, 150, 151, 152, 0, 153, 154, 155, 156, 157,
+	0, 100, 101, 102, 0, 99, 0, 0, 127, 128,
+	129, 130, 131, 132, 104, 0, 0, 105, 0, 0,
+	0, 0, 0, 98, 106, 0, 0, 0, 0, 193,
+	0, 0, 103, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 107, 0, 108, 109,
+	110, 0, 0, 111, 0, 112, 0, 113, 114, 0,
+	0, 115, 116, 117, 118, 119, 0, 0, 120, 0,
+	0, 0, 121, 122, 0, 123, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 468, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 125, 0, 126,
+	133, 0, 0, 0, 0, 0, 0, 134, 135, 136,
+	137, 138, 139, 140, 141, 142, 143, 144, 145, 146,
+	0, 147, 148, 149, 150, 151, 152, 0, 153, 154,
+	155, 156, 157, 0, 100, 101, 102, 0, 99, 0,
+	0, 127, 128, 129, 130, 131, 132, 104, 0, 0,
+	105, 0, 0, 0, 0, 0, 98, 106, 0, 0,
+	0, 0, 193, 0, 0, 103, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 107,
+	0, 108, 109, 110, 0, 0, 111, 0, 112, 0,
+	113, 114, 0, 0, 115, 116, 117, 118, 119, 0,
+	0, 120, 0, 0, 0, 121, 122, 0, 123, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 438, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	125, 0, 126, 133, 0, 0, 0, 0, 0, 0,
+	134, 135, 136, 137, 138, 139, 140, 141, 142, 143,
+	144, 145, 146, 0, 147, 148, 149, 150, 151, 152,
+	0, 153, 154, 155, 156, 157, 0, 100, 101, 102,
+	0, 99, 0, 0, 127, 128, 129, 130, 131, 132,
+	104, 0, 0, 105, 0, 0, 0, 0, 0, 98,
+	106, 0, 0, 0, 0, 193, 0, 0, 103, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 107, 0, 108, 109, 110, 0, 0, 111,
+	0, 112, 0, 113, 114, 0, 0, 115, 116, 117,
+	118, 119, 0, 0, 120, 0, 0, 0, 121, 122,
+	0, 123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 125, 0, 126, 133, 0, 0, 0,
+	0, 0, 0, 134, 135, 136, 137, 138, 139, 140,
+	141, 142, 143, 144, 145, 146, 0, 147, 148, 149,
+	150, 151, 152, 0, 153, 154, 155, 156, 157, 0,
+	100, 101, 102, 0, 99, 0, 0, 127, 128, 129,
+	130, 131, 132, 104, 0, 0, 105, 0, 0, 0,
+	0, 0, 98, 106, 0, 0, 0, 0, 193, 0,
+	0, 103, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 107, 0, 108, 109, 110,
+	0, 0, 111, 0, 112, 0, 113, 114, 0, 0,
+	115, 116, 117, 118, 119, 0, 0, 120, 0, 0,
+	0, 121, 122, 0, 123, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 313, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 125, 0, 126, 133,
+	0, 0, 0, 0, 0, 0, 134, 135, 136, 137,
+	138, 139, 140, 141, 142, 143, 144, 145, 146, 0,
+	147, 148, 149, 150, 151, 152, 0, 153, 154, 155,
+	156, 157, 0, 100, 101, 102, 0, 99, 0, 0,
+	127, 128, 129, 130, 131, 132, 104, 0, 0, 105,
+	0, 0, 0, 0, 0, 98, 106, 0, 0, 0,
+	0, 193, 0, 0, 103, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 107, 0,
+	108, 109, 110, 0, 0, 111, 0, 112, 0, 113,
+	114, 0, 0, 115, 116, 117, 118, 119, 0, 0,
+	120, 0, 0, 0, 121, 122, 0, 123, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 125,
+	0, 126, 133, 0, 0, 0, 0, 0, 0, 134,
+	135, 136, 137, 138, 139, 140, 141, 142, 143, 144,
+	145, 146, 0, 147, 148, 149, 150, 151, 152, 0,
+	153, 154, 155, 156, 157, 0, 100, 101, 102, 0,
+	99, 0, 0, 127, 128, 129, 130, 131, 132, 104,
+	0, 0, 105, 0, 0, 0, 0, 0, 98, 106,
+	0, 0, 0, 0, 193, 0, 0, 103, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 107, 0, 108, 109, 110, 0, 0, 111, 0,
+	112, 0, 113, 114, 0, 0, 115, 116, 117, 118,
+	119, 0, 0, 120, 0, 0, 0, 121, 122, 0,
+	123, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 125, 0, 126, 133, 0, 0, 0, 0,
+	0, 0, 134, 135, 136, 137, 138, 139, 140, 141,
+	142, 143, 144, 145, 146, 0, 147, 148, 149, 150,
+	151, 152, 0, 153, 154, 155, 156, 157, 0, 100,
+	101, 102, 0, 99, 0, 0, 127, 128, 129, 130,
+	131, 132, 104, 0, 0, 105, 0, 0, 0, 0,
+	0, 98, 106, 0, 0, 0, 0, 193, 0, 0,
+	103, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 107, 0, 108, 109, 110, 0,
+	0, 111, 0, 112, 0, 113, 114, 0, 0, 115,
+	116, 117, 118, 119, 0, 0, 120, 0, 0, 0,
+	121, 122, 0, 123, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 126, 133, 0,
+	0, 0, 0, 0, 0, 134, 135, 136, 137, 138,
+	139, 140, 141, 142, 143, 144, 145, 146, 0, 147,
+	148, 149, 150, 151, 152, 0, 153, 154, 155, 156,
+	157, 0, 100, 101, 102, 0, 99, 0, 0, 127,
+	128, 129, 130, 131, 132, 104, 0, 0, 105, 0,
+	0, 0, 0, 0, 98, 106, 0, 0, 0, 0,
+	193, 0, 0, 103, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 107, 0, 108,
+	109, 110, 0, 0, 111, 0, 112, 0, 113, 114,
+	0, 0, 115, 116, 117, 118, 119, 0, 0, 120,
+	0, 0, 0, 121, 122, 0, 123, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 284,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 125, 0,
+	126, 133, 0, 0, 0, 0, 0, 0, 134, 135,
+	136, 137, 138, 139, 140, 141, 142, 143, 144, 145,
+	146, 0, 147, 148, 149, 150, 151, 152, 0, 153,
+	154, 155, 156, 157, 0, 100, 101, 102, 0, 99,
+	0, 0, 127, 128, 129, 130, 131, 132, 104, 0,
+	0, 105, 0, 0, 0, 0, 0, 98, 106, 0,
+	0, 0, 0, 193, 0, 0, 103, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	107, 0, 108, 109, 110, 0, 0, 111, 0, 112,
+	0, 113, 114, 0, 0, 115, 116, 117, 118, 119,
+	0, 0, 120, 0, 0, 0, 121, 122, 0, 123,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 281, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 125, 0, 126, 133, 0, 0, 0, 0, 0,
+	0, 134, 135, 136, 137, 138, 139, 140, 141, 142,
+	143, 144, 145, 146, 0, 147, 148, 149, 150, 151,
+	152, 0, 153, 154, 155, 156, 157, 0, 100, 101,
+	102, 0, 99, 0, 0, 127, 128, 129, 130, 131,
+	132, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193,
 }
 
 var yyPact = [...]int16{
-	2111, -1000, -1000, 12, -1000, -1000, -1000, 467, -1000, 516,
-	157, 463, 353, 231, 315, 546, 568, 566, 566, 457,
-	456, 424, 3023, 369, 142, 289, 316, 427, -1000, 2111,
-	-1000, 194, -1000, 156, 154, 3221, 153, 150, 295, 542,
-	145, -1000, 144, 587, 3122, 148, 146, 2924, 143, 3023,
-	3023, 139, 534, 471, 68, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 533, 3023, 3023, 3023, 451, -1000, -1000, -1000,
+	1231, -1000, -1000, 137, -1000, -1000, -1000, 897, -1000, 949,
+	356, 893, 354, 1667, 369, 451, 420, 789, 1000, 6816,
+	508, 1030, 948, 948, 888, 878, 823, 6816, 864, 348,
+	712, 331, 632, 630, 826, -1000, 1231, -1000, 379, -1000,
+	346, 394, 345, 821, 819, 384, 386, -1000, 2788, -1000,
+	234, -1000, 150, 133, -1000, -1000, 2788, 3158, -1000, 2603,
+	554, -1000, -1000, 132, 131, 191, 413, -1000, -1000, -1000,
+	-1000, -1000, 129, 237, 233, 232, -1000, -1000, -1000, 126,
+	-1000, -1000, -1000, -116, 204, 125, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 351, 316, 316, 121, 136, -1000, 379, -1000,
-	-1000, 138, -1000, 473, -1000, 1408, -1000, -1000, 137, -1000,
-	-1000, 329, -1000, 328, 10, -1000, 326, 375, -1000, 531,
-	324, 295, 597, -1000, -1000, 583, 1126, 1126, 197, 306,
-	304, -1000, -1000, 303, 3023, 74, -1000, -1000, 545, 591,
-	616, -1000, 566, 608, 9, 9, 406, 114, 316, -1000,
-	-1000, -1000, 357, 136, 121, 7, -1000, 135, 419, -1000,
-	51, 2825, 124, 175, -1000, 1549, -1000, 87, -1000, 18,
-	6, -1000, -1000, 1549, 1690, -1000, 1267, 225, -1000, -1000,
-	5, 70, 4, -1000, -1000, -1000, -1000, -1000, 3, 94,
-	-1000, -1000, -1000, -1000, 73, 2, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 301, 299, 2599,
-	298, 316, 3023, 296, 530, 585, -1000, 1126, 1126, -1000,
-	1549, -1000, -1000, -1000, 3023, 134, 133, 1, 2726, 500,
-	497, 494, 499, 574, 3023, -1000, 3023, 330, 2726, 330,
-	621, 1549, 107, -1000, 162, -1000, 351, -1000, 354, 316,
-	-1000, 2342, 1549, -1000, -1000, 2472, 1549, 1549, -1000, 1690,
-	297, 1690, 294, 1690, 309, 1690, 1690, 1690, 1690, 1690,
-	1690, 316, 342, -1000, -1000, -1000, -17, -19, 564, 245,
-	49, 93, 1961, 1549, -1000, 2726, 1549, 129, 3023, -54,
-	-1000, -1000, -1000, 487, 485, 564, 1549, 126, -1000, -1000,
-	125, -1000, 0, -1000, 3023, 91, -1000, -1000, -1000, 193,
-	-1000, -1000, 2726, -1000, 2726, 3023, 2726, 2726, 123, 2726,
-	86, 515, 514, 529, -10, -1000, -65, -1000, 438, 288,
-	538, -1000, 621, 114, 1549, 316, 351, -23, 621, 587,
-	484, -1, -2, -3, -4, 2825, 2825, -1000, -1000, -1000,
-	175, -1000, 27, -1000, 241, 48, 1690, -5, 27, 1690,
-	27, 18, 18, -1000, -1000, -1000, -24, 341, 1549, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	418, -1000, -1000, -1000, -1000, -1000, -1000, 85, -1000, -26,
-	-71, 1831, 372, -1000, -27, 40, -1000, -1000, -7, -1000,
-	2599, 77, -8, -113, -1000, 479, 363, 2726, -9, 606,
-	-1000, -72, -1000, -1000, 488, -1000, -1000, 218, 606, 605,
-	604, 438, 447, 65, -1000, 1549, 2726, -1000, -1000, 985,
-	373, 844, 321, 528, 288, -1000, -1000, -1000, 316, -1000,
-	81, 2825, -10, -33, 561, 557, -34, -37, 122, -39,
-	-1000, -1000, -1000, 1690, 27, 700, 27, -1000, 337, 1549,
-	1549, 345, 1549, -1000, 282, 268, 84, -41, 2726, 564,
-	267, 1549, 2599, -1000, -1000, -1000, 2726, 2726, 258, 100,
-	89, 1549, 316, -79, 2726, -1000, -1000, -1000, -1000, -1000,
-	2726, 323, 322, 564, -1000, 119, 118, -1000, 441, -10,
-	-46, -1000, -1000, 51, 438, 1549, -1000, -1000, 1549, 77,
-	373, -1000, 406, -1000, 81, 414, 177, 2215, -1000, -1000,
-	-82, 2825, 116, 115, 2825, 2825, -48, 2825, 27, -49,
-	-92, 289, -1000, 343, -1000, 1549, -59, -11, -12, -60,
-	-1000, -61, -62, -13, -1000, -93, -94, -95, 223, -1000,
-	237, -118, -64, -1000, -1000, 405, -96, -1000, 233, 230,
-	-1000, -1000, -1000, 446, -1000, -1000, -1000, -1000, 248, -1000,
-	438, 395, -1000, 2088, 413, 2342, -1000, -1000, -1000, -75,
-	-77, -1000, -1000, -1000, -1000, -1000, -1000, 1549, -1000, -1000,
-	257, 257, -1000, -78, -1000, 257, -1000, -1000, 201, 232,
-	1549, -1000, -1000, -1000, 1549, 397, -1000, -1000, -1000, 450,
-	246, -1000, 408, 393, 349, 2342, 2342, -1000, 2825, 587,
-	-1000, 383, 390, 383, -1000, 383, 3023, 491, -1000, -1000,
-	-1000, 1549, 114, -1000, 383, 1549, 2726, 526, -14, 621,
-	-1000, -1000, 2825, -85, 388, 1549, -107, -108, -15, -1000,
-	481, -1000, 44, 288, -1000, 42, -1000, -1000, 1549, 2726,
-	526, -1000, -1000, 1549, 40, -1000, -1000, 2726, -1000, 373,
-	2726, -1000, -97, -1000, 32, 368, -100, -1000, -1000, -1000,
-	1549, 333, -1000, -1000, -1000, 368, -1000, 319, 333, -1000,
-	-1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	374, 8283, 8120, -1000, 458, 7957, 6816, 539, 344, 343,
+	666, 997, 334, 328, 327, 325, -1000, 324, 1057, 7794,
+	7631, 370, 367, 7468, 7305, 537, 365, 339, 6653, 321,
+	6816, -1000, 201, -1000, 534, 6816, 320, 988, 902, 187,
+	119, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 987, 6816,
+	6816, 6816, 879, -1000, 6816, 118, 734, 670, 670, 284,
+	312, -1000, 758, -1000, -1000, 319, -1000, 912, -1000, 670,
+	2418, -1000, -1000, 317, -1000, -1000, 911, -1000, 316, 315,
+	2788, 2788, -1000, 2973, 589, 3158, 645, 3158, 668, 3158,
+	3158, 3158, 3158, 3158, 3158, 3158, 670, 731, -1000, -1000,
+	-1000, 45, 35, 1200, 421, 5835, 186, 231, 227, -1000,
+	117, 2788, -1000, -1000, -1000, 3343, 2788, 5835, 2788, 691,
+	-1000, 690, 140, -1000, 688, -1000, 687, 757, -1000, 7142,
+	986, 1049, 985, 686, 666, 1070, 116, 115, -1000, -1000,
+	-1000, 1042, -1000, 2233, 2233, 517, 663, -1000, 661, 108,
+	660, 108, 659, 658, -1000, -1000, 655, 6979, 979, 654,
+	977, 647, 6816, 195, -1000, -1000, 6816, 6816, 422, 1067,
+	6490, -1000, 948, 5835, 6327, 57, 57, 797, 275, 6164,
+	2788, 670, -1000, -1000, -1000, 737, 312, 284, 113, -1000,
+	310, -1000, 817, -1000, 179, 6164, -1000, 670, -1000, -1000,
+	386, -1000, 155, 112, 110, -1000, 574, 165, 3158, 109,
+	155, 3158, 155, 155, 150, 150, -1000, -1000, -1000, 14,
+	719, 2788, -1000, -1000, -1000, -121, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 809, -1000, -1000,
+	-1000, -1000, -1000, -1000, -29, -1000, -1000, -1000, -1000, -1000,
+	225, 222, -1000, -1000, 800, 756, 13, -31, 4181, 411,
+	12, -1000, -1000, -1000, -1000, -125, 177, -1000, 194, 11,
+	643, 641, 5335, 1020, 631, 629, 670, 755, 683, 6816,
+	872, -1000, -1000, 6816, 628, 967, 1055, 416, 416, -1000,
+	-1000, 2233, 2233, -1000, 2788, -1000, -1000, -1000, 6816, 6816,
+	-1000, 416, 309, -1000, 308, 6816, 6816, -1000, 622, 6816,
+	307, 6816, 306, 105, 5835, -1000, 504, 939, 938, 935,
+	932, 611, 1046, 6816, 946, -1000, 6816, -1000, -32, -1000,
+	6816, 836, 644, 5835, 644, 1077, 2788, 278, -1000, 380,
+	600, -1000, 4840, 2, -1000, 734, -1000, 736, 670, -1000,
+	4509, 2788, -1000, -1000, 2788, 2788, -1000, 3158, 155, 1482,
+	155, -1000, 715, 2788, 2788, 726, -118, -108, 250, 2788,
+	5835, -1000, -1000, -1000, 2788, 1200, 617, 616, 5171, -36,
+	5835, 790, 410, -1000, 2788, 5835, 615, 302, 6816, -39,
+	-1000, -1000, -1000, 927, 926, 301, 1200, 2788, 6816, 6816,
+	6816, -1000, 670, 621, 910, 966, -1000, -1000, -1000, 99,
+	-1000, 6816, 218, 1, 182, -1000, 1200, -1000, 0, -1000,
+	-1000, -1000, 515, -1000, -2, 108, 108, -1000, -1000, 6816,
+	-1000, 965, -1000, 964, 4017, 470, -1000, 189, -1000, -1000,
+	5835, 6816, 5835, 5835, 300, 5835, 6816, 212, 944, 1086,
+	-1000, -1000, 5835, 836, 1083, -1000, -1000, 961, 75, -1000,
+	-42, 830, 635, 994, -1000, 1077, 275, 2788, 4509, -1000,
+	-1000, -1000, -1000, 670, 734, -3, 1077, 6001, 707, 107,
+	106, 104, 101, 6164, 6164, -4, -6, 155, -7, -43,
+	632, -1000, 725, -1000, 2788, -127, -1000, -128, -44, -8,
+	-1000, -9, -11, 10, 10, -12, -13, -1000, 5007, -16,
+	96, 787, -1000, -1000, 10, -1000, 84, 605, 5335, 3853,
+	89, 602, 525, -1000, 908, -1000, 754, -1000, 6816, 597,
+	747, 6816, 4017, 468, 88, 1075, 452, 416, -1000, 753,
+	-1000, -1000, -1000, -1000, -1000, 6816, 6816, -46, -1000, -1000,
+	2788, 87, 5835, -1000, -1000, 943, -1000, -1000, 415, -1000,
+	1075, 1082, 298, -1000, 1081, 296, 830, 857, 181, -1000,
+	2788, -1000, -1000, 2048, 772, 1863, 696, 959, 635, -1000,
+	-1000, 957, -1000, 670, -1000, 245, -1000, 6164, 4673, 294,
+	1042, -1000, 75, -17, 1019, 1018, -18, -19, 293, -20,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2788, -1000, -1000, -1000,
+	-1000, 242, -1000, -1000, -1000, -1000, -1000, -1000, 606, -1000,
+	-1000, -1000, -21, -22, -23, 768, 80, -1000, 5335, 1017,
+	-1000, 783, -1000, -1000, -1000, 5835, 5835, 924, 2788, 549,
+	481, -1000, 572, 885, 670, 752, 78, -1000, -1000, -1000,
+	-1000, 905, -62, 77, 4017, -1000, -1000, -1000, -1000, 1200,
+	-1000, 211, -1000, -1000, -1000, 4017, -24, 5835, -1000, 5835,
+	680, 678, 1200, -1000, 292, -1000, 291, -1000, -1000, 837,
+	75, -26, -1000, 179, 830, 2788, -1000, -1000, 2788, 3853,
+	772, 2788, -1000, 797, -1000, 245, 804, 389, 4345, -1000,
+	-1000, 1009, 408, -1000, -66, 6164, 6816, 6816, 6164, 6164,
+	-27, 6164, -1000, -28, 762, 782, -1000, -1000, -1000, 781,
+	767, -80, 6816, 428, -84, -86, 74, -1000, 584, 2788,
+	751, -1000, 808, -1000, 670, 2788, 501, 796, 5835, -87,
+	750, 454, -1000, -1000, -30, -1000, 564, 562, 596, -1000,
+	-1000, 860, -1000, -1000, -1000, -1000, 592, -1000, 830, 728,
+	785, -1000, 90, 803, 4509, -1000, 3158, 3158, -1000, -1000,
+	-33, -34, -1000, -1000, -1000, -1000, -1000, 486, 780, 2788,
+	5835, 778, 605, 904, 72, -1000, 523, 5835, 929, -1000,
+	-1000, 69, 68, -1000, -35, 499, 2788, -37, 793, 210,
+	-1000, 288, -1000, -1000, -1000, -1000, 2788, -1000, 877, 563,
+	-1000, 728, -1000, 516, 791, 776, 692, 4509, 4509, -1000,
+	5498, 161, 6164, 1057, -56, 247, -1000, -1000, 2788, 177,
+	749, 5835, -1000, 884, 5835, 6816, -88, 522, 923, 2788,
+	2788, -1000, 551, -1000, -1000, 2788, 454, -1000, -1000, 275,
+	-1000, -1000, 381, 464, 444, 2788, 5672, 955, 67, 1077,
+	-1000, -1000, 3158, -1000, 6164, -1000, -1000, 272, 439, 496,
+	430, -1000, -1000, 176, 749, -63, -1000, -1000, 749, 807,
+	-68, 59, 518, -1000, 6816, -1000, -70, -81, -1000, -1000,
+	560, -1000, 5835, -1000, -1000, 170, 721, 2788, 381, 762,
+	287, -1000, 174, -1000, -1000, 65, 64, 419, -1000, 2788,
+	5835, 955, 5498, -1000, 289, -1000, -1000, -1000, -1000, -1000,
+	2788, 699, -1000, -82, 63, -1000, -1000, 5835, 6816, 59,
+	489, 942, 136, -1000, 164, 842, -1000, 714, 635, 172,
+	745, 5672, 5835, 5835, 60, -1000, -92, -1000, -1000, 272,
+	749, -1000, 676, -1000, 2788, 954, -94, 59, -1000, 581,
+	-1000, -1000, 58, 711, 560, 5835, 869, -1000, 845, 863,
+	772, 282, 56, -1000, -102, -104, 3689, -1000, -1000, 699,
+	-1000, -1000, -85, 874, -1000, -1000, 954, -1000, 2788, -1000,
+	-1000, 364, 275, -1000, 57, 844, -1000, 744, -1000, -1000,
+	-1000, -107, -1000, -1000, 3524, -1000, 941, 368, 368, 954,
+	-100, 2788, 170, 883, -1000, 56, -1000, 3689, -1000, -109,
+	49, -1000, -1000, 561, 268, -1000, -1000, -1000, 48, -1000,
+	-1000, -1000, 2788, -1000, -1000, 2788, -114, -112, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 716, 579, 712, 711, 709, 453, 45, 35, 11,
-	175, 18, 707, 28, 19, 8, 29, 706, 39, 704,
-	702, 7, 698, 31, 59, 41, 509, 14, 697, 696,
-	38, 695, 17, 694, 21, 693, 30, 25, 0, 692,
-	26, 690, 687, 683, 682, 36, 679, 678, 32, 37,
-	5, 40, 34, 673, 671, 6, 4, 668, 667, 23,
-	16, 663, 9, 659, 13, 3, 1, 48, 384, 15,
-	652, 10, 312, 641, 640, 639, 637, 33, 12, 636,
-	27, 634, 190, 632, 631, 22, 630, 43, 24, 20,
-	2, 629,
+	0, 1206, 1036, 1204, 1202, 1027, 45, 64, 8, 1201,
+	1200, 62, 48, 9, 33, 5, 41, 32, 1, 50,
+	39, 1199, 35, 1198, 1195, 2, 1194, 55, 43, 61,
+	84, 25, 1192, 1191, 58, 42, 53, 67, 1190, 30,
+	1189, 24, 1188, 54, 40, 12, 1187, 26, 1186, 547,
+	47, 1185, 74, 51, 7, 1184, 1182, 1179, 1178, 27,
+	1176, 4, 20, 0, 1175, 34, 1171, 1169, 1168, 1166,
+	75, 1165, 1162, 37, 71, 13, 73, 68, 1160, 1155,
+	1154, 16, 1153, 3, 1152, 1151, 1150, 18, 11, 1148,
+	38, 23, 1145, 1144, 44, 29, 1143, 49, 1141, 19,
+	17, 6, 63, 72, 1134, 1132, 31, 70, 1130, 1129,
+	15, 1126, 1124, 1121, 56, 10, 1120, 52, 1116, 1113,
+	69, 1111, 1107, 1102, 36, 1100, 46, 178, 887, 297,
+	28, 66, 1099, 1098, 1097, 21, 1096, 65, 59, 22,
 }
 
-var yyR1 = [...]int8{
-	0, 1, 1, 2, 2, 91, 91, 3, 3, 3,
+var yyR1 = [...]uint8{
+	0, 1, 1, 2, 2, 135, 135, 3, 3, 3,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 4, 82, 82, 82, 81,
-	81, 81, 81, 81, 81, 81, 80, 80, 80, 80,
-	72, 72, 5, 5, 5, 5, 25, 25, 79, 79,
-	79, 60, 60, 60, 78, 78, 77, 13, 13, 14,
-	12, 12, 16, 16, 15, 15, 17, 17, 17, 17,
-	17, 17, 17, 17, 17, 17, 17, 17, 17, 85,
-	85, 85, 85, 85, 85, 85, 85, 18, 18, 37,
-	37, 36, 36, 36, 36, 8, 76, 76, 61, 61,
-	61, 70, 70, 73, 73, 74, 74, 74, 6, 6,
+	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 131, 131, 138, 138, 133, 133, 134,
+	134, 134, 9, 9, 10, 10, 8, 8, 132, 132,
+	132, 132, 132, 120, 120, 120, 119, 119, 118, 118,
+	118, 118, 118, 118, 118, 117, 117, 117, 117, 107,
+	107, 108, 108, 5, 5, 5, 5, 5, 5, 48,
+	48, 47, 47, 47, 47, 47, 49, 49, 136, 52,
+	52, 51, 51, 50, 50, 137, 137, 139, 139, 91,
+	91, 29, 29, 116, 116, 116, 95, 95, 95, 115,
+	115, 114, 16, 16, 17, 15, 15, 19, 19, 18,
+	18, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 123,
+	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
+	22, 22, 53, 53, 54, 57, 57, 57, 58, 58,
+	59, 59, 59, 59, 59, 60, 60, 55, 55, 56,
+	56, 44, 44, 43, 43, 43, 43, 43, 62, 62,
+	45, 45, 45, 61, 61, 61, 61, 11, 11, 113,
+	113, 113, 46, 46, 112, 112, 124, 124, 124, 124,
+	96, 96, 96, 105, 105, 109, 109, 110, 110, 110,
 	6, 6, 6, 6, 6, 6, 6, 6, 6, 6,
-	6, 6, 6, 7, 7, 23, 23, 22, 22, 58,
-	58, 59, 59, 19, 19, 19, 19, 19, 19, 19,
-	20, 20, 21, 21, 89, 90, 90, 9, 9, 11,
-	11, 10, 10, 87, 87, 87, 87, 87, 87, 87,
-	87, 87, 87, 87, 88, 88, 86, 86, 86, 86,
-	86, 86, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 86, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 86, 86, 86, 86, 86, 86, 86, 24, 24,
-	24, 24, 24, 24, 24, 24, 24, 24, 26, 27,
-	28, 28, 28, 29, 29, 29, 30, 30, 31, 31,
-	32, 32, 33, 33, 33, 33, 33, 33, 34, 34,
-	50, 50, 40, 40, 54, 54, 41, 41, 55, 55,
-	55, 55, 55, 56, 56, 64, 64, 71, 71, 63,
-	63, 65, 65, 65, 66, 66, 66, 69, 69, 68,
-	68, 67, 62, 62, 62, 62, 62, 35, 35, 39,
-	39, 57, 83, 83, 43, 43, 38, 44, 44, 45,
-	45, 49, 49, 46, 46, 46, 46, 46, 46, 46,
-	46, 46, 47, 47, 47, 47, 47, 48, 48, 48,
-	51, 51, 51, 51, 52, 52, 53, 53, 53, 42,
-	42, 42, 42, 75, 75, 84, 84, 84, 84, 84,
-	84,
+	6, 6, 6, 6, 6, 6, 7, 7, 27, 27,
+	26, 26, 93, 93, 94, 94, 23, 23, 23, 23,
+	23, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 85, 85, 85, 85, 86, 86, 24, 24, 25,
+	25, 25, 128, 128, 129, 129, 12, 12, 20, 20,
+	90, 90, 14, 14, 13, 13, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 126, 126, 126, 126,
+	127, 127, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 125, 125, 125, 125, 125, 125, 125, 125,
+	125, 125, 28, 28, 28, 28, 28, 28, 28, 28,
+	28, 28, 28, 28, 28, 30, 31, 32, 32, 33,
+	33, 34, 34, 35, 35, 36, 36, 37, 37, 38,
+	38, 39, 39, 40, 40, 40, 40, 40, 40, 41,
+	41, 75, 75, 65, 65, 79, 79, 80, 80, 81,
+	81, 81, 81, 82, 82, 83, 83, 83, 66, 66,
+	130, 130, 92, 92, 87, 87, 87, 87, 87, 88,
+	88, 99, 99, 106, 106, 98, 98, 100, 100, 100,
+	101, 101, 101, 104, 104, 103, 103, 102, 97, 97,
+	97, 97, 97, 42, 42, 64, 64, 89, 121, 121,
+	68, 68, 63, 69, 69, 70, 70, 74, 74, 71,
+	71, 71, 71, 71, 71, 71, 71, 71, 71, 71,
+	71, 72, 72, 72, 72, 72, 73, 73, 73, 76,
+	76, 76, 76, 77, 77, 78, 78, 78, 67, 67,
+	67, 67, 67, 111, 111, 122, 122, 122, 122, 122,
+	122,
 }
 
 var yyR2 = [...]int8{
 	0, 1, 0, 2, 3, 0, 1, 1, 1, 1,
-	2, 1, 1, 1, 2, 3, 4, 3, 6, 3,
-	2, 3, 3, 9, 6, 3, 5, 4, 6, 3,
-	8, 5, 5, 3, 3, 3, 5, 8, 10, 9,
-	11, 7, 5, 6, 6, 8, 6, 6, 9, 9,
-	8, 7, 7, 3, 8, 8, 2, 1, 3, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	0, 3, 7, 6, 8, 9, 2, 1, 0, 4,
-	6, 0, 2, 2, 1, 3, 3, 1, 3, 3,
-	1, 3, 0, 1, 1, 3, 1, 1, 1, 1,
-	1, 6, 2, 1, 9, 9, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 4, 9, 1,
-	3, 1, 1, 3, 10, 7, 0, 2, 0, 3,
-	3, 0, 2, 0, 1, 0, 1, 2, 1, 3,
-	6, 4, 7, 4, 3, 3, 2, 2, 3, 2,
-	2, 4, 2, 13, 3, 0, 1, 0, 1, 1,
-	1, 2, 4, 1, 2, 4, 4, 5, 7, 6,
-	2, 3, 1, 3, 1, 1, 1, 1, 3, 1,
-	3, 0, 3, 1, 1, 1, 1, 1, 1, 1,
+	2, 1, 1, 1, 2, 3, 4, 3, 5, 3,
+	4, 4, 2, 2, 6, 3, 2, 3, 3, 10,
+	7, 19, 16, 3, 5, 4, 6, 3, 3, 6,
+	3, 5, 2, 3, 4, 6, 4, 6, 8, 5,
+	5, 3, 3, 3, 5, 6, 9, 4, 6, 1,
+	2, 5, 10, 5, 7, 11, 5, 7, 8, 10,
+	10, 9, 11, 7, 9, 5, 7, 6, 6, 8,
+	6, 6, 9, 9, 9, 6, 7, 7, 3, 8,
+	8, 7, 7, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 1, 3, 1, 6, 0, 2,
+	2, 2, 2, 2, 1, 3, 1, 4, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	3, 0, 1, 7, 6, 8, 9, 9, 5, 1,
+	2, 7, 5, 6, 11, 7, 10, 8, 2, 0,
+	1, 1, 3, 2, 1, 0, 3, 0, 2, 0,
+	2, 2, 1, 0, 4, 6, 0, 2, 2, 1,
+	3, 3, 1, 3, 3, 1, 3, 0, 1, 1,
+	3, 1, 1, 1, 1, 1, 6, 2, 2, 2,
+	1, 1, 1, 6, 6, 1, 1, 1, 4, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	4, 6, 1, 1, 5, 0, 2, 5, 1, 1,
+	2, 2, 2, 2, 2, 1, 1, 0, 2, 3,
+	5, 1, 3, 1, 1, 3, 9, 11, 0, 3,
+	0, 4, 4, 1, 2, 1, 2, 7, 10, 0,
+	1, 1, 0, 4, 0, 2, 1, 2, 3, 4,
+	3, 3, 5, 0, 2, 0, 1, 0, 1, 2,
+	1, 3, 6, 4, 7, 4, 3, 3, 2, 2,
+	3, 2, 2, 4, 2, 3, 14, 3, 0, 1,
+	0, 1, 1, 1, 2, 4, 1, 2, 3, 4,
+	2, 4, 4, 5, 7, 6, 7, 6, 7, 11,
+	12, 1, 1, 1, 1, 0, 5, 2, 3, 1,
+	3, 5, 1, 3, 1, 1, 1, 3, 1, 3,
+	1, 3, 1, 3, 0, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 4,
-	4, 4, 4, 4, 4, 2, 6, 7, 1, 2,
-	0, 2, 2, 0, 2, 2, 2, 1, 0, 1,
-	1, 2, 5, 7, 4, 3, 2, 6, 0, 1,
-	0, 2, 0, 2, 0, 3, 0, 2, 0, 2,
-	2, 5, 4, 0, 2, 0, 3, 0, 4, 3,
-	5, 0, 1, 1, 0, 2, 2, 0, 3, 1,
-	3, 5, 0, 1, 2, 2, 2, 2, 4, 0,
-	1, 5, 4, 5, 0, 2, 1, 3, 1, 3,
-	1, 2, 1, 3, 3, 4, 5, 4, 3, 4,
-	3, 1, 4, 6, 6, 1, 1, 3, 3, 1,
-	3, 3, 3, 1, 2, 1, 3, 3, 1, 1,
-	1, 3, 6, 0, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 3, 6, 8, 4, 4, 4, 4,
+	4, 4, 2, 6, 7, 1, 2, 2, 1, 0,
+	1, 2, 2, 0, 1, 2, 2, 2, 1, 0,
+	1, 1, 2, 5, 7, 4, 3, 2, 6, 0,
+	1, 0, 2, 0, 2, 0, 3, 1, 3, 1,
+	4, 4, 5, 1, 3, 1, 2, 3, 0, 2,
+	0, 6, 0, 2, 0, 2, 2, 5, 4, 0,
+	2, 0, 3, 0, 4, 3, 5, 0, 1, 1,
+	0, 2, 2, 0, 3, 1, 3, 5, 0, 1,
+	2, 2, 2, 2, 4, 0, 1, 5, 4, 5,
+	0, 2, 1, 3, 1, 3, 1, 2, 1, 3,
+	3, 4, 5, 4, 3, 4, 3, 6, 6, 3,
+	1, 4, 6, 6, 1, 1, 3, 3, 1, 3,
+	3, 3, 1, 2, 1, 3, 3, 1, 1, 1,
+	3, 6, 4, 0, 1, 1, 1, 1, 1, 1,
 	1,
 }
 
 var yyChk = [...]int16{
-	-1000, -1, -2, -3, -4, -5, -6, 50, 52, 53,
-	54, 55, 4, 7, 5, 6, 36, 45, 46, 56,
-	57, 60, 61, -7, 10, 115, 102, 67, -91, 156,
-	51, 39, 139, 54, 8, 32, 119, 122, 34, 33,
-	9, 139, 8, 15, 32, 119, 122, 34, 9, 32,
-	32, 9, -82, 82, -81, 67, 4, 56, 61, 60,
-	5, 36, -82, 58, 58, 69, -26, -88, 139, -86,
-	14, 23, 5, 8, 15, 34, 36, 37, 38, 41,
-	43, 45, 46, 49, 50, 51, 52, 53, 56, 60,
-	61, 63, 96, 115, 117, 126, 127, 128, 129, 130,
-	131, 118, 81, 88, 89, -68, 103, -67, 139, 116,
-	117, 32, 118, 47, -6, -22, 68, -2, 54, 139,
-	139, 96, 139, 96, -89, -88, 96, 139, 139, -72,
-	96, 34, 139, 139, -27, -28, 18, 19, -88, 96,
-	96, 139, 139, 96, 35, -89, 139, -88, -89, 139,
-	35, 49, 149, 35, -26, -26, -26, 62, -23, 82,
-	-6, -6, -7, 149, -68, 80, 139, 48, -58, 152,
-	-59, -38, -44, -45, -49, 93, -46, -48, -47, -51,
-	97, -57, -52, 83, 151, -53, 157, -42, -19, -17,
-	125, -21, 145, 140, 141, 142, 143, 144, 112, 29,
-	-18, 132, 133, 111, -90, 139, -88, -87, 124, 28,
-	25, 30, 24, 31, 59, 26, 139, 93, 93, 157,
-	93, 80, 35, 93, -72, 10, -29, 21, 20, -30,
-	22, -38, -30, 123, 97, 97, 97, -89, 147, 37,
-	38, 5, 36, 10, 8, -82, 8, -10, 157, -10,
-	-40, 72, -78, -77, 139, -6, 81, -67, -7, 157,
-	139, 69, 149, -62, -88, 80, 136, 135, -49, 137,
-	99, 124, -75, 95, 93, 138, 150, 151, 152, 153,
-	154, 157, -39, -38, -52, 97, -38, -7, 113, 157,
-	-20, 148, 157, 157, 142, 147, 157, 97, 97, -37,
-	-36, -8, -35, 42, 120, -90, 44, 41, 125, 29,
-	97, -6, -89, 97, 35, 11, -30, -30, -38, -88,
-	139, 139, 157, -90, 40, 39, 40, 40, 41, 40,
-	11, -88, -88, -25, 59, -6, -9, -90, -25, -71,
-	7, -38, -40, 149, 137, -23, 81, -6, -24, -26,
-	157, 116, 117, 32, 118, -18, -38, -88, -87, 145,
-	-45, -49, -48, 111, 93, -48, 94, 98, -48, 95,
-	-48, -51, -51, -52, -52, -52, -6, -83, 84, 158,
-	158, -85, 24, 25, 26, 27, 28, 29, 30, 31,
-	-84, 126, 127, 128, 129, 130, 131, 148, 142, 152,
-	-21, 68, -38, -90, -16, -15, -38, 139, -89, 158,
-	149, 43, 43, -85, -38, 139, 139, 157, -89, 142,
-	123, -9, -8, -89, -90, -90, 139, -90, 142, 39,
-	39, -79, 35, -13, -14, 157, 149, 158, -60, 66,
-	-55, 75, 106, 34, -71, -77, -38, -6, -23, 158,
-	-71, -27, 59, -6, 16, 17, 157, 157, 157, 157,
-	-62, -62, 111, 135, -48, 157, -48, 158, -43, 84,
-	86, -38, 69, 142, 158, 158, 149, -21, 157, 80,
-	158, 149, 157, -36, -11, -90, 157, 157, -61, 159,
-	157, 44, 80, -9, 157, -80, 12, 13, 14, 158,
-	39, 62, 5, 139, -80, 9, 9, -60, 63, 149,
-	-16, -90, 152, -59, -56, 76, -38, 82, 91, 35,
-	-55, -6, -31, -32, -33, -34, 104, 149, 134, -62,
-	-13, 158, 23, 23, 158, 158, 139, 158, -48, -6,
-	-15, 115, 87, -38, -38, 85, -38, 100, 100, 142,
-	158, -21, -85, 100, -38, -37, -9, -9, -74, 111,
-	93, 140, 140, -38, -6, 158, -9, -90, 93, 93,
-	-85, 139, 139, 64, -14, 158, -60, -38, -38, -11,
-	-56, -40, -32, 70, -34, 109, -24, 158, -62, 139,
-	139, -62, -62, 158, -62, 158, 158, 85, -38, 158,
-	157, 157, 158, 158, 158, 157, 158, 158, 158, -70,
-	114, 111, 160, 158, 72, 158, 111, 111, 65, 61,
-	107, -60, -54, 73, -24, 109, 70, -24, 158, 158,
-	-38, -69, 101, -69, 158, -69, 121, -73, 110, -38,
-	-38, 72, 62, 108, -41, 71, 74, -71, 105, -24,
-	-24, -62, -27, -64, 77, 74, -64, -64, -89, -76,
-	42, -38, -78, -64, -38, -12, -21, -50, 35, 157,
-	-71, -62, 158, 74, -15, 158, 158, 157, 43, -55,
-	149, -38, -9, -50, -63, -38, -9, -56, -21, 158,
-	149, -65, 78, 79, 158, -38, -66, 90, -65, 91,
-	92, -66,
+	-1000, -1, -2, -3, -4, -5, -6, 53, 55, 56,
+	57, 58, 172, 109, 174, 4, 7, 5, 6, 134,
+	129, 39, 48, 49, 59, 60, 63, 64, 141, 147,
+	-7, 10, 118, 105, 70, -135, 201, 54, 42, 183,
+	57, 183, 72, -63, 85, -69, -70, -74, 96, -71,
+	-73, -72, -76, 100, -89, -77, 86, 196, -78, 202,
+	-67, -23, -21, 161, 154, -25, -84, 184, 185, 186,
+	187, 188, 115, 29, 33, 34, 170, 171, -22, 189,
+	175, 176, 114, 168, -129, 183, -127, -126, -125, 160,
+	28, 25, 30, 31, 24, 32, 62, 26, 14, 159,
+	155, 156, 157, 23, 5, 8, 15, 37, 39, 40,
+	41, 44, 46, 48, 49, 52, 53, 54, 55, 56,
+	59, 63, 64, 66, 99, 118, 120, 162, 163, 164,
+	165, 166, 167, 121, 128, 129, 130, 131, 132, 133,
+	134, 135, 136, 137, 138, 139, 140, 142, 143, 144,
+	145, 146, 147, 149, 150, 151, 152, 153, 183, 85,
+	8, 35, 140, -49, -136, 122, 125, 128, 127, 131,
+	37, 36, 9, 143, 144, 179, 183, 8, 15, 35,
+	140, 143, 144, 122, 125, 128, 127, 131, 37, 9,
+	35, -128, -127, 183, 128, 35, 9, -120, 85, -119,
+	-118, 70, 4, 59, 64, 63, 5, 39, -120, 61,
+	61, 72, -30, -128, 61, 183, 84, 91, 92, -103,
+	106, -102, 183, 119, 120, 35, 121, 50, -6, 134,
+	-26, 71, -2, 57, 183, 183, 173, 183, 72, 72,
+	179, 178, -74, 180, 102, 160, -111, 98, 96, 181,
+	182, 195, 196, 197, 198, 199, 202, -64, -63, -77,
+	100, -63, -7, 116, 202, 202, -24, 193, 192, -86,
+	158, 202, 186, 186, 186, 202, 204, 191, 202, 99,
+	183, 99, -128, -127, 99, -49, 99, -128, -128, 122,
+	183, 183, -107, 99, 37, 183, 183, 183, 183, 183,
+	-31, -33, -34, 18, 19, -128, 99, -127, 99, 183,
+	99, 183, 99, 99, -128, -128, 99, 122, 183, 99,
+	183, 99, 38, -127, 183, -128, 191, 122, -128, 183,
+	38, 52, 194, 202, 38, -30, -30, -30, 65, -30,
+	202, -27, 85, -6, -6, -7, 194, -103, 83, 183,
+	51, -6, -93, 197, -94, -63, 183, 51, 183, 183,
+	-70, -74, -73, 169, 85, 114, 96, -73, 97, 101,
+	-73, 98, -73, -73, -76, -76, -77, -77, -77, -6,
+	-121, 87, 203, 203, -124, -123, 24, 25, 26, 27,
+	28, 29, 30, 31, 32, 33, 34, -122, 162, 163,
+	164, 165, 166, 167, -15, -25, 161, 29, 33, 34,
+	193, 192, 186, 186, 202, -63, 197, -25, 71, 203,
+	-85, 184, 185, 175, 176, -19, -18, -63, -129, -19,
+	96, 96, 202, 104, 96, 96, 83, -128, 99, 38,
+	-133, 20, 19, 38, 96, -107, 10, 202, 202, -35,
+	-36, 21, 20, -37, 22, -63, -37, 126, 100, 100,
+	-137, 202, 100, -137, 100, 100, 100, -128, 99, 38,
+	100, 38, 100, -128, 191, -127, -128, 40, 41, 5,
+	39, 183, 10, 8, -131, -128, 35, -120, -12, -129,
+	8, -131, -13, 202, -13, -65, 75, -115, -114, 183,
+	-97, -127, 83, -19, -6, 84, -102, -7, 202, 183,
+	72, 194, -97, -6, 202, 202, 114, 178, -73, 202,
+	-73, 203, -68, 87, 89, -63, -96, 204, 202, 72,
+	194, 203, 186, 186, 75, 83, 203, 203, 194, -25,
+	202, 159, 203, 205, 194, 191, 203, 100, 100, -44,
+	-43, -11, -42, 45, 123, 44, -129, 47, 23, 100,
+	100, -6, 83, 96, -128, -134, 59, 64, 63, -128,
+	100, 38, 11, -52, -51, -50, 183, -124, -52, -37,
+	-37, -63, -128, -127, -52, 183, 183, -128, -128, 100,
+	-128, 183, -128, 183, 202, 108, -129, -127, -108, 130,
+	43, 42, 43, 43, 44, 43, 104, 11, -127, 42,
+	-128, 203, 194, -127, -138, 42, 72, -29, 62, -6,
+	-12, -29, -106, 7, -63, -65, 194, 180, 108, -127,
+	-126, 189, 203, -27, 84, -6, -28, -30, 202, 119,
+	120, 35, 121, -22, -63, -63, -63, -73, -6, -18,
+	118, 90, -63, -63, 88, 204, 205, 184, 184, -63,
+	-25, -63, -124, 103, 103, 186, -25, 203, 194, -25,
+	76, 159, -63, -129, 103, 183, -128, 203, 194, 46,
+	46, 183, -124, -63, -128, -127, -128, -6, 100, -132,
+	51, 38, 202, 108, -128, 186, 203, 194, -124, 203,
+	126, 203, -137, -137, -128, 38, 38, -20, -90, -129,
+	202, 139, 191, -11, -128, -129, -129, 183, -129, -128,
+	186, 42, 9, -129, -138, 9, -116, 38, -16, -17,
+	202, 203, -95, 69, -87, 78, 109, 37, -106, -114,
+	-63, -28, -6, -27, 203, -106, -97, -32, 83, 51,
+	-34, -36, 62, -6, 16, 17, 202, 202, 202, 202,
+	-97, -97, 203, 203, 203, 203, 88, -63, 205, 205,
+	203, 194, 203, 203, 203, -53, -54, 183, 202, -53,
+	203, 203, 186, -25, 203, 202, 76, -53, 202, 104,
+	-130, 104, -43, -14, -129, 202, 202, 123, 47, -110,
+	135, 114, 96, 51, 83, -128, 108, 85, 70, 64,
+	63, -128, -20, 139, 202, -117, 12, 13, 14, 145,
+	-50, 83, -128, -128, 203, 194, -63, 202, -129, 42,
+	65, 5, 183, -117, 9, 183, 9, 183, -95, 66,
+	194, -19, 197, -94, -88, 79, -63, 85, 94, 38,
+	-87, 38, -6, -38, -39, -40, -41, 107, 194, 177,
+	-97, 23, 183, -35, -16, 203, 23, 23, 203, 203,
+	183, 203, -63, 184, -104, 104, 203, 203, 203, 80,
+	202, -44, 23, 77, -12, -12, 46, -63, -105, 117,
+	136, 114, 62, -6, 83, 202, 51, 203, 202, -20,
+	-124, 186, -90, 203, -129, -129, 96, 96, -124, 183,
+	183, 67, -17, 203, -95, -63, -63, -14, -88, -63,
+	-65, -39, 73, -41, 112, -28, 29, 160, 203, -97,
+	-128, -128, -97, -97, 203, -97, 203, -99, 80, 77,
+	77, 80, 203, -128, 149, 203, 203, 202, -109, 113,
+	-63, 83, 72, -6, -63, 132, 75, -129, 203, 83,
+	-139, 146, 203, 114, 114, -92, 108, 68, 64, 110,
+	-95, -48, -47, 87, -79, 76, -28, 112, 73, -28,
+	-73, -73, 203, 203, -57, -58, 110, 149, 77, -18,
+	-25, 77, -130, 51, 202, 124, -12, -112, 45, 202,
+	202, 203, 133, -63, 203, 75, 186, 183, -63, 65,
+	111, -47, 142, 96, -66, 74, 77, -106, 108, -28,
+	-28, -97, 178, -97, -31, 203, -59, 160, 152, 153,
+	-60, 184, 185, -98, -63, -100, 81, 82, -25, 62,
+	-129, -128, 203, -46, 124, 46, -63, -63, -9, -8,
+	53, -5, 65, -63, -139, -115, -91, 178, 142, -55,
+	148, -63, -80, -81, -25, 156, 157, 154, -75, 38,
+	202, -106, -73, -97, -59, 150, 151, 133, 150, 151,
+	194, -100, 203, -100, 72, 203, -62, 202, 124, -128,
+	203, 203, -10, -8, -129, 88, -63, -91, -99, -56,
+	183, 194, 202, 202, 155, -63, -12, -75, -97, 178,
+	-63, -101, 93, 203, 202, -45, -12, -128, -62, -113,
+	138, 137, 42, -135, 201, 191, 64, 63, 67, 88,
+	-87, 194, 83, -81, -15, -15, 202, 203, -59, -100,
+	94, 95, -63, 38, 203, -62, -45, -110, 202, 90,
+	-8, -129, 65, 68, 59, 67, -88, 183, -54, 203,
+	203, -82, -83, -25, 202, -101, 203, 63, 64, -45,
+	-63, 180, -115, -13, 68, 83, 203, 194, 203, -15,
+	42, -61, 126, 65, 183, -61, 203, -63, 62, -54,
+	-83, 203, 202, 114, 183, 202, -63, -18, 203, 203,
 }
 
 var yyDef = [...]int16{
 	2, -2, 1, 5, 7, 8, 9, 11, 12, 13,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 59,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	270, 0, 0, 0, 290, 3, 6, 10, 0, 14,
+	0, 0, 0, 0, 0, 512, 514, 516, 0, 518,
+	-2, 530, 538, 339, 534, 542, 505, 0, 544, 0,
+	547, 548, 549, 340, 0, 296, 315, 181, 182, 183,
+	184, 185, 0, 345, 346, 347, 190, 191, 192, 0,
+	195, 196, 197, 0, 319, 350, 324, 325, 351, 336,
+	337, 338, 341, 342, 343, 344, 348, 349, 352, 353,
+	354, 355, 356, 357, 358, 359, 360, 361, 362, 363,
+	364, 365, 366, 367, 368, 369, 370, 371, 372, 373,
+	374, 375, 376, 377, 378, 379, 380, 381, 382, 383,
+	384, 385, 386, 387, 388, 389, 390, 391, 392, 393,
+	394, 395, 396, 397, 398, 399, 400, 401, 402, 403,
+	404, 405, 406, 407, 408, 409, 410, 411, 22, 23,
+	0, 0, 0, 42, 0, 0, 0, 0, 0, 0,
+	129, 0, 0, 0, 0, 0, 26, 0, 429, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 60, 322, 350, 0, 0, 0, 0, 0, 114,
+	116, 118, 119, 120, 121, 122, 123, 124, 0, 0,
+	0, 0, 0, 425, 0, 0, 288, 0, 0, 0,
+	0, 495, 0, 278, 279, 0, 281, 282, 284, 0,
+	0, 291, 4, 0, 17, 15, 0, 19, 0, 0,
+	0, 0, 517, 0, 0, 0, 0, 0, 554, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 506, 543,
+	339, 0, 0, 0, 0, 0, 297, 0, 0, 300,
+	0, 0, 187, 188, 189, 0, 177, 0, 177, 0,
+	25, 378, 0, 38, 378, 43, 378, 0, 52, 0,
+	0, 0, 0, 0, 129, 0, 0, 0, 148, 27,
+	28, 433, 430, 0, 0, 33, 378, 40, 378, 155,
+	0, 155, 0, 378, 51, 53, 378, 0, 0, 0,
+	0, 0, 0, 0, 88, 37, 0, 0, 0, 0,
+	0, 113, 0, 0, 0, 334, 334, 453, 0, 498,
+	177, 0, 289, 276, 277, 271, 0, 0, 0, 280,
+	0, 285, 287, 292, 293, 498, 16, 0, 20, 21,
+	513, 515, 519, 0, 0, 520, 0, 0, 0, 0,
+	524, 0, 526, 529, 536, 537, 539, 540, 541, 0,
+	510, 0, 545, 546, 550, 256, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 0, 555, 556,
+	557, 558, 559, 560, 0, 175, 340, 345, 346, 347,
+	0, 0, 298, 317, 0, 0, 0, 0, 0, 0,
+	0, 311, 312, 313, 314, 0, 178, 179, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 378, 0,
+	0, 97, 98, 0, 0, 0, 0, 149, 149, 426,
+	434, 0, 0, 431, 0, 438, 432, 35, 0, 0,
+	44, 149, 0, 46, 0, 0, 0, 57, 378, 0,
+	0, 0, 0, 0, 0, 323, 131, 0, 0, 0,
+	0, 0, 0, 359, 0, 93, 0, 115, 0, 326,
+	359, 0, 0, 0, 0, 483, 0, 453, 169, 0,
+	0, 499, 0, 0, 275, 288, 496, 273, 0, 283,
+	0, 0, 294, 18, 0, 0, 521, 0, 523, 0,
+	525, 531, 0, 0, 0, 0, 257, 0, 0, 0,
+	0, 552, 299, 318, 0, 0, 301, 302, 0, 0,
+	0, 0, 0, 198, 0, 0, 210, 0, 0, 0,
+	231, 233, 234, 0, 0, 365, 0, 0, 0, 0,
+	0, 49, 0, 0, 108, 0, 99, 100, 101, 0,
+	130, 0, 0, 0, 150, 151, 0, 154, 0, 435,
+	436, 437, 34, 41, 0, 155, 155, 50, 54, 0,
+	63, 0, 66, 0, 0, 0, 75, 324, 61, 132,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 138, 0, 0, 0, 157, 3, 6,
-	10, 0, 14, 0, 0, 0, 0, 0, 70, 0,
-	0, 20, 0, 240, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 57, 59, 60, 61, 62, 63,
-	64, 65, 0, 0, 0, 0, 0, 238, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 155, 0, 0, 0, 0, 289, 0, 146,
-	147, 0, 149, 150, 152, 0, 158, 4, 0, 17,
-	15, 0, 19, 218, 0, 174, 0, 0, 34, 0,
-	0, 70, 0, 21, 22, 243, 0, 0, 25, 218,
-	0, 33, 35, 0, 0, 0, 53, 29, 0, 0,
-	0, 56, 0, 0, 181, 181, 262, 0, 0, 156,
-	144, 145, 139, 0, 0, 0, 148, 0, 154, 159,
-	160, 292, 306, 308, 310, 0, 312, -2, 321, 329,
-	186, 325, 333, 299, 0, 335, 0, 338, 339, 340,
-	187, 163, 0, 96, 97, 98, 99, 100, 0, 191,
-	103, 106, 107, 108, 172, 194, 175, 176, 183, 184,
-	185, 188, 189, 190, 192, 193, 16, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 239, 0, 0, 241,
-	0, 247, 242, 27, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 58, 0, 0, 0, 0,
-	277, 0, 262, 84, 0, 143, 155, 290, 141, 0,
-	151, 0, 0, 161, 293, 0, 0, 0, 311, 0,
-	0, 0, 0, 0, 344, 0, 0, 0, 0, 0,
-	0, 0, 0, 300, 334, 186, 0, 0, 0, 0,
-	164, 0, 0, 0, 102, 0, 92, 0, 0, 0,
-	119, 121, 122, 0, 0, 0, 0, 205, 187, 191,
-	0, 31, 0, 71, 0, 0, 244, 245, 246, 26,
-	32, 36, 0, 42, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 78, 0, 77, 0, 177, 81, 268,
-	0, 263, 277, 0, 0, 0, 155, 0, 277, 240,
-	0, 0, 220, 0, 227, 292, 292, 294, 295, 296,
-	307, 309, 313, 314, 0, 0, 0, 0, 318, 0,
-	320, 327, 328, 330, 331, 332, 0, 304, 0, 336,
-	337, 341, 109, 110, 111, 112, 113, 114, 115, 116,
-	0, 345, 346, 347, 348, 349, 350, 0, 170, 0,
-	0, 0, 0, 173, 0, 93, 94, 18, 0, 24,
-	0, 0, 0, 128, 297, 0, 0, 0, 0, 66,
-	28, 0, 43, 44, 0, 46, 47, 0, 66, 0,
-	0, 81, 0, 76, 87, 92, 0, 182, 73, 0,
-	273, 0, 0, 0, 268, 85, 86, 140, 0, 291,
-	-2, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	235, 162, 315, 0, 317, 0, 319, 322, 0, 0,
-	0, 0, 0, 171, 165, 166, 0, 0, 0, 0,
-	117, 0, 0, 120, 123, 179, 0, 0, 135, 0,
-	0, 0, 0, 0, 0, 51, 67, 68, 69, 41,
-	0, 0, 0, 0, 52, 0, 0, 72, 0, 0,
-	0, 178, 82, 83, 81, 0, 269, 270, 0, 0,
-	273, 142, 262, 249, -2, 0, 258, 0, 259, 228,
-	0, 292, 0, 0, 292, 292, 0, 292, 316, 0,
-	0, 219, 301, 0, 305, 0, 0, 0, 0, 0,
-	167, 0, 0, 0, 95, 0, 0, 0, 131, 136,
-	0, 0, 0, 298, 30, 37, 0, 45, 0, 0,
-	50, 54, 55, 0, 88, 89, 74, 274, 0, 278,
-	81, 264, 251, 0, 0, 0, 256, 229, 230, 0,
-	0, 231, 232, 233, 234, 323, 324, 0, 302, 342,
-	287, 287, 169, 0, 101, 287, 23, 180, 0, 133,
-	0, 137, 129, 130, 0, 39, 48, 49, 79, 0,
-	272, 75, 266, 0, 277, 0, 0, 255, 292, 240,
-	303, 275, 0, 275, 168, 275, 0, 126, 134, 132,
-	38, 0, 0, 271, 275, 0, 0, 260, 0, 277,
-	254, 236, 292, 0, 0, 0, 0, 0, 0, 125,
-	0, 40, 80, 268, 267, 265, 90, 252, 0, 0,
-	260, 237, 104, 0, 288, 105, 118, 0, 127, 273,
-	0, 261, 0, 257, 276, 281, 0, 153, 91, 253,
-	0, 284, 282, 283, 124, 281, 279, 0, 284, 285,
-	286, 280,
+	94, 117, 0, 0, 0, 95, 96, 163, 0, 162,
+	0, 166, 474, 0, 454, 483, 0, 0, 0, 500,
+	501, 502, 138, 0, 288, 0, 483, 498, 0, 0,
+	380, 0, 387, 498, 498, 0, 0, 522, 0, 0,
+	379, 507, 0, 511, 0, 0, 258, 0, 0, 0,
+	176, 0, 0, 0, 0, 0, 0, 303, 0, 0,
+	0, 0, 180, 321, 0, 24, 0, 470, 0, 0,
+	0, 0, 267, 503, 0, 39, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 125, 0, 0, 153, 0,
+	36, 156, 45, 47, 58, 0, 0, 0, 328, 330,
+	0, 0, 0, 77, 78, 0, 80, 81, 0, 85,
+	125, 0, 0, 327, 0, 0, 166, 0, 161, 172,
+	177, 335, 134, 0, 479, 0, 0, 0, 474, 170,
+	171, 0, 272, 0, 497, -2, 412, 498, 0, 0,
+	433, 428, 0, 0, 0, 0, 0, 0, 0, 0,
+	422, 295, 527, 528, 532, 533, 0, 508, 259, 260,
+	261, 0, 551, 316, 186, 193, 212, 213, 493, 194,
+	305, 307, 0, 0, 0, 0, 0, 211, 0, 0,
+	30, 0, 232, 235, 332, 0, 0, 0, 0, 263,
+	0, 268, 0, 0, 0, 0, 0, 109, 110, 111,
+	112, 0, 0, 0, 0, 86, 126, 127, 128, 0,
+	152, 0, 64, 67, 73, 0, 0, 0, 76, 0,
+	0, 0, 0, 87, 0, 91, 0, 92, 133, 0,
+	0, 0, 167, 168, 166, 0, 475, 476, 0, 0,
+	479, 0, 274, 453, 440, -2, 0, 449, 0, 450,
+	413, 357, 0, 427, 0, 498, 0, 0, 498, 498,
+	0, 498, 509, 0, 481, 0, 306, 308, 304, 0,
+	0, 0, 0, 0, 0, 0, 0, 504, 265, 0,
+	0, 269, 0, 48, 0, 0, 0, 68, 0, 0,
+	0, 157, 329, 331, 0, 79, 0, 0, 472, 89,
+	90, 0, 173, 174, 135, 480, 0, 484, 166, 0,
+	455, 442, 0, 0, 0, 447, 0, 0, 416, 417,
+	0, 0, 418, 419, 420, 421, 262, 215, 0, 0,
+	0, 0, 470, 0, 0, 333, 0, 0, 254, 266,
+	264, 0, 0, 56, 0, 0, 0, 0, 71, 0,
+	147, 0, 74, 82, 83, 84, 0, 164, 0, 478,
+	136, 137, 139, 0, 468, 0, 483, 0, 0, 446,
+	498, 0, 498, 429, 0, 0, 218, 219, 0, 494,
+	487, 0, 29, 0, 0, 0, 0, 252, 0, 0,
+	0, 62, 0, 69, 70, 0, 157, 158, 473, 0,
+	477, 140, 159, 0, 227, 0, 0, 451, 0, 483,
+	445, 414, 0, 423, 498, 214, 216, 0, 0, 0,
+	0, 225, 226, 482, 487, 0, 488, 489, 487, 0,
+	0, 238, 0, 247, 0, 255, 0, 0, 65, 102,
+	0, 106, 0, 72, 146, 165, 0, 0, 159, 481,
+	0, 469, 456, 457, 459, 355, 356, 0, 443, 0,
+	0, 451, 498, 424, 0, 220, 221, 222, 223, 224,
+	0, 490, 309, 0, 0, 471, 240, 0, 0, 238,
+	249, 0, 5, 104, 0, 0, 160, 0, 474, 228,
+	0, 0, 0, 0, 0, 452, 0, 448, 415, 0,
+	487, 485, 0, 310, 0, 236, 0, 238, 240, 267,
+	250, 251, 0, 0, 6, 0, 0, 142, 0, 0,
+	479, 0, 0, 458, 0, 0, 0, 444, 217, 490,
+	491, 492, 0, 0, 239, 240, 253, 248, 0, 103,
+	105, 0, 0, 143, 334, 0, 286, 0, 229, 460,
+	461, 0, 463, 465, 0, 486, 0, 0, 0, 237,
+	0, 0, 141, 0, 145, 0, 462, 0, 466, 0,
+	0, 241, 243, 0, 245, 242, 32, 107, 0, 230,
+	464, 467, 0, 244, 246, 0, 0, 0, 31, 144,
 }
 
 var yyTok1 = [...]uint8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 154, 3, 3,
-	157, 158, 152, 150, 149, 151, 155, 153, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 199, 3, 3,
+	202, 203, 197, 195, 194, 196, 200, 198, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 159, 3, 160,
+	3, 204, 3, 205,
 }
 
 var yyTok2 = [...]uint8{
@@ -1124,7 +1968,12 @@ var yyTok2 = [...]uint8{
 	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
-	142, 143, 144, 145, 146, 147, 148, 156,
+	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166, 167, 168, 169, 170, 171,
+	172, 173, 174, 175, 176, 177, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 188, 189, 190, 191,
+	192, 193, 201,
 }
 
 var yyTok3 = [...]int8{
@@ -1532,653 +2381,1374 @@ yydefault:
 			yyVAL.stmt = &RollbackToSavepointStmt{name: yyDollar[3].id}
 		}
 	case 18:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: true, DB: yyDollar[6].id}
+			yyVAL.stmt = &DeclareCursorStmt{name: yyDollar[2].id, query: yyDollar[5].stmt.(DataSource)}
 		}
 	case 19:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: false, DB: yyDollar[3].id}
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[3].id, count: &Integer{val: 1}}
 		}
 	case 20:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[4].id, count: yyDollar[2].exp}
 		}
 	case 21:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
+			yyVAL.stmt = &FetchStmt{cursor: yyDollar[4].id}
 		}
 	case 22:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
+			yyVAL.stmt = &CloseCursorStmt{name: yyDollar[2].id}
 		}
 	case 23:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = newCreateTableStmt(yyDollar[6].str, yyDollar[8].tableElems, true)
+			yyVAL.stmt = &CloseCursorStmt{all: true}
 		}
 	case 24:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = newCreateTableStmt(yyDollar[3].str, yyDollar[5].tableElems, false)
+			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: true, DB: yyDollar[6].id}
 		}
 	case 25:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].str}
+			yyVAL.stmt = &CreateDatabaseStmt{ifNotExists: false, DB: yyDollar[3].id}
 		}
 	case 26:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &DropTableStmt{table: yyDollar[5].str, ifExists: true}
+			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[2].id}
 		}
 	case 27:
-		yyDollar = yyS[yypt-4 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].str, cascade: true}
+			yyVAL.stmt = &UseDatabaseStmt{DB: yyDollar[3].id}
 		}
 	case 28:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropTableStmt{table: yyDollar[5].str, ifExists: true, cascade: true}
+			yyVAL.stmt = &UseSnapshotStmt{period: yyDollar[3].period}
 		}
 	case 29:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		{
-			yyVAL.stmt = &TruncateTableStmt{table: yyDollar[3].str}
+			stmt := newCreateTableStmt(yyDollar[6].str, yyDollar[8].tableElems, true)
+			stmt.partitionBy = yyDollar[10].str
+			yyVAL.stmt = stmt
 		}
 	case 30:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.stmt = &CreateViewStmt{viewName: yyDollar[6].id, ifNotExists: true, query: yyDollar[8].stmt.(DataSource)}
+			stmt := newCreateTableStmt(yyDollar[3].str, yyDollar[5].tableElems, false)
+			stmt.partitionBy = yyDollar[7].str
+			yyVAL.stmt = stmt
 		}
 	case 31:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-19 : yypt+1]
 		{
-			yyVAL.stmt = &CreateViewStmt{viewName: yyDollar[3].id, query: yyDollar[5].stmt.(DataSource)}
+			yyVAL.stmt = &CreateTableStmt{table: yyDollar[6].str, ifNotExists: true, partitionOf: &partitionSpec{table: yyDollar[9].str, from: yyDollar[14].exp, to: yyDollar[18].exp}}
 		}
 	case 32:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-16 : yypt+1]
 		{
-			yyVAL.stmt = &DropViewStmt{viewName: yyDollar[5].id, ifExists: true}
+			yyVAL.stmt = &CreateTableStmt{table: yyDollar[3].str, partitionOf: &partitionSpec{table: yyDollar[6].str, from: yyDollar[11].exp, to: yyDollar[15].exp}}
 		}
 	case 33:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropViewStmt{viewName: yyDollar[3].id}
+			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].str}
 		}
 	case 34:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &CreateSequenceStmt{name: yyDollar[3].id, startValue: 1, increment: 1}
+			yyVAL.stmt = &DropTableStmt{table: yyDollar[5].str, ifExists: true}
 		}
 	case 35:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &DropSequenceStmt{name: yyDollar[3].id}
+			yyVAL.stmt = &DropTableStmt{table: yyDollar[3].str, cascade: true}
 		}
 	case 36:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &DropSequenceStmt{name: yyDollar[5].id, ifExists: true}
+			yyVAL.stmt = &DropTableStmt{table: yyDollar[5].str, ifExists: true, cascade: true}
 		}
 	case 37:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].str, cols: yyDollar[7].colNames}
+			yyVAL.stmt = &TruncateTableStmt{table: yyDollar[3].str}
 		}
 	case 38:
-		yyDollar = yyS[yypt-10 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].str, cols: yyDollar[7].colNames, predicate: yyDollar[10].exp}
+			yyVAL.stmt = &CreateSchemaStmt{name: yyDollar[3].str}
 		}
 	case 39:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].str, cols: yyDollar[8].colNames}
+			yyVAL.stmt = &CreateSchemaStmt{name: yyDollar[6].str, ifNotExists: true}
 		}
 	case 40:
-		yyDollar = yyS[yypt-11 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].str, cols: yyDollar[8].colNames, predicate: yyDollar[11].exp}
+			yyVAL.stmt = &DropSchemaStmt{name: yyDollar[3].str}
 		}
 	case 41:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].str, cols: yyDollar[6].colNames}
+			yyVAL.stmt = &DropSchemaStmt{name: yyDollar[5].str, ifExists: true}
 		}
 	case 42:
-		yyDollar = yyS[yypt-5 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].str, cols: []string{yyDollar[5].str}}
+			yyVAL.stmt = yyDollar[2].createRoutine
 		}
 	case 43:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].str, colSpec: yyDollar[6].colSpec}
+			yyDollar[3].createRoutine.orReplace = true
+			yyVAL.stmt = yyDollar[3].createRoutine
 		}
 	case 44:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &RenameTableStmt{oldName: yyDollar[3].str, newName: yyDollar[6].str}
+			yyVAL.stmt = &DropRoutineStmt{kind: RoutineFunction, name: yyDollar[3].id}
 		}
 	case 45:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].str, oldName: yyDollar[6].str, newName: yyDollar[8].str}
+			yyVAL.stmt = &DropRoutineStmt{kind: RoutineFunction, name: yyDollar[5].id, ifExists: true}
 		}
 	case 46:
-		yyDollar = yyS[yypt-6 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.stmt = &DropColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str}
+			yyVAL.stmt = &DropRoutineStmt{kind: RoutineProcedure, name: yyDollar[3].id}
 		}
 	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &DropConstraintStmt{table: yyDollar[3].str, constraintName: yyDollar[6].id}
+			yyVAL.stmt = &DropRoutineStmt{kind: RoutineProcedure, name: yyDollar[5].id, ifExists: true}
 		}
 	case 48:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-8 : yypt+1]
 		{
-			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnSetNotNull}
+			yyVAL.stmt = &CreateViewStmt{viewName: yyDollar[6].str, ifNotExists: true, query: yyDollar[8].stmt.(DataSource)}
 		}
 	case 49:
-		yyDollar = yyS[yypt-9 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnDropNotNull}
+			yyVAL.stmt = &CreateViewStmt{viewName: yyDollar[3].str, query: yyDollar[5].stmt.(DataSource)}
 		}
 	case 50:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			if strings.ToUpper(yyDollar[7].id) != "TYPE" {
-				yylex.Error("expected TYPE keyword")
-				goto ret1
-			}
-			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnSetType, newType: yyDollar[8].sqlType}
+			yyVAL.stmt = &DropViewStmt{viewName: yyDollar[5].str, ifExists: true}
 		}
 	case 51:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &CreateUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+			yyVAL.stmt = &DropViewStmt{viewName: yyDollar[3].str}
 		}
 	case 52:
-		yyDollar = yyS[yypt-7 : yypt+1]
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &AlterUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+			yyVAL.stmt = &CreateSequenceStmt{name: yyDollar[3].str, startValue: 1, increment: 1}
 		}
 	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.stmt = &DropUserStmt{username: yyDollar[3].id}
+			yyVAL.stmt = &DropSequenceStmt{name: yyDollar[3].str}
 		}
 	case 54:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].str, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+			yyVAL.stmt = &DropSequenceStmt{name: yyDollar[5].str, ifExists: true}
 		}
 	case 55:
-		yyDollar = yyS[yypt-8 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].str, user: yyDollar[8].id, privileges: yyDollar[2].sqlPrivileges}
+			yyVAL.stmt = &CreateMaterializedViewStmt{name: yyDollar[4].str, query: yyDollar[6].stmt.(DataSource), querySQL: sourceText(yylex, yyDollar[5].pos+len(yyDollar[5].keyword))}
 		}
 	case 56:
-		yyDollar = yyS[yypt-2 : yypt+1]
+		yyDollar = yyS[yypt-9 : yypt+1]
 		{
-			yyVAL.sqlPrivileges = allPrivileges
+			yyVAL.stmt = &CreateMaterializedViewStmt{name: yyDollar[7].str, ifNotExists: true, query: yyDollar[9].stmt.(DataSource), querySQL: sourceText(yylex, yyDollar[8].pos+len(yyDollar[8].keyword))}
 		}
 	case 57:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sqlPrivileges = []SQLPrivilege{yyDollar[1].sqlPrivilege}
+			yyVAL.stmt = &DropMaterializedViewStmt{name: yyDollar[4].str}
 		}
 	case 58:
-		yyDollar = yyS[yypt-3 : yypt+1]
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.sqlPrivileges = append(yyDollar[3].sqlPrivileges, yyDollar[1].sqlPrivilege)
+			yyVAL.stmt = &DropMaterializedViewStmt{name: yyDollar[6].str, ifExists: true}
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeSelect
+			yyVAL.stmt = &AnalyzeStmt{}
 		}
 	case 60:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeCreate
+			yyVAL.stmt = &AnalyzeStmt{table: yyDollar[2].str}
 		}
 	case 61:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeInsert
+			yyVAL.stmt = &RefreshMaterializedViewStmt{name: yyDollar[4].str, incrementally: yyDollar[5].boolean}
 		}
 	case 62:
-		yyDollar = yyS[yypt-1 : yypt+1]
+		yyDollar = yyS[yypt-10 : yypt+1]
 		{
-			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
+			yyVAL.stmt = &CreatePolicyStmt{name: yyDollar[3].id, table: yyDollar[5].str, command: SQLPrivilege(yyDollar[6].str), exp: yyDollar[9].exp}
 		}
 	case 63:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &DropPolicyStmt{name: yyDollar[3].id, table: yyDollar[5].str}
+		}
+	case 64:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &DropPolicyStmt{name: yyDollar[5].id, table: yyDollar[7].str, ifExists: true}
+		}
+	case 65:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			sql := sourceText(yylex, yyDollar[1].pos)
+			if yyDollar[11].pos > 0 {
+				sql = sourceTextUntil(yylex, yyDollar[1].pos, yyDollar[11].pos)
+			}
+			yyVAL.stmt = &CreateTriggerStmt{name: yyDollar[3].id, timing: TriggerTiming(yyDollar[4].str), event: SQLPrivilege(yyDollar[5].str), table: yyDollar[7].str, body: yyDollar[11].stmts, sql: sql}
+		}
+	case 66:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &DropTriggerStmt{name: yyDollar[3].id, table: yyDollar[5].str}
+		}
+	case 67:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &DropTriggerStmt{name: yyDollar[5].id, table: yyDollar[7].str, ifExists: true}
+		}
+	case 68:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			cols, exps := indexElems(yyDollar[7].values)
+			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].str, cols: cols, exps: exps}
+		}
+	case 69:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		{
+			cols, exps := indexElems(yyDollar[7].values)
+			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].str, cols: cols, exps: exps, predicate: yyDollar[10].exp}
+		}
+	case 70:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		{
+			yyVAL.stmt = &CreateIndexStmt{ifNotExists: yyDollar[3].boolean, table: yyDollar[5].str, cols: []string{yyDollar[9].str}, fullText: true}
+		}
+	case 71:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			cols, exps := indexElems(yyDollar[8].values)
+			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].str, cols: cols, exps: exps}
+		}
+	case 72:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			cols, exps := indexElems(yyDollar[8].values)
+			yyVAL.stmt = &CreateIndexStmt{unique: true, ifNotExists: yyDollar[4].boolean, table: yyDollar[6].str, cols: cols, exps: exps, predicate: yyDollar[11].exp}
+		}
+	case 73:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			cols, _ := indexElems(yyDollar[6].values)
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].str, cols: cols}
+		}
+	case 74:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[4].str, cols: []string{yyDollar[8].str}, fullText: true}
+		}
+	case 75:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].str, cols: []string{yyDollar[5].str}}
+		}
+	case 76:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &DropIndexStmt{table: yyDollar[3].str + "." + yyDollar[5].str, cols: []string{yyDollar[7].str}}
+		}
+	case 77:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &AddColumnStmt{table: yyDollar[3].str, colSpec: yyDollar[6].colSpec}
+		}
+	case 78:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &RenameTableStmt{oldName: yyDollar[3].str, newName: yyDollar[6].str}
+		}
+	case 79:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.stmt = &RenameColumnStmt{table: yyDollar[3].str, oldName: yyDollar[6].str, newName: yyDollar[8].str}
+		}
+	case 80:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &DropColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str}
+		}
+	case 81:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &DropConstraintStmt{table: yyDollar[3].str, constraintName: yyDollar[6].id}
+		}
+	case 82:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnSetNotNull}
+		}
+	case 83:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnDropNotNull}
+		}
+	case 84:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			if strings.ToUpper(yyDollar[7].id) != "TYPE" {
+				yylex.Error("expected TYPE keyword")
+				goto ret1
+			}
+			yyVAL.stmt = &AlterColumnStmt{table: yyDollar[3].str, colName: yyDollar[6].str, action: AlterColumnSetType, newType: yyDollar[8].typeSpec.t, typeMod: yyDollar[8].typeSpec.typeMod, using: yyDollar[9].exp}
+		}
+	case 85:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			if strings.ToUpper(yyDollar[4].id) != "DETACH" {
+				yylex.Error("expected DETACH keyword")
+				goto ret1
+			}
+			yyVAL.stmt = &DetachPartitionStmt{table: yyDollar[3].str, partition: yyDollar[6].str}
+		}
+	case 86:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &CreateUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+		}
+	case 87:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &AlterUserStmt{username: yyDollar[3].id, password: yyDollar[6].str, permission: yyDollar[7].permission}
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &DropUserStmt{username: yyDollar[3].id}
+		}
+	case 89:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			privileges, err := databasePrivileges(yyDollar[2].sqlPrivileges)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].str, user: yyDollar[8].id, privileges: privileges, isGrant: true}
+		}
+	case 90:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			privileges, err := databasePrivileges(yyDollar[2].sqlPrivileges)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+			yyVAL.stmt = &AlterPrivilegesStmt{database: yyDollar[5].str, user: yyDollar[8].id, privileges: privileges}
+		}
+	case 91:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[4].str, user: yyDollar[7].id, privileges: yyDollar[2].sqlPrivileges, isGrant: true}
+		}
+	case 92:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.stmt = &AlterTablePrivilegesStmt{table: yyDollar[4].str, user: yyDollar[7].id, privileges: yyDollar[2].sqlPrivileges}
+		}
+	case 94:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = string(TriggerBefore)
+		}
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = string(TriggerAfter)
+		}
+	case 99:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeInsert)
+		}
+	case 100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeUpdate)
+		}
+	case 101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeDelete)
+		}
+	case 102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.stmts = []SQLStmt{yyDollar[1].stmt}
+			yyVAL.pos = 0
+		}
+	case 103:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.stmts = yyDollar[2].stmts
+			yyVAL.pos = yyDollar[4].pos + len(yyDollar[4].keyword)
+		}
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.stmts = []SQLStmt{yyDollar[1].stmt}
+		}
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
+		}
+	case 107:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.stmt = &SetNewValueStmt{row: yyDollar[2].str, col: yyDollar[4].str, op: yyDollar[5].cmpOp, exp: yyDollar[6].exp}
+		}
+	case 108:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.str = ""
+		}
+	case 109:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.str = ""
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeSelect)
+		}
+	case 111:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeUpdate)
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.str = string(SQLPrivilegeDelete)
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = nil
+		}
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = []*privilegeSpec{yyDollar[1].privilegeSpec}
+		}
+	case 115:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.sqlPrivileges = append(yyDollar[3].sqlPrivileges, yyDollar[1].privilegeSpec)
+		}
+	case 116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.privilegeSpec = &privilegeSpec{privilege: yyDollar[1].sqlPrivilege}
+		}
+	case 117:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.privilegeSpec = &privilegeSpec{privilege: yyDollar[1].sqlPrivilege, cols: yyDollar[3].colNames}
+		}
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeSelect
+		}
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeCreate
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeInsert
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlPrivilege = SQLPrivilegeUpdate
+		}
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeDelete
 		}
-	case 64:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeDrop
 		}
-	case 65:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlPrivilege = SQLPrivilegeAlter
 		}
-	case 66:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadWrite
 		}
-	case 67:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadOnly
 		}
-	case 68:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionReadWrite
 		}
-	case 69:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.permission = PermissionAdmin
 		}
-	case 70:
+	case 129:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 71:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 72:
+	case 131:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.boolean = false
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.boolean = true
+		}
+	case 133:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			stmt := &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[4].colNames, ds: yyDollar[5].ds, onConflict: yyDollar[6].onConflict}
+			if yyDollar[7].targets != nil {
+				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[7].targets, tableName: yyDollar[3].tableRef.table}
+			} else {
+				yyVAL.stmt = stmt
+			}
+		}
+	case 134:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			stmt := &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[4].colNames, ds: yyDollar[5].ds}
+			if yyDollar[6].targets != nil {
+				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[6].targets, tableName: yyDollar[3].tableRef.table}
+			} else {
+				yyVAL.stmt = stmt
+			}
+		}
+	case 135:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			stmt := &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].colNames, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
+			if yyDollar[8].targets != nil {
+				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[8].targets, tableName: yyDollar[3].tableRef.table}
+			} else {
+				yyVAL.stmt = stmt
+			}
+		}
+	case 136:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			stmt := &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].colNames, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
+			if yyDollar[9].targets != nil {
+				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[9].targets, tableName: yyDollar[2].tableRef.table}
+			} else {
+				yyVAL.stmt = stmt
+			}
+		}
+	case 137:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyDollar[3].tableRef.as = yyDollar[4].id
+			yyVAL.stmt = &MergeStmt{target: yyDollar[3].tableRef, source: yyDollar[6].ds, on: yyDollar[8].exp, actions: yyDollar[9].mergeActions}
+		}
+	case 138:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.stmt = &CallStmt{name: yyDollar[2].id, params: yyDollar[4].values}
+		}
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.mergeActions = []*mergeAction{yyDollar[1].mergeAction}
+		}
+	case 140:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.mergeActions = append(yyDollar[1].mergeActions, yyDollar[2].mergeAction)
+		}
+	case 141:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			stmt := &UpsertIntoStmt{isInsert: true, tableRef: yyDollar[3].tableRef, cols: yyDollar[4].colNames, ds: yyDollar[5].ds, onConflict: yyDollar[6].onConflict}
-			if yyDollar[7].targets != nil {
-				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[7].targets, tableName: yyDollar[3].tableRef.table}
-			} else {
-				yyVAL.stmt = stmt
-			}
+			yyVAL.mergeAction = &mergeAction{matched: true, cond: yyDollar[3].exp, kind: MergeUpdate, updates: yyDollar[7].updates}
+		}
+	case 142:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.mergeAction = &mergeAction{matched: true, cond: yyDollar[3].exp, kind: MergeDelete}
+		}
+	case 143:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.mergeAction = &mergeAction{matched: true, cond: yyDollar[3].exp, kind: MergeDoNothing}
+		}
+	case 144:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.mergeAction = &mergeAction{cond: yyDollar[4].exp, kind: MergeInsert, cols: yyDollar[7].colNames, values: yyDollar[10].values}
+		}
+	case 145:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.mergeAction = &mergeAction{cond: yyDollar[4].exp, kind: MergeDoNothing}
+		}
+	case 146:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		{
+			yyVAL.createRoutine = &CreateRoutineStmt{kind: RoutineFunction, name: yyDollar[2].id, args: yyDollar[4].routineArgs, returns: yyDollar[7].typeSpec.t, body: yyDollar[9].str}
+		}
+	case 147:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			yyVAL.createRoutine = &CreateRoutineStmt{kind: RoutineProcedure, name: yyDollar[2].id, args: yyDollar[4].routineArgs, body: yyDollar[7].str}
+		}
+	case 148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			if yyDollar[2].id != "replace" {
+				yylex.Error("syntax error: REPLACE expected")
+				goto ret1
+			}
+		}
+	case 149:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.routineArgs = nil
+		}
+	case 150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.routineArgs = yyDollar[1].routineArgs
+		}
+	case 151:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.routineArgs = []routineArg{yyDollar[1].routineArg}
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.routineArgs = append(yyDollar[1].routineArgs, yyDollar[3].routineArg)
+		}
+	case 153:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.routineArg = routineArg{name: yyDollar[1].id, t: yyDollar[2].typeSpec.t}
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.routineArg = routineArg{t: yyDollar[1].typeSpec.t}
+		}
+	case 155:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+		}
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+		}
+	case 157:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
 		}
-	case 73:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 158:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			stmt := &UpsertIntoStmt{tableRef: yyDollar[3].tableRef, cols: yyDollar[4].colNames, ds: yyDollar[5].ds}
-			if yyDollar[6].targets != nil {
-				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[6].targets, tableName: yyDollar[3].tableRef.table}
-			} else {
-				yyVAL.stmt = stmt
+			if yyDollar[2].id != "sql" {
+				yylex.Error(fmt.Sprintf("unsupported language %s", yyDollar[2].id))
+				goto ret1
 			}
 		}
-	case 74:
-		yyDollar = yyS[yypt-8 : yypt+1]
+	case 159:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			stmt := &DeleteFromStmt{tableRef: yyDollar[3].tableRef, where: yyDollar[4].exp, indexOn: yyDollar[5].colNames, limit: yyDollar[6].exp, offset: yyDollar[7].exp}
-			if yyDollar[8].targets != nil {
-				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[8].targets, tableName: yyDollar[3].tableRef.table}
-			} else {
-				yyVAL.stmt = stmt
-			}
+			yyVAL.exp = nil
 		}
-	case 75:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 160:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			stmt := &UpdateStmt{tableRef: yyDollar[2].tableRef, updates: yyDollar[4].updates, where: yyDollar[5].exp, indexOn: yyDollar[6].colNames, limit: yyDollar[7].exp, offset: yyDollar[8].exp}
-			if yyDollar[9].targets != nil {
-				yyVAL.stmt = &ReturningStmt{dml: stmt, returning: yyDollar[9].targets, tableName: yyDollar[2].tableRef.table}
-			} else {
-				yyVAL.stmt = stmt
-			}
+			yyVAL.exp = yyDollar[2].exp
 		}
-	case 76:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &valuesDataSource{rows: yyDollar[2].rows}
 		}
-	case 77:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.ds = yyDollar[1].stmt.(DataSource)
 		}
-	case 78:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.onConflict = nil
 		}
-	case 79:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.onConflict = &OnConflictDo{}
 		}
-	case 80:
+	case 165:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.onConflict = &OnConflictDo{updates: yyDollar[6].updates}
 		}
-	case 81:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.targets = nil
 		}
-	case 82:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.targets = []TargetEntry{{Exp: &ColSelector{col: "*"}}}
 		}
-	case 83:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.targets = yyDollar[2].targets
 		}
-	case 84:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.updates = []*colUpdate{yyDollar[1].update}
 		}
-	case 85:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.updates = append(yyDollar[1].updates, yyDollar[3].update)
 		}
-	case 86:
+	case 171:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.update = &colUpdate{col: yyDollar[1].id, op: yyDollar[2].cmpOp, val: yyDollar[3].exp}
 		}
-	case 87:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.rows = []*RowSpec{yyDollar[1].row}
 		}
-	case 88:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.rows = append(yyDollar[1].rows, yyDollar[3].row)
 		}
-	case 89:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.row = &RowSpec{Values: yyDollar[2].values}
 		}
-	case 90:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.cols = []*ColSelector{yyDollar[1].col}
 		}
-	case 91:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cols = append(yyDollar[1].cols, yyDollar[3].col)
 		}
-	case 92:
+	case 177:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.values = nil
 		}
-	case 93:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = yyDollar[1].values
 		}
-	case 94:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.values = []ValueExp{yyDollar[1].exp}
 		}
-	case 95:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
 		}
-	case 96:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
 		}
-	case 97:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
 		}
-	case 98:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Varchar{val: yyDollar[1].str}
 		}
-	case 99:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Bool{val: yyDollar[1].boolean}
 		}
-	case 100:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Blob{val: yyDollar[1].blob}
 		}
-	case 101:
+	case 186:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].sqlType}
+			yyVAL.value = &Cast{val: yyDollar[3].exp, t: yyDollar[5].typeSpec.t, typeMod: yyDollar[5].typeSpec.typeMod}
 		}
-	case 102:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.value = &Cast{val: &Varchar{val: yyDollar[2].str}, t: TimestampType}
 		}
-	case 103:
+	case 188:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.value = &Cast{val: &Varchar{val: yyDollar[2].str}, t: DateType}
+		}
+	case 189:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.value = &Cast{val: &Varchar{val: yyDollar[2].str}, t: IntervalType}
+		}
+	case 190:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.value = &FnCall{fn: CurrentDateFnCall}
+		}
+	case 191:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.value = &FnCall{fn: NowFnCall}
+		}
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = yyDollar[1].value
 		}
-	case 104:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 193:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.value = &WindowFnExp{fnName: aggFnName(yyDollar[1].aggFn), partitionBy: yyDollar[7].values, orderBy: yyDollar[8].ordexps}
+			yyDollar[6].windowFn.fnName = aggFnName(yyDollar[1].aggFn)
+			yyVAL.value = yyDollar[6].windowFn
 		}
-	case 105:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 194:
+		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.value = &WindowFnExp{fnName: aggFnName(yyDollar[1].aggFn), params: []ValueExp{&ColSelector{table: yyDollar[3].col.table, col: yyDollar[3].col.col}}, partitionBy: yyDollar[7].values, orderBy: yyDollar[8].ordexps}
+			yyDollar[6].windowFn.fnName = aggFnName(yyDollar[1].aggFn)
+			yyDollar[6].windowFn.params = []ValueExp{&ColSelector{table: yyDollar[3].col.table, col: yyDollar[3].col.col}}
+			yyVAL.value = yyDollar[6].windowFn
 		}
-	case 106:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: yyDollar[1].id}
 		}
-	case 107:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
 		}
-	case 108:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.value = &NullValue{t: AnyType}
 		}
-	case 109:
+	case 198:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.value = &ArrayExp{elems: yyDollar[3].values}
+		}
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sqlType = IntegerType
 		}
-	case 110:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 200:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = BooleanType
+		}
+	case 201:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = VarcharType
+		}
+	case 202:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = UUIDType
+		}
+	case 203:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = BLOBType
+		}
+	case 204:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = TimestampType
+		}
+	case 205:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = Float64Type
+		}
+	case 206:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = DecimalType
+		}
+	case 207:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = JSONType
+		}
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = DateType
+		}
+	case 209:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.sqlType = IntervalType
+		}
+	case 210:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
+		}
+	case 211:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyDollar[6].windowFn.fnName = strings.ToUpper(yyDollar[1].id)
+			yyDollar[6].windowFn.params = yyDollar[3].values
+			yyVAL.value = yyDollar[6].windowFn
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.windowFn = yyDollar[1].windowFn
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.windowFn = &WindowFnExp{window: yyDollar[1].id}
+		}
+	case 214:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.windowFn = &WindowFnExp{partitionBy: yyDollar[2].values, orderBy: yyDollar[3].ordexps, frame: yyDollar[4].frame}
+		}
+	case 215:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.frame = nil
+		}
+	case 216:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frame = &windowFrame{mode: yyDollar[1].frameMode, start: yyDollar[2].frameBound, end: frameBound{kind: currentRow}}
+		}
+	case 217:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.frame = &windowFrame{mode: yyDollar[1].frameMode, start: yyDollar[3].frameBound, end: yyDollar[5].frameBound}
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.frameMode = frameRows
+		}
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.frameMode = frameRange
+		}
+	case 220:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frameBound = frameBound{kind: unboundedPreceding}
+		}
+	case 221:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frameBound = frameBound{kind: unboundedFollowing}
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frameBound = frameBound{kind: currentRow}
+		}
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frameBound = frameBound{kind: offsetPreceding, offset: yyDollar[1].value.(TypedValue)}
+		}
+	case 224:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.frameBound = frameBound{kind: offsetFollowing, offset: yyDollar[1].value.(TypedValue)}
+		}
+	case 225:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.value = &Integer{val: int64(yyDollar[1].integer)}
+		}
+	case 226:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.value = &Float64{val: float64(yyDollar[1].float)}
+		}
+	case 227:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.windowDefs = nil
+		}
+	case 228:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.windowDefs = yyDollar[2].windowDefs
+		}
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.windowDefs = map[string]*WindowFnExp{yyDollar[1].id: yyDollar[3].windowFn}
+		}
+	case 230:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			if _, exists := yyDollar[1].windowDefs[yyDollar[3].id]; exists {
+				yylex.Error(fmt.Sprintf("window %s is already defined", yyDollar[3].id))
+				goto ret1
+			}
+			yyDollar[1].windowDefs[yyDollar[3].id] = yyDollar[5].windowFn
+			yyVAL.windowDefs = yyDollar[1].windowDefs
+		}
+	case 231:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.tableElems = []TableElem{yyDollar[1].tableElem}
+		}
+	case 232:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.tableElems = append(yyDollar[1].tableElems, yyDollar[3].tableElem)
+		}
+	case 233:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.tableElem = yyDollar[1].colSpec
+		}
+	case 234:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.tableElem = yyDollar[1].check
+		}
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.tableElem = PrimaryKeyConstraint(yyDollar[3].colNames)
+		}
+	case 236:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		{
+			yyDollar[9].fk.cols = yyDollar[4].colNames
+			yyDollar[9].fk.refTable = yyDollar[7].str
+			yyDollar[9].fk.refCols = yyDollar[8].colNames
+			yyVAL.tableElem = yyDollar[9].fk
+		}
+	case 237:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyDollar[11].fk.name = yyDollar[2].id
+			yyDollar[11].fk.cols = yyDollar[6].colNames
+			yyDollar[11].fk.refTable = yyDollar[9].str
+			yyDollar[11].fk.refCols = yyDollar[10].colNames
+			yyVAL.tableElem = yyDollar[11].fk
+		}
+	case 238:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.colNames = nil
+		}
+	case 239:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.sqlType = BooleanType
+			yyVAL.colNames = yyDollar[2].colNames
 		}
-	case 111:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 240:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.sqlType = VarcharType
+			yyVAL.fk = &ForeignKeyConstraint{}
 		}
-	case 112:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 241:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sqlType = UUIDType
+			yyDollar[1].fk.onDelete = yyDollar[4].refAction
+			yyVAL.fk = yyDollar[1].fk
 		}
-	case 113:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 242:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sqlType = BLOBType
+			yyDollar[1].fk.onUpdate = yyDollar[4].refAction
+			yyVAL.fk = yyDollar[1].fk
 		}
-	case 114:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlType = TimestampType
+			yyVAL.refAction = ReferentialCascade
 		}
-	case 115:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.sqlType = Float64Type
+			yyVAL.refAction = ReferentialSetNull
 		}
-	case 116:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sqlType = JSONType
+			if strings.ToUpper(yyDollar[1].id) != "RESTRICT" {
+				yylex.Error("expected CASCADE, SET NULL, RESTRICT or NO ACTION")
+				goto ret1
+			}
+			yyVAL.refAction = ReferentialRestrict
 		}
-	case 117:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
 		{
-			yyVAL.value = &FnCall{fn: yyDollar[1].id, params: yyDollar[3].values}
+			if strings.ToUpper(yyDollar[1].id) != "NO" || strings.ToUpper(yyDollar[2].id) != "ACTION" {
+				yylex.Error("expected CASCADE, SET NULL, RESTRICT or NO ACTION")
+				goto ret1
+			}
+			yyVAL.refAction = ReferentialNoAction
 		}
-	case 118:
-		yyDollar = yyS[yypt-9 : yypt+1]
+	case 247:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.value = &WindowFnExp{fnName: strings.ToUpper(yyDollar[1].id), params: yyDollar[3].values, partitionBy: yyDollar[7].values, orderBy: yyDollar[8].ordexps}
+			yyVAL.colSpec = &ColSpec{
+				colName:       yyDollar[1].str,
+				colType:       yyDollar[2].typeSpec.t,
+				maxLen:        yyDollar[2].typeSpec.typeMod,
+				notNull:       yyDollar[3].boolean || yyDollar[6].boolean,
+				defaultValue:  yyDollar[4].exp,
+				autoIncrement: yyDollar[5].boolean,
+				primaryKey:    yyDollar[6].boolean,
+				references:    yyDollar[7].fk,
+			}
 		}
-	case 119:
-		yyDollar = yyS[yypt-1 : yypt+1]
+	case 248:
+		yyDollar = yyS[yypt-10 : yypt+1]
 		{
-			yyVAL.tableElems = []TableElem{yyDollar[1].tableElem}
+			yyVAL.colSpec = &ColSpec{
+				colName:   yyDollar[1].str,
+				colType:   yyDollar[2].typeSpec.t,
+				maxLen:    yyDollar[2].typeSpec.typeMod,
+				notNull:   yyDollar[10].boolean,
+				generated: yyDollar[7].exp,
+				virtual:   yyDollar[9].boolean,
+			}
 		}
-	case 120:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 249:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.tableElems = append(yyDollar[1].tableElems, yyDollar[3].tableElem)
+			yyVAL.boolean = true
 		}
-	case 121:
+	case 250:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.tableElem = yyDollar[1].colSpec
+			yyVAL.boolean = true
 		}
-	case 122:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.tableElem = yyDollar[1].check
-		}
-	case 123:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		{
-			yyVAL.tableElem = PrimaryKeyConstraint(yyDollar[3].colNames)
+			yyVAL.boolean = false
 		}
-	case 124:
-		yyDollar = yyS[yypt-10 : yypt+1]
+	case 252:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.tableElem = &ForeignKeyConstraint{cols: yyDollar[4].colNames, refTable: yyDollar[7].str, refCols: yyDollar[9].colNames}
+			yyVAL.fk = nil
 		}
-	case 125:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 253:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.colSpec = &ColSpec{
-				colName:       yyDollar[1].str,
-				colType:       yyDollar[2].sqlType,
-				maxLen:        int(yyDollar[3].integer),
-				notNull:       yyDollar[4].boolean || yyDollar[7].boolean,
-				defaultValue:  yyDollar[5].exp,
-				autoIncrement: yyDollar[6].boolean,
-				primaryKey:    yyDollar[7].boolean,
-			}
+			yyDollar[4].fk.refTable = yyDollar[2].str
+			yyDollar[4].fk.refCols = yyDollar[3].colNames
+			yyVAL.fk = yyDollar[4].fk
 		}
-	case 126:
+	case 254:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 127:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 128:
-		yyDollar = yyS[yypt-0 : yypt+1]
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.integer = 0
+			yyVAL.typeSpec = typeSpec{t: yyDollar[1].sqlType}
 		}
-	case 129:
+	case 257:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			ts, err := newTypeSpec(yyDollar[1].sqlType, yyDollar[2].typeArgs, false)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+			yyVAL.typeSpec = ts
+		}
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.integer = yyDollar[2].integer
+			yyVAL.typeSpec = typeSpec{t: ArrayTypeOf(yyDollar[1].sqlType)}
 		}
-	case 130:
+	case 259:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			ts, err := newTypeSpec(yyDollar[1].sqlType, yyDollar[2].typeArgs, true)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+			yyVAL.typeSpec = ts
+		}
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.integer = yyDollar[2].integer
+			yyVAL.typeArgs = []uint64{yyDollar[2].integer}
 		}
-	case 131:
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.typeArgs = []uint64{yyDollar[2].integer}
+		}
+	case 262:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.typeArgs = []uint64{yyDollar[2].integer, yyDollar[4].integer}
+		}
+	case 263:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 132:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 133:
+	case 265:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 134:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 135:
+	case 267:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 136:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 137:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 138:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
-	case 139:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &CTEStmt{ctes: yyDollar[2].cteDefs, query: yyDollar[3].stmt.(DataSource)}
 		}
-	case 140:
+	case 272:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.stmt = &CTEStmt{ctes: yyDollar[2].cteDefs, query: &UnionStmt{distinct: yyDollar[5].distinct, left: yyDollar[3].stmt.(DataSource), right: yyDollar[6].stmt.(DataSource)}}
 		}
-	case 141:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			for _, c := range yyDollar[3].cteDefs {
@@ -2186,7 +3756,7 @@ yydefault:
 			}
 			yyVAL.stmt = &CTEStmt{ctes: yyDollar[3].cteDefs, query: yyDollar[4].stmt.(DataSource)}
 		}
-	case 142:
+	case 274:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			for _, c := range yyDollar[3].cteDefs {
@@ -2194,7 +3764,7 @@ yydefault:
 			}
 			yyVAL.stmt = &CTEStmt{ctes: yyDollar[3].cteDefs, query: &UnionStmt{distinct: yyDollar[6].distinct, left: yyDollar[4].stmt.(DataSource), right: yyDollar[7].stmt.(DataSource)}}
 		}
-	case 143:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &UnionStmt{
@@ -2203,7 +3773,7 @@ yydefault:
 				right:    yyDollar[4].stmt.(DataSource),
 			}
 		}
-	case 144:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &ExceptStmt{
@@ -2211,7 +3781,7 @@ yydefault:
 				right: yyDollar[3].stmt.(DataSource),
 			}
 		}
-	case 145:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &IntersectStmt{
@@ -2219,71 +3789,82 @@ yydefault:
 				right: yyDollar[3].stmt.(DataSource),
 			}
 		}
-	case 146:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}},
 			}
 		}
-	case 147:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}},
 			}
 		}
-	case 148:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}},
 			}
 		}
-	case 149:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}},
 			}
 		}
-	case 150:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants"}},
 			}
 		}
-	case 151:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
 				ds: &FnDataSourceStmt{fnCall: &FnCall{fn: "grants", params: []ValueExp{&Varchar{val: yyDollar[4].id}}}},
 			}
 		}
-	case 152:
+	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.stmt = &ExplainStmt{query: yyDollar[2].stmt.(DataSource)}
 		}
-	case 153:
-		yyDollar = yyS[yypt-13 : yypt+1]
+	case 285:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.stmt = &ExplainStmt{query: yyDollar[3].stmt.(DataSource), analyze: true}
+		}
+	case 286:
+		yyDollar = yyS[yypt-14 : yypt+1]
 		{
+			if err := resolveWindowRefs(yyDollar[3].targets, yyDollar[11].windowDefs); err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+
 			yyVAL.stmt = &SelectStmt{
-				distinct: yyDollar[2].distinct,
-				targets:  yyDollar[3].targets,
-				ds:       yyDollar[5].ds,
-				indexOn:  yyDollar[6].colNames,
-				joins:    yyDollar[7].joins,
-				where:    yyDollar[8].exp,
-				groupBy:  yyDollar[9].cols,
-				having:   yyDollar[10].exp,
-				orderBy:  yyDollar[11].ordexps,
-				limit:    yyDollar[12].exp,
-				offset:   yyDollar[13].exp,
+				distinct:     yyDollar[2].distinct,
+				targets:      yyDollar[3].targets,
+				ds:           yyDollar[5].ds,
+				indexOn:      yyDollar[6].colNames,
+				joins:        yyDollar[7].joins,
+				where:        yyDollar[8].exp,
+				groupBy:      yyDollar[9].groupBy.cols,
+				groupingSets: yyDollar[9].groupBy.sets,
+				having:       yyDollar[10].exp,
+				orderBy:      yyDollar[12].ordexps,
+				limit:        yyDollar[13].exp,
+				offset:       yyDollar[14].exp,
 			}
 		}
-	case 154:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.stmt = &SelectStmt{
@@ -2292,163 +3873,298 @@ yydefault:
 				ds:       &valuesDataSource{rows: []*RowSpec{{}}},
 			}
 		}
-	case 155:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 156:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 157:
+	case 290:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.distinct = false
 		}
-	case 158:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.distinct = true
 		}
-	case 159:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = nil
 		}
-	case 160:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.targets = yyDollar[1].targets
 		}
-	case 161:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.targets = []TargetEntry{{Exp: yyDollar[1].exp, As: yyDollar[2].id}}
 		}
-	case 162:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.targets = append(yyDollar[1].targets, TargetEntry{Exp: yyDollar[3].exp, As: yyDollar[4].id})
 		}
-	case 163:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.sel = yyDollar[1].col
 		}
-	case 164:
+	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: yyDollar[2].jsonFields}
 		}
-	case 165:
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: []string{yyDollar[3].str}, text: true}
+		}
+	case 299:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.sel = &JSONSelector{ColSelector: yyDollar[1].col, fields: append(yyDollar[2].jsonFields, yyDollar[4].str), text: true}
+		}
+	case 300:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyDollar[1].aggSel.filter = yyDollar[2].exp
+
+			if err := yyDollar[1].aggSel.validate(); err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+
+			yyVAL.sel = yyDollar[1].aggSel
+		}
+	case 301:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
+		}
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
-			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, col: "*"}
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
+		}
+	case 303:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[4].col.table, col: yyDollar[4].col.col, distinct: true}
+		}
+	case 304:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			// Accept `COUNT(DISTINCT(col))` — XORM's builder wraps the
+			// distinct argument in extra parens when it emits aggregated
+			// SELECTs (Gitea GetUserOrgsList per-org repo count uses this).
+			// Semantically identical to COUNT(DISTINCT col).
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[5].col.table, col: yyDollar[5].col.col, distinct: true}
+		}
+	case 305:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col, separator: yyDollar[5].str}
+		}
+	case 306:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[4].col.table, col: yyDollar[4].col.col, separator: yyDollar[6].str, distinct: true}
+		}
+	case 307:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, keySel: yyDollar[3].col, table: yyDollar[5].col.table, col: yyDollar[5].col.col}
+		}
+	case 308:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, keySel: yyDollar[4].col, table: yyDollar[6].col.table, col: yyDollar[6].col.col, distinct: true}
+		}
+	case 309:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[9].col.table, col: yyDollar[9].col.col, withinGroup: true, desc: yyDollar[10].opt_ord}
+		}
+	case 310:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		{
+			yyVAL.aggSel = &AggColSelector{aggFn: yyDollar[1].aggFn, fraction: yyDollar[3].exp, table: yyDollar[10].col.table, col: yyDollar[10].col.col, withinGroup: true, desc: yyDollar[11].opt_ord}
+		}
+	case 311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.exp = &Integer{val: int64(yyDollar[1].integer)}
+		}
+	case 312:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.exp = &Float64{val: yyDollar[1].float}
 		}
-	case 166:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 313:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col}
+			yyVAL.exp = &Param{id: yyDollar[1].id}
 		}
-	case 167:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 314:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		{
-			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[4].col.table, col: yyDollar[4].col.col, distinct: true}
+			yyVAL.exp = &Param{id: fmt.Sprintf("param%d", yyDollar[1].pparam), pos: yyDollar[1].pparam}
 		}
-	case 168:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 315:
+		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			// Accept `COUNT(DISTINCT(col))` — XORM's builder wraps the
-			// distinct argument in extra parens when it emits aggregated
-			// SELECTs (Gitea GetUserOrgsList per-org repo count uses this).
-			// Semantically identical to COUNT(DISTINCT col).
-			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[5].col.table, col: yyDollar[5].col.col, distinct: true}
+			yyVAL.exp = nil
 		}
-	case 169:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 316:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		{
-			yyVAL.sel = &AggColSelector{aggFn: yyDollar[1].aggFn, table: yyDollar[3].col.table, col: yyDollar[3].col.col, separator: yyDollar[5].str}
+			yyVAL.exp = yyDollar[4].exp
 		}
-	case 170:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.jsonFields = []string{yyDollar[2].str}
 		}
-	case 171:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.jsonFields = append(yyVAL.jsonFields, yyDollar[3].str)
 		}
-	case 172:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{col: yyDollar[1].str}
 		}
-	case 173:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.col = &ColSelector{table: yyDollar[1].str, col: yyDollar[3].str}
 		}
-	case 176:
+	case 321:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.col = &ColSelector{table: yyDollar[3].str, col: yyDollar[5].str}
+		}
+	case 323:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
+		}
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.str = yyDollar[1].keyword
 		}
-	case 177:
+	case 326:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.colNames = []string{yyDollar[1].str}
 		}
-	case 178:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.colNames = append(yyDollar[1].colNames, yyDollar[3].str)
 		}
-	case 179:
+	case 328:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.values = []ValueExp{yyDollar[1].exp}
+		}
+	case 329:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].exp)
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.exp = &ColSelector{col: yyDollar[1].str}
+		}
+	case 331:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.exp = yyDollar[2].exp
+		}
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.colNames = []string{yyDollar[1].str}
 		}
-	case 180:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.colNames = yyDollar[2].colNames
 		}
-	case 181:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.colNames = nil
 		}
-	case 182:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.colNames = yyDollar[2].colNames
 		}
-	case 194:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.str = yyDollar[1].id
 		}
-	case 195:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.str = string(yyDollar[1].keyword)
 		}
-	case 228:
+	case 412:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyDollar[1].tableRef.as = yyDollar[2].id
+			yyVAL.ds = yyDollar[1].tableRef
+		}
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyDollar[1].tableRef.period = yyDollar[2].period
 			yyDollar[1].tableRef.as = yyDollar[3].id
 			yyVAL.ds = yyDollar[1].tableRef
 		}
-	case 229:
+	case 414:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyDollar[1].tableRef.period = period{end: &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: yyDollar[5].exp}}}
+			yyDollar[1].tableRef.as = yyDollar[6].id
+			yyVAL.ds = yyDollar[1].tableRef
+		}
+	case 415:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		{
+			if strings.ToUpper(yyDollar[3].id) != "SYSTEM_TIME" {
+				yylex.Error("expected SYSTEM_TIME keyword")
+				goto ret1
+			}
+			yyDollar[1].tableRef.period = period{
+				start:      &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: yyDollar[5].exp}},
+				end:        &openPeriod{inclusive: true, instant: periodInstant{instantType: timeInstant, exp: yyDollar[7].exp}},
+				systemTime: true,
+			}
+			yyDollar[1].tableRef.as = yyDollar[8].id
+			yyVAL.ds = yyDollar[1].tableRef
+		}
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &valuesDataSource{inferTypes: true, rows: yyDollar[3].rows}
 		}
-	case 230:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			switch s := yyDollar[2].stmt.(type) {
@@ -2463,112 +4179,122 @@ yydefault:
 			}
 			yyVAL.ds = yyDollar[2].stmt.(DataSource)
 		}
-	case 231:
+	case 418:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "databases"}, as: yyDollar[4].id}
 		}
-	case 232:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "tables"}, as: yyDollar[4].id}
 		}
-	case 233:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "table", params: []ValueExp{&Varchar{val: yyDollar[3].id}}}}
 		}
-	case 234:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: &FnCall{fn: "users"}, as: yyDollar[4].id}
 		}
-	case 235:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.ds = &FnDataSourceStmt{fnCall: yyDollar[1].value.(*FnCall), as: yyDollar[2].id}
 		}
-	case 236:
+	case 423:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
-			yyVAL.ds = &tableRef{table: yyDollar[4].id, history: true, as: yyDollar[6].id}
+			yyVAL.ds = &tableRef{table: yyDollar[4].str, history: true, as: yyDollar[6].id}
 		}
-	case 237:
+	case 424:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
-			yyVAL.ds = &tableRef{table: yyDollar[4].id, diff: true, period: yyDollar[6].period, as: yyDollar[7].id}
+			yyVAL.ds = &tableRef{table: yyDollar[4].str, diff: true, period: yyDollar[6].period, as: yyDollar[7].id}
 		}
-	case 238:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.tableRef = &tableRef{table: yyDollar[1].str}
 		}
-	case 239:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
 		}
-	case 240:
+	case 427:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.period = period{start: yyDollar[1].openPeriod, end: yyDollar[2].openPeriod}
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.period = period{end: yyDollar[1].openPeriod}
+		}
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 241:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 242:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 243:
+	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.openPeriod = nil
 		}
-	case 244:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{inclusive: true, instant: yyDollar[2].periodInstant}
 		}
-	case 245:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.openPeriod = &openPeriod{instant: yyDollar[2].periodInstant}
 		}
-	case 246:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: txInstant, exp: yyDollar[2].exp}
 		}
-	case 247:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.periodInstant = periodInstant{instantType: timeInstant, exp: yyDollar[1].exp}
 		}
-	case 248:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joins = nil
 		}
-	case 249:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = yyDollar[1].joins
 		}
-	case 250:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joins = []*JoinSpec{yyDollar[1].join}
 		}
-	case 251:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.joins = append([]*JoinSpec{yyDollar[1].join}, yyDollar[2].joins...)
 		}
-	case 252:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			if yyDollar[5].exp == nil && yyDollar[1].joinType != CrossJoin {
@@ -2581,22 +4307,22 @@ yydefault:
 			}
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, indexOn: yyDollar[4].colNames, cond: cond.(ValueExp)}
 		}
-	case 253:
+	case 444:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[3].ds, cond: buildUsingCond(yyDollar[6].colNames)}
 		}
-	case 254:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: yyDollar[2].joinType, ds: yyDollar[4].ds, natural: true, cond: &Bool{val: true}}
 		}
-	case 255:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.join = &JoinSpec{joinType: InnerJoin, ds: yyDollar[3].ds, lateral: true, cond: &Bool{val: true}}
 		}
-	case 256:
+	case 447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			// SQL-89 implicit cross-join: `FROM a, b` is equivalent to
@@ -2607,7 +4333,7 @@ yydefault:
 			//     SELECT COUNT(*) FROM issue_label, issue WHERE ...
 			yyVAL.join = &JoinSpec{joinType: CrossJoin, ds: yyDollar[2].ds, cond: &Bool{val: true}}
 		}
-	case 257:
+	case 448:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			cond := yyDollar[6].exp
@@ -2616,192 +4342,278 @@ yydefault:
 			}
 			yyVAL.join = &JoinSpec{joinType: yyDollar[1].joinType, ds: yyDollar[4].ds, indexOn: yyDollar[5].colNames, cond: cond.(ValueExp), lateral: true}
 		}
-	case 258:
+	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.joinType = InnerJoin
 		}
-	case 259:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.joinType = yyDollar[1].joinType
 		}
-	case 260:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 261:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 262:
+	case 453:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 263:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 264:
+	case 455:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
-			yyVAL.cols = nil
+			yyVAL.groupBy = groupByClause{}
 		}
-	case 265:
+	case 456:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.cols = yyDollar[3].cols
+			if err := yyDollar[3].groupBy.expand(); err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+
+			yyVAL.groupBy = yyDollar[3].groupBy
+		}
+	case 457:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.groupBy = groupByClause{elems: [][][]*ColSelector{yyDollar[1].groupingSets}}
 		}
-	case 266:
+	case 458:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.groupBy = groupByClause{elems: append(yyDollar[1].groupBy.elems, yyDollar[3].groupingSets)}
+		}
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.groupingSets = [][]*ColSelector{{yyDollar[1].col}}
+		}
+	case 460:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.groupingSets = rollupSets(yyDollar[3].cols)
+		}
+	case 461:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			sets, err := cubeSets(yyDollar[3].cols)
+			if err != nil {
+				yylex.Error(err.Error())
+				goto ret1
+			}
+
+			yyVAL.groupingSets = sets
+		}
+	case 462:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		{
+			yyVAL.groupingSets = yyDollar[4].groupingSets
+		}
+	case 463:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.groupingSets = [][]*ColSelector{yyDollar[1].cols}
+		}
+	case 464:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.groupingSets = append(yyDollar[1].groupingSets, yyDollar[3].cols)
+		}
+	case 465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		{
+			yyVAL.cols = []*ColSelector{yyDollar[1].col}
+		}
+	case 466:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.cols = []*ColSelector{}
+		}
+	case 467:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.cols = yyDollar[2].cols
+		}
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 267:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 268:
+	case 470:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.str = ""
+		}
+	case 471:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.str = yyDollar[5].str
+		}
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 269:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 270:
+	case 474:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		{
+			yyVAL.exp = nil
+		}
+	case 475:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		{
+			yyVAL.exp = yyDollar[2].exp
+		}
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 271:
+	case 477:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[3].exp
 		}
-	case 272:
+	case 478:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[3].exp
 		}
-	case 273:
+	case 479:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 274:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 275:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.ordexps = nil
 		}
-	case 276:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordexps = yyDollar[3].ordexps
 		}
-	case 277:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.colNames = nil
 		}
-	case 278:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.colNames = yyDollar[4].colNames
 		}
-	case 279:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.ordexps = []*OrdExp{{exp: yyDollar[1].exp, descOrder: yyDollar[2].opt_ord, nullsOrder: yyDollar[3].nullsOrder}}
 		}
-	case 280:
+	case 486:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.ordexps = append(yyDollar[1].ordexps, &OrdExp{exp: yyDollar[3].exp, descOrder: yyDollar[4].opt_ord, nullsOrder: yyDollar[5].nullsOrder})
 		}
-	case 281:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 282:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = false
 		}
-	case 283:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.opt_ord = true
 		}
-	case 284:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.nullsOrder = NullsDefault
 		}
-	case 285:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.nullsOrder = NullsFirst
 		}
-	case 286:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.nullsOrder = NullsLast
 		}
-	case 287:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.values = nil
 		}
-	case 288:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.values = yyDollar[3].values
 		}
-	case 289:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.cteDefs = []*CTEDef{yyDollar[1].cteDef}
 		}
-	case 290:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.cteDefs = append(yyDollar[1].cteDefs, yyDollar[3].cteDef)
 		}
-	case 291:
+	case 497:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.cteDef = &CTEDef{name: yyDollar[1].id, query: yyDollar[4].stmt.(DataSource)}
 		}
-	case 292:
+	case 498:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.id = ""
 		}
-	case 293:
+	case 499:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.id = yyDollar[1].str
 		}
-	case 294:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.id = yyDollar[2].str
 		}
-	case 295:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			// Allow reserved type names (TIMESTAMP, INTEGER, VARCHAR, …) to
@@ -2811,7 +4623,7 @@ yydefault:
 			// because qualifiedName only covered IDENTIFIER / unreserved_keyword.
 			yyVAL.id = string(yyDollar[2].keyword)
 		}
-	case 296:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			// Accept aggregate-function names (count, sum, max, min, avg,
@@ -2822,27 +4634,27 @@ yydefault:
 			// which failed at Parse with "unexpected AGGREGATE_FUNC".
 			yyVAL.id = string(yyDollar[2].aggFn)
 		}
-	case 297:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{exp: yyDollar[2].exp}
 		}
-	case 298:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.check = CheckConstraint{name: yyDollar[2].id, exp: yyDollar[4].exp}
 		}
-	case 299:
+	case 505:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 300:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 301:
+	case 507:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.exp = &CaseWhenExp{
@@ -2851,62 +4663,62 @@ yydefault:
 				elseExp:  yyDollar[4].exp,
 			}
 		}
-	case 302:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.whenThenClauses = []whenThenClause{{when: yyDollar[2].exp, then: yyDollar[4].exp}}
 		}
-	case 303:
+	case 509:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.whenThenClauses = append(yyDollar[1].whenThenClauses, whenThenClause{when: yyDollar[3].exp, then: yyDollar[5].exp})
 		}
-	case 304:
+	case 510:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.exp = nil
 		}
-	case 305:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 306:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 307:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &BinBoolExp{left: yyDollar[1].exp, op: Or, right: yyDollar[3].exp}
 		}
-	case 309:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &BinBoolExp{left: yyDollar[1].exp, op: And, right: yyDollar[3].exp}
 		}
-	case 311:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			yyVAL.exp = &NotBoolExp{exp: yyDollar[2].exp}
 		}
-	case 313:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &CmpBoolExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[3].exp}
 		}
-	case 314:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &CmpBoolExp{left: yyDollar[1].exp, op: EQ, right: &NullValue{t: AnyType}}
 		}
-	case 315:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &CmpBoolExp{left: yyDollar[1].exp, op: NE, right: &NullValue{t: AnyType}}
 		}
-	case 316:
+	case 522:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		{
 			yyVAL.exp = &BinBoolExp{
@@ -2923,32 +4735,47 @@ yydefault:
 				},
 			}
 		}
-	case 317:
+	case 523:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: yyDollar[2].boolean, pattern: yyDollar[4].exp}
 		}
-	case 318:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, caseInsensitive: true, pattern: yyDollar[3].exp}
 		}
-	case 319:
+	case 525:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: true, caseInsensitive: true, pattern: yyDollar[4].exp}
 		}
-	case 320:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &LikeBoolExp{val: yyDollar[1].exp, notLike: true, pattern: yyDollar[3].exp}
 		}
-	case 322:
+	case 527:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.exp = &AnyAllExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, right: yyDollar[5].exp}
+		}
+	case 528:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		{
+			yyVAL.exp = &AnyAllExp{left: yyDollar[1].exp, op: yyDollar[2].cmpOp, all: true, right: yyDollar[5].exp}
+		}
+	case 529:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		{
+			yyVAL.exp = &ArrayContainsExp{left: yyDollar[1].exp, right: yyDollar[3].exp}
+		}
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		{
 			yyVAL.exp = &ExistsBoolExp{q: (yyDollar[3].stmt).(DataSource)}
 		}
-	case 323:
+	case 532:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			ds, ok := yyDollar[5].stmt.(DataSource)
@@ -2963,42 +4790,42 @@ yydefault:
 			}
 			yyVAL.exp = &InSubQueryExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, q: sel}
 		}
-	case 324:
+	case 533:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &InListExp{val: yyDollar[1].exp, notIn: yyDollar[2].boolean, values: yyDollar[5].values}
 		}
-	case 325:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].exp
 		}
-	case 327:
+	case 536:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: yyDollar[1].exp, op: ADDOP, right: yyDollar[3].exp}
 		}
-	case 328:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: yyDollar[1].exp, op: SUBSOP, right: yyDollar[3].exp}
 		}
-	case 330:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: yyDollar[1].exp, op: MULTOP, right: yyDollar[3].exp}
 		}
-	case 331:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: yyDollar[1].exp, op: DIVOP, right: yyDollar[3].exp}
 		}
-	case 332:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &NumExp{left: yyDollar[1].exp, op: MODOP, right: yyDollar[3].exp}
 		}
-	case 334:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		{
 			i, isInt := yyDollar[2].exp.(*Integer)
@@ -3009,72 +4836,77 @@ yydefault:
 				yyVAL.exp = &NumExp{left: &Integer{val: 0}, op: SUBSOP, right: yyDollar[2].exp}
 			}
 		}
-	case 336:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[2].exp
 		}
-	case 337:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
 			yyVAL.exp = &ScalarSubQueryExp{stmt: yyDollar[2].stmt.(*SelectStmt)}
 		}
-	case 339:
+	case 548:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].sel
 		}
-	case 340:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.exp = yyDollar[1].value
 		}
-	case 341:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		{
-			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].sqlType}
+			yyVAL.exp = &Cast{val: yyDollar[1].exp, t: yyDollar[3].typeSpec.t, typeMod: yyDollar[3].typeSpec.typeMod}
 		}
-	case 342:
+	case 551:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		{
 			yyVAL.exp = &ExtractFromTimestampExp{Field: yyDollar[3].timestampField, Exp: yyDollar[5].exp}
 		}
-	case 343:
+	case 552:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		{
+			yyVAL.exp = &GroupingExp{cols: yyDollar[3].cols}
+		}
+	case 553:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		{
 			yyVAL.boolean = false
 		}
-	case 344:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.boolean = true
 		}
-	case 345:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeYear
 		}
-	case 346:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeMonth
 		}
-	case 347:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeDay
 		}
-	case 348:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeHour
 		}
-	case 349:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeMinute
 		}
-	case 350:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		{
 			yyVAL.timestampField = TimestampFieldTypeSecond
@@ -30,6 +30,7 @@ import (
 // savepointState captures SQLTx state at a SAVEPOINT for later rollback.
 type savepointState struct {
 	updatedRows      int
+	mergedRows       MergeResult
 	lastInsertedPKs  map[string]int64
 	firstInsertedPKs map[string]int64
 	mutatedCatalog   bool
@@ -55,14 +56,32 @@ type SQLTx struct {
 	mutatedCatalog bool // set when a DDL stmt was executed within the current tx
 
 	updatedRows      int
+	mergedRows       MergeResult      // rows written by each kind of MERGE action
 	lastInsertedPKs  map[string]int64 // last inserted PK by table name
 	firstInsertedPKs map[string]int64 // first inserted PK by table name
 
+	pendingFKChecks []pendingFKCheck // foreign key checks deferred to the end of the current statement
+
+	// rows written to tables with foreign keys, by table id, see
+	// trackWrittenRow
+	writtenRows map[uint32]*writtenRows
+
+	triggerDepth int // nesting level of the triggers being run
+	routineDepth int // nesting level of the functions and procedures being run
+
+	queryProfile *queryProfile // set while EXPLAIN ANALYZE runs a query
+
+	fullTextStats map[string]*fullTextStats // term statistics of the fulltext scans, by query
+
+	user User // logged user running the current statement, nil when privileges are not checked
+
 	txHeader *store.TxHeader // header is set once tx is committed
 
 	onCommittedCallbacks []onCommittedCallback
 
 	savepoints map[string]*savepointState
+
+	cursors map[string]*cursor // cursors declared within the tx, by name
 }
 
 type onCommittedCallback = func(sqlTx *SQLTx) error
@@ -93,6 +112,12 @@ func (sqlTx *SQLTx) UpdatedRows() int {
 	return sqlTx.updatedRows
 }
 
+// MergedRows returns the number of rows inserted, updated and deleted by the
+// MERGE statements of the transaction.
+func (sqlTx *SQLTx) MergedRows() MergeResult {
+	return sqlTx.mergedRows
+}
+
 func (sqlTx *SQLTx) LastInsertedPKs() map[string]int64 {
 	return sqlTx.lastInsertedPKs
 }
@@ -118,7 +143,11 @@ func (sqlTx *SQLTx) distinctSpillThreshold() int {
 }
 
 func (sqlTx *SQLTx) newKeyReader(rSpec store.KeyReaderSpec) (store.KeyReader, error) {
-	return sqlTx.tx.NewKeyReader(rSpec)
+	r, err := sqlTx.tx.NewKeyReader(rSpec)
+	if err != nil || sqlTx.queryProfile == nil {
+		return r, err
+	}
+	return &profiledKeyReader{KeyReader: r, profile: sqlTx.queryProfile}, nil
 }
 
 func (sqlTx *SQLTx) get(ctx context.Context, key []byte) (store.ValueRef, error) {
@@ -154,6 +183,7 @@ func (sqlTx *SQLTx) Savepoint(name string) {
 
 	sqlTx.savepoints[name] = &savepointState{
 		updatedRows:      sqlTx.updatedRows,
+		mergedRows:       sqlTx.mergedRows,
 		lastInsertedPKs:  lastPKs,
 		firstInsertedPKs: firstPKs,
 		mutatedCatalog:   sqlTx.mutatedCatalog,
@@ -172,6 +202,7 @@ func (sqlTx *SQLTx) RollbackToSavepoint(name string) error {
 
 	// Restore state
 	sqlTx.updatedRows = sp.updatedRows
+	sqlTx.mergedRows = sp.mergedRows
 	sqlTx.lastInsertedPKs = sp.lastInsertedPKs
 	sqlTx.firstInsertedPKs = sp.firstInsertedPKs
 	sqlTx.mutatedCatalog = sp.mutatedCatalog
@@ -199,13 +230,21 @@ func (sqlTx *SQLTx) ReleaseSavepoint(name string) error {
 func (sqlTx *SQLTx) Cancel() error {
 	defer sqlTx.removeTempFiles()
 
+	// the tx is discarded anyway, errors closing its cursors are irrelevant
+	sqlTx.closeCursors()
+
 	return sqlTx.tx.Cancel()
 }
 
 func (sqlTx *SQLTx) Commit(ctx context.Context) error {
 	defer sqlTx.removeTempFiles()
 
-	err := sqlTx.tx.RequireMVCCOnFollowingTxs(sqlTx.mutatedCatalog)
+	err := sqlTx.closeCursors()
+	if err != nil {
+		return err
+	}
+
+	err = sqlTx.tx.RequireMVCCOnFollowingTxs(sqlTx.mutatedCatalog)
 	if err != nil {
 		return err
 	}
@@ -30,6 +30,7 @@ type TxOptions struct {
 	ExplicitClose           bool
 	UnsafeMVCC              bool
 	Extra                   []byte
+	SearchPath              []string // schemas searched for unqualified names, nil for public only
 }
 
 func DefaultTxOptions() *TxOptions {
@@ -81,3 +82,8 @@ func (opts *TxOptions) WithExtra(data []byte) *TxOptions {
 	opts.Extra = data
 	return opts
 }
+
+func (opts *TxOptions) WithSearchPath(searchPath []string) *TxOptions {
+	opts.SearchPath = searchPath
+	return opts
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Table statistics are collected by ANALYZE, which scans the rows of a table
+// counting them together with the distinct and NULL values of each column.
+// They are stored in the catalog and used by the planner to estimate the
+// cost of the plans of a query. Statistics are not maintained as rows are
+// written, ANALYZE must be run again to refresh them.
+
+// analyzeSketchSize is the number of hashes kept to estimate the distinct
+// values of a column, which are counted exactly up to this number.
+const analyzeSketchSize = 1024
+
+// TableStats are the statistics of a table collected by ANALYZE.
+type TableStats struct {
+	rows uint64
+	cols map[uint32]*ColumnStats
+}
+
+// ColumnStats are the statistics of a column collected by ANALYZE.
+type ColumnStats struct {
+	distinct uint64
+	nulls    uint64
+}
+
+// Stats returns the statistics of the table, or nil if it was never
+// analyzed.
+func (t *Table) Stats() *TableStats {
+	return t.stats
+}
+
+func (s *TableStats) Rows() uint64 {
+	return s.rows
+}
+
+// Column returns the statistics of the column with the given id, or nil when
+// the column was added after the table was analyzed.
+func (s *TableStats) Column(colID uint32) *ColumnStats {
+	return s.cols[colID]
+}
+
+// DistinctValues returns the estimated number of distinct non-NULL values.
+func (s *ColumnStats) DistinctValues() uint64 {
+	return s.distinct
+}
+
+func (s *ColumnStats) NullValues() uint64 {
+	return s.nulls
+}
+
+type AnalyzeStmt struct {
+	table string // all tables when empty
+}
+
+func (stmt *AnalyzeStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *AnalyzeStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *AnalyzeStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *AnalyzeStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	var tables []*Table
+
+	if stmt.table == "" {
+		for _, table := range tx.catalog.GetTables() {
+			if table.systemScan == nil {
+				tables = append(tables, table)
+			}
+		}
+	} else {
+		table, err := tx.catalog.GetTableByName(stmt.table)
+		if err != nil {
+			return nil, err
+		}
+
+		if table.systemScan != nil {
+			return nil, ErrIllegalArguments
+		}
+		tables = append(tables, table)
+	}
+
+	for _, table := range tables {
+		stats, err := tx.analyzeTable(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+
+		table.stats = stats
+
+		err = persistTableStats(tx, table)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func (tx *SQLTx) analyzeTable(ctx context.Context, table *Table) (*TableStats, error) {
+	reader, err := newRawRowReader(tx, nil, table, period{}, table.name, &ScanSpecs{Index: table.primaryIndex})
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	sketches := make([]*distinctSketch, len(table.cols))
+	for i := range sketches {
+		sketches[i] = newDistinctSketch()
+	}
+
+	stats := &TableStats{cols: make(map[uint32]*ColumnStats, len(table.cols))}
+	for _, col := range table.cols {
+		stats.cols[col.id] = &ColumnStats{}
+	}
+
+	for {
+		row, err := reader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		stats.rows++
+
+		for i, col := range table.cols {
+			v := row.ValuesBySelector[EncodeSelector("", table.name, col.colName)]
+			if v == nil || v.IsNull() {
+				stats.cols[col.id].nulls++
+				continue
+			}
+			sketches[i].add(v)
+		}
+	}
+
+	for i, col := range table.cols {
+		stats.cols[col.id].distinct = sketches[i].estimate()
+	}
+	return stats, nil
+}
+
+// distinctSketch estimates the number of distinct values added to it by
+// keeping the analyzeSketchSize smallest of their hashes (k minimum values).
+type distinctSketch struct {
+	hashes maxUint64Heap
+	kept   map[uint64]struct{}
+}
+
+func newDistinctSketch() *distinctSketch {
+	return &distinctSketch{kept: make(map[uint64]struct{})}
+}
+
+func (s *distinctSketch) add(v TypedValue) {
+	h := fnv.New64a()
+	h.Write([]byte(hashJoinKey(v)))
+	hash := mixHash(h.Sum64())
+
+	if _, ok := s.kept[hash]; ok {
+		return
+	}
+
+	if len(s.hashes) == analyzeSketchSize {
+		if hash > s.hashes[0] {
+			return
+		}
+		delete(s.kept, heap.Pop(&s.hashes).(uint64))
+	}
+
+	heap.Push(&s.hashes, hash)
+	s.kept[hash] = struct{}{}
+}
+
+func (s *distinctSketch) estimate() uint64 {
+	if len(s.hashes) < analyzeSketchSize {
+		return uint64(len(s.hashes))
+	}
+	// the k-th smallest hash of n uniformly distributed ones is expected
+	// at k/n of the hash space
+	return uint64(float64(analyzeSketchSize-1) * math.MaxUint64 / float64(s.hashes[0]))
+}
+
+// mixHash spreads the bits of an fnv hash, whose high bits barely change
+// for similar short values, over the whole hash space (splitmix64 finalizer).
+func mixHash(h uint64) uint64 {
+	h ^= h >> 30
+	h *= 0xbf58476d1ce4e5b9
+	h ^= h >> 27
+	h *= 0x94d049bb133111eb
+	h ^= h >> 31
+	return h
+}
+
+type maxUint64Heap []uint64
+
+func (h maxUint64Heap) Len() int           { return len(h) }
+func (h maxUint64Heap) Less(i, j int) bool { return h[i] > h[j] }
+func (h maxUint64Heap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *maxUint64Heap) Push(x interface{}) {
+	*h = append(*h, x.(uint64))
+}
+
+func (h *maxUint64Heap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+func persistTableStats(tx *SQLTx, table *Table) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogStatsPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+	)
+
+	// {rows}({colID}{distinct}{nulls})*
+	val := make([]byte, 8, 8+len(table.stats.cols)*(EncIDLen+16))
+	binary.BigEndian.PutUint64(val, table.stats.rows)
+
+	for _, col := range table.cols {
+		colStats, ok := table.stats.cols[col.id]
+		if !ok {
+			continue
+		}
+
+		val = binary.BigEndian.AppendUint32(val, col.id)
+		val = binary.BigEndian.AppendUint64(val, colStats.distinct)
+		val = binary.BigEndian.AppendUint64(val, colStats.nulls)
+	}
+
+	return tx.set(mappedKey, nil, val)
+}
+
+func persistTableStatsDeletion(ctx context.Context, tx *SQLTx, table *Table) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogStatsPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
+func loadTableStats(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (*TableStats, error) {
+	prefix := MapKey(sqlPrefix, catalogStatsPrefix, EncodeID(dbID), EncodeID(tableID))
+
+	var stats *TableStats
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		if len(value) < 8 || (len(value)-8)%(EncIDLen+16) != 0 {
+			return ErrCorruptedData
+		}
+
+		stats = &TableStats{
+			rows: binary.BigEndian.Uint64(value),
+			cols: make(map[uint32]*ColumnStats),
+		}
+
+		for off := 8; off < len(value); off += EncIDLen + 16 {
+			colID := binary.BigEndian.Uint32(value[off:])
+
+			stats.cols[colID] = &ColumnStats{
+				distinct: binary.BigEndian.Uint64(value[off+EncIDLen:]),
+				nulls:    binary.BigEndian.Uint64(value[off+EncIDLen+8:]),
+			}
+		}
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return stats, err
+}
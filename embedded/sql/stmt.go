@@ -34,17 +34,30 @@ import (
 )
 
 const (
-	catalogPrefix          = "CTL."
-	catalogTablePrefix     = "CTL.TABLE."     // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
-	catalogColumnPrefix    = "CTL.COLUMN."    // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
-	catalogIndexPrefix     = "CTL.INDEX."     // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
-	catalogCheckPrefix     = "CTL.CHECK."     // (key=CTL.CHECK.{1}{tableID}{checkID}, value={nameLen}{name}{expText})
-	catalogPrivilegePrefix = "CTL.PRIVILEGE." // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
-	catalogViewPrefix      = "CTL.VIEW."      // (key=CTL.VIEW.{1}{viewID}, value={viewName\0sqlText})
-	catalogSequencePrefix  = "CTL.SEQUENCE."  // (key=CTL.SEQUENCE.{1}{seqName}, value={currValue}{increment})
-
-	RowPrefix    = "R." // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
-	MappedPrefix = "M." // (key=M.{tableID}{indexID}({null}({val}{padding}{valLen})?)*({pkVal}{padding}{pkValLen})+, value={count (colID valLen val)+})
+	catalogPrefix           = "CTL."
+	catalogTablePrefix      = "CTL.TABLE."     // (key=CTL.TABLE.{1}{tableID}, value={tableNAME})
+	catalogColumnPrefix     = "CTL.COLUMN."    // (key=CTL.COLUMN.{1}{tableID}{colID}{colTYPE}, value={(auto_incremental | nullable){maxLen}{colNAME}})
+	catalogIndexPrefix      = "CTL.INDEX."     // (key=CTL.INDEX.{1}{tableID}{indexID}, value={unique {colID1}(ASC|DESC)...{colIDN}(ASC|DESC)})
+	catalogCheckPrefix      = "CTL.CHECK."     // (key=CTL.CHECK.{1}{tableID}{checkID}, value={nameLen}{name}{expText})
+	catalogForeignKeyPrefix = "CTL.FK."        // (key=CTL.FK.{1}{tableID}{fkID}, value={nameLen}{name}{onDelete}{onUpdate}{refTableID}{colCount}{colID}*{refColID}*)
+	catalogPrivilegePrefix  = "CTL.PRIVILEGE." // (key=CTL.PRIVILEGE.{1}{tableID}{username}, value={count}({privLen-1}{privilege}{colCount}{colID}*)*)
+	catalogPolicyPrefix     = "CTL.POLICY."    // (key=CTL.POLICY.{1}{tableID}{policyName}, value={commandLen}{command}{expText})
+	catalogMatViewPrefix    = "CTL.MATVIEW."   // (key=CTL.MATVIEW.{1}{tableID}, value={refreshedTxID}{sourceTableID}{sqlText})
+	catalogTriggerPrefix    = "CTL.TRIGGER."   // (key=CTL.TRIGGER.{1}{tableID}{triggerName}, value={sqlText})
+	catalogStatsPrefix      = "CTL.STATS."     // (key=CTL.STATS.{1}{tableID}, value={rows}({colID}{distinct}{nulls})*)
+	catalogIndexExpPrefix   = "CTL.INDEXEXP."  // (key=CTL.INDEXEXP.{1}{tableID}{colID}, value={expText})
+	catalogViewPrefix       = "CTL.VIEW."      // (key=CTL.VIEW.{1}{viewID}, value={viewName\0sqlText})
+	catalogSequencePrefix   = "CTL.SEQUENCE."  // (key=CTL.SEQUENCE.{1}{seqName}, value={currValue}{increment})
+	catalogSchemaPrefix     = "CTL.SCHEMA."    // (key=CTL.SCHEMA.{1}{schemaName}, value={schemaName})
+	catalogRoutinePrefix    = "CTL.ROUTINE."   // (key=CTL.ROUTINE.{1}{routineName}, value={sqlText})
+	catalogPartitionPrefix  = "CTL.PARTITION." // (key=CTL.PARTITION.{1}{tableID}, value={parentTableID}{colID}({lowerBound}{upperBound})?)
+
+	catalogMigrationPrefix         = "CTL.MIGRATION."          // (key=CTL.MIGRATION.{1}{tableID}{shadowColID}, value={colID}{state}{typeMod}{startedAt}{completedAt}({len}{colName|fromType|toType|using|err})*)
+	catalogMigrationProgressPrefix = "CTL.MIGRATION_PROGRESS." // (key=CTL.MIGRATION_PROGRESS.{1}{tableID}{shadowColID}, value={rows}{lastMappedKey})
+
+	RowPrefix      = "R." // (key=R.{1}{tableID}{0}({null}({pkVal}{padding}{pkValLen})?)+, value={count (colID valLen val)+})
+	MappedPrefix   = "M." // (key=M.{tableID}{indexID}({null}({val}{padding}{valLen})?)*({pkVal}{padding}{pkValLen})+, value={count (colID valLen val)+})
+	FullTextPrefix = "F." // (key=F.{tableID}{indexID}{term}({pkVal}{padding}{pkValLen})+, value={termFreq})
 )
 
 const (
@@ -56,18 +69,34 @@ const (
 	nullableFlag      byte = 1 << iota
 	autoIncrementFlag byte = 1 << iota
 	hasDefaultFlag    byte = 1 << iota
+	generatedFlag     byte = 1 << iota
+	virtualFlag       byte = 1 << iota
 )
 
 const (
-	revCol        = "_rev"
-	txMetadataCol = "_tx_metadata"
-	diffActionCol = "_diff_action"
+	revCol         = "_rev"
+	txMetadataCol  = "_tx_metadata"
+	diffActionCol  = "_diff_action"
+	txIDCol        = "_tx_id"
+	txTimestampCol = "_tx_timestamp"
+	revisionCol    = "_revision" // alias of revCol available on every table
 )
 
 var reservedColumns = map[string]struct{}{
-	revCol:        {},
-	txMetadataCol: {},
-	diffActionCol: {},
+	revCol:         {},
+	txMetadataCol:  {},
+	diffActionCol:  {},
+	txIDCol:        {},
+	txTimestampCol: {},
+	revisionCol:    {},
+}
+
+// systemColumns are the pseudo-columns available on every table, in the
+// order they are laid out by the row readers
+var systemColumns = []ColDescriptor{
+	{Column: txIDCol, Type: IntegerType},
+	{Column: txTimestampCol, Type: TimestampType},
+	{Column: revisionCol, Type: IntegerType},
 }
 
 func isReservedCol(col string) bool {
@@ -75,6 +104,49 @@ func isReservedCol(col string) bool {
 	return ok
 }
 
+func orderedSystemCols(referenced map[string]bool) []string {
+	var cols []string
+	for _, c := range systemColumns {
+		if referenced[c.Column] {
+			cols = append(cols, c.Column)
+		}
+	}
+	return cols
+}
+
+func isSystemCol(col string) bool {
+	return systemColType(col) != ""
+}
+
+// shadowsSystemCol reports whether t has a column named after the
+// pseudo-column col. Such columns may exist in tables created before the
+// pseudo-columns were introduced, and they are read instead.
+func (t *Table) shadowsSystemCol(col string) bool {
+	_, exists := t.colsByName[col]
+	return exists && isSystemCol(col)
+}
+
+// readableSystemCols returns the pseudo-columns in cols not shadowed by a
+// column of t.
+func (t *Table) readableSystemCols(cols []string) []string {
+	var readable []string
+	for _, col := range cols {
+		if !t.shadowsSystemCol(col) {
+			readable = append(readable, col)
+		}
+	}
+	return readable
+}
+
+func systemColType(col string) SQLValueType {
+	for _, c := range systemColumns {
+		if c.Column == col {
+			return c.Type
+		}
+	}
+	return ""
+}
+
 type SQLValueType = string
 
 const (
@@ -84,13 +156,22 @@ const (
 	UUIDType      SQLValueType = "UUID"
 	BLOBType      SQLValueType = "BLOB"
 	Float64Type   SQLValueType = "FLOAT"
+	DecimalType   SQLValueType = "DECIMAL"
 	TimestampType SQLValueType = "TIMESTAMP"
+	DateType      SQLValueType = "DATE"
+	IntervalType  SQLValueType = "INTERVAL"
 	AnyType       SQLValueType = "ANY"
 	JSONType      SQLValueType = "JSON"
 )
 
 func IsNumericType(t SQLValueType) bool {
-	return t == IntegerType || t == Float64Type
+	return t == IntegerType || t == Float64Type || t == DecimalType
+}
+
+// IsDateTimeType reports whether t is one of the date/time types taking
+// part in date/time arithmetic.
+func IsDateTimeType(t SQLValueType) bool {
+	return t == TimestampType || t == DateType || t == IntervalType
 }
 
 type Permission = string
@@ -129,6 +210,21 @@ const (
 	MIN        AggregateFn = "MIN"
 	AVG        AggregateFn = "AVG"
 	STRING_AGG AggregateFn = "STRING_AGG"
+	ARRAY_AGG  AggregateFn = "ARRAY_AGG"
+
+	STDDEV          AggregateFn = "STDDEV"
+	STDDEV_POP      AggregateFn = "STDDEV_POP"
+	STDDEV_SAMP     AggregateFn = "STDDEV_SAMP"
+	VARIANCE        AggregateFn = "VARIANCE"
+	VAR_POP         AggregateFn = "VAR_POP"
+	VAR_SAMP        AggregateFn = "VAR_SAMP"
+	PERCENTILE_CONT AggregateFn = "PERCENTILE_CONT"
+	PERCENTILE_DISC AggregateFn = "PERCENTILE_DISC"
+	MODE            AggregateFn = "MODE"
+	BOOL_AND        AggregateFn = "BOOL_AND"
+	BOOL_OR         AggregateFn = "BOOL_OR"
+	JSON_AGG        AggregateFn = "JSON_AGG"
+	JSON_OBJECT_AGG AggregateFn = "JSON_OBJECT_AGG"
 )
 
 type CmpOperator = int
@@ -523,7 +619,10 @@ type CreateTableStmt struct {
 	ifNotExists bool
 	colsSpec    []*ColSpec
 	checks      []CheckConstraint
+	foreignKeys []*ForeignKeyConstraint
 	pkColNames  PrimaryKeyConstraint
+	partitionBy string         // PARTITION BY RANGE column, if any
+	partitionOf *partitionSpec // set when creating a partition of another table
 }
 
 func NewCreateTableStmt(table string, ifNotExists bool, colsSpec []*ColSpec, pkColNames []string) *CreateTableStmt {
@@ -558,11 +657,28 @@ func zeroRow(tableName string, cols []*ColSpec) *Row {
 }
 
 func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.partitionOf != nil {
+		return stmt.execPartitionOfAt(ctx, tx, params)
+	}
+
 	if err := stmt.validatePrimaryKey(); err != nil {
 		return nil, err
 	}
 
-	if stmt.ifNotExists && tx.catalog.ExistTable(stmt.table) {
+	// the columns of existing tables named after the pseudo-columns are
+	// still loaded, but new ones can not be created
+	for _, cs := range stmt.colsSpec {
+		if isSystemCol(cs.colName) {
+			return nil, fmt.Errorf("%w(%s)", ErrReservedWord, cs.colName)
+		}
+	}
+
+	tableName, err := tx.catalog.creationName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := tx.catalog.tablesByName[tableName]; exists && stmt.ifNotExists {
 		return tx, nil
 	}
 
@@ -571,9 +687,9 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		colSpecs[uint32(i)+1] = cs
 	}
 
-	row := zeroRow(stmt.table, stmt.colsSpec)
+	row := zeroRow(tableName, stmt.colsSpec)
 	for _, check := range stmt.checks {
-		value, err := check.exp.reduce(tx, row, stmt.table)
+		value, err := check.exp.reduce(tx, row, tableName)
 		if err != nil {
 			return nil, err
 		}
@@ -586,7 +702,8 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 	nextUnnamedCheck := 0
 	checks := make(map[string]CheckConstraint)
 	for id, check := range stmt.checks {
-		name := fmt.Sprintf("%s_check%d", stmt.table, nextUnnamedCheck+1)
+		_, unqualifiedName := SplitTableName(tableName)
+		name := fmt.Sprintf("%s_check%d", unqualifiedName, nextUnnamedCheck+1)
 		if check.name != "" {
 			name = check.name
 		} else {
@@ -597,12 +714,12 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		checks[name] = check
 	}
 
-	table, err := tx.catalog.newTable(stmt.table, colSpecs, checks, uint32(len(colSpecs)))
+	table, err := tx.catalog.newTable(tableName, colSpecs, checks, uint32(len(colSpecs)))
 	if err != nil {
 		return nil, err
 	}
 
-	createIndexStmt := &CreateIndexStmt{unique: true, table: table.name, cols: stmt.primaryKeyCols()}
+	createIndexStmt := &CreateIndexStmt{unique: true, table: table.qualifiedName(), cols: stmt.primaryKeyCols()}
 	_, err = createIndexStmt.execAt(ctx, tx, params)
 	if err != nil {
 		return nil, err
@@ -627,6 +744,29 @@ func (stmt *CreateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		}
 	}
 
+	if stmt.partitionBy != "" {
+		if err := table.partitionByRange(stmt.partitionBy); err != nil {
+			return nil, err
+		}
+
+		if err := persistPartitioning(tx, table); err != nil {
+			return nil, err
+		}
+	}
+
+	// foreign keys are resolved once the table and its primary key exist,
+	// so a table may reference itself
+	for id, spec := range stmt.foreignKeys {
+		fk, err := table.newForeignKey(uint32(id), spec)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := persistForeignKey(tx, table, fk); err != nil {
+			return nil, err
+		}
+	}
+
 	mappedKey := MapKey(tx.sqlPrefix(), catalogTablePrefix, EncodeID(DatabaseID), EncodeID(table.id))
 
 	err = tx.set(mappedKey, nil, []byte(table.name))
@@ -680,6 +820,12 @@ func persistColumn(tx *SQLTx, col *Column) error {
 		defaultSQL = col.defaultValue.String()
 	}
 
+	// the expression of a generated column takes the place of the default
+	if col.generated != nil {
+		hasDefault = true
+		defaultSQL = col.generated.String()
+	}
+
 	colNameBytes := []byte(col.Name())
 
 	// Column names and default expressions are hard-capped so that the
@@ -715,11 +861,24 @@ func persistColumn(tx *SQLTx, col *Column) error {
 		v[0] = v[0] | nullableFlag
 	}
 
-	if hasDefault {
+	if col.generated != nil {
+		v[0] = v[0] | generatedFlag
+	} else if hasDefault {
 		v[0] = v[0] | hasDefaultFlag
 	}
 
-	binary.BigEndian.PutUint32(v[1:], uint32(col.MaxLen()))
+	if col.virtual {
+		v[0] = v[0] | virtualFlag
+	}
+
+	maxLen := col.MaxLen()
+	if col.colType == DecimalType {
+		// DECIMAL columns keep their precision and scale in place of
+		// the fixed key length
+		maxLen = col.maxLen
+	}
+
+	binary.BigEndian.PutUint32(v[1:], uint32(maxLen))
 
 	mappedKey := MapKey(
 		tx.sqlPrefix(),
@@ -759,6 +918,61 @@ func persistCheck(tx *SQLTx, table *Table, check *CheckConstraint) error {
 	return tx.set(mappedKey, nil, val)
 }
 
+func persistForeignKey(tx *SQLTx, table *Table, fk *ForeignKey) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogForeignKeyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		EncodeID(fk.id),
+	)
+
+	name := fk.name
+
+	if len(name) == 0 || len(name) > 256 {
+		return fmt.Errorf("constraint name len: %w", ErrMaxLengthExceeded)
+	}
+
+	// {nameLen-1}{name}{onDelete}{onUpdate}{refTableID}{colCount}{colID}*{refColID}*
+	val := make([]byte, 1+len(name)+2+EncIDLen+1+2*len(fk.colIDs)*EncIDLen)
+
+	val[0] = byte(len(name) - 1)
+	off := 1 + copy(val[1:], []byte(name))
+
+	val[off] = byte(fk.onDelete)
+	val[off+1] = byte(fk.onUpdate)
+	off += 2
+
+	binary.BigEndian.PutUint32(val[off:], fk.refTableID)
+	off += EncIDLen
+
+	val[off] = byte(len(fk.colIDs))
+	off++
+
+	for _, colID := range fk.colIDs {
+		binary.BigEndian.PutUint32(val[off:], colID)
+		off += EncIDLen
+	}
+
+	for _, colID := range fk.refColIDs {
+		binary.BigEndian.PutUint32(val[off:], colID)
+		off += EncIDLen
+	}
+
+	return tx.set(mappedKey, nil, val)
+}
+
+func persistForeignKeyDeletion(ctx context.Context, tx *SQLTx, tableID uint32, fkID uint32) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogForeignKeyPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(tableID),
+		EncodeID(fkID),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
 func persistView(tx *SQLTx, viewName string, sqlText string) error {
 	mappedKey := MapKey(
 		tx.sqlPrefix(),
@@ -812,6 +1026,9 @@ type ColSpec struct {
 	notNull       bool
 	primaryKey    bool
 	defaultValue  ValueExp
+	generated     ValueExp
+	virtual       bool
+	references    *ForeignKeyConstraint // set by an inline REFERENCES clause
 }
 
 func NewColSpec(name string, colType SQLValueType, maxLen int, autoIncrement bool, notNull bool) *ColSpec {
@@ -829,7 +1046,11 @@ type CreateIndexStmt struct {
 	ifNotExists bool
 	table       string
 	cols        []string
-	predicate   ValueExp // WHERE clause for partial indexes (nil = full index)
+	exps        []ValueExp // expressions of the index, by position (nil = only columns)
+	predicate   ValueExp   // WHERE clause for partial indexes (nil = full index)
+	fullText    bool       // USING FULLTEXT
+
+	partitionIndex bool // set when created along with the index of the partitioned table
 }
 
 func NewCreateIndexStmt(table string, cols []string, isUnique bool) *CreateIndexStmt {
@@ -849,6 +1070,10 @@ func (stmt *CreateIndexStmt) inferParameters(ctx context.Context, tx *SQLTx, par
 }
 
 func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	if stmt.fullText {
+		return stmt.execFullTextAt(ctx, tx)
+	}
+
 	if len(stmt.cols) < 1 {
 		return nil, ErrIllegalArguments
 	}
@@ -862,12 +1087,39 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	if err := stmt.checkPartitioning(table); err != nil {
+		return nil, err
+	}
+
 	colIDs := make([]uint32, len(stmt.cols))
 
 	indexKeyLen := 0
 
+	// expression columns created by the statement, discarded if it fails
+	var expCols []*Column
+	created := false
+
+	defer func() {
+		if !created {
+			for _, col := range expCols {
+				delete(table.expCols, col.id)
+			}
+		}
+	}()
+
 	for i, colName := range stmt.cols {
-		col, err := table.GetColumnByName(colName)
+		var col *Column
+
+		if i < len(stmt.exps) && stmt.exps[i] != nil {
+			n := len(table.expCols)
+
+			col, err = table.indexExpColumn(stmt.exps[i])
+			if err == nil && len(table.expCols) > n {
+				expCols = append(expCols, col)
+			}
+		} else {
+			col, err = table.GetColumnByName(colName)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -876,6 +1128,22 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 			return nil, ErrCannotIndexJson
 		}
 
+		if col.virtual {
+			return nil, fmt.Errorf("%w (%s)", ErrCannotIndexVirtualColumn, col.colName)
+		}
+
+		if col.IsGenerated() && table.primaryIndex == nil {
+			return nil, fmt.Errorf("%w: column '%s' can not be part of the primary key", ErrInvalidGeneratedColumn, col.colName)
+		}
+
+		if IsArrayType(col.Type()) {
+			return nil, fmt.Errorf("%w (%s)", ErrCannotIndexArray, col.colName)
+		}
+
+		if col.Type() == IntervalType {
+			return nil, fmt.Errorf("%w (%s)", ErrCannotIndexInterval, col.colName)
+		}
+
 		if variableSizedType(col.colType) && !tx.engine.lazyIndexConstraintValidation && (col.MaxLen() == 0 || col.MaxLen() > MaxKeyLen) {
 			return nil, fmt.Errorf("%w: can not create index using column '%s'. Max key length for variable columns is %d", ErrLimitedKeyType, col.colName, MaxKeyLen)
 		}
@@ -889,6 +1157,12 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, fmt.Errorf("%w: can not create index using columns '%v'. Max key length is %d", ErrLimitedKeyType, stmt.cols, MaxKeyLen)
 	}
 
+	if len(expCols) > 0 && table.primaryIndex != nil {
+		if err := tx.validateIndexExps(ctx, table, expCols); err != nil {
+			return nil, err
+		}
+	}
+
 	if stmt.unique && table.primaryIndex != nil {
 		// check table is empty
 		pkPrefix := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id))
@@ -911,6 +1185,13 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	for _, col := range expCols {
+		if err := persistIndexExpColumn(tx, table, col); err != nil {
+			return nil, err
+		}
+	}
+	created = true
+
 	// Set predicate for partial indexes
 	if stmt.predicate != nil {
 		index.predicate = stmt.predicate
@@ -923,7 +1204,7 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 	encodedValues := make([]byte, 1+len(index.cols)*colSpecLen)
 
 	if index.IsUnique() {
-		encodedValues[0] = 1
+		encodedValues[0] = uniqueIndexFlag
 	}
 
 	for i, col := range index.cols {
@@ -937,6 +1218,19 @@ func (stmt *CreateIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	// partitions keep the indexes of their partitioned table
+	for _, partition := range table.partitions() {
+		idxStmt := &CreateIndexStmt{
+			unique:         stmt.unique,
+			table:          partition.qualifiedName(),
+			cols:           stmt.cols,
+			partitionIndex: true,
+		}
+		if _, err := idxStmt.execAt(ctx, tx, params); err != nil {
+			return nil, err
+		}
+	}
+
 	tx.mutatedCatalog = true
 
 	return tx, nil
@@ -969,6 +1263,14 @@ func (stmt *AddColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	if err := checkNotPartitioned(table); err != nil {
+		return nil, err
+	}
+
+	if stmt.colSpec.references != nil {
+		return nil, fmt.Errorf("%w: foreign keys can only be defined when creating the table", ErrInvalidForeignKey)
+	}
+
 	col, err := table.newColumn(stmt.colSpec)
 	if err != nil {
 		return nil, err
@@ -979,6 +1281,13 @@ func (stmt *AddColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	if col.IsGenerated() && !col.virtual {
+		err = tx.backfillGeneratedColumn(ctx, table, col)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tx.mutatedCatalog = true
 
 	return tx, nil
@@ -1014,7 +1323,7 @@ func (stmt *RenameTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		EncodeID(DatabaseID),
 		EncodeID(table.id),
 	)
-	err = tx.set(mappedKey, nil, []byte(stmt.newName))
+	err = tx.set(mappedKey, nil, []byte(table.name))
 	if err != nil {
 		return nil, err
 	}
@@ -1052,6 +1361,10 @@ func (stmt *RenameColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[
 		return nil, err
 	}
 
+	if err := checkNotPartitioned(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.renameColumn(stmt.oldName, stmt.newName)
 	if err != nil {
 		return nil, err
@@ -1094,6 +1407,10 @@ func (stmt *DropColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		return nil, err
 	}
 
+	if err := checkNotPartitioned(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.GetColumnByName(stmt.colName)
 	if err != nil {
 		return nil, err
@@ -1138,6 +1455,27 @@ func canDropColumn(tx *SQLTx, table *Table, col *Column) error {
 			return err
 		}
 	}
+
+	cols := make(map[string]ColDescriptor, len(colSpecs))
+	for _, cs := range colSpecs {
+		desc := ColDescriptor{Table: table.name, Column: cs.colName, Type: cs.colType}
+		cols[desc.Selector()] = desc
+	}
+
+	for name, policy := range table.policies {
+		err := policy.exp.requiresType(BooleanType, cols, make(map[string]SQLValueType), table.name)
+		if errors.Is(err, ErrColumnDoesNotExist) {
+			return fmt.Errorf("%w %s because %s policy requires it", ErrCannotDropColumn, col.Name(), name)
+		}
+	}
+
+	for name, trigger := range table.triggers {
+		for _, stmt := range trigger.body {
+			if set, ok := stmt.(*SetNewValueStmt); ok && set.col == col.colName {
+				return fmt.Errorf("%w %s because %s trigger requires it", ErrCannotDropColumn, col.Name(), name)
+			}
+		}
+	}
 	return nil
 }
 
@@ -1167,6 +1505,8 @@ type AlterColumnStmt struct {
 	colName string
 	action  AlterColumnAction
 	newType SQLValueType
+	typeMod int
+	using   ValueExp
 }
 
 func (stmt *AlterColumnStmt) readOnly() bool                     { return false }
@@ -1182,6 +1522,10 @@ func (stmt *AlterColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		return nil, err
 	}
 
+	if err := checkNotPartitioned(table); err != nil {
+		return nil, err
+	}
+
 	col, err := table.GetColumnByName(stmt.colName)
 	if err != nil {
 		return nil, err
@@ -1201,7 +1545,12 @@ func (stmt *AlterColumnStmt) execAt(ctx context.Context, tx *SQLTx, params map[s
 		}
 		col.notNull = false
 	case AlterColumnSetType:
-		col.colType = stmt.newType
+		// rows are rewritten in the background, the column keeps its
+		// current type until all of them hold the converted value
+		err := tx.alterColumnType(ctx, table, col, stmt.newType, stmt.typeMod, stmt.using)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	tx.mutatedCatalog = true
@@ -1227,6 +1576,19 @@ func (stmt *DropConstraintStmt) execAt(ctx context.Context, tx *SQLTx, params ma
 		return nil, err
 	}
 
+	if _, isForeignKey := table.foreignKeys[stmt.constraintName]; isForeignKey {
+		id, err := table.deleteForeignKey(stmt.constraintName)
+		if err != nil {
+			return nil, err
+		}
+
+		err = persistForeignKeyDeletion(ctx, tx, table.id, id)
+
+		tx.mutatedCatalog = true
+
+		return tx, err
+	}
+
 	id, err := table.deleteCheck(stmt.constraintName)
 	if err != nil {
 		return nil, err
@@ -1352,9 +1714,21 @@ func (stmt *UpsertIntoStmt) validate(table *Table) (map[uint32]int, error) {
 			return nil, fmt.Errorf("%w (%s)", ErrDuplicatedColumn, col.colName)
 		}
 
+		if col.IsGenerated() {
+			return nil, fmt.Errorf("%w (%s)", ErrCannotWriteGeneratedColumn, col.colName)
+		}
+
 		selPosByColID[col.id] = i
 	}
 
+	if stmt.onConflict != nil {
+		for _, u := range stmt.onConflict.updates {
+			if col, exists := table.colsByName[u.col]; exists && col.IsGenerated() {
+				return nil, fmt.Errorf("%w (%s)", ErrCannotWriteGeneratedColumn, col.colName)
+			}
+		}
+	}
+
 	return selPosByColID, nil
 }
 
@@ -1366,17 +1740,30 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 	// which is how Gitea's per-repo issue counter (a repeated UPSERT
 	// RETURNING max_index) ended up returning `1` for every issue.
 	stmt.returnedRows = nil
+	tx.pendingFKChecks = nil
 
 	table, err := stmt.tableRef.referencedTable(tx)
 	if err != nil {
 		return nil, err
 	}
 
+	hasForeignKeys := len(table.foreignKeys) > 0 || len(tx.catalog.referencingForeignKeys(table)) > 0
+
 	selPosByColID, err := stmt.validate(table)
 	if err != nil {
 		return nil, err
 	}
 
+	err = checkNotMaterializedView(table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stmt.checkPrivileges(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	r := &Row{
 		ValuesByPosition: make([]TypedValue, len(table.cols)),
 		ValuesBySelector: make(map[string]TypedValue),
@@ -1406,6 +1793,10 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		var pkMustExist bool
 
 		for colID, col := range table.colsByID {
+			if col.IsGenerated() {
+				continue
+			}
+
 			colPos, specified := selPosByColID[colID]
 			if !specified {
 				// Use default value if defined
@@ -1414,6 +1805,10 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 					if err != nil {
 						return nil, fmt.Errorf("error evaluating default for column '%s': %w", col.colName, err)
 					}
+					defVal, err = normalizeColumnValue(col, defVal)
+					if err != nil {
+						return nil, err
+					}
 					if !defVal.IsNull() {
 						valuesByColID[colID] = defVal
 					}
@@ -1454,6 +1849,11 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 				return nil, err
 			}
 
+			rval, err = normalizeColumnValue(col, rval)
+			if err != nil {
+				return nil, err
+			}
+
 			if rval.IsNull() {
 				if col.notNull || col.autoIncrement {
 					return nil, fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
@@ -1480,22 +1880,14 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			valuesByColID[colID] = rval
 		}
 
-		for i, col := range table.cols {
-			v := valuesByColID[col.id]
-
-			if v == nil {
-				v = NewNull(AnyType)
-			} else if len(table.checkConstraints) > 0 && col.Type() == JSONType {
-				s, _ := v.RawValue().(string)
-				jsonVal, err := NewJsonFromString(s)
-				if err != nil {
-					return nil, err
-				}
-				v = jsonVal
-			}
+		err = table.computeGeneratedValues(tx, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
 
-			r.ValuesByPosition[i] = v
-			r.ValuesBySelector[EncodeSelector("", table.name, col.colName)] = v
+		err = setRowValues(r, table, valuesByColID)
+		if err != nil {
+			return nil, err
 		}
 
 		if err := checkConstraints(tx, table.checkConstraints, r, table.name); err != nil {
@@ -1507,8 +1899,14 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			return nil, err
 		}
 
+		// rows of a partitioned table are stored in the partition holding them
+		rowTable, err := table.partitionFor(valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		// pk entry
-		mappedPKey := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals, pkEncVals)
+		mappedPKey := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(rowTable.id), EncodeID(rowTable.primaryIndex.id), pkEncVals, pkEncVals)
 		if len(mappedPKey) > MaxKeyLen {
 			return nil, ErrMaxKeyLengthExceeded
 		}
@@ -1522,6 +1920,8 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			return nil, fmt.Errorf("%w: specified value must be greater than current one", ErrInvalidValue)
 		}
 
+		pkExists := err == nil
+
 		if stmt.isInsert {
 			if err == nil && stmt.onConflict == nil {
 				return nil, store.ErrKeyAlreadyExists
@@ -1582,6 +1982,11 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 						return nil, err
 					}
 
+					rval, err = normalizeColumnValue(col, rval)
+					if err != nil {
+						return nil, err
+					}
+
 					valuesByColID[col.id] = rval
 
 					// update row representation for check constraints
@@ -1593,7 +1998,76 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 						}
 					}
 				}
+
+				if table.hasGeneratedColumns() {
+					err = table.computeGeneratedValues(tx, valuesByColID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = setRowValues(r, table, valuesByColID)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+
+		event := SQLPrivilegeInsert
+		if pkExists {
+			event = SQLPrivilegeUpdate
+		}
+
+		var currValuesByColID map[uint32]TypedValue
+
+		restricted := pkExists && tx.policyCondition(table, SQLPrivilegeUpdate) != nil
+
+		if pkExists && (hasForeignKeys || table.hasTriggers(event) || restricted) {
+			currRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
+			if err != nil {
+				return nil, err
 			}
+
+			// overwriting a row is an update of it
+			if restricted {
+				if err := tx.checkOverwrittenRowPolicies(table, currRow); err != nil {
+					return nil, err
+				}
+			}
+
+			currValuesByColID = table.valuesByColID(currRow)
+		}
+
+		changed, err := tx.fireTriggers(ctx, table, TriggerBefore, event, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		if changed {
+			err = table.computeGeneratedValues(tx, valuesByColID)
+			if err != nil {
+				return nil, err
+			}
+
+			err = setRowValues(r, table, valuesByColID)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := checkConstraints(tx, table.checkConstraints, r, table.name); err != nil {
+				return nil, err
+			}
+		}
+
+		if restricted {
+			if err := tx.checkPolicies(table, SQLPrivilegeUpdate, r); err != nil {
+				return nil, err
+			}
+		}
+
+		err = tx.checkForeignKeys(table, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
 		}
 
 		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, !stmt.isInsert)
@@ -1601,6 +2075,18 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 			return nil, err
 		}
 
+		if currValuesByColID != nil && hasForeignKeys {
+			err = tx.onReferencedRowUpdate(ctx, table, currValuesByColID, valuesByColID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = tx.fireTriggers(ctx, table, TriggerAfter, event, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		// Capture row for RETURNING clause
 		capturedRow := &Row{
 			ValuesByPosition: make([]TypedValue, len(r.ValuesByPosition)),
@@ -1612,9 +2098,38 @@ func (stmt *UpsertIntoStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		}
 		stmt.returnedRows = append(stmt.returnedRows, capturedRow)
 	}
+
+	err = tx.validateForeignKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return tx, nil
 }
 
+// setRowValues sets the values of row to the ones of the table columns
+// in valuesByColID, as expected by check constraints.
+func setRowValues(row *Row, table *Table, valuesByColID map[uint32]TypedValue) error {
+	for i, col := range table.cols {
+		v := valuesByColID[col.id]
+
+		if v == nil {
+			v = NewNull(AnyType)
+		} else if len(table.checkConstraints) > 0 && col.Type() == JSONType {
+			s, _ := v.RawValue().(string)
+			jsonVal, err := NewJsonFromString(s)
+			if err != nil {
+				return err
+			}
+			v = jsonVal
+		}
+
+		row.ValuesByPosition[i] = v
+		row.ValuesBySelector[EncodeSelector("", table.name, col.colName)] = v
+	}
+	return nil
+}
+
 func checkConstraints(tx *SQLTx, checks map[string]CheckConstraint, row *Row, table string) error {
 	for _, check := range checks {
 		val, err := check.exp.reduce(tx, row, table)
@@ -1636,14 +2151,31 @@ func checkConstraints(tx *SQLTx, checks map[string]CheckConstraint, row *Row, ta
 func (tx *SQLTx) encodeRowValue(valuesByColID map[uint32]TypedValue, table *Table) ([]byte, error) {
 	valbuf := bytes.Buffer{}
 
-	// null values are not serialized
+	// while the type of a column is being changed, its converted value is
+	// written under the shadow column of the migration
+	var migration *columnMigration
+	var migratedVal TypedValue
+
+	if migration = table.activeColumnMigration(); migration != nil {
+		v, err := tx.migratedValue(table, migration, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+		migratedVal = v
+	}
+
+	// null values and virtual columns are not serialized
 	encodedVals := 0
-	for _, v := range valuesByColID {
-		if !v.IsNull() {
+	for _, col := range table.cols {
+		if v, ok := valuesByColID[col.id]; ok && !v.IsNull() && !col.virtual {
 			encodedVals++
 		}
 	}
 
+	if migratedVal != nil && !migratedVal.IsNull() {
+		encodedVals++
+	}
+
 	b := make([]byte, EncLenLen)
 	binary.BigEndian.PutUint32(b, uint32(encodedVals))
 
@@ -1654,7 +2186,7 @@ func (tx *SQLTx) encodeRowValue(valuesByColID map[uint32]TypedValue, table *Tabl
 
 	for _, col := range table.cols {
 		rval, specified := valuesByColID[col.id]
-		if !specified || rval.IsNull() {
+		if !specified || rval.IsNull() || col.virtual {
 			continue
 		}
 
@@ -1677,25 +2209,63 @@ func (tx *SQLTx) encodeRowValue(valuesByColID map[uint32]TypedValue, table *Tabl
 		}
 	}
 
+	if migratedVal != nil && !migratedVal.IsNull() {
+		b := make([]byte, EncIDLen)
+		binary.BigEndian.PutUint32(b, migration.shadowID)
+
+		encVal, err := EncodeValue(migratedVal, migration.toType, migration.maxLen())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w: table: %s, column: %s", ErrCannotAlterColumnType, err, table.name, migration.colName)
+		}
+
+		_, err = valbuf.Write(b)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = valbuf.Write(encVal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return valbuf.Bytes(), nil
 }
 
 func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID map[uint32]TypedValue, table *Table, reuseIndex bool) error {
+	err := tx.trackWrittenRow(table, pkEncVals, valuesByColID)
+	if err != nil {
+		return err
+	}
+
+	table, err = table.partitionFor(valuesByColID)
+	if err != nil {
+		return err
+	}
+
+	err = tx.trackWrittenRow(table, pkEncVals, valuesByColID)
+	if err != nil {
+		return err
+	}
+
 	var reusableIndexEntries map[uint32]struct{}
+	var currValuesByColID map[uint32]TypedValue
 
-	if reuseIndex && len(table.indexes) > 1 {
+	if reuseIndex && (len(table.indexes) > 1 || len(table.fullTextIndexes) > 0) {
 		currPKRow, err := tx.fetchPKRow(ctx, table, valuesByColID)
 		if err == nil {
-			currValuesByColID := make(map[uint32]TypedValue, len(currPKRow.ValuesBySelector))
+			currValuesByColID = make(map[uint32]TypedValue, len(currPKRow.ValuesBySelector))
 
 			for _, col := range table.cols {
 				encSel := EncodeSelector("", table.name, col.colName)
 				currValuesByColID[col.id] = currPKRow.ValuesBySelector[encSel]
 			}
 
-			reusableIndexEntries, err = tx.deprecateIndexEntries(pkEncVals, currValuesByColID, valuesByColID, table)
-			if err != nil {
-				return err
+			if len(table.indexes) > 1 {
+				reusableIndexEntries, err = tx.deprecateIndexEntries(pkEncVals, currValuesByColID, valuesByColID, table)
+				if err != nil {
+					return err
+				}
 			}
 		} else if !errors.Is(err, ErrNoMoreRows) {
 			return err
@@ -1714,6 +2284,16 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		return err
 	}
 
+	err = tx.updateFullTextEntries(table, pkEncVals, currValuesByColID, valuesByColID)
+	if err != nil {
+		return err
+	}
+
+	indexValuesByColID, err := table.withIndexExpValues(valuesByColID)
+	if err != nil {
+		return err
+	}
+
 	// create in-memory and validate entries for secondary indexes
 	for _, index := range table.indexes {
 		if index.IsPrimary() {
@@ -1734,7 +2314,7 @@ func (tx *SQLTx) doUpsert(ctx context.Context, pkEncVals []byte, valuesByColID m
 		indexKeyLen := 0
 
 		for i, col := range index.cols {
-			rval, specified := valuesByColID[col.id]
+			rval, specified := indexValuesByColID[col.id]
 			if !specified {
 				rval = &NullValue{t: col.colType}
 			}
@@ -1816,6 +2396,14 @@ func encodedKey(index *Index, valuesByColID map[uint32]TypedValue) ([]byte, erro
 }
 
 func (tx *SQLTx) fetchPKRow(ctx context.Context, table *Table, valuesByColID map[uint32]TypedValue) (*Row, error) {
+	// a partition is read under the name of its partitioned table
+	alias := table.name
+
+	table, err := table.partitionFor(valuesByColID)
+	if err != nil {
+		return nil, err
+	}
+
 	pkRanges := make(map[uint32]*typedValueRange, len(table.primaryIndex.cols))
 
 	for _, pkCol := range table.primaryIndex.cols {
@@ -1832,7 +2420,7 @@ func (tx *SQLTx) fetchPKRow(ctx context.Context, table *Table, valuesByColID map
 		rangesByColID: pkRanges,
 	}
 
-	r, err := newRawRowReader(tx, nil, table, period{}, table.name, scanSpecs)
+	r, err := newRawRowReader(tx, nil, table, period{}, alias, scanSpecs)
 	if err != nil {
 		return nil, err
 	}
@@ -1855,6 +2443,16 @@ func (tx *SQLTx) deprecateIndexEntries(
 		return nil, err
 	}
 
+	currValuesByColID, err = table.withIndexExpValues(currValuesByColID)
+	if err != nil {
+		return nil, err
+	}
+
+	newValuesByColID, err = table.withIndexExpValues(newValuesByColID)
+	if err != nil {
+		return nil, err
+	}
+
 	reusableIndexEntries = make(map[uint32]struct{})
 
 	for _, index := range table.indexes {
@@ -1964,7 +2562,7 @@ func (stmt *UpdateStmt) inferParameters(ctx context.Context, tx *SQLTx, params m
 			Column: c.colName,
 			Type:   c.colType,
 		}
-		cols[EncodeSelector("", table.name, c.colName)] = desc
+		cols[EncodeSelector("", stmt.tableRef.Alias(), c.colName)] = desc
 		// Also key by bare column name so a parser that emits an
 		// unqualified ColSelector resolves directly.
 		cols[c.colName] = desc
@@ -1976,7 +2574,7 @@ func (stmt *UpdateStmt) inferParameters(ctx context.Context, tx *SQLTx, params m
 			return err
 		}
 
-		err = update.val.requiresType(col.colType, cols, params, table.name)
+		err = update.val.requiresType(col.colType, cols, params, stmt.tableRef.Alias())
 		if err != nil {
 			return err
 		}
@@ -2002,6 +2600,10 @@ func (stmt *UpdateStmt) validate(table *Table) error {
 			return ErrPKCanNotBeUpdated
 		}
 
+		if col.IsGenerated() {
+			return fmt.Errorf("%w (%s)", ErrCannotWriteGeneratedColumn, col.colName)
+		}
+
 		_, duplicated := colIDs[col.id]
 		if duplicated {
 			return ErrDuplicatedColumn
@@ -2015,13 +2617,16 @@ func (stmt *UpdateStmt) validate(table *Table) error {
 
 func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	stmt.returnedRows = nil // reset RETURNING capture (see UpsertIntoStmt.execAt)
+	tx.pendingFKChecks = nil
 
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
-		where:   stmt.where,
-		indexOn: stmt.indexOn,
-		limit:   stmt.limit,
-		offset:  stmt.offset,
+		ds:                stmt.tableRef,
+		where:             stmt.where,
+		indexOn:           stmt.indexOn,
+		limit:             stmt.limit,
+		offset:            stmt.offset,
+		privilegesChecked: true,
+		policyCommand:     SQLPrivilegeUpdate,
 	}
 
 	rowReader, err := selectStmt.Resolve(ctx, tx, params, nil)
@@ -2037,11 +2642,25 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		return nil, err
 	}
 
+	err = checkNotMaterializedView(table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stmt.checkPrivileges(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	cols, err := rowReader.colsBySelector(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// rows are read qualified by the table alias, which is the unqualified
+	// name of tables of schemas other than public
+	alias := rowReader.TableAlias()
+
 	for {
 		row, err := rowReader.Read(ctx)
 		if errors.Is(err, ErrNoMoreRows) {
@@ -2053,10 +2672,15 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		valuesByColID := make(map[uint32]TypedValue, len(row.ValuesBySelector))
 
 		for _, col := range table.cols {
-			encSel := EncodeSelector("", table.name, col.colName)
+			encSel := EncodeSelector("", alias, col.colName)
 			valuesByColID[col.id] = row.ValuesBySelector[encSel]
 		}
 
+		currValuesByColID := make(map[uint32]TypedValue, len(valuesByColID))
+		for colID, v := range valuesByColID {
+			currValuesByColID[colID] = v
+		}
+
 		for _, update := range stmt.updates {
 			col, err := table.GetColumnByName(update.col)
 			if err != nil {
@@ -2068,12 +2692,17 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 				return nil, err
 			}
 
-			rval, err := sval.reduce(tx, row, table.name)
+			rval, err := sval.reduce(tx, row, alias)
+			if err != nil {
+				return nil, err
+			}
+
+			err = rval.requiresType(col.colType, cols, nil, alias)
 			if err != nil {
 				return nil, err
 			}
 
-			err = rval.requiresType(col.colType, cols, nil, table.name)
+			rval, err = normalizeColumnValue(col, rval)
 			if err != nil {
 				return nil, err
 			}
@@ -2081,6 +2710,16 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			valuesByColID[col.id] = rval
 		}
 
+		_, err = tx.fireTriggers(ctx, table, TriggerBefore, SQLPrivilegeUpdate, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = table.computeGeneratedValues(tx, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		for i, col := range table.cols {
 			v := valuesByColID[col.id]
 
@@ -2092,13 +2731,22 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			return nil, err
 		}
 
+		if err := tx.checkPolicies(table, SQLPrivilegeUpdate, row); err != nil {
+			return nil, err
+		}
+
 		pkEncVals, err := encodedKey(table.primaryIndex, valuesByColID)
 		if err != nil {
 			return nil, err
 		}
 
+		rowTable, err := table.partitionFor(valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		// primary index entry
-		mkey := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(table.id), EncodeID(table.primaryIndex.id), pkEncVals, pkEncVals)
+		mkey := MapKey(tx.sqlPrefix(), MappedPrefix, EncodeID(rowTable.id), EncodeID(rowTable.primaryIndex.id), pkEncVals, pkEncVals)
 
 		// mkey must exist
 		_, err = tx.get(ctx, mkey)
@@ -2106,11 +2754,26 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 			return nil, err
 		}
 
+		err = tx.checkForeignKeys(table, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		err = tx.doUpsert(ctx, pkEncVals, valuesByColID, table, true)
 		if err != nil {
 			return nil, err
 		}
 
+		err = tx.onReferencedRowUpdate(ctx, table, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.fireTriggers(ctx, table, TriggerAfter, SQLPrivilegeUpdate, currValuesByColID, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
 		// Capture row for RETURNING clause
 		capturedRow := &Row{
 			ValuesByPosition: make([]TypedValue, len(row.ValuesByPosition)),
@@ -2123,6 +2786,11 @@ func (stmt *UpdateStmt) execAt(ctx context.Context, tx *SQLTx, params map[string
 		stmt.returnedRows = append(stmt.returnedRows, capturedRow)
 	}
 
+	err = tx.validateForeignKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return tx, nil
 }
 
@@ -2164,14 +2832,17 @@ func (stmt *DeleteFromStmt) inferParameters(ctx context.Context, tx *SQLTx, para
 
 func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
 	stmt.returnedRows = nil // reset RETURNING capture (see UpsertIntoStmt.execAt)
+	tx.pendingFKChecks = nil
 
 	selectStmt := &SelectStmt{
-		ds:      stmt.tableRef,
-		where:   stmt.where,
-		indexOn: stmt.indexOn,
-		orderBy: stmt.orderBy,
-		limit:   stmt.limit,
-		offset:  stmt.offset,
+		ds:                stmt.tableRef,
+		where:             stmt.where,
+		indexOn:           stmt.indexOn,
+		orderBy:           stmt.orderBy,
+		limit:             stmt.limit,
+		offset:            stmt.offset,
+		privilegesChecked: true,
+		policyCommand:     SQLPrivilegeDelete,
 	}
 
 	rowReader, err := selectStmt.Resolve(ctx, tx, params, nil)
@@ -2182,6 +2853,16 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 
 	table := rowReader.ScanSpecs().Index.table
 
+	err = checkNotMaterializedView(table)
+	if err != nil {
+		return nil, err
+	}
+
+	err = stmt.checkPrivileges(tx, table)
+	if err != nil {
+		return nil, err
+	}
+
 	for {
 		row, err := rowReader.Read(ctx)
 		if errors.Is(err, ErrNoMoreRows) {
@@ -2194,7 +2875,7 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		valuesByColID := make(map[uint32]TypedValue, len(row.ValuesBySelector))
 
 		for _, col := range table.cols {
-			encSel := EncodeSelector("", table.name, col.colName)
+			encSel := EncodeSelector("", rowReader.TableAlias(), col.colName)
 			valuesByColID[col.id] = row.ValuesBySelector[encSel]
 		}
 
@@ -2212,19 +2893,48 @@ func (stmt *DeleteFromStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 		for k, v := range row.ValuesBySelector {
 			capturedRow.ValuesBySelector[k] = v
 		}
+		for _, col := range table.cols {
+			capturedRow.ValuesBySelector[EncodeSelector("", table.name, col.colName)] = valuesByColID[col.id]
+		}
 		stmt.returnedRows = append(stmt.returnedRows, capturedRow)
 
+		_, err = tx.fireTriggers(ctx, table, TriggerBefore, SQLPrivilegeDelete, valuesByColID, nil)
+		if err != nil {
+			return nil, err
+		}
+
 		err = tx.deleteIndexEntries(pkEncVals, valuesByColID, table)
 		if err != nil {
 			return nil, err
 		}
 
+		err = tx.onReferencedRowDelete(ctx, table, valuesByColID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.fireTriggers(ctx, table, TriggerAfter, SQLPrivilegeDelete, valuesByColID, nil)
+		if err != nil {
+			return nil, err
+		}
+
 		tx.updatedRows++
 	}
+
+	err = tx.validateForeignKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return tx, nil
 }
 
 func (tx *SQLTx) deleteIndexEntries(pkEncVals []byte, valuesByColID map[uint32]TypedValue, table *Table) error {
+	table, err := table.partitionFor(valuesByColID)
+	if err != nil {
+		return err
+	}
+
 	encodedRowValue, err := tx.encodeRowValue(valuesByColID, table)
 	if err != nil {
 		return err
@@ -2261,7 +2971,7 @@ func (tx *SQLTx) deleteIndexEntries(pkEncVals []byte, valuesByColID map[uint32]T
 		}
 	}
 
-	return nil
+	return tx.updateFullTextEntries(table, pkEncVals, valuesByColID, nil)
 }
 
 type ValueExp interface {
@@ -2427,7 +3137,7 @@ func (n *NullValue) String() string {
 }
 
 func (n *NullValue) Compare(val TypedValue) (int, error) {
-	if n.t != AnyType && val.Type() != AnyType && n.t != val.Type() {
+	if n.t != AnyType && val.Type() != AnyType && n.t != val.Type() && !isArrayCoercible(n.t, val.Type()) {
 		return 0, ErrNotComparableValues
 	}
 
@@ -2510,15 +3220,14 @@ func (v *Integer) inferType(cols map[string]ColDescriptor, params map[string]SQL
 }
 
 func (v *Integer) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	// Integer literals promote to Float64 implicitly — see
+	// Integer literals promote to Float64 and Decimal implicitly — see
 	// mayApplyImplicitConversion in implicit_conversion.go. The static
 	// type check must mirror runtime coercion or `UPDATE t SET f = 10`
 	// against a Float column fails with "INTEGER can not be interpreted
 	// as type FLOAT" even though the engine would happily widen at
 	// encode time. This matters for PG dumps (DECIMAL/NUMERIC columns
-	// filled with bare integer literals) since immudb aliases
-	// DECIMAL/NUMERIC to FLOAT.
-	if t != IntegerType && t != Float64Type && t != JSONType {
+	// filled with bare integer literals).
+	if t != IntegerType && t != Float64Type && t != DecimalType && t != JSONType {
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
 	}
 	return nil
@@ -2562,7 +3271,7 @@ func (v *Integer) Compare(val TypedValue) (int, error) {
 		return -res, err
 	}
 
-	if val.Type() == Float64Type {
+	if val.Type() == Float64Type || val.Type() == DecimalType {
 		r, err := val.Compare(v)
 		return r * -1, err
 	}
@@ -2645,7 +3354,7 @@ func (v *Timestamp) Compare(val TypedValue) (int, error) {
 		return 1, nil
 	}
 
-	if val.Type() != TimestampType {
+	if val.Type() != TimestampType && val.Type() != DateType {
 		return 0, ErrNotComparableValues
 	}
 
@@ -2687,12 +3396,16 @@ func (v *Varchar) inferType(cols map[string]ColDescriptor, params map[string]SQL
 }
 
 func (v *Varchar) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	// Accept VARCHAR, JSON, TIMESTAMP, UUID. TIMESTAMP / UUID are allowed
-	// because the engine parses ISO-8601 timestamps and RFC-4122 UUID
-	// strings at conversion time. Rejecting here would make ORM clients
-	// (Rails, Django) unable to bind timestamp/UUID parameters as
-	// strings — which is the default wire format.
-	if t != VarcharType && t != JSONType && t != TimestampType && t != UUIDType {
+	// Accept VARCHAR, JSON, TIMESTAMP, UUID, DECIMAL. TIMESTAMP / UUID /
+	// DECIMAL are allowed because the engine parses ISO-8601 timestamps,
+	// RFC-4122 UUID strings and numeric literals at conversion time.
+	// Rejecting here would make ORM clients (Rails, Django) unable to
+	// bind timestamp/UUID/numeric parameters as strings — which is the
+	// default wire format.
+	// Array types are accepted in their text representation e.g. '{1,2,3}',
+	// as are dates and intervals e.g. '2024-01-31' or '7 days'.
+	if t != VarcharType && t != JSONType && t != TimestampType && t != UUIDType && t != DecimalType && !IsArrayType(t) &&
+		t != DateType && t != IntervalType {
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, VarcharType, t)
 	}
 	return nil
@@ -2747,6 +3460,11 @@ func (v *Varchar) Compare(val TypedValue) (int, error) {
 		return bytes.Compare(parsed[:], rval[:]), nil
 	}
 
+	if IsArrayType(val.Type()) || val.Type() == IntervalType {
+		res, err := val.Compare(v)
+		return -res, err
+	}
+
 	if val.Type() != VarcharType {
 		return 0, ErrNotComparableValues
 	}
@@ -3035,7 +3753,7 @@ func (v *Float64) inferType(cols map[string]ColDescriptor, params map[string]SQL
 }
 
 func (v *Float64) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if t != Float64Type && t != JSONType {
+	if t != Float64Type && t != DecimalType && t != JSONType {
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, Float64Type, t)
 	}
 	return nil
@@ -3070,7 +3788,7 @@ func (v *Float64) RawValue() interface{} {
 }
 
 func (v *Float64) Compare(val TypedValue) (int, error) {
-	if val.Type() == JSONType {
+	if val.Type() == JSONType || val.Type() == DecimalType {
 		res, err := val.Compare(v)
 		return -res, err
 	}
@@ -3100,16 +3818,109 @@ func (v *Float64) Compare(val TypedValue) (int, error) {
 	return -1, nil
 }
 
-// WindowFnExp represents a window function expression: fn(...) OVER (PARTITION BY ... ORDER BY ...)
+// WindowFnExp represents a window function expression:
+// fn(...) OVER (PARTITION BY ... ORDER BY ... [ROWS|RANGE frame]) or fn(...) OVER name
 type WindowFnExp struct {
 	fnName      string
 	params      []ValueExp
+	window      string // name of the WINDOW clause entry, until resolved
 	partitionBy []ValueExp
 	orderBy     []*OrdExp
-	alias       string // column alias for the result
+	frame       *windowFrame // nil for the default frame
+	alias       string       // column alias for the result
+}
+
+type windowFrameMode string
+
+const (
+	frameRows  windowFrameMode = "ROWS"
+	frameRange windowFrameMode = "RANGE"
+)
+
+type frameBoundType int
+
+const (
+	unboundedPreceding frameBoundType = iota
+	offsetPreceding
+	currentRow
+	offsetFollowing
+	unboundedFollowing
+)
+
+type frameBound struct {
+	kind   frameBoundType
+	offset TypedValue // set for offsetPreceding and offsetFollowing bounds
+}
+
+// windowFrame restricts the rows of the partition a window function is
+// computed over, relative to the current row
+type windowFrame struct {
+	mode  windowFrameMode
+	start frameBound
+	end   frameBound
+}
+
+func (f *windowFrame) validate(orderBy []*OrdExp) error {
+	if f.start.kind == unboundedFollowing {
+		return fmt.Errorf("%w: frame start cannot be UNBOUNDED FOLLOWING", ErrIllegalArguments)
+	}
+
+	if f.end.kind == unboundedPreceding {
+		return fmt.Errorf("%w: frame end cannot be UNBOUNDED PRECEDING", ErrIllegalArguments)
+	}
+
+	if f.end.kind < f.start.kind {
+		return fmt.Errorf("%w: frame end cannot precede frame start", ErrIllegalArguments)
+	}
+
+	for _, b := range []frameBound{f.start, f.end} {
+		if b.kind != offsetPreceding && b.kind != offsetFollowing {
+			continue
+		}
+
+		if f.mode == frameRows && b.offset.Type() != IntegerType {
+			return fmt.Errorf("%w: ROWS frame offsets must be integers", ErrIllegalArguments)
+		}
+
+		if f.mode == frameRange && len(orderBy) != 1 {
+			return fmt.Errorf("%w: RANGE frame offsets require exactly one ORDER BY expression", ErrIllegalArguments)
+		}
+	}
+	return nil
+}
+
+// resolveWindowRefs copies the definitions of the windows named in the
+// WINDOW clause of a query into the window functions referencing them
+func resolveWindowRefs(targets []TargetEntry, windows map[string]*WindowFnExp) error {
+	for _, t := range targets {
+		wfn, ok := t.Exp.(*WindowFnExp)
+		if !ok || wfn.window == "" {
+			continue
+		}
+
+		w, exists := windows[wfn.window]
+		if !exists {
+			return fmt.Errorf("window %s does not exist", wfn.window)
+		}
+
+		wfn.partitionBy = w.partitionBy
+		wfn.orderBy = w.orderBy
+		wfn.frame = w.frame
+		wfn.window = ""
+	}
+	return nil
 }
 
 func (v *WindowFnExp) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	switch v.fnName {
+	case "MIN", "MAX", "FIRST_VALUE", "LAST_VALUE", "NTH_VALUE", "LAG", "LEAD":
+		if len(v.params) > 0 {
+			t, err := v.params[0].inferType(cols, params, implicitTable)
+			if err == nil {
+				return t, nil
+			}
+		}
+	}
 	return v.resultType(), nil
 }
 
@@ -3162,7 +3973,7 @@ func (v *WindowFnExp) resultType() SQLValueType {
 	switch v.fnName {
 	case "ROW_NUMBER", "RANK", "DENSE_RANK", "COUNT", "NTILE":
 		return IntegerType
-	case "SUM", "AVG":
+	case "SUM", "AVG", "PERCENT_RANK", "CUME_DIST":
 		return Float64Type
 	default:
 		return AnyType
@@ -3244,7 +4055,8 @@ func (v *FnCall) reduceParams(tx *SQLTx, row *Row, implicitTable string) ([]Type
 func (v *FnCall) resolveFunc() (Function, error) {
 	fn, exists := builtinFunctions[strings.ToUpper(v.fn)]
 	if !exists {
-		return nil, fmt.Errorf("%w: unknown function %s", ErrIllegalArguments, v.fn)
+		// stored functions are looked up in the catalog when called
+		return &storedFunction{name: strings.ToLower(v.fn)}, nil
 	}
 	return fn, nil
 }
@@ -3270,8 +4082,9 @@ func (v *FnCall) String() string {
 }
 
 type Cast struct {
-	val ValueExp
-	t   SQLValueType
+	val     ValueExp
+	t       SQLValueType
+	typeMod int
 }
 
 func (c *Cast) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -3299,7 +4112,7 @@ func (c *Cast) substitute(params map[string]interface{}) (ValueExp, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Cast{val: val, t: c.t}, nil
+	return &Cast{val: val, t: c.t, typeMod: c.typeMod}, nil
 }
 
 func (c *Cast) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, error) {
@@ -3313,7 +4126,16 @@ func (c *Cast) reduce(tx *SQLTx, row *Row, implicitTable string) (TypedValue, er
 		return nil, err
 	}
 
-	return conv(val)
+	cval, err := conv(val)
+	if err != nil {
+		return nil, err
+	}
+
+	if d, ok := cval.(*Decimal); ok && c.typeMod != 0 {
+		return applyDecimalTypeMod(d, c.typeMod)
+	}
+
+	return cval, nil
 }
 
 func (v *Cast) selectors() []Selector {
@@ -3322,8 +4144,9 @@ func (v *Cast) selectors() []Selector {
 
 func (c *Cast) reduceSelectors(row *Row, implicitTable string) ValueExp {
 	return &Cast{
-		val: c.val.reduceSelectors(row, implicitTable),
-		t:   c.t,
+		val:     c.val.reduceSelectors(row, implicitTable),
+		t:       c.t,
+		typeMod: c.typeMod,
 	}
 }
 
@@ -3336,6 +4159,10 @@ func (c *Cast) selectorRanges(table *Table, asTable string, params map[string]in
 }
 
 func (c *Cast) String() string {
+	if c.t == DecimalType && c.typeMod != 0 {
+		precision, scale := decimalPrecisionAndScale(c.typeMod)
+		return fmt.Sprintf("CAST (%s AS %s(%d,%d))", c.val.String(), c.t, precision, scale)
+	}
 	return fmt.Sprintf("CAST (%s AS %s)", c.val.String(), c.t)
 }
 
@@ -3412,6 +4239,26 @@ func (p *Param) substitute(params map[string]interface{}) (ValueExp, error) {
 		{
 			return &Float64{val: v}, nil
 		}
+	case *Decimal:
+		{
+			return v, nil
+		}
+	case *Interval:
+		{
+			return v, nil
+		}
+	case time.Duration:
+		{
+			return intervalFromDuration(v), nil
+		}
+	case TypedValue:
+		{
+			return v, nil
+		}
+	}
+
+	if arr, ok := arrayFromSlice(val); ok {
+		return arr, nil
 	}
 	return nil, ErrUnsupportedParameter
 }
@@ -3747,6 +4594,22 @@ type SelectStmt struct {
 	limit     ValueExp
 	offset    ValueExp
 	as        string
+
+	// groupingSets holds the grouping sets of GROUP BY ROLLUP, CUBE or
+	// GROUPING SETS, in which case groupBy lists every grouped column.
+	groupingSets [][]*ColSelector
+
+	// privilegesChecked is set on scans built internally for tables whose
+	// privileges were already checked by the enclosing statement.
+	privilegesChecked bool
+
+	// joinedScan is set on scans of joined tables, which keep the
+	// pseudo-columns referenced by the enclosing statement.
+	joinedScan bool
+
+	// policyCommand selects the row-level security policies filtering the
+	// rows of a table data source, SELECT ones when empty.
+	policyCommand SQLPrivilege
 }
 
 func NewSelectStmt(
@@ -4123,12 +4986,26 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 	// here ensures "ORDER BY alias" always works regardless of entry point.
 	stmt.resolveOrderByAliases()
 
-	scanSpecs, err := stmt.genScanSpecs(tx, params)
+	err = stmt.checkPrivileges(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	rowReader, err := stmt.ds.Resolve(ctx, tx, params, scanSpecs)
+	// With statistics the planner may change the order and the strategy of
+	// the joins: q only differs from stmt in its FROM, joins and WHERE.
+	q := stmt
+
+	plan := stmt.planJoins(tx, params)
+	if plan != nil {
+		q = plan.query
+	}
+
+	scanSpecs, err := q.genScanSpecs(tx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rowReader, err := q.ds.Resolve(ctx, tx, params, scanSpecs)
 	if err != nil {
 		return nil, err
 	}
@@ -4138,15 +5015,24 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		}
 	}()
 
+	if tref, ok := q.ds.(*tableRef); ok {
+		table, tErr := tref.referencedTable(tx)
+		if tErr == nil {
+			rowReader = tx.applyPolicies(table, stmt.rowsCommand(), rowReader)
+		}
+	}
+
+	rowReader = tx.profileScan(rowReader, q.ds, len(q.indexOn) > 0)
+
 	// Effective WHERE and joins after predicate pushdown. Defaults to the
 	// AST originals; pushdownInnerOnlyConjuncts may relocate inner-only
 	// WHERE conjuncts into INNER join conds (D7) without mutating the AST.
-	effectiveWhere := stmt.where
-	effectiveJoins := stmt.joins
+	effectiveWhere := q.where
+	effectiveJoins := q.joins
 
-	if stmt.joins != nil {
+	if q.joins != nil {
 		hasFullOuter := false
-		for _, jspec := range stmt.joins {
+		for _, jspec := range q.joins {
 			if jspec.joinType == FullOuterJoin {
 				hasFullOuter = true
 				break
@@ -4157,14 +5043,16 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		// FOJ semantics make NULL-extension on either side, so an inner-only
 		// filter pushed past the join would change the result set.
 		if !hasFullOuter {
-			effectiveWhere, effectiveJoins = pushdownInnerOnlyConjuncts(stmt.where, stmt.joins)
+			effectiveWhere, effectiveJoins = pushdownInnerOnlyConjuncts(q.where, q.joins)
 		}
 
+		effectiveJoins = q.bindSystemCols(tx, effectiveJoins)
+
 		if hasFullOuter {
 			// Process joins one at a time when FULL OUTER JOIN is present
 			for _, jspec := range effectiveJoins {
 				if jspec.joinType == FullOuterJoin {
-					rightQ := &SelectStmt{ds: jspec.ds, indexOn: jspec.indexOn}
+					rightQ := &SelectStmt{ds: jspec.ds, indexOn: jspec.indexOn, privilegesChecked: true, joinedScan: true}
 					rightReader, jErr := rightQ.Resolve(ctx, tx, params, nil)
 					if jErr != nil {
 						return nil, jErr
@@ -4174,13 +5062,13 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 						rightReader.Close()
 						return nil, jErr
 					}
-					rowReader = fojReader
+					rowReader = tx.profile(fojReader, "Full Outer Join", rowReader, rightReader)
 				} else {
 					jReader, jErr := newJointRowReader(rowReader, []*JoinSpec{jspec})
 					if jErr != nil {
 						return nil, jErr
 					}
-					rowReader = jReader
+					rowReader = tx.profileJoin(jReader, rowReader)
 				}
 			}
 		} else {
@@ -4188,7 +5076,11 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 			if jErr != nil {
 				return nil, jErr
 			}
-			rowReader = jointRowReader
+			rowReader = tx.profileJoin(jointRowReader, rowReader)
+
+			if plan != nil && plan.reordered {
+				rowReader = newJoinOrderRowReader(rowReader, plan.aliases)
+			}
 		}
 	}
 
@@ -4197,22 +5089,22 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 	// decoding the row payload — the WHERE predicate (if any) is evaluated
 	// against values decoded from the index key, which is sound only when
 	// every column referenced by WHERE belongs to the chosen index.
-	if rawRdr, ok := rowReader.(*rawRowReader); ok {
+	if rawRdr, ok := unprofiled(rowReader).(*rawRowReader); ok {
 		if stmt.isCountStarShape() {
 			agg := stmt.targets[0].Exp.(*AggColSelector)
 			if effectiveWhere == nil {
-				rowReader = newCountingRowReader(rawRdr, agg)
+				rowReader = tx.profile(newCountingRowReader(rawRdr, agg), "Count", rowReader)
 				goto applyOrderBy
 			}
 			if stmt.canCountWithKeyOnly(rawRdr.scanSpecs.Index, effectiveWhere, rawRdr.tableAlias, params) {
-				rowReader = newKeyFilterCountingRowReader(rawRdr, agg, effectiveWhere)
+				rowReader = tx.profile(newKeyFilterCountingRowReader(rawRdr, agg, effectiveWhere), "Count", rowReader)
 				goto applyOrderBy
 			}
 		}
 	}
 
 	if effectiveWhere != nil {
-		rowReader = newConditionalRowReader(rowReader, effectiveWhere)
+		rowReader = tx.profile(newConditionalRowReader(rowReader, effectiveWhere), "Filter", rowReader)
 	}
 
 	if stmt.containsAggregations() || len(stmt.groupBy) > 0 {
@@ -4228,14 +5120,17 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		//   c) orderBySortExps == nil && stmt.orderBy != nil: rearrangeOrdExps
 		//      merged ORDER BY into the GROUP BY sort, which hash agg bypassed.
 		//      Re-add the sort here using the original ORDER BY expressions.
-		useHashAgg := len(scanSpecs.groupBySortExps) > 0
+		//
+		// Grouping sets always take the hash path, case (c) then sorting by
+		// the ORDER BY expressions themselves.
+		useHashAgg := len(scanSpecs.groupBySortExps) > 0 || stmt.groupingSets != nil
 
 		if useHashAgg {
-			hashGrpRdr, hErr := newHashGroupedRowReader(rowReader, allAggregations(stmt.targets), stmt.extractGroupByCols(), stmt.groupBy)
+			hashGrpRdr, hErr := newHashGroupedRowReader(rowReader, allAggregations(stmt.targets), stmt.extractGroupByCols(), stmt.groupBy, stmt.groupingSets)
 			if hErr != nil {
 				return nil, hErr
 			}
-			rowReader = hashGrpRdr
+			rowReader = tx.profile(hashGrpRdr, "Hash Aggregate", rowReader)
 
 			// Case (c): ORDER BY was merged into the GROUP BY sort by
 			// rearrangeOrdExps (stmt.orderBy set but orderBySortExps nil).
@@ -4243,11 +5138,16 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 			// groupBySortExps key, which covers the ORDER BY prefix and
 			// produces more deterministic within-group ordering.
 			if stmt.orderBy != nil && len(scanSpecs.orderBySortExps) == 0 {
-				sortRdr, sErr := newSortRowReader(rowReader, scanSpecs.groupBySortExps)
+				sortExps := scanSpecs.groupBySortExps
+				if stmt.groupingSets != nil {
+					sortExps = stmt.orderBy
+				}
+
+				sortRdr, sErr := newSortRowReader(rowReader, sortExps)
 				if sErr != nil {
 					return nil, sErr
 				}
-				rowReader = sortRdr
+				rowReader = tx.profile(sortRdr, "Sort", rowReader)
 			}
 		} else {
 			// groupBySortExps == 0: the index already provides GROUP BY order;
@@ -4257,11 +5157,11 @@ func (stmt *SelectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 			if err != nil {
 				return nil, err
 			}
-			rowReader = groupedRowReader
+			rowReader = tx.profile(groupedRowReader, "Group Aggregate", rowReader)
 		}
 
 		if stmt.having != nil {
-			rowReader = newConditionalRowReader(rowReader, stmt.having)
+			rowReader = tx.profile(newConditionalRowReader(rowReader, stmt.having), "Filter", rowReader)
 		}
 	}
 
@@ -4280,7 +5180,7 @@ applyOrderBy:
 				sortRdr.topNLimit = lv
 			}
 		}
-		rowReader = sortRdr
+		rowReader = tx.profile(sortRdr, "Sort", rowReader)
 	}
 
 	// Detect window functions in targets and wrap with windowRowReader
@@ -4303,18 +5203,29 @@ applyOrderBy:
 		if tx != nil && tx.engine != nil {
 			maxRows = tx.engine.maxWindowRows
 		}
-		winReader, wErr := newWindowRowReader(ctx, rowReader, windowFns, maxRows)
+		winReader, wErr := newWindowRowReader(ctx, rowReader, windowFns, maxRows, len(stmt.orderBy) > 0)
 		if wErr != nil {
 			return nil, wErr
 		}
-		rowReader = winReader
+		rowReader = tx.profile(winReader, "Window", rowReader)
+	}
+
+	// pseudo-columns and hidden columns are only returned when explicitly
+	// selected, while the scans of joined tables keep them for the enclosing
+	// query
+	hiddenCol := func(col ColDescriptor) bool { return false }
+	if !stmt.joinedScan {
+		shadowed, hidden := stmt.shadowedSystemCols(tx), stmt.hiddenCols(tx)
+		hiddenCol = func(col ColDescriptor) bool {
+			return hidden[col.Selector()] || (isSystemCol(col.Column) && !shadowed[col.Selector()])
+		}
 	}
 
-	projectedRowReader, err := newProjectedRowReader(ctx, rowReader, stmt.as, stmt.targets)
+	projectedRowReader, err := newProjectedRowReader(ctx, rowReader, stmt.as, stmt.targets, hiddenCol)
 	if err != nil {
 		return nil, err
 	}
-	rowReader = projectedRowReader
+	rowReader = tx.profile(projectedRowReader, "Projection", rowReader)
 
 	if stmt.distinct {
 		var distinctRowReader *distinctRowReader
@@ -4322,7 +5233,7 @@ applyOrderBy:
 		if err != nil {
 			return nil, err
 		}
-		rowReader = distinctRowReader
+		rowReader = tx.profile(distinctRowReader, "Distinct", rowReader)
 	}
 
 	if stmt.offset != nil {
@@ -4339,7 +5250,7 @@ applyOrderBy:
 				return nil, fmt.Errorf("%w: invalid offset", err)
 			}
 		} else {
-			rowReader = newOffsetRowReader(rowReader, offset)
+			rowReader = tx.profile(newOffsetRowReader(rowReader, offset), "Offset", rowReader)
 		}
 	}
 
@@ -4358,7 +5269,7 @@ applyOrderBy:
 				return nil, fmt.Errorf("%w: invalid limit", ErrIllegalArguments)
 			}
 			if limit > 0 {
-				rowReader = newLimitRowReader(rowReader, limit)
+				rowReader = tx.profile(newLimitRowReader(rowReader, limit), "Limit", rowReader)
 			}
 		}
 	}
@@ -4402,6 +5313,12 @@ func ordExpsHasPrefix(cols, prefix []*OrdExp, table string) bool {
 }
 
 func (stmt *SelectStmt) groupByOrdExps() []*OrdExp {
+	// Grouping sets are always hash-aggregated, so no scan order can
+	// stand in for their aggregation.
+	if stmt.groupingSets != nil {
+		return nil
+	}
+
 	groupByCols := stmt.groupBy
 
 	ordExps := make([]*OrdExp, 0, len(groupByCols))
@@ -4435,18 +5352,38 @@ func (stmt *SelectStmt) containsAggregations() bool {
 // columns through their inner SELECT rather than through the Selector graph,
 // so selectors() cannot enumerate them.  When present, projection pushdown
 // must be disabled for safety.
+// expandAggSelectors appends to sels the selectors referenced by the
+// arguments and FILTER clauses of the aggregations among them.
+func expandAggSelectors(sels []Selector) []Selector {
+	var args []Selector
+	for _, sel := range sels {
+		if agg, ok := sel.(*AggColSelector); ok {
+			args = append(args, agg.argSelectors()...)
+		}
+	}
+
+	if len(args) == 0 {
+		return sels
+	}
+	return append(append([]Selector(nil), sels...), args...)
+}
+
 func expContainsSubquery(exp ValueExp) bool {
 	switch e := exp.(type) {
 	case *ExistsBoolExp:
 		return true
 	case *InSubQueryExp:
 		return true
+	case *ScalarSubQueryExp:
+		return true
 	case *BinBoolExp:
 		return expContainsSubquery(e.left) || expContainsSubquery(e.right)
 	case *NotBoolExp:
 		return expContainsSubquery(e.exp)
 	case *CmpBoolExp:
 		return expContainsSubquery(e.left) || expContainsSubquery(e.right)
+	case *AggColSelector:
+		return e.filter != nil && expContainsSubquery(e.filter)
 	case *CaseWhenExp:
 		if e.exp != nil && expContainsSubquery(e.exp) {
 			return true
@@ -4470,7 +5407,7 @@ func expContainsSubquery(exp ValueExp) bool {
 // in which case projection pushdown must not be applied.
 //
 // Conservative by design: any doubt → returns (nil, true) → decode all.
-func (stmt *SelectStmt) collectNeededColIDs(table *Table, tableAlias string) (map[uint32]bool, bool) {
+func (stmt *SelectStmt) collectNeededColIDs(table *Table, tableAlias string, policyCond ValueExp) (map[uint32]bool, bool) {
 	// SELECT * is represented as an empty targets slice; the expansion to
 	// individual columns happens later in newProjectedRowReader.  Skip pushdown.
 	if len(stmt.targets) == 0 {
@@ -4492,6 +5429,13 @@ func (stmt *SelectStmt) collectNeededColIDs(table *Table, tableAlias string) (ma
 			return nil, true
 		}
 	}
+	for _, t := range stmt.targets {
+		for _, sel := range t.Exp.selectors() {
+			if agg, ok := sel.(*AggColSelector); ok && expContainsSubquery(agg) {
+				return nil, true
+			}
+		}
+	}
 
 	// Gather all ValueExp nodes whose column references must be decoded.
 	allExps := make([]ValueExp, 0, len(stmt.targets)+4)
@@ -4515,10 +5459,13 @@ func (stmt *SelectStmt) collectNeededColIDs(table *Table, tableAlias string) (ma
 			allExps = append(allExps, j.cond)
 		}
 	}
+	if policyCond != nil {
+		allExps = append(allExps, policyCond)
+	}
 
 	needed := make(map[uint32]bool)
 	for _, exp := range allExps {
-		for _, sel := range exp.selectors() {
+		for _, sel := range expandAggSelectors(exp.selectors()) {
 			var colName, colTable string
 			switch s := sel.(type) {
 			case *ColSelector:
@@ -4537,11 +5484,21 @@ func (stmt *SelectStmt) collectNeededColIDs(table *Table, tableAlias string) (ma
 			if colTable != "" && colTable != tableAlias {
 				continue // column belongs to a joined table, not this scan
 			}
+			if isSystemCol(colName) && !table.shadowsSystemCol(colName) {
+				continue // read from the entry, not decoded from the row
+			}
 			col, err := table.GetColumnByName(colName)
 			if err != nil {
 				return nil, true // unresolvable, decode all
 			}
 			needed[col.id] = true
+
+			// virtual columns are computed from the columns they use
+			if col.virtual {
+				for _, id := range table.generatedDeps(col) {
+					needed[id] = true
+				}
+			}
 		}
 	}
 	return needed, false
@@ -4560,7 +5517,7 @@ func (stmt *SelectStmt) isCountStarShape() bool {
 		return false
 	}
 	agg, ok := stmt.targets[0].Exp.(*AggColSelector)
-	return ok && agg.aggFn == COUNT && agg.col == "*" && !agg.distinct
+	return ok && agg.aggFn == COUNT && agg.col == "*" && !agg.distinct && agg.filter == nil
 }
 
 // canCountWithKeyOnly reports whether `where` can be evaluated against values
@@ -4580,7 +5537,9 @@ func (stmt *SelectStmt) canCountWithKeyOnly(idx *Index, where ValueExp, tableAli
 
 	indexedCols := make(map[string]struct{}, len(idx.cols))
 	for _, c := range idx.cols {
-		indexedCols[c.colName] = struct{}{}
+		if !c.isIndexExp() {
+			indexedCols[c.colName] = struct{}{}
+		}
 	}
 
 	for _, sel := range where.selectors() {
@@ -4652,6 +5611,153 @@ func (stmt *SelectStmt) hasTxMetadata() bool {
 	return false
 }
 
+// systemColsOf returns the pseudo-columns of the table aliased as alias
+// referenced anywhere in the statement. Unqualified references are bound to
+// the table in the FROM clause.
+func (stmt *SelectStmt) systemColsOf(alias string) []string {
+	exps := make([]ValueExp, 0, len(stmt.targets)+len(stmt.groupBy)+len(stmt.orderBy)+len(stmt.joins)+2)
+	for _, t := range stmt.targets {
+		exps = append(exps, t.Exp)
+	}
+	for _, gb := range stmt.groupBy {
+		exps = append(exps, gb)
+	}
+	for _, ob := range stmt.orderBy {
+		exps = append(exps, ob.exp)
+	}
+	for _, j := range stmt.joins {
+		exps = append(exps, j.cond)
+	}
+	exps = append(exps, stmt.where, stmt.having)
+
+	referenced := make(map[string]bool)
+	for _, exp := range exps {
+		if exp == nil {
+			continue
+		}
+
+		for _, sel := range expandAggSelectors(exp.selectors()) {
+			var col, table string
+			switch s := sel.(type) {
+			case *ColSelector:
+				col, table = s.col, s.table
+			case *AggColSelector:
+				col, table = s.col, s.table
+			default:
+				continue
+			}
+
+			if table == "" {
+				table = stmt.ds.Alias()
+			}
+
+			if isSystemCol(col) && table == alias {
+				referenced[col] = true
+			}
+		}
+	}
+
+	return orderedSystemCols(referenced)
+}
+
+// shadowedSystemCols returns the selectors of the columns named after a
+// pseudo-column in the tables read by the statement.
+func (stmt *SelectStmt) shadowedSystemCols(tx *SQLTx) map[string]bool {
+	shadowed := make(map[string]bool)
+
+	for _, ref := range stmt.tableRefs() {
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			continue
+		}
+
+		for _, col := range systemColumns {
+			if table.shadowsSystemCol(col.Column) {
+				shadowed[EncodeSelector("", ref.Alias(), col.Column)] = true
+			}
+		}
+	}
+
+	return shadowed
+}
+
+// hiddenCols returns the selectors of the hidden columns of the tables read
+// by the statement.
+func (stmt *SelectStmt) hiddenCols(tx *SQLTx) map[string]bool {
+	hidden := make(map[string]bool)
+
+	for _, ref := range stmt.tableRefs() {
+		table, err := ref.referencedTable(tx)
+		if err != nil {
+			continue
+		}
+
+		for _, col := range table.cols {
+			if col.IsHidden() {
+				hidden[EncodeSelector("", ref.Alias(), col.colName)] = true
+			}
+		}
+	}
+
+	return hidden
+}
+
+// tableRefs returns the tables read by the statement, not counting the ones
+// read by subqueries.
+func (stmt *SelectStmt) tableRefs() []*tableRef {
+	dss := []DataSource{stmt.ds}
+	for _, j := range stmt.joins {
+		dss = append(dss, j.ds)
+	}
+
+	var refs []*tableRef
+
+	for _, ds := range dss {
+		if ref, isTableRef := ds.(*tableRef); isTableRef {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// bindSystemCols returns the joins whose tables were given the pseudo-columns
+// the statement references on them, so they are read by the inner scans.
+func (stmt *SelectStmt) bindSystemCols(tx *SQLTx, joins []*JoinSpec) []*JoinSpec {
+	var bound []*JoinSpec
+
+	for i, jspec := range joins {
+		ref, isTableRef := jspec.ds.(*tableRef)
+		if !isTableRef {
+			continue
+		}
+
+		cols := stmt.systemColsOf(ref.Alias())
+		if table, err := ref.referencedTable(tx); err == nil {
+			cols = table.readableSystemCols(cols)
+		}
+		if len(cols) == 0 {
+			continue
+		}
+
+		if bound == nil {
+			bound = append([]*JoinSpec(nil), joins...)
+		}
+
+		refCopy := *ref
+		refCopy.systemCols = cols
+
+		jspecCopy := *jspec
+		jspecCopy.ds = &refCopy
+
+		bound[i] = &jspecCopy
+	}
+
+	if bound == nil {
+		return joins
+	}
+	return bound
+}
+
 func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (*ScanSpecs, error) {
 	groupByCols, orderByCols := stmt.groupByOrdExps(), stmt.orderBy
 
@@ -4667,7 +5773,7 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 
 	table, err := tableRef.referencedTable(tx)
 	if err != nil {
-		if tx.engine.tableResolveFor(tableRef.table) != nil {
+		if tx.engine.tableResolveFor(tx.resolveViewName(tableRef.table)) != nil {
 			return &ScanSpecs{
 				groupBySortExps: groupByCols,
 				orderBySortExps: orderByCols,
@@ -4678,7 +5784,7 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 
 	rangesByColID := make(map[uint32]*typedValueRange)
 	if stmt.where != nil {
-		err = stmt.where.selectorRanges(table, tableRef.Alias(), params, rangesByColID)
+		err = foldTxStableExps(stmt.where, tx).selectorRanges(table, tableRef.Alias(), params, rangesByColID)
 		if err != nil {
 			// In a JOIN context, the outer-table selectorRanges pass can
 			// legitimately encounter unqualified column references that
@@ -4704,8 +5810,20 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 		return nil, err
 	}
 
+	// a MATCH over a fulltext index reads the rows containing the query terms
+	var fullText *fullTextScan
+	if preferredIndex == nil && stmt.where != nil && !tableRef.history && !tableRef.diff && !tableRef.period.systemTime {
+		fullText = fullTextScanFor(tx, table, tableRef.Alias(), stmt.where, params)
+	}
+
+	costBased := preferredIndex == nil && fullText == nil && table.stats != nil && !tableRef.history && !tableRef.diff
+
 	var sortingIndex *Index
-	if preferredIndex == nil {
+	if fullText != nil {
+		sortingIndex = table.primaryIndex
+	} else if costBased {
+		sortingIndex, _ = cheapestIndex(table, rangesByColID, groupByCols, orderByCols)
+	} else if preferredIndex == nil {
 		sortingIndex = stmt.selectSortingIndex(groupByCols, orderByCols, table, rangesByColID)
 	} else {
 		sortingIndex = preferredIndex
@@ -4727,18 +5845,27 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 	// history/diff scan, look for a secondary index whose leading columns are
 	// fully covered by equality ranges. This turns O(N×M) nested-loop join
 	// inner scans into O(N+M) index seeks without touching history/diff paths.
-	if sortingIndex == table.primaryIndex && !tableRef.history && !tableRef.diff {
+	if sortingIndex == table.primaryIndex && !tableRef.history && !tableRef.diff && !costBased && fullText == nil {
 		if idx := stmt.selectINLJIndex(table, rangesByColID); idx != nil {
 			sortingIndex = idx
 		}
 	}
 
+	// partitions are read one after the other, which keeps the order of
+	// the index only when it leads with the partitioning column
+	var partitions []*Table
+	keepsOrder := true
+	if table.partitioning != nil {
+		partitions = table.prunedPartitions(rangesByColID)
+		keepsOrder = len(partitions) <= 1 || sortingIndex.cols[0].id == table.partitioning.colID
+	}
+
 	var descOrder bool
-	if len(groupByCols) > 0 && sortingIndex.coversOrdCols(groupByCols, rangesByColID) {
+	if keepsOrder && len(groupByCols) > 0 && sortingIndex.coversOrdCols(groupByCols, rangesByColID) {
 		groupByCols = nil
 	}
 
-	if len(groupByCols) == 0 && len(orderByCols) > 0 && sortingIndex.coversOrdCols(orderByCols, rangesByColID) {
+	if keepsOrder && len(groupByCols) == 0 && len(orderByCols) > 0 && sortingIndex.coversOrdCols(orderByCols, rangesByColID) {
 		descOrder = orderByCols[0].descOrder
 		orderByCols = nil
 	}
@@ -4750,7 +5877,7 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 	// scans always decode everything; wildcard SELECT does too).
 	var neededColIDs map[uint32]bool
 	if !tableRef.history && !tableRef.diff && !stmt.hasTxMetadata() {
-		ids, wildcard := stmt.collectNeededColIDs(table, tableRef.Alias())
+		ids, wildcard := stmt.collectNeededColIDs(table, tableRef.Alias(), tx.policyCondition(table, stmt.rowsCommand()))
 		if !wildcard {
 			neededColIDs = ids
 		}
@@ -4762,10 +5889,13 @@ func (stmt *SelectStmt) genScanSpecs(tx *SQLTx, params map[string]interface{}) (
 		IncludeHistory:    tableRef.history,
 		IncludeDiff:       tableRef.diff,
 		IncludeTxMetadata: stmt.hasTxMetadata(),
+		systemCols:        table.readableSystemCols(tableRef.systemColsIn(stmt)),
 		DescOrder:         descOrder,
 		groupBySortExps:   groupByCols,
 		orderBySortExps:   orderByCols,
 		neededColIDs:      neededColIDs,
+		fullText:          fullText,
+		partitions:        partitions,
 	}, nil
 }
 
@@ -4887,7 +6017,7 @@ func (stmt *UnionStmt) resolveUnionAll(ctx context.Context, tx *SQLTx, params ma
 		rowReader.alias = stmt.as
 	}
 
-	return rowReader, nil
+	return tx.profile(rowReader, "Append", leftRowReader, rightRowReader), nil
 }
 
 func (stmt *UnionStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (ret RowReader, err error) {
@@ -4906,7 +6036,7 @@ func (stmt *UnionStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string
 		if err != nil {
 			return nil, err
 		}
-		rowReader = distinctReader
+		rowReader = tx.profile(distinctReader, "Distinct", rowReader)
 	}
 
 	return rowReader, nil
@@ -4958,7 +6088,11 @@ func (stmt *ExceptStmt) Resolve(ctx context.Context, tx *SQLTx, params map[strin
 		}
 	}()
 
-	return newSetOpRowReader(ctx, leftReader, rightReader, setOpExcept)
+	rowReader, err := newSetOpRowReader(ctx, leftReader, rightReader, setOpExcept)
+	if err != nil {
+		return nil, err
+	}
+	return tx.profile(rowReader, "Except", leftReader, rightReader), nil
 }
 
 // IntersectStmt implements INTERSECT set operation (rows in both left and right)
@@ -5003,12 +6137,17 @@ func (stmt *IntersectStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 		}
 	}()
 
-	return newSetOpRowReader(ctx, leftReader, rightReader, setOpIntersect)
+	rowReader, err := newSetOpRowReader(ctx, leftReader, rightReader, setOpIntersect)
+	if err != nil {
+		return nil, err
+	}
+	return tx.profile(rowReader, "Intersect", leftReader, rightReader), nil
 }
 
 // ExplainStmt returns the query plan as text rows
 type ExplainStmt struct {
-	query DataSource
+	query   DataSource
+	analyze bool // run the query and annotate the plan with its counters
 }
 
 func (stmt *ExplainStmt) readOnly() bool                     { return true }
@@ -5024,8 +6163,19 @@ func (stmt *ExplainStmt) execAt(ctx context.Context, tx *SQLTx, params map[strin
 }
 
 func (stmt *ExplainStmt) Resolve(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
-	// Build a description of the query plan
-	lines := stmt.describePlan(stmt.query, 0)
+	var lines []string
+
+	if stmt.analyze {
+		var err error
+
+		lines, err = stmt.analyzePlan(ctx, tx, params)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Build a description of the query plan
+		lines = stmt.describePlan(tx, params, stmt.query, 0)
+	}
 
 	cols := []ColDescriptor{{Column: "plan", Type: VarcharType}}
 	rows := make([][]ValueExp, len(lines))
@@ -5036,7 +6186,37 @@ func (stmt *ExplainStmt) Resolve(ctx context.Context, tx *SQLTx, params map[stri
 	return NewValuesRowReader(tx, nil, cols, true, "explain", rows)
 }
 
-func (stmt *ExplainStmt) describePlan(ds DataSource, indent int) []string {
+// analyzePlan runs the query, discarding its rows, and describes the row
+// readers it was resolved into together with their counters.
+func (stmt *ExplainStmt) analyzePlan(ctx context.Context, tx *SQLTx, params map[string]interface{}) ([]string, error) {
+	tx.queryProfile = newQueryProfile()
+	defer func() {
+		tx.queryProfile = nil
+	}()
+
+	rowReader, err := stmt.query.Resolve(ctx, tx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rowReader.Close()
+
+	rowReader = tx.profile(rowReader, "Result")
+	tx.queryProfile.building = false
+
+	for {
+		_, err := rowReader.Read(ctx)
+		if errors.Is(err, ErrNoMoreRows) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rowReader.(*profiledRowReader).node.describe(0), nil
+}
+
+func (stmt *ExplainStmt) describePlan(tx *SQLTx, params map[string]interface{}, ds DataSource, indent int) []string {
 	prefix := ""
 	for i := 0; i < indent; i++ {
 		prefix += "  "
@@ -5046,46 +6226,33 @@ func (stmt *ExplainStmt) describePlan(ds DataSource, indent int) []string {
 
 	switch s := ds.(type) {
 	case *SelectStmt:
-		scanType := "Seq Scan"
-		tableName := ""
-
-		if tr, ok := s.ds.(*tableRef); ok {
-			tableName = tr.table
-			if tr.as != "" {
-				tableName = tr.table + " " + tr.as
-			}
+		plan := s.planJoins(tx, params)
+		if plan != nil {
+			s = plan.query
 		}
 
-		if s.indexOn != nil && len(s.indexOn) > 0 {
-			scanType = fmt.Sprintf("Index Scan using (%s)", strings.Join(s.indexOn, ", "))
-		}
-
-		line := fmt.Sprintf("%s-> %s on %s", prefix, scanType, tableName)
-		lines = append(lines, line)
+		lines = append(lines, prefix+"-> "+describeScan(tx, params, s, plan))
 
 		if s.where != nil {
 			lines = append(lines, fmt.Sprintf("%s  Filter: %s", prefix, s.where.String()))
 		}
 
-		if s.joins != nil {
-			for _, j := range s.joins {
-				joinName := "Inner Join"
-				switch j.joinType {
-				case LeftJoin:
-					joinName = "Left Join"
-				case RightJoin:
-					joinName = "Right Join"
-				case CrossJoin:
-					joinName = "Cross Join"
-				case FullOuterJoin:
-					joinName = "Full Outer Join"
-				}
-				lines = append(lines, fmt.Sprintf("%s  -> %s", prefix, joinName))
-				if j.cond != nil {
-					lines = append(lines, fmt.Sprintf("%s    Cond: %s", prefix, j.cond.String()))
-				}
-				lines = append(lines, stmt.describePlan(j.ds, indent+2)...)
+		for i, j := range s.joins {
+			var step *joinPlanStep
+			if plan != nil {
+				step = plan.steps[i]
+			}
+
+			lines = append(lines, fmt.Sprintf("%s  -> %s", prefix, describeJoin(j, step)))
+			if j.cond != nil {
+				lines = append(lines, fmt.Sprintf("%s    Cond: %s", prefix, j.cond.String()))
+			}
+
+			if tr, ok := j.ds.(*tableRef); ok && step != nil && step.lookupIndex != nil {
+				lines = append(lines, fmt.Sprintf("%s    -> Index Scan using %s on %s", prefix, describeIndex(step.lookupIndex), describeTableRef(tr)))
+				continue
 			}
+			lines = append(lines, stmt.describePlan(tx, params, j.ds, indent+2)...)
 		}
 
 		if s.groupBy != nil {
@@ -5096,6 +6263,18 @@ func (stmt *ExplainStmt) describePlan(ds DataSource, indent int) []string {
 			lines = append(lines, fmt.Sprintf("%s  Group By: %s", prefix, strings.Join(cols, ", ")))
 		}
 
+		if s.groupingSets != nil {
+			sets := make([]string, len(s.groupingSets))
+			for i, set := range s.groupingSets {
+				cols := make([]string, len(set))
+				for j, g := range set {
+					cols[j] = g.String()
+				}
+				sets[i] = "(" + strings.Join(cols, ", ") + ")"
+			}
+			lines = append(lines, fmt.Sprintf("%s  Grouping Sets: %s", prefix, strings.Join(sets, ", ")))
+		}
+
 		if s.orderBy != nil {
 			cols := make([]string, len(s.orderBy))
 			for i, o := range s.orderBy {
@@ -5114,29 +6293,29 @@ func (stmt *ExplainStmt) describePlan(ds DataSource, indent int) []string {
 
 	case *UnionStmt:
 		lines = append(lines, fmt.Sprintf("%s-> Union", prefix))
-		lines = append(lines, stmt.describePlan(s.left, indent+1)...)
-		lines = append(lines, stmt.describePlan(s.right, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.left, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.right, indent+1)...)
 
 	case *ExceptStmt:
 		lines = append(lines, fmt.Sprintf("%s-> Except", prefix))
-		lines = append(lines, stmt.describePlan(s.left, indent+1)...)
-		lines = append(lines, stmt.describePlan(s.right, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.left, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.right, indent+1)...)
 
 	case *IntersectStmt:
 		lines = append(lines, fmt.Sprintf("%s-> Intersect", prefix))
-		lines = append(lines, stmt.describePlan(s.left, indent+1)...)
-		lines = append(lines, stmt.describePlan(s.right, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.left, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.right, indent+1)...)
 
 	case *CTEStmt:
 		lines = append(lines, fmt.Sprintf("%s-> CTE", prefix))
 		for _, cte := range s.ctes {
 			lines = append(lines, fmt.Sprintf("%s  CTE %s:", prefix, cte.name))
-			lines = append(lines, stmt.describePlan(cte.query, indent+2)...)
+			lines = append(lines, stmt.describePlan(tx, params, cte.query, indent+2)...)
 		}
-		lines = append(lines, stmt.describePlan(s.query, indent+1)...)
+		lines = append(lines, stmt.describePlan(tx, params, s.query, indent+1)...)
 
 	case *tableRef:
-		lines = append(lines, fmt.Sprintf("%s-> Seq Scan on %s", prefix, s.table))
+		lines = append(lines, fmt.Sprintf("%s-> Seq Scan on %s", prefix, describeTableRef(s)))
 
 	default:
 		lines = append(lines, fmt.Sprintf("%s-> Scan", prefix))
@@ -5145,6 +6324,115 @@ func (stmt *ExplainStmt) describePlan(ds DataSource, indent int) []string {
 	return lines
 }
 
+// describeScan describes how the FROM of the query is read: the index chosen
+// for it and, when the table was analyzed, the estimated rows and cost.
+func describeScan(tx *SQLTx, params map[string]interface{}, s *SelectStmt, plan *queryPlan) string {
+	tr, ok := s.ds.(*tableRef)
+	if !ok {
+		return "Seq Scan on "
+	}
+
+	// the plan of queries which can not be resolved (e.g. unknown tables or
+	// CTEs) is described without the index
+	scanSpecs, err := s.genScanSpecs(tx, params)
+	if err != nil || scanSpecs.Index == nil {
+		return "Seq Scan on " + describeTableRef(tr)
+	}
+
+	desc := describeScanSpecs(tr, scanSpecs, len(s.indexOn) > 0)
+
+	table := scanSpecs.Index.table
+	if table.stats == nil {
+		return desc
+	}
+
+	rows, cost := estimateScanSpecs(table, scanSpecs)
+	if plan != nil {
+		rows, cost = plan.scanRows, plan.scanCost
+	}
+	return fmt.Sprintf("%s (rows=%.0f cost=%.2f)", desc, rows, cost)
+}
+
+func describeJoin(j *JoinSpec, step *joinPlanStep) string {
+	var desc string
+
+	switch j.joinType {
+	case RightJoin:
+		return "Right Join"
+	case CrossJoin:
+		return "Cross Join"
+	case FullOuterJoin:
+		return "Full Outer Join"
+	}
+
+	strategy := joinStrategyIndexLoop
+	if step != nil {
+		strategy = step.strategy
+	} else if tr, ok := j.ds.(*tableRef); ok && !j.lateral && !j.natural {
+		// without statistics equi-joins are always hash joins
+		if isEquiJoinCond(j.cond, tr.Alias()) {
+			strategy = joinStrategyHash
+		}
+	}
+
+	if strategy == joinStrategyHash {
+		desc = "Hash Join"
+	} else {
+		desc = "Nested Loop Join"
+	}
+
+	if j.joinType == LeftJoin {
+		desc = "Left " + desc
+	}
+
+	if step != nil {
+		desc = fmt.Sprintf("%s (rows=%.0f cost=%.2f)", desc, step.rows, step.cost)
+	}
+	return desc
+}
+
+// describeScanSpecs describes the scan of tr by scanSpecs: a sequential scan
+// of the primary index unless another index or a range of it is used.
+func describeScanSpecs(tr *tableRef, scanSpecs *ScanSpecs, indexOn bool) string {
+	desc := describeIndexScan(tr, scanSpecs, indexOn)
+
+	if scanSpecs != nil && scanSpecs.partitions != nil {
+		desc += " (partitions: " + describePartitions(scanSpecs.partitions) + ")"
+	}
+	return desc
+}
+
+func describeIndexScan(tr *tableRef, scanSpecs *ScanSpecs, indexOn bool) string {
+	if scanSpecs == nil || scanSpecs.Index == nil || len(scanSpecs.Index.cols) == 0 {
+		return "Seq Scan on " + describeTableRef(tr)
+	}
+
+	if scanSpecs.fullText != nil {
+		return fmt.Sprintf("Full Text Scan using %s on %s", describeIndex(scanSpecs.fullText.index), describeTableRef(tr))
+	}
+
+	_, hasRange := scanSpecs.rangesByColID[scanSpecs.Index.cols[0].id]
+	if !scanSpecs.Index.IsPrimary() || hasRange || indexOn {
+		return fmt.Sprintf("Index Scan using %s on %s", describeIndex(scanSpecs.Index), describeTableRef(tr))
+	}
+	return "Seq Scan on " + describeTableRef(tr)
+}
+
+func describeIndex(index *Index) string {
+	cols := make([]string, len(index.cols))
+	for i, col := range index.cols {
+		cols[i] = col.colName
+	}
+	return "(" + strings.Join(cols, ", ") + ")"
+}
+
+func describeTableRef(tr *tableRef) string {
+	if tr.as != "" {
+		return tr.table + " " + tr.as
+	}
+	return tr.table
+}
+
 // CreateSequenceStmt creates a named sequence for auto-incrementing values
 type CreateSequenceStmt struct {
 	name       string
@@ -5160,9 +6448,14 @@ func (stmt *CreateSequenceStmt) inferParameters(ctx context.Context, tx *SQLTx,
 }
 
 func (stmt *CreateSequenceStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
-	tx.engine.CreateSequence(stmt.name, stmt.startValue, stmt.increment)
+	name, err := tx.catalog.creationName(stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.engine.CreateSequence(name, stmt.startValue, stmt.increment)
 
-	seq := tx.engine.sequences[stmt.name]
+	seq := tx.engine.sequences[name]
 	if err := persistSequence(tx, seq); err != nil {
 		return nil, err
 	}
@@ -5184,11 +6477,13 @@ func (stmt *DropSequenceStmt) inferParameters(ctx context.Context, tx *SQLTx, pa
 }
 
 func (stmt *DropSequenceStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
-	if !tx.engine.DropSequence(stmt.name) && !stmt.ifExists {
+	name := tx.resolveSequenceName(stmt.name)
+
+	if !tx.engine.DropSequence(name) && !stmt.ifExists {
 		return nil, fmt.Errorf("sequence does not exist (%s)", stmt.name)
 	}
 
-	if err := deleteSequence(ctx, tx, stmt.name); err != nil && !stmt.ifExists {
+	if err := deleteSequence(ctx, tx, name); err != nil && !stmt.ifExists {
 		return nil, err
 	}
 
@@ -5224,6 +6519,17 @@ func (stmt *ReturningStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 	// with "missing parameter" on every Param-bound INSERT/UPDATE.
 	// In that case fall back to an empty row reader with the right
 	// column descriptors so the caller can describe the result shape.
+	if table, err := stmt.resolveTable(tx); err == nil {
+		exps := make([]ValueExp, len(stmt.returning))
+		for i, t := range stmt.returning {
+			exps[i] = t.Exp
+		}
+
+		if err := checkReadPrivileges(tx, table, stmt.alias(), exps...); err != nil {
+			return nil, err
+		}
+	}
+
 	_, err := stmt.dml.execAt(ctx, tx, params)
 	if err != nil {
 		if len(params) == 0 && errors.Is(err, ErrMissingParameter) {
@@ -5232,7 +6538,7 @@ func (stmt *ReturningStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 				return nil, tErr
 			}
 			cols := stmt.buildReturnCols(table)
-			return NewValuesRowReader(tx, nil, cols, true, stmt.tableName, nil)
+			return NewValuesRowReader(tx, nil, cols, true, stmt.alias(), nil)
 		}
 		return nil, err
 	}
@@ -5255,7 +6561,7 @@ func (stmt *ReturningStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 			return nil, tErr
 		}
 		cols := stmt.buildReturnCols(table)
-		return NewValuesRowReader(tx, nil, cols, true, stmt.tableName, nil)
+		return NewValuesRowReader(tx, nil, cols, true, stmt.alias(), nil)
 	}
 
 	// Build column descriptors from captured rows and returning targets
@@ -5271,7 +6577,7 @@ func (stmt *ReturningStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 	for i, r := range capturedRows {
 		rowVals := make([]ValueExp, len(cols))
 		for j, col := range cols {
-			sel := EncodeSelector("", stmt.tableName, col.Column)
+			sel := EncodeSelector("", table.name, col.Column)
 			if v, ok := r.ValuesBySelector[sel]; ok {
 				rowVals[j] = v.(ValueExp)
 			} else {
@@ -5281,13 +6587,20 @@ func (stmt *ReturningStmt) Resolve(ctx context.Context, tx *SQLTx, params map[st
 		rows[i] = rowVals
 	}
 
-	return NewValuesRowReader(tx, nil, cols, true, stmt.tableName, rows)
+	return NewValuesRowReader(tx, nil, cols, true, stmt.alias(), rows)
 }
 
 func (stmt *ReturningStmt) resolveTable(tx *SQLTx) (*Table, error) {
 	return tx.catalog.GetTableByName(stmt.tableName)
 }
 
+// alias returns the name the returned columns are qualified with, the
+// unqualified name of the table.
+func (stmt *ReturningStmt) alias() string {
+	_, name := SplitTableName(stmt.tableName)
+	return name
+}
+
 func (stmt *ReturningStmt) buildReturnCols(table *Table) []ColDescriptor {
 	// Check for RETURNING * (star)
 	if len(stmt.returning) == 1 {
@@ -5563,7 +6876,12 @@ func (stmt *CreateViewStmt) inferParameters(ctx context.Context, tx *SQLTx, para
 }
 
 func (stmt *CreateViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
-	if tx.engine.tableResolveFor(stmt.viewName) != nil {
+	viewName, err := tx.catalog.creationName(stmt.viewName)
+	if err != nil {
+		return nil, err
+	}
+
+	if tx.engine.tableResolveFor(viewName) != nil {
 		if stmt.ifNotExists {
 			return tx, nil
 		}
@@ -5571,20 +6889,21 @@ func (stmt *CreateViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[st
 	}
 
 	// Check that the view name doesn't conflict with a real table
-	if tx.catalog != nil {
-		if _, exists := tx.catalog.tablesByName[stmt.viewName]; exists {
-			return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, stmt.viewName)
-		}
+	if _, exists := tx.catalog.tablesByName[viewName]; exists {
+		return nil, fmt.Errorf("%w (%s)", ErrTableAlreadyExists, stmt.viewName)
 	}
 
-	tx.engine.registerTableResolver(stmt.viewName, &viewResolver{
-		name:  stmt.viewName,
+	tx.engine.registerTableResolver(viewName, &viewResolver{
+		name:  viewName,
 		query: stmt.query,
 	})
 
+	// plans analysed before may have resolved the name differently
+	tx.engine.invalidateCatalogCache()
+
 	// Persist view to catalog storage so it survives restart
 	if stmt.querySQL != "" {
-		if err := persistView(tx, stmt.viewName, stmt.querySQL); err != nil {
+		if err := persistView(tx, viewName, stmt.querySQL); err != nil {
 			return nil, fmt.Errorf("failed to persist view %s: %w", stmt.viewName, err)
 		}
 	}
@@ -5606,17 +6925,20 @@ func (stmt *DropViewStmt) inferParameters(ctx context.Context, tx *SQLTx, params
 }
 
 func (stmt *DropViewStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
-	if tx.engine.tableResolveFor(stmt.viewName) == nil {
+	viewName := tx.resolveViewName(stmt.viewName)
+
+	if tx.engine.tableResolveFor(viewName) == nil {
 		if stmt.ifExists {
 			return tx, nil
 		}
 		return nil, fmt.Errorf("view does not exist (%s)", stmt.viewName)
 	}
 
-	delete(tx.engine.tableResolvers, stmt.viewName)
+	delete(tx.engine.tableResolvers, viewName)
+	tx.engine.invalidateCatalogCache()
 
 	// Remove from persistent storage (ignore errors for legacy session-scoped views)
-	_ = deleteView(ctx, tx, stmt.viewName)
+	_ = deleteView(ctx, tx, viewName)
 
 	return tx, nil
 }
@@ -5671,6 +6993,25 @@ type tableRef struct {
 	diff    bool
 	period  period
 	as      string
+
+	// systemCols are the pseudo-columns referenced by an enclosing query
+	// joining the table
+	systemCols []string
+}
+
+// systemColsIn returns the pseudo-columns to be read when the table is
+// scanned by stmt.
+func (ref *tableRef) systemColsIn(stmt *SelectStmt) []string {
+	if len(ref.systemCols) == 0 {
+		return stmt.systemColsOf(ref.Alias())
+	}
+
+	referenced := make(map[string]bool)
+	for _, col := range append(stmt.systemColsOf(ref.Alias()), ref.systemCols...) {
+		referenced[col] = true
+	}
+
+	return orderedSystemCols(referenced)
 }
 
 func (ref *tableRef) readOnly() bool {
@@ -5684,6 +7025,10 @@ func (ref *tableRef) requiredPrivileges() []SQLPrivilege {
 type period struct {
 	start *openPeriod
 	end   *openPeriod
+
+	// set by FOR SYSTEM_TIME BETWEEN, every row version which was valid at
+	// some instant of the period is read instead of the last update in it
+	systemTime bool
 }
 
 type openPeriod struct {
@@ -5819,13 +7164,19 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 			}
 			return newSystemTableRowReader(tx, table, stmt.Alias(), rows)
 		}
+		if table.partitioning != nil {
+			if stmt.diff {
+				return nil, fmt.Errorf("%w: diff queries are not supported over partitioned tables", ErrIllegalArguments)
+			}
+			return newPartitionedRowReader(tx, params, table, stmt.period, stmt.Alias(), scanSpecs)
+		}
 		if stmt.diff {
-			return newDiffRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+			return newDiffRowReader(tx, params, table, stmt.period, stmt.Alias(), scanSpecs)
 		}
-		return newRawRowReader(tx, params, table, stmt.period, stmt.as, scanSpecs)
+		return newRawRowReader(tx, params, table, stmt.period, stmt.Alias(), scanSpecs)
 	}
 
-	if resolver := tx.engine.tableResolveFor(stmt.table); resolver != nil {
+	if resolver := tx.engine.tableResolveFor(tx.resolveViewName(stmt.table)); resolver != nil {
 		return resolver.Resolve(ctx, tx, stmt.Alias())
 	}
 	return nil, err
@@ -5833,7 +7184,10 @@ func (stmt *tableRef) Resolve(ctx context.Context, tx *SQLTx, params map[string]
 
 func (stmt *tableRef) Alias() string {
 	if stmt.as == "" {
-		return stmt.table
+		// columns of schema-qualified tables are referenced by the
+		// unqualified table name
+		_, name := SplitTableName(stmt.table)
+		return name
 	}
 	return stmt.as
 }
@@ -5920,8 +7274,9 @@ type JoinSpec struct {
 	ds       DataSource
 	cond     ValueExp
 	indexOn  []string
-	natural  bool // NATURAL JOIN — condition is built at resolve time from matching column names
-	lateral  bool // LATERAL — subquery can reference columns from preceding FROM items
+	natural  bool         // NATURAL JOIN — condition is built at resolve time from matching column names
+	lateral  bool         // LATERAL — subquery can reference columns from preceding FROM items
+	strategy joinStrategy // chosen by the planner when the joined tables have statistics
 }
 
 type NullsOrder int
@@ -6051,6 +7406,12 @@ func lookupUnqualifiedCol(cols map[string]ColDescriptor, col string) (ColDescrip
 }
 
 func (sel *ColSelector) substitute(params map[string]interface{}) (ValueExp, error) {
+	// NEW and OLD rows of the statements run by triggers
+	if sel.table == triggerNewRow || sel.table == triggerOldRow {
+		if v, ok := params[EncodeSelector("", sel.table, sel.col)].(TypedValue); ok {
+			return v, nil
+		}
+	}
 	return sel, nil
 }
 
@@ -6134,6 +7495,17 @@ type AggColSelector struct {
 	col       string
 	distinct  bool
 	separator string // for STRING_AGG(col, separator)
+
+	// keySel is the key column of JSON_OBJECT_AGG(key, col).
+	keySel *ColSelector
+	// fraction is the argument of PERCENTILE_CONT and PERCENTILE_DISC.
+	fraction ValueExp
+	// withinGroup is set for ordered-set aggregations, whose column is
+	// given by WITHIN GROUP (ORDER BY col [DESC]).
+	withinGroup bool
+	desc        bool
+	// filter holds the FILTER (WHERE ...) condition of the aggregation.
+	filter ValueExp
 }
 
 func NewAggColSelector(aggFn AggregateFn, table, col string) *AggColSelector {
@@ -6148,12 +7520,87 @@ func EncodeSelector(aggFn, table, col string) string {
 	return aggFn + "(" + table + "." + col + ")"
 }
 
+func isOrderedSetAggregation(aggFn AggregateFn) bool {
+	return aggFn == PERCENTILE_CONT || aggFn == PERCENTILE_DISC || aggFn == MODE
+}
+
+func isStatisticalAggregation(aggFn AggregateFn) bool {
+	switch aggFn {
+	case STDDEV, STDDEV_POP, STDDEV_SAMP, VARIANCE, VAR_POP, VAR_SAMP:
+		return true
+	}
+	return false
+}
+
+// validate checks the arguments of the aggregation against the ones its
+// function takes.
+func (sel *AggColSelector) validate() error {
+	if sel.col == "*" && sel.aggFn != COUNT {
+		return fmt.Errorf("%w: %s(*) is not supported", ErrIllegalArguments, sel.aggFn)
+	}
+
+	if isOrderedSetAggregation(sel.aggFn) != sel.withinGroup {
+		if sel.withinGroup {
+			return fmt.Errorf("%w: %s does not support WITHIN GROUP", ErrIllegalArguments, sel.aggFn)
+		}
+		return fmt.Errorf("%w: %s requires WITHIN GROUP (ORDER BY ...)", ErrIllegalArguments, sel.aggFn)
+	}
+
+	if (sel.aggFn == PERCENTILE_CONT || sel.aggFn == PERCENTILE_DISC) != (sel.fraction != nil) {
+		return fmt.Errorf("%w: wrong number of arguments for %s", ErrIllegalArguments, sel.aggFn)
+	}
+
+	if (sel.aggFn == JSON_OBJECT_AGG) != (sel.keySel != nil) {
+		return fmt.Errorf("%w: wrong number of arguments for %s", ErrIllegalArguments, sel.aggFn)
+	}
+	return nil
+}
+
+// aggKey is the aggregate function part of the selector, which tells apart
+// aggregations of the same column with different arguments or clauses.
+func (sel *AggColSelector) aggKey() string {
+	key := sel.aggFn
+	if sel.distinct {
+		key += " DISTINCT"
+	}
+	if sel.keySel != nil {
+		key += " " + EncodeSelector("", sel.keySel.table, sel.keySel.col)
+	}
+	if sel.fraction != nil {
+		key += " " + sel.fraction.String()
+	}
+	if sel.desc {
+		key += " DESC"
+	}
+	if sel.filter != nil {
+		key += " FILTER " + sel.filter.String()
+	}
+	return key
+}
+
 func (sel *AggColSelector) resolve(implicitTable string) (aggFn, table, col string) {
 	table = implicitTable
 	if sel.table != "" {
 		table = sel.table
 	}
-	return sel.aggFn, table, sel.col
+	return sel.aggKey(), table, sel.col
+}
+
+// resultType returns the type of the aggregation over a column of type t.
+func (sel *AggColSelector) resultType(t SQLValueType) SQLValueType {
+	switch {
+	case sel.aggFn == COUNT:
+		return IntegerType
+	case isStatisticalAggregation(sel.aggFn), sel.aggFn == PERCENTILE_CONT:
+		return Float64Type
+	case sel.aggFn == BOOL_AND, sel.aggFn == BOOL_OR:
+		return BooleanType
+	case sel.aggFn == JSON_AGG, sel.aggFn == JSON_OBJECT_AGG:
+		return JSONType
+	case sel.aggFn == ARRAY_AGG:
+		return ArrayTypeOf(t)
+	}
+	return t
 }
 
 func (sel *AggColSelector) inferType(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
@@ -6163,21 +7610,28 @@ func (sel *AggColSelector) inferType(cols map[string]ColDescriptor, params map[s
 
 	colSelector := &ColSelector{table: sel.table, col: sel.col}
 
-	if sel.aggFn == SUM || sel.aggFn == AVG {
-		t, err := colSelector.inferType(cols, params, implicitTable)
-		if err != nil {
+	t, err := colSelector.inferType(cols, params, implicitTable)
+	if err != nil {
+		return AnyType, err
+	}
+
+	if sel.keySel != nil {
+		if _, err := sel.keySel.inferType(cols, params, implicitTable); err != nil {
 			return AnyType, err
 		}
+	}
 
-		if t != IntegerType && t != Float64Type {
+	if sel.aggFn == SUM || sel.aggFn == AVG || isStatisticalAggregation(sel.aggFn) || sel.aggFn == PERCENTILE_CONT {
+		if !IsNumericType(t) {
 			return AnyType, fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, t)
 
 		}
-
-		return t, nil
 	}
 
-	return colSelector.inferType(cols, params, implicitTable)
+	if (sel.aggFn == BOOL_AND || sel.aggFn == BOOL_OR) && t != BooleanType && t != AnyType {
+		return AnyType, fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
+	}
+	return sel.resultType(t), nil
 }
 
 func (sel *AggColSelector) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
@@ -6190,12 +7644,28 @@ func (sel *AggColSelector) requiresType(t SQLValueType, cols map[string]ColDescr
 
 	colSelector := &ColSelector{table: sel.table, col: sel.col}
 
-	if sel.aggFn == SUM || sel.aggFn == AVG {
-		if t != IntegerType && t != Float64Type {
+	switch {
+	case sel.aggFn == SUM || sel.aggFn == AVG:
+		if !IsNumericType(t) {
 			return fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, t)
 		}
+	case sel.aggFn == ARRAY_AGG:
+		if !IsArrayType(t) {
+			return fmt.Errorf("%w: ARRAY can not be interpreted as type %v", ErrInvalidTypes, t)
+		}
+		return colSelector.requiresType(ArrayElemType(t), cols, params, implicitTable)
+	case isStatisticalAggregation(sel.aggFn) || sel.aggFn == PERCENTILE_CONT ||
+		sel.aggFn == BOOL_AND || sel.aggFn == BOOL_OR ||
+		sel.aggFn == JSON_AGG || sel.aggFn == JSON_OBJECT_AGG:
+		rt, err := sel.inferType(cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+		if rt != t {
+			return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, rt, t)
+		}
+		return nil
 	}
-
 	return colSelector.requiresType(t, cols, params, implicitTable)
 }
 
@@ -6212,6 +7682,10 @@ func (sel *AggColSelector) reduce(tx *SQLTx, row *Row, implicitTable string) (Ty
 	if !ok {
 		return nil, fmt.Errorf("%w (%s)", ErrColumnDoesNotExist, sel.col)
 	}
+
+	if qv, ok := v.(*qualifiedAggValue); ok {
+		return qv.AggregatedValue, nil
+	}
 	return v, nil
 }
 
@@ -6219,6 +7693,19 @@ func (sel *AggColSelector) selectors() []Selector {
 	return []Selector{sel}
 }
 
+// argSelectors returns the selectors referenced by the aggregation besides
+// its column.
+func (sel *AggColSelector) argSelectors() []Selector {
+	var sels []Selector
+	if sel.keySel != nil {
+		sels = append(sels, sel.keySel)
+	}
+	if sel.filter != nil {
+		sels = append(sels, sel.filter.selectors()...)
+	}
+	return sels
+}
+
 func (sel *AggColSelector) reduceSelectors(row *Row, implicitTable string) ValueExp {
 	return sel
 }
@@ -6232,7 +7719,31 @@ func (sel *AggColSelector) selectorRanges(table *Table, asTable string, params m
 }
 
 func (sel *AggColSelector) String() string {
-	return sel.aggFn + "(" + sel.col + ")"
+	s := sel.aggFn + "("
+
+	if sel.withinGroup {
+		if sel.fraction != nil {
+			s += sel.fraction.String()
+		}
+		s += ") WITHIN GROUP (ORDER BY " + sel.col
+		if sel.desc {
+			s += " DESC"
+		}
+		s += ")"
+	} else {
+		if sel.distinct {
+			s += "DISTINCT "
+		}
+		if sel.keySel != nil {
+			s += sel.keySel.String() + ", "
+		}
+		s += sel.col + ")"
+	}
+
+	if sel.filter != nil {
+		s += " FILTER (WHERE " + sel.filter.String() + ")"
+	}
+	return s
 }
 
 type NumExp struct {
@@ -6246,7 +7757,7 @@ func (bexp *NumExp) inferType(cols map[string]ColDescriptor, params map[string]S
 	if err != nil {
 		return AnyType, err
 	}
-	if tleft != AnyType && tleft != IntegerType && tleft != Float64Type && tleft != JSONType {
+	if tleft != AnyType && !IsNumericType(tleft) && tleft != JSONType && !IsDateTimeType(tleft) {
 		return AnyType, fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, tleft)
 	}
 
@@ -6254,15 +7765,24 @@ func (bexp *NumExp) inferType(cols map[string]ColDescriptor, params map[string]S
 	if err != nil {
 		return AnyType, err
 	}
-	if tright != AnyType && tright != IntegerType && tright != Float64Type && tright != JSONType {
+	if tright != AnyType && !IsNumericType(tright) && tright != JSONType && !IsDateTimeType(tright) {
 		return AnyType, fmt.Errorf("%w: %v or %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, Float64Type, tright)
 	}
 
+	if IsDateTimeType(tleft) || IsDateTimeType(tright) {
+		return bexp.inferDateTimeType(tleft, tright, cols, params, implicitTable)
+	}
+
 	if tleft == IntegerType && tright == IntegerType {
 		// Both sides are integer types - the result is also integer
 		return IntegerType, nil
 	}
 
+	if tleft == DecimalType || tright == DecimalType {
+		// Decimal arithmetic absorbs integer and float operands
+		return DecimalType, nil
+	}
+
 	if tleft != AnyType && tright != AnyType {
 		// Both sides have concrete types but at least one of them is float
 		return Float64Type, nil
@@ -6272,6 +7792,38 @@ func (bexp *NumExp) inferType(cols map[string]ColDescriptor, params map[string]S
 	return AnyType, nil
 }
 
+func (bexp *NumExp) inferDateTimeType(tleft, tright SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) (SQLValueType, error) {
+	t, reqLeft, reqRight, ok := dateTimeOperatorType(bexp.op, tleft, tright)
+	if !ok {
+		return AnyType, fmt.Errorf("%w: operator %s is not defined for %v and %v", ErrInvalidTypes, NumOperatorString(bexp.op), tleft, tright)
+	}
+
+	if tleft == AnyType {
+		err := bexp.left.requiresType(reqLeft, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+
+	if tright == AnyType {
+		err := bexp.right.requiresType(reqRight, cols, params, implicitTable)
+		if err != nil {
+			return AnyType, err
+		}
+	}
+
+	return t, nil
+}
+
+// hasDateTimeOperand reports whether any of the operands is of a
+// date/time type, making the expression a date/time operation.
+func (bexp *NumExp) hasDateTimeOperand(cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) bool {
+	tleft, _ := bexp.left.inferType(cols, params, implicitTable)
+	tright, _ := bexp.right.inferType(cols, params, implicitTable)
+
+	return IsDateTimeType(tleft) || IsDateTimeType(tright)
+}
+
 func copyParams(params map[string]SQLValueType) map[string]SQLValueType {
 	ret := make(map[string]SQLValueType, len(params))
 	for k, v := range params {
@@ -6290,14 +7842,26 @@ func restoreParams(params, restore map[string]SQLValueType) {
 }
 
 func (bexp *NumExp) requiresType(t SQLValueType, cols map[string]ColDescriptor, params map[string]SQLValueType, implicitTable string) error {
-	if t != IntegerType && t != Float64Type {
+	if IsDateTimeType(t) || bexp.hasDateTimeOperand(cols, params, implicitTable) {
+		it, err := bexp.inferType(cols, params, implicitTable)
+		if err != nil {
+			return err
+		}
+
+		if it != t && !(it == DateType && t == TimestampType) {
+			return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, it, t)
+		}
+		return nil
+	}
+
+	if !IsNumericType(t) {
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
 	}
 
 	floatArgs := 2
 	paramsOrig := copyParams(params)
 	err := bexp.left.requiresType(t, cols, params, implicitTable)
-	if err != nil && t == Float64Type {
+	if err != nil && t != IntegerType {
 		restoreParams(params, paramsOrig)
 		floatArgs--
 		err = bexp.left.requiresType(IntegerType, cols, params, implicitTable)
@@ -6308,7 +7872,7 @@ func (bexp *NumExp) requiresType(t SQLValueType, cols map[string]ColDescriptor,
 
 	paramsOrig = copyParams(params)
 	err = bexp.right.requiresType(t, cols, params, implicitTable)
-	if err != nil && t == Float64Type {
+	if err != nil && t != IntegerType {
 		restoreParams(params, paramsOrig)
 		floatArgs--
 		err = bexp.right.requiresType(IntegerType, cols, params, implicitTable)
@@ -6317,7 +7881,7 @@ func (bexp *NumExp) requiresType(t SQLValueType, cols map[string]ColDescriptor,
 		return err
 	}
 
-	if t == Float64Type && floatArgs == 0 {
+	if t != IntegerType && floatArgs == 0 {
 		// Currently this case requires explicit float cast
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, IntegerType, t)
 	}
@@ -6723,6 +8287,28 @@ func coerceTypes(t1, t2 SQLValueType) (SQLValueType, bool) {
 	case (t1 == IntegerType && t2 == Float64Type) ||
 		(t1 == Float64Type && t2 == IntegerType):
 		return Float64Type, true
+	case (t1 == DecimalType && IsNumericType(t2)) ||
+		(t2 == DecimalType && IsNumericType(t1)):
+		return DecimalType, true
+	case (t1 == TimestampType && t2 == DateType) ||
+		(t1 == DateType && t2 == TimestampType):
+		// dates are promoted to the midnight timestamp of the day
+		return TimestampType, true
+	case (t1 == IntervalType && t2 == VarcharType) ||
+		(t1 == VarcharType && t2 == IntervalType):
+		// interval text representation e.g. '7 days'
+		return IntervalType, true
+	case IsArrayType(t1) && IsArrayType(t2):
+		elemType, ok := coerceTypes(ArrayElemType(t1), ArrayElemType(t2))
+		if !ok {
+			return "", false
+		}
+		return ArrayTypeOf(elemType), true
+	case IsArrayType(t1) && t2 == VarcharType:
+		// array text representation e.g. '{1,2,3}'
+		return t1, true
+	case IsArrayType(t2) && t1 == VarcharType:
+		return t2, true
 	}
 	return "", false
 }
@@ -6760,7 +8346,7 @@ func coerceTypesForCase(t1, t2 SQLValueType) (SQLValueType, bool) {
 // source type gets a Varchar converter there, add it here too.
 func isCoercibleToVarchar(t SQLValueType) bool {
 	switch t {
-	case IntegerType, Float64Type, BooleanType,
+	case IntegerType, Float64Type, DecimalType, BooleanType,
 		TimestampType, UUIDType, BLOBType:
 		return true
 	}
@@ -6852,7 +8438,7 @@ func (bexp *CmpBoolExp) isConstant() bool {
 func (bexp *CmpBoolExp) selectorRanges(table *Table, asTable string, params map[string]interface{}, rangesByColID map[uint32]*typedValueRange) error {
 	matchingFunc := func(_, right ValueExp) (*ColSelector, ValueExp, bool) {
 		s, isSel := bexp.left.(*ColSelector)
-		if isSel && s.col != revCol && bexp.right.isConstant() {
+		if isSel && (!isReservedCol(s.col) || table.shadowsSystemCol(s.col)) && bexp.right.isConstant() {
 			return s, right, true
 		}
 		return nil, nil, false
@@ -6864,7 +8450,7 @@ func (bexp *CmpBoolExp) selectorRanges(table *Table, asTable string, params map[
 	}
 
 	if !ok {
-		return nil
+		return table.indexExpRanges(bexp, asTable, params, rangesByColID)
 	}
 
 	aggFn, t, col := sel.resolve(table.name)
@@ -6891,6 +8477,11 @@ func (bexp *CmpBoolExp) selectorRanges(table *Table, asTable string, params map[
 		return err
 	}
 
+	if IsDateTimeType(rval.Type()) && !IsDateTimeType(column.colType) {
+		// left to the evaluation of the WHERE clause, which reports the type mismatch
+		return nil
+	}
+
 	return updateRangeFor(column.id, rval, bexp.op, rangesByColID)
 }
 
@@ -6921,7 +8512,7 @@ func (te *ExtractFromTimestampExp) inferType(cols map[string]ColDescriptor, para
 		return "", err
 	}
 
-	if inferredType != TimestampType &&
+	if !IsDateTimeType(inferredType) &&
 		inferredType != VarcharType &&
 		inferredType != AnyType {
 		return "", fmt.Errorf("timestamp expression must be of type %v or %v, but was: %v", TimestampType, VarcharType, inferredType)
@@ -6933,6 +8524,11 @@ func (te *ExtractFromTimestampExp) requiresType(t SQLValueType, cols map[string]
 	if t != IntegerType && t != Float64Type {
 		return fmt.Errorf("%w: %v can not be interpreted as type %v", ErrInvalidTypes, BooleanType, t)
 	}
+
+	inferredType, err := te.Exp.inferType(cols, params, implicitTable)
+	if err == nil && (inferredType == DateType || inferredType == IntervalType) {
+		return te.Exp.requiresType(inferredType, cols, params, implicitTable)
+	}
 	return te.Exp.requiresType(TimestampType, cols, params, implicitTable)
 }
 
@@ -6961,7 +8557,11 @@ func (te *ExtractFromTimestampExp) reduce(tx *SQLTx, row *Row, implicitTable str
 		return NewNull(IntegerType), nil
 	}
 
-	if t := v.Type(); t != TimestampType && t != VarcharType {
+	if iv, ok := v.(*Interval); ok {
+		return NewInteger(int64(intervalField(string(te.Field), iv))), nil
+	}
+
+	if t := v.Type(); t != TimestampType && t != DateType && t != VarcharType {
 		return nil, fmt.Errorf("%w: expected type %v but found type %v", ErrInvalidTypes, TimestampType, t)
 	}
 
@@ -7784,6 +9384,8 @@ func (stmt *FnDataSourceStmt) Alias() string {
 		}
 	case GrantsFnCall:
 		return "grants"
+	case UnnestFnCall:
+		return "unnest"
 	}
 
 	// not reachable
@@ -7824,11 +9426,62 @@ func (stmt *FnDataSourceStmt) Resolve(ctx context.Context, tx *SQLTx, params map
 		{
 			return stmt.resolveListGrants(ctx, tx, params, scanSpecs)
 		}
+	case UnnestFnCall:
+		{
+			return stmt.resolveUnnest(ctx, tx, params, scanSpecs)
+		}
 	}
 
 	return nil, fmt.Errorf("%w (%s)", ErrFunctionDoesNotExist, stmt.fnCall.fn)
 }
 
+// resolveUnnest expands an array into a set of rows, one for each
+// element, in a single column named after the function.
+func (stmt *FnDataSourceStmt) resolveUnnest(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (RowReader, error) {
+	if len(stmt.fnCall.params) != 1 {
+		return nil, fmt.Errorf("%w: function '%s' expects one parameter but %d were provided", ErrIllegalArguments, UnnestFnCall, len(stmt.fnCall.params))
+	}
+
+	exp, err := stmt.fnCall.params[0].substitute(params)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := exp.reduce(tx, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var arr *Array
+
+	switch {
+	case val.IsNull():
+		arr = NewArray(AnyType, nil)
+	case IsArrayType(val.Type()), val.Type() == VarcharType:
+		arr, err = asArray(val, AnyType)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: function '%s' expects an array but %v was provided", ErrIllegalArguments, UnnestFnCall, val.Type())
+	}
+
+	cols := []ColDescriptor{
+		{
+			Column: "unnest",
+			Type:   arr.elemType,
+		},
+	}
+
+	values := make([][]ValueExp, len(arr.vals))
+
+	for i, e := range arr.vals {
+		values[i] = []ValueExp{e}
+	}
+
+	return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), values)
+}
+
 func (stmt *FnDataSourceStmt) resolveListDatabases(ctx context.Context, tx *SQLTx, params map[string]interface{}, _ *ScanSpecs) (rowReader RowReader, err error) {
 	if len(stmt.fnCall.params) > 0 {
 		return nil, fmt.Errorf("%w: function '%s' expect no parameters but %d were provided", ErrIllegalArguments, DatabasesFnCall, len(stmt.fnCall.params))
@@ -7916,9 +9569,13 @@ func (stmt *FnDataSourceStmt) resolveShowTable(ctx context.Context, tx *SQLTx, p
 		return nil, err
 	}
 
-	values := make([][]ValueExp, len(table.cols))
+	values := make([][]ValueExp, 0, len(table.cols))
+
+	for _, c := range table.cols {
+		if c.IsHidden() {
+			continue
+		}
 
-	for i, c := range table.cols {
 		index := "NO"
 
 		indexed, err := table.IsIndexed(c.Name())
@@ -7947,14 +9604,18 @@ func (stmt *FnDataSourceStmt) resolveShowTable(ctx context.Context, tx *SQLTx, p
 			maxLen = fmt.Sprintf("(%d)", c.MaxLen())
 		}
 
-		values[i] = []ValueExp{
+		if c.Precision() > 0 {
+			maxLen = fmt.Sprintf("(%d,%d)", c.Precision(), c.Scale())
+		}
+
+		values = append(values, []ValueExp{
 			&Varchar{val: c.colName},
 			&Varchar{val: c.Type() + maxLen},
 			&Bool{val: c.IsNullable()},
 			&Varchar{val: index},
 			&Bool{val: c.IsAutoIncremental()},
 			&Bool{val: unique},
-		}
+		})
 	}
 
 	return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), values)
@@ -8056,9 +9717,13 @@ func (stmt *FnDataSourceStmt) resolveListColumns(ctx context.Context, tx *SQLTx,
 		return nil, err
 	}
 
-	values := make([][]ValueExp, len(table.cols))
+	values := make([][]ValueExp, 0, len(table.cols))
+
+	for _, c := range table.cols {
+		if c.IsHidden() {
+			continue
+		}
 
-	for i, c := range table.cols {
 		indexed, err := table.IsIndexed(c.Name())
 		if err != nil {
 			return nil, err
@@ -8072,7 +9737,7 @@ func (stmt *FnDataSourceStmt) resolveListColumns(ctx context.Context, tx *SQLTx,
 			}
 		}
 
-		values[i] = []ValueExp{
+		values = append(values, []ValueExp{
 			&Varchar{val: table.name},
 			&Varchar{val: c.colName},
 			&Varchar{val: c.colType},
@@ -8082,7 +9747,7 @@ func (stmt *FnDataSourceStmt) resolveListColumns(ctx context.Context, tx *SQLTx,
 			&Bool{val: indexed},
 			&Bool{val: table.PrimaryIndex().IncludesCol(c.ID())},
 			&Bool{val: unique},
-		}
+		})
 	}
 
 	return NewValuesRowReader(tx, params, cols, true, stmt.Alias(), values)
@@ -8177,6 +9842,14 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 			Column: "privilege",
 			Type:   VarcharType,
 		},
+		{
+			Column: "table_name",
+			Type:   VarcharType,
+		},
+		{
+			Column: "column_name",
+			Type:   VarcharType,
+		},
 	}
 
 	var err error
@@ -8199,6 +9872,41 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 				values = append(values, []ValueExp{
 					&Varchar{val: user.Username()},
 					&Varchar{val: string(p)},
+					NewNull(VarcharType),
+					NewNull(VarcharType),
+				})
+			}
+		}
+	}
+
+	// privileges granted on tables, one row per column for column privileges
+	for _, table := range tx.catalog.GetTables() {
+		for _, g := range table.grants {
+			if username != "" && g.user != username {
+				continue
+			}
+
+			if len(g.colIDs) == 0 {
+				values = append(values, []ValueExp{
+					&Varchar{val: g.user},
+					&Varchar{val: string(g.privilege)},
+					&Varchar{val: table.name},
+					NewNull(VarcharType),
+				})
+				continue
+			}
+
+			for _, colID := range g.colIDs {
+				col, err := table.GetColumnByID(colID)
+				if err != nil {
+					continue
+				}
+
+				values = append(values, []ValueExp{
+					&Varchar{val: g.user},
+					&Varchar{val: string(g.privilege)},
+					&Varchar{val: table.name},
+					&Varchar{val: col.colName},
 				})
 			}
 		}
@@ -8211,7 +9919,9 @@ func (stmt *FnDataSourceStmt) resolveListGrants(ctx context.Context, tx *SQLTx,
 type DropTableStmt struct {
 	table    string
 	ifExists bool // DROP TABLE IF EXISTS: succeed silently if table does not exist
-	cascade  bool // DROP TABLE ... CASCADE: no-op in immudb (no FKs), accepted for compat
+	cascade  bool // DROP TABLE ... CASCADE: also drops foreign keys of other tables referencing this one
+
+	materializedView bool // set by DROP MATERIALIZED VIEW, the only way to drop tables storing one
 }
 
 func NewDropTableStmt(table string) *DropTableStmt {
@@ -8251,6 +9961,38 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	if table.matView != nil && !stmt.materializedView {
+		return nil, fmt.Errorf("%w: '%s' is a materialized view", ErrIllegalArguments, table.name)
+	}
+
+	// the partitions of a partitioned table are dropped along with it
+	for _, partition := range table.partitions() {
+		drop := &DropTableStmt{table: partition.qualifiedName()}
+		if _, err := drop.execAt(ctx, tx, params); err != nil {
+			return nil, err
+		}
+	}
+
+	// foreign keys of other tables referencing this one
+	for _, ref := range tx.catalog.referencingForeignKeys(table) {
+		if ref.table == table {
+			continue
+		}
+
+		if !stmt.cascade {
+			return nil, fmt.Errorf("%w: table '%s' is referenced by foreign key '%s' of table '%s'",
+				ErrForeignKeyViolation, table.name, ref.fk.name, ref.table.name)
+		}
+
+		if _, err := ref.table.deleteForeignKey(ref.fk.name); err != nil {
+			return nil, err
+		}
+
+		if err := persistForeignKeyDeletion(ctx, tx, ref.table.id, ref.fk.id); err != nil {
+			return nil, err
+		}
+	}
+
 	// delete table
 	mappedKey := MapKey(
 		tx.sqlPrefix(),
@@ -8295,7 +10037,72 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		}
 	}
 
+	// delete foreign keys
+	for _, fk := range table.foreignKeys {
+		if err := persistForeignKeyDeletion(ctx, tx, table.id, fk.id); err != nil {
+			return nil, err
+		}
+	}
+
+	// delete grants
+	if err := persistTableGrantsDeletion(ctx, tx, table); err != nil {
+		return nil, err
+	}
+
+	// delete policies
+	for name := range table.policies {
+		if err := persistPolicyDeletion(ctx, tx, table, name); err != nil {
+			return nil, err
+		}
+	}
+
+	if table.matView != nil {
+		if err := persistMaterializedViewDeletion(ctx, tx, table); err != nil {
+			return nil, err
+		}
+	}
+
+	// delete triggers
+	for name := range table.triggers {
+		if err := persistTriggerDeletion(ctx, tx, table, name); err != nil {
+			return nil, err
+		}
+	}
+
+	if table.stats != nil {
+		if err := persistTableStatsDeletion(ctx, tx, table); err != nil {
+			return nil, err
+		}
+	}
+
+	for colID := range table.expCols {
+		mappedKey := MapKey(
+			tx.sqlPrefix(),
+			catalogIndexExpPrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+			EncodeID(colID),
+		)
+		if err := tx.delete(ctx, mappedKey); err != nil {
+			return nil, err
+		}
+	}
+
 	// delete indexes
+	for _, index := range table.GetFullTextIndexes() {
+		mappedKey := MapKey(
+			tx.sqlPrefix(),
+			catalogIndexPrefix,
+			EncodeID(DatabaseID),
+			EncodeID(table.id),
+			EncodeID(index.id),
+		)
+		err = tx.delete(ctx, mappedKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, index := range table.indexes {
 		mappedKey := MapKey(
 			tx.sqlPrefix(),
@@ -8323,6 +10130,12 @@ func (stmt *DropTableStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		}
 	}
 
+	if table.partitioned() {
+		if err := persistPartitioningDeletion(ctx, tx, table); err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.catalog.deleteTable(table)
 	if err != nil {
 		return nil, err
@@ -8368,8 +10181,34 @@ func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map
 		return nil, err
 	}
 
+	if err := checkNotMaterializedView(table); err != nil {
+		return nil, err
+	}
+
+	// A partitioned table holds no rows of its own.
+	if table.partitioning != nil {
+		for _, partition := range table.partitions() {
+			truncate := &TruncateTableStmt{table: partition.qualifiedName()}
+			if _, err := truncate.execAt(ctx, tx, params); err != nil {
+				return nil, err
+			}
+		}
+		return tx, nil
+	}
+
+	// Recreating the table assigns it a fresh ID, which would leave
+	// foreign keys of other tables pointing at the dropped one.
+	for _, ref := range tx.catalog.referencingForeignKeys(table) {
+		if ref.table != table {
+			return nil, fmt.Errorf("%w: can not truncate table '%s' referenced by foreign key '%s' of table '%s'",
+				ErrForeignKeyViolation, table.name, ref.fk.name, ref.table.name)
+		}
+	}
+
 	// Capture schema before the drop mutates the in-memory catalog.
-	origCols := table.ColumnsByID()
+	// Columns are captured in id order, so that a truncated partition
+	// keeps the column ids of its partitioned table.
+	origCols := table.cols
 	colsSpec := make([]*ColSpec, 0, len(origCols))
 	for _, c := range origCols {
 		colsSpec = append(colsSpec, &ColSpec{
@@ -8379,6 +10218,8 @@ func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map
 			autoIncrement: c.autoIncrement,
 			notNull:       c.notNull,
 			defaultValue:  c.defaultValue,
+			generated:     c.generated,
+			virtual:       c.virtual,
 		})
 	}
 
@@ -8395,11 +10236,23 @@ func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map
 		checks = append(checks, CheckConstraint{name: cc.name, exp: cc.exp})
 	}
 
+	// Capture foreign keys by name; they are resolved again on re-creation.
+	foreignKeys := make([]*ForeignKeyConstraint, 0, len(table.foreignKeys))
+	for _, fk := range table.foreignKeys {
+		spec, err := tx.catalog.foreignKeyConstraintOf(table, fk)
+		if err != nil {
+			return nil, err
+		}
+		foreignKeys = append(foreignKeys, spec)
+	}
+
 	// Capture non-primary indexes to recreate after the table exists again.
 	type savedIndex struct {
 		unique    bool
 		colNames  []string
+		exps      []ValueExp
 		predicate ValueExp
+		fullText  bool
 	}
 	var savedIndexes []savedIndex
 	for _, idx := range table.indexes {
@@ -8407,24 +10260,34 @@ func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map
 			continue
 		}
 		colNames := make([]string, 0, len(idx.cols))
+		exps := make([]ValueExp, 0, len(idx.cols))
 		for _, c := range idx.cols {
 			colNames = append(colNames, c.colName)
+			exps = append(exps, c.exp)
 		}
-		savedIndexes = append(savedIndexes, savedIndex{unique: idx.unique, colNames: colNames, predicate: idx.predicate})
+		savedIndexes = append(savedIndexes, savedIndex{unique: idx.unique, colNames: colNames, exps: exps, predicate: idx.predicate})
+	}
+	for _, idx := range table.GetFullTextIndexes() {
+		savedIndexes = append(savedIndexes, savedIndex{colNames: []string{idx.cols[0].colName}, fullText: true})
 	}
 
+	// The qualified name keeps referring to the table whatever the search
+	// path is.
+	tableName := table.qualifiedName()
+
 	// Drop the existing table (metadata-only, bounded work).
-	drop := &DropTableStmt{table: stmt.table}
+	drop := &DropTableStmt{table: tableName}
 	if _, err := drop.execAt(ctx, tx, params); err != nil {
 		return nil, err
 	}
 
 	// Recreate the table with the captured schema.
 	create := &CreateTableStmt{
-		table:      stmt.table,
-		colsSpec:   colsSpec,
-		checks:     checks,
-		pkColNames: pkColNames,
+		table:      tableName,
+		colsSpec:    colsSpec,
+		checks:      checks,
+		foreignKeys: foreignKeys,
+		pkColNames:  pkColNames,
 	}
 	if _, err := create.execAt(ctx, tx, params); err != nil {
 		return nil, err
@@ -8434,22 +10297,52 @@ func (stmt *TruncateTableStmt) execAt(ctx context.Context, tx *SQLTx, params map
 	for _, si := range savedIndexes {
 		idxStmt := &CreateIndexStmt{
 			unique:    si.unique,
-			table:     stmt.table,
+			table:     tableName,
 			cols:      si.colNames,
+			exps:      si.exps,
 			predicate: si.predicate,
+			fullText:  si.fullText,
 		}
 		if _, err := idxStmt.execAt(ctx, tx, params); err != nil {
 			return nil, err
 		}
 	}
 
+	newTable, err := tx.catalog.GetTableByName(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.copyGrants(ctx, table, newTable); err != nil {
+		return nil, err
+	}
+
+	if err := tx.copyPolicies(table, newTable); err != nil {
+		return nil, err
+	}
+
+	if err := tx.copyTriggers(table, newTable); err != nil {
+		return nil, err
+	}
+
+	// A truncated partition keeps its bounds.
+	if table.partitionOf != nil {
+		newTable.setPartitionOf(table.partitionOf)
+		if err := persistPartitioning(tx, newTable); err != nil {
+			return nil, err
+		}
+	}
+
 	return tx, nil
 }
 
 // DropIndexStmt represents a statement to delete a table.
 type DropIndexStmt struct {
-	table string
-	cols  []string
+	table    string
+	cols     []string
+	fullText bool
+
+	partitionIndex bool // set when dropped along with the index of the partitioned table
 }
 
 func NewDropIndexStmt(table string, cols []string) *DropIndexStmt {
@@ -8478,15 +10371,23 @@ func (stmt *DropIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, ErrTableDoesNotExist
 	}
 
+	if stmt.fullText {
+		return stmt.execFullTextAt(ctx, tx)
+	}
+
 	table, err := tx.catalog.GetTableByName(stmt.table)
 	if err != nil {
 		return nil, err
 	}
 
+	if table.partitionOf != nil && !stmt.partitionIndex {
+		return nil, fmt.Errorf("%w: indexes of partition '%s' follow those of its partitioned table", ErrIllegalArguments, table.name)
+	}
+
 	cols := make([]*Column, len(stmt.cols))
 
 	for i, colName := range stmt.cols {
-		col, err := table.GetColumnByName(colName)
+		col, err := table.indexColumnByName(colName)
 		if err != nil {
 			return nil, err
 		}
@@ -8531,6 +10432,18 @@ func (stmt *DropIndexStmt) execAt(ctx context.Context, tx *SQLTx, params map[str
 		return nil, err
 	}
 
+	err = tx.deleteUnusedIndexExps(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, partition := range table.partitions() {
+		dropStmt := &DropIndexStmt{table: partition.qualifiedName(), cols: stmt.cols, partitionIndex: true}
+		if _, err := dropStmt.execAt(ctx, tx, params); err != nil {
+			return nil, err
+		}
+	}
+
 	tx.mutatedCatalog = true
 
 	return tx, nil
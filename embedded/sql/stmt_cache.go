@@ -0,0 +1,233 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"maps"
+	"strings"
+	"sync"
+)
+
+// The statement cache is a parse cache: it keeps the statements parsed from
+// a SQL text and the types inferred for their parameters, while the row
+// readers of queries are still built on every execution.
+
+// StmtCacheHitObserver and StmtCacheMissObserver are the statement cache
+// counterparts of CatalogCacheHitObserver and CatalogCacheMissObserver,
+// invoked on every lookup made by PrepareStmts.
+var (
+	StmtCacheHitObserver  = func() {}
+	StmtCacheMissObserver = func() {}
+)
+
+// stmtKey identifies the statements of a SQL text parsed under a catalog
+// version. The version is bumped whenever a DDL statement commits, so the
+// statements parsed before it are never reused.
+type stmtKey struct {
+	sql            string
+	catalogVersion uint64
+}
+
+// cachedStmts is the statement cache entry of a SQL text. It stays in the
+// cache while its statements are in use: statements hold state while they
+// are executed, so the entry keeps the parsed copies which are not in use
+// and hands each of them to a single caller at a time.
+type cachedStmts struct {
+	key stmtKey
+
+	mu     sync.Mutex
+	free   [][]SQLStmt
+	params map[string]SQLValueType // nil until the parameters are inferred
+}
+
+func (c *cachedStmts) take() []SQLStmt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.free) == 0 {
+		return nil
+	}
+
+	stmts := c.free[len(c.free)-1]
+	c.free = c.free[:len(c.free)-1]
+
+	return stmts
+}
+
+func (c *cachedStmts) giveBack(stmts []SQLStmt) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.free = append(c.free, stmts)
+}
+
+// preparedStmts are the statements handed to a caller of preparedStmts,
+// along with the cache entry they belong to, if any.
+type preparedStmts struct {
+	entry *cachedStmts
+	stmts []SQLStmt
+}
+
+// PrepareStmts parses sql, taking the statements from the statement cache
+// when the same text was parsed under the current catalog version and a
+// copy of them is not in use.
+//
+// Unless an error is returned, release must be called once the statements
+// and the readers resolved from them are no longer in use.
+func (e *Engine) PrepareStmts(sql string) (stmts []SQLStmt, release func(), err error) {
+	p, release, err := e.prepareStmts(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.stmts, release, nil
+}
+
+func (e *Engine) prepareStmts(sql string) (p *preparedStmts, release func(), err error) {
+	if e.stmtCache == nil {
+		stmts, err := ParseSQL(strings.NewReader(sql))
+		if err != nil {
+			return nil, nil, err
+		}
+		return &preparedStmts{stmts: stmts}, func() {}, nil
+	}
+
+	key := stmtKey{
+		sql:            sql,
+		catalogVersion: e.cachedCatalogVersion.Load(),
+	}
+
+	p = &preparedStmts{entry: e.cachedStmts(key)}
+
+	if p.entry != nil {
+		p.stmts = p.entry.take()
+	}
+
+	if p.stmts != nil {
+		StmtCacheHitObserver()
+	} else {
+		StmtCacheMissObserver()
+
+		p.stmts, err = ParseSQL(strings.NewReader(sql))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if p.entry == nil {
+			p.entry = e.addCachedStmts(key)
+		}
+	}
+
+	if retainsStmts(p.stmts) {
+		return p, func() {}, nil
+	}
+
+	var once sync.Once
+
+	return p, func() { once.Do(func() { e.releaseStmts(p) }) }, nil
+}
+
+func (e *Engine) cachedStmts(key stmtKey) *cachedStmts {
+	cached, err := e.stmtCache.Get(key)
+	if err != nil {
+		return nil
+	}
+	return cached.(*cachedStmts)
+}
+
+// addCachedStmts returns the cache entry of key, adding it unless a
+// concurrent caller already did.
+func (e *Engine) addCachedStmts(key stmtKey) *cachedStmts {
+	e.stmtCacheMutex.Lock()
+	defer e.stmtCacheMutex.Unlock()
+
+	if entry := e.cachedStmts(key); entry != nil {
+		return entry
+	}
+
+	entry := &cachedStmts{key: key}
+	e.stmtCache.Put(key, entry)
+
+	return entry
+}
+
+func (e *Engine) releaseStmts(p *preparedStmts) {
+	// the catalog changed while the statements were in use, possibly
+	// because of the statements themselves
+	if e.cachedCatalogVersion.Load() != p.entry.key.catalogVersion {
+		return
+	}
+
+	p.entry.giveBack(p.stmts)
+}
+
+// retainsStmts reports whether some of stmts are still in use once they
+// are executed, as the query read by a cursor until it is closed or the one
+// of a view, so they are never handed to other callers.
+func retainsStmts(stmts []SQLStmt) bool {
+	for _, stmt := range stmts {
+		switch stmt.(type) {
+		case *DeclareCursorStmt, *CreateViewStmt:
+			return true
+		}
+	}
+	return false
+}
+
+// inferParameters returns the types of the parameters of p within tx. They
+// are inferred once for all the transactions reading the catalog the
+// statements were parsed under.
+func (e *Engine) inferParameters(ctx context.Context, tx *SQLTx, p *preparedStmts) (map[string]SQLValueType, error) {
+	shared := p.entry != nil &&
+		tx.openCatalogVersion == p.entry.key.catalogVersion &&
+		!tx.mutatedCatalog &&
+		len(tx.opts.SearchPath) == 0
+
+	if shared {
+		p.entry.mu.Lock()
+		params := maps.Clone(p.entry.params)
+		p.entry.mu.Unlock()
+
+		if params != nil {
+			return params, nil
+		}
+	}
+
+	params, err := e.InferParametersPreparedStmts(ctx, tx, p.stmts)
+	if err != nil {
+		return nil, err
+	}
+
+	if shared {
+		p.entry.mu.Lock()
+		p.entry.params = maps.Clone(params)
+		p.entry.mu.Unlock()
+	}
+	return params, nil
+}
+
+// stmtsRowReader hands the statements of a query back to the statement
+// cache once the reader is closed.
+type stmtsRowReader struct {
+	RowReader
+	release func()
+}
+
+func (r *stmtsRowReader) Close() error {
+	defer r.release()
+	return r.RowReader.Close()
+}
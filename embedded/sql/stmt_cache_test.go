@@ -0,0 +1,214 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStmtCache(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	var hits, misses int
+
+	StmtCacheHitObserver = func() { hits++ }
+	StmtCacheMissObserver = func() { misses++ }
+
+	t.Cleanup(func() {
+		StmtCacheHitObserver = func() {}
+		StmtCacheMissObserver = func() {}
+	})
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, "CREATE TABLE table1(id INTEGER, title VARCHAR, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, hits)
+	require.Equal(t, 1, misses)
+
+	for i := 1; i <= 3; i++ {
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO table1(id, title) VALUES (@id, 'title')", map[string]interface{}{"id": i})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, hits)
+	require.Equal(t, 2, misses)
+
+	t.Run("statements in use should not be shared", func(t *testing.T) {
+		hits, misses = 0, 0
+
+		r1, err := engine.Query(ctx, nil, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		r2, err := engine.Query(ctx, nil, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 0, hits)
+		require.Equal(t, 2, misses)
+
+		rows, err := ReadAllRows(ctx, r1)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		require.NoError(t, r1.Close())
+		require.NoError(t, r2.Close())
+
+		// both copies are kept once released, so concurrent executions
+		// no longer parse the statements
+		hits, misses = 0, 0
+
+		r1, err = engine.Query(ctx, nil, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		r2, err = engine.Query(ctx, nil, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, hits)
+		require.Equal(t, 0, misses)
+
+		rows, err = ReadAllRows(ctx, r2)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+
+		require.NoError(t, r1.Close())
+		require.NoError(t, r2.Close())
+	})
+
+	t.Run("concurrent executions", func(t *testing.T) {
+		var lookups atomic.Int64
+
+		StmtCacheHitObserver = func() { lookups.Add(1) }
+		StmtCacheMissObserver = func() { lookups.Add(1) }
+
+		defer func() {
+			StmtCacheHitObserver = func() { hits++ }
+			StmtCacheMissObserver = func() { misses++ }
+		}()
+
+		var wg sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for j := 0; j < 10; j++ {
+					rows, err := engine.queryAll(ctx, nil, "SELECT id FROM table1 WHERE id > @id", map[string]interface{}{"id": j % 3})
+					require.NoError(t, err)
+					require.Len(t, rows, 3-j%3)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		require.Equal(t, int64(80), lookups.Load())
+	})
+
+	t.Run("statements should be prepared again after a DDL statement", func(t *testing.T) {
+		hits, misses = 0, 0
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE table1 ADD COLUMN amount INTEGER", nil)
+		require.NoError(t, err)
+
+		rows, err := engine.queryAll(ctx, nil, "SELECT * FROM table1", nil)
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Len(t, rows[0].ValuesByPosition, 3)
+
+		_, err = engine.queryAll(ctx, nil, "SELECT id FROM table1", nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 0, hits)
+		require.Equal(t, 3, misses)
+	})
+
+	t.Run("statements read by cursors should not be shared", func(t *testing.T) {
+		declare := "DECLARE c CURSOR FOR SELECT id FROM table1 ORDER BY id"
+
+		tx1, _, err := engine.Exec(ctx, nil, "BEGIN", nil)
+		require.NoError(t, err)
+		defer tx1.Cancel()
+
+		tx2, _, err := engine.Exec(ctx, nil, "BEGIN", nil)
+		require.NoError(t, err)
+		defer tx2.Cancel()
+
+		hits, misses = 0, 0
+
+		_, _, err = engine.Exec(ctx, tx1, declare, nil)
+		require.NoError(t, err)
+
+		_, _, err = engine.Exec(ctx, tx2, declare, nil)
+		require.NoError(t, err)
+
+		require.Equal(t, 0, hits)
+		require.Equal(t, 2, misses)
+
+		for _, tx := range []*SQLTx{tx1, tx2} {
+			rows, err := engine.queryAll(ctx, tx, "FETCH ALL FROM c", nil)
+			require.NoError(t, err)
+			require.Len(t, rows, 3)
+		}
+
+		_, _, err = engine.Exec(ctx, tx1, "CLOSE c", nil)
+		require.NoError(t, err)
+
+		hits = 0
+
+		_, _, err = engine.Exec(ctx, tx1, declare, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, hits)
+	})
+
+	t.Run("inferred parameters should be shared", func(t *testing.T) {
+		hits, misses = 0, 0
+
+		query := "SELECT id FROM table1 WHERE title = @title AND amount > @amount"
+
+		params, err := engine.InferParameters(ctx, nil, query)
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"title": VarcharType, "amount": IntegerType}, params)
+
+		// the parameters returned are owned by the caller
+		params["title"] = BooleanType
+
+		params, err = engine.InferParameters(ctx, nil, query)
+		require.NoError(t, err)
+		require.Equal(t, map[string]SQLValueType{"title": VarcharType, "amount": IntegerType}, params)
+		require.Equal(t, 1, hits)
+
+		_, _, err = engine.Exec(ctx, nil, "ALTER TABLE table1 RENAME COLUMN amount TO total", nil)
+		require.NoError(t, err)
+
+		_, err = engine.InferParameters(ctx, nil, query)
+		require.ErrorIs(t, err, ErrColumnDoesNotExist)
+	})
+
+	t.Run("parsing errors should not be cached", func(t *testing.T) {
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO", nil)
+		require.ErrorIs(t, err, ErrParsingError)
+
+		_, _, err = engine.Exec(ctx, nil, "INSERT INTO", nil)
+		require.ErrorIs(t, err, ErrParsingError)
+	})
+}
+
+func TestStmtCacheDisabled(t *testing.T) {
+	engine, _ := setupCommonTestWithEngineOptions(t, DefaultOptions().WithPrefix(sqlPrefix).WithStmtCacheSize(0))
+	require.Nil(t, engine.stmtCache)
+
+	stmts, release, err := engine.PrepareStmts("SELECT 1")
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+	release()
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// systemTimeKeyReader reads the entries of a FOR SYSTEM_TIME BETWEEN scan.
+// A version of a key is valid from the tx which wrote it until the tx which
+// wrote the next version of the same key, so every version which was valid
+// at some instant of the period is read, not only the last one updated
+// within it. The underlying reader must include the history of the keys.
+type systemTimeKeyReader struct {
+	reader  store.KeyReader
+	filters []store.FilterFn
+	ts      time.Time
+
+	// next entry, already read from the underlying reader
+	nextKey []byte
+	nextVal store.ValueRef
+
+	// versions of the current key, valid within the period
+	pendingKey  []byte
+	pendingVals []store.ValueRef
+}
+
+func newSystemTimeKeyReader(reader store.KeyReader, filters []store.FilterFn, ts time.Time) *systemTimeKeyReader {
+	return &systemTimeKeyReader{
+		reader:  reader,
+		filters: filters,
+		ts:      ts,
+	}
+}
+
+func (r *systemTimeKeyReader) Read(ctx context.Context) (key []byte, val store.ValueRef, err error) {
+	return r.ReadBetween(ctx, 0, math.MaxUint64)
+}
+
+// ReadBetween returns the versions which were written up to finalTxID and
+// not replaced before initialTxID.
+func (r *systemTimeKeyReader) ReadBetween(ctx context.Context, initialTxID, finalTxID uint64) (key []byte, val store.ValueRef, err error) {
+	for len(r.pendingVals) == 0 {
+		key, versions, err := r.readVersions(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.pendingKey = key
+
+		for i, v := range versions {
+			if v.Tx() > finalTxID {
+				continue
+			}
+
+			if next := versionAfter(versions, i); next != nil && next.Tx() < initialTxID {
+				continue
+			}
+
+			if r.filtered(v) {
+				continue
+			}
+
+			r.pendingVals = append(r.pendingVals, v)
+		}
+	}
+
+	val = r.pendingVals[0]
+	r.pendingVals = r.pendingVals[1:]
+
+	return r.pendingKey, val, nil
+}
+
+// readVersions reads every version of the next key, in the order of the scan.
+func (r *systemTimeKeyReader) readVersions(ctx context.Context) ([]byte, []store.ValueRef, error) {
+	if r.nextVal == nil {
+		key, val, err := r.reader.Read(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.nextKey, r.nextVal = key, val
+	}
+
+	key := r.nextKey
+	versions := []store.ValueRef{r.nextVal}
+
+	r.nextKey, r.nextVal = nil, nil
+
+	for {
+		k, v, err := r.reader.Read(ctx)
+		if errors.Is(err, store.ErrNoMoreEntries) {
+			return key, versions, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !bytes.Equal(k, key) {
+			r.nextKey, r.nextVal = k, v
+			return key, versions, nil
+		}
+
+		versions = append(versions, v)
+	}
+}
+
+// versionAfter returns the version written right after the i-th one, which
+// is next to it whether the versions are read in ascending or descending order.
+func versionAfter(versions []store.ValueRef, i int) store.ValueRef {
+	hc := versions[i].HC() + 1
+
+	if i+1 < len(versions) && versions[i+1].HC() == hc {
+		return versions[i+1]
+	}
+	if i > 0 && versions[i-1].HC() == hc {
+		return versions[i-1]
+	}
+	return nil
+}
+
+// filtered reports whether v is a deleted or expired version, which is not
+// a row but still ends the validity of the version preceding it.
+func (r *systemTimeKeyReader) filtered(v store.ValueRef) bool {
+	for _, filter := range r.filters {
+		if filter(v, r.ts) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *systemTimeKeyReader) Reset() error {
+	r.nextKey, r.nextVal = nil, nil
+	r.pendingKey, r.pendingVals = nil, nil
+
+	return r.reader.Reset()
+}
+
+func (r *systemTimeKeyReader) Close() error {
+	return r.reader.Close()
+}
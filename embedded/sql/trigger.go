@@ -0,0 +1,488 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://mariadb.com/bsl11/
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/codenotary/immudb/embedded/store"
+)
+
+// Triggers run a list of INSERT, UPSERT, UPDATE and DELETE statements for
+// every row of a table inserted, updated or deleted. They are run by the
+// statement writing the row, within its transaction, so the rows written by
+// triggers are committed, and can be verified, together with it.
+//
+// Within the statements of a trigger NEW.col and OLD.col refer to the values
+// of the row being written and of its previous version. NEW is not defined
+// for DELETE triggers, nor OLD for INSERT ones. BEFORE INSERT and BEFORE
+// UPDATE triggers may change the row about to be written with
+// SET NEW.col = exp.
+//
+// Rows written by an UPSERT or by INSERT ... ON CONFLICT DO UPDATE fire
+// UPDATE triggers when they already exist. Statements run by triggers may
+// fire further triggers, up to maxTriggerDepth nested levels.
+
+type TriggerTiming string
+
+const (
+	TriggerBefore TriggerTiming = "BEFORE"
+	TriggerAfter  TriggerTiming = "AFTER"
+)
+
+const (
+	triggerNewRow = "new"
+	triggerOldRow = "old"
+
+	maxTriggerDepth = 16
+)
+
+// Trigger is a list of statements run when rows of a table are written.
+type Trigger struct {
+	name   string
+	timing TriggerTiming
+	event  SQLPrivilege // INSERT, UPDATE or DELETE
+	body   []SQLStmt
+	sql    string
+}
+
+func (t *Trigger) Name() string {
+	return t.name
+}
+
+func (t *Trigger) Timing() TriggerTiming {
+	return t.timing
+}
+
+// Event returns the statement firing the trigger.
+func (t *Trigger) Event() SQLPrivilege {
+	return t.event
+}
+
+// SQL returns the statement the trigger was created with.
+func (t *Trigger) SQL() string {
+	return t.sql
+}
+
+// GetTriggers returns the triggers defined on the table sorted by name,
+// which is also the order they are run in.
+func (t *Table) GetTriggers() []*Trigger {
+	triggers := make([]*Trigger, 0, len(t.triggers))
+	for _, tr := range t.triggers {
+		triggers = append(triggers, tr)
+	}
+
+	sort.Slice(triggers, func(i, j int) bool {
+		return triggers[i].name < triggers[j].name
+	})
+	return triggers
+}
+
+func (t *Table) hasTriggers(event SQLPrivilege) bool {
+	for _, tr := range t.triggers {
+		if tr.event == event {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) newTrigger(name string, timing TriggerTiming, event SQLPrivilege, body []SQLStmt, sql string) (*Trigger, error) {
+	if _, exists := t.triggers[name]; exists {
+		return nil, fmt.Errorf("%w (%s)", ErrTriggerAlreadyExists, name)
+	}
+
+	if t.systemScan != nil {
+		return nil, fmt.Errorf("%w: triggers can not be defined on system tables", ErrIllegalArguments)
+	}
+
+	if err := checkNotMaterializedView(t); err != nil {
+		return nil, err
+	}
+
+	if timing != TriggerBefore && timing != TriggerAfter {
+		return nil, fmt.Errorf("%w: unsupported timing %s", ErrInvalidTrigger, timing)
+	}
+
+	if event != SQLPrivilegeInsert && event != SQLPrivilegeUpdate && event != SQLPrivilegeDelete {
+		return nil, fmt.Errorf("%w: unsupported event %s", ErrInvalidTrigger, event)
+	}
+
+	for _, stmt := range body {
+		switch s := stmt.(type) {
+		case *UpsertIntoStmt, *UpdateStmt, *DeleteFromStmt:
+		case *SetNewValueStmt:
+			if timing != TriggerBefore || event == SQLPrivilegeDelete {
+				return nil, fmt.Errorf("%w: NEW can only be set by BEFORE INSERT and BEFORE UPDATE triggers", ErrInvalidTrigger)
+			}
+
+			err := s.validate(t)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("%w: only INSERT, UPSERT, UPDATE and DELETE statements are allowed", ErrInvalidTrigger)
+		}
+	}
+
+	tr := &Trigger{name: name, timing: timing, event: event, body: body, sql: sql}
+
+	if t.triggers == nil {
+		t.triggers = make(map[string]*Trigger)
+	}
+	t.triggers[name] = tr
+
+	return tr, nil
+}
+
+// fireTriggers runs the triggers defined on table for event at timing.
+// oldValues and newValues hold the previous and new version of the row
+// being written, either of them may be nil. newValues is updated by BEFORE
+// triggers setting NEW, in which case true is returned.
+func (tx *SQLTx) fireTriggers(ctx context.Context, table *Table, timing TriggerTiming, event SQLPrivilege, oldValues, newValues map[uint32]TypedValue) (bool, error) {
+	var triggers []*Trigger
+
+	for _, tr := range table.GetTriggers() {
+		if tr.timing == timing && tr.event == event {
+			triggers = append(triggers, tr)
+		}
+	}
+
+	if len(triggers) == 0 {
+		return false, nil
+	}
+
+	if tx.triggerDepth >= maxTriggerDepth {
+		return false, fmt.Errorf("%w (%s)", ErrMaxTriggerDepthExceeded, triggers[0].name)
+	}
+
+	// the statement firing the triggers keeps its own foreign key checks and
+	// only reports the rows it writes itself
+	pendingFKChecks := tx.pendingFKChecks
+	updatedRows := tx.updatedRows
+
+	tx.triggerDepth++
+
+	defer func() {
+		tx.triggerDepth--
+		tx.pendingFKChecks = pendingFKChecks
+		tx.updatedRows = updatedRows
+	}()
+
+	params := make(map[string]interface{}, 2*len(table.cols))
+
+	for _, col := range table.cols {
+		if oldValues != nil {
+			params[EncodeSelector("", triggerOldRow, col.colName)] = valueOrNull(oldValues[col.id], col)
+		}
+		if newValues != nil {
+			params[EncodeSelector("", triggerNewRow, col.colName)] = valueOrNull(newValues[col.id], col)
+		}
+	}
+
+	changed := false
+
+	for _, tr := range triggers {
+		for _, stmt := range tr.body {
+			set, ok := stmt.(*SetNewValueStmt)
+			if !ok {
+				_, err := triggerStmt(stmt).execAt(ctx, tx, params)
+				if err != nil {
+					return false, fmt.Errorf("trigger %s: %w", tr.name, err)
+				}
+				continue
+			}
+
+			col, err := table.GetColumnByName(set.col)
+			if err != nil {
+				return false, err
+			}
+
+			val, err := set.exp.substitute(params)
+			if err != nil {
+				return false, err
+			}
+
+			rval, err := val.reduce(tx, nil, table.name)
+			if err != nil {
+				return false, fmt.Errorf("trigger %s: %w", tr.name, err)
+			}
+
+			rval, err = normalizeColumnValue(col, rval)
+			if err != nil {
+				return false, err
+			}
+
+			if rval.IsNull() && col.notNull {
+				return false, fmt.Errorf("%w (%s)", ErrNotNullableColumnCannotBeNull, col.colName)
+			}
+
+			newValues[col.id] = rval
+			params[EncodeSelector("", triggerNewRow, col.colName)] = rval
+
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+func valueOrNull(v TypedValue, col *Column) TypedValue {
+	if v == nil {
+		return &NullValue{t: col.colType}
+	}
+	return v
+}
+
+// triggerStmt returns a copy of stmt to be run by a trigger, as statements
+// keep the rows they return and triggers are shared by transactions.
+func triggerStmt(stmt SQLStmt) SQLStmt {
+	switch s := stmt.(type) {
+	case *UpsertIntoStmt:
+		c := *s
+		return &c
+	case *UpdateStmt:
+		c := *s
+		return &c
+	case *DeleteFromStmt:
+		c := *s
+		return &c
+	}
+	return stmt
+}
+
+// SetNewValueStmt changes a value of the row about to be written by the
+// statement firing a BEFORE trigger.
+type SetNewValueStmt struct {
+	row string
+	col string
+	op  CmpOperator
+	exp ValueExp
+}
+
+func (stmt *SetNewValueStmt) validate(table *Table) error {
+	if stmt.row != triggerNewRow || stmt.op != EQ {
+		return fmt.Errorf("%w: only NEW can be set", ErrInvalidTrigger)
+	}
+
+	col, err := table.GetColumnByName(stmt.col)
+	if err != nil {
+		return err
+	}
+
+	if table.PrimaryIndex().IncludesCol(col.id) {
+		return ErrPKCanNotBeUpdated
+	}
+	return nil
+}
+
+func (stmt *SetNewValueStmt) readOnly() bool {
+	return true
+}
+
+func (stmt *SetNewValueStmt) requiredPrivileges() []SQLPrivilege {
+	return nil
+}
+
+func (stmt *SetNewValueStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *SetNewValueStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	return nil, fmt.Errorf("%w: NEW can only be set by triggers", ErrIllegalArguments)
+}
+
+type CreateTriggerStmt struct {
+	name   string
+	table  string
+	timing TriggerTiming
+	event  SQLPrivilege
+	body   []SQLStmt
+	sql    string
+}
+
+func (stmt *CreateTriggerStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *CreateTriggerStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeCreate}
+}
+
+func (stmt *CreateTriggerStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *CreateTriggerStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger, err := table.newTrigger(stmt.name, stmt.timing, stmt.event, stmt.body, stmt.sql)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistTrigger(tx, table, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+type DropTriggerStmt struct {
+	name     string
+	table    string
+	ifExists bool
+}
+
+func (stmt *DropTriggerStmt) readOnly() bool {
+	return false
+}
+
+func (stmt *DropTriggerStmt) requiredPrivileges() []SQLPrivilege {
+	return []SQLPrivilege{SQLPrivilegeDrop}
+}
+
+func (stmt *DropTriggerStmt) inferParameters(ctx context.Context, tx *SQLTx, params map[string]SQLValueType) error {
+	return nil
+}
+
+func (stmt *DropTriggerStmt) execAt(ctx context.Context, tx *SQLTx, params map[string]interface{}) (*SQLTx, error) {
+	table, err := tx.catalog.GetTableByName(stmt.table)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := table.triggers[stmt.name]; !exists {
+		if stmt.ifExists {
+			return tx, nil
+		}
+		return nil, fmt.Errorf("%w (%s)", ErrTriggerDoesNotExist, stmt.name)
+	}
+
+	delete(table.triggers, stmt.name)
+
+	err = persistTriggerDeletion(ctx, tx, table, stmt.name)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.mutatedCatalog = true
+
+	return tx, nil
+}
+
+func persistTrigger(tx *SQLTx, table *Table, trigger *Trigger) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogTriggerPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		[]byte(trigger.name),
+	)
+	return tx.set(mappedKey, nil, []byte(trigger.sql))
+}
+
+func persistTriggerDeletion(ctx context.Context, tx *SQLTx, table *Table, name string) error {
+	mappedKey := MapKey(
+		tx.sqlPrefix(),
+		catalogTriggerPrefix,
+		EncodeID(DatabaseID),
+		EncodeID(table.id),
+		[]byte(name),
+	)
+	return tx.delete(ctx, mappedKey)
+}
+
+// copyTriggers re-creates on table the triggers defined on src, which may
+// be a previous incarnation of the same table.
+func (tx *SQLTx) copyTriggers(src, table *Table) error {
+	for _, tr := range src.GetTriggers() {
+		trigger, err := table.newTrigger(tr.name, tr.timing, tr.event, tr.body, tr.sql)
+		if err != nil {
+			return err
+		}
+
+		err = persistTrigger(tx, table, trigger)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func loadTriggers(ctx context.Context, dbID, tableID uint32, tx *store.OngoingTx, sqlPrefix []byte, copyToTx bool) (map[string]*Trigger, error) {
+	prefix := MapKey(sqlPrefix, catalogTriggerPrefix, EncodeID(dbID), EncodeID(tableID))
+	triggers := make(map[string]*Trigger)
+
+	err := iteratePrefix(ctx, tx, prefix, func(key, value []byte, deleted bool) error {
+		if deleted {
+			return nil
+		}
+
+		trigger, err := parseTrigger(sqlPrefix, key, value)
+		if err != nil {
+			return err
+		}
+		triggers[trigger.name] = trigger
+
+		if copyToTx {
+			return tx.Set(key, nil, value)
+		}
+		return nil
+	})
+	return triggers, err
+}
+
+func parseTrigger(prefix, key, value []byte) (*Trigger, error) {
+	encKey, err := trimPrefix(prefix, key, []byte(catalogTriggerPrefix))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encKey) <= 2*EncIDLen {
+		return nil, ErrCorruptedData
+	}
+
+	stmts, err := ParseSQLString(string(value))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stmts) != 1 {
+		return nil, ErrCorruptedData
+	}
+
+	stmt, ok := stmts[0].(*CreateTriggerStmt)
+	if !ok {
+		return nil, ErrCorruptedData
+	}
+
+	return &Trigger{
+		name:   string(encKey[2*EncIDLen:]),
+		timing: stmt.timing,
+		event:  stmt.event,
+		body:   stmt.body,
+		sql:    stmt.sql,
+	}, nil
+}
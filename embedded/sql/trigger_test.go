@@ -0,0 +1,249 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codenotary/immudb/embedded/store"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		CREATE TRIGGER version BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.version = OLD.version + 1;
+		CREATE TRIGGER audit AFTER DELETE ON accounts FOR EACH ROW BEGIN
+			INSERT INTO audit (account, op) VALUES (OLD.id, 'delete');
+			DELETE FROM balances WHERE account = OLD.id;
+		END;
+		DROP TRIGGER IF EXISTS audit ON accounts`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 3)
+
+	create, ok := stmts[0].(*CreateTriggerStmt)
+	require.True(t, ok)
+	require.Equal(t, "version", create.name)
+	require.Equal(t, "accounts", create.table)
+	require.Equal(t, TriggerBefore, create.timing)
+	require.Equal(t, SQLPrivilegeUpdate, create.event)
+	require.Equal(t, "CREATE TRIGGER version BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.version = OLD.version + 1", create.sql)
+	require.Len(t, create.body, 1)
+	require.IsType(t, &SetNewValueStmt{}, create.body[0])
+
+	create, ok = stmts[1].(*CreateTriggerStmt)
+	require.True(t, ok)
+	require.Equal(t, TriggerAfter, create.timing)
+	require.Equal(t, SQLPrivilegeDelete, create.event)
+	require.Len(t, create.body, 2)
+	require.IsType(t, &UpsertIntoStmt{}, create.body[0])
+	require.IsType(t, &DeleteFromStmt{}, create.body[1])
+
+	require.Equal(t, &DropTriggerStmt{name: "audit", table: "accounts", ifExists: true}, stmts[2])
+
+	_, err = ParseSQLString("CREATE TRIGGER t AFTER INSERT ON accounts FOR EACH ROW SELECT * FROM accounts")
+	require.Error(t, err)
+}
+
+func TestTriggers(t *testing.T) {
+	engine, st := setupCommonTestWithOptions(t, store.DefaultOptions())
+
+	exec := func(sql string) (int, error) {
+		_, txs, err := engine.Exec(context.Background(), nil, sql, nil)
+		if err != nil {
+			return 0, err
+		}
+		return txs[len(txs)-1].UpdatedRows(), nil
+	}
+
+	_, err := exec(`
+		CREATE TABLE accounts (
+			id INTEGER AUTO_INCREMENT,
+			name VARCHAR[32],
+			balance INTEGER NOT NULL,
+			version INTEGER,
+			PRIMARY KEY id
+		);
+		CREATE TABLE audit (id INTEGER AUTO_INCREMENT, account INTEGER, op VARCHAR[8], balance INTEGER, PRIMARY KEY id);
+		CREATE TABLE totals (name VARCHAR[8], balance INTEGER, PRIMARY KEY name);
+	`)
+	require.NoError(t, err)
+
+	_, err = exec(`
+		INSERT INTO totals (name, balance) VALUES ('all', 0);
+
+		CREATE TRIGGER init_version BEFORE INSERT ON accounts FOR EACH ROW SET NEW.version = 1;
+		CREATE TRIGGER next_version BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.version = OLD.version + 1;
+
+		CREATE TRIGGER audit_insert AFTER INSERT ON accounts FOR EACH ROW BEGIN
+			INSERT INTO audit (account, op, balance) VALUES (NEW.id, 'insert', NEW.balance);
+			UPDATE totals SET balance = balance + NEW.balance WHERE name = 'all';
+		END;
+
+		CREATE TRIGGER audit_update AFTER UPDATE ON accounts FOR EACH ROW BEGIN
+			INSERT INTO audit (account, op, balance) VALUES (NEW.id, 'update', NEW.balance);
+			UPDATE totals SET balance = balance - OLD.balance + NEW.balance WHERE name = 'all';
+		END;
+
+		CREATE TRIGGER audit_delete AFTER DELETE ON accounts FOR EACH ROW BEGIN
+			INSERT INTO audit (account, op, balance) VALUES (OLD.id, 'delete', OLD.balance);
+			UPDATE totals SET balance = balance - OLD.balance WHERE name = 'all';
+		END
+	`)
+	require.NoError(t, err)
+
+	accounts := "SELECT id, name, balance, version FROM accounts ORDER BY id"
+	audit := "SELECT account, op, balance FROM audit ORDER BY id"
+	totals := "SELECT balance FROM totals"
+
+	t.Run("triggers run with the statement writing rows", func(t *testing.T) {
+		n, err := exec("INSERT INTO accounts (name, balance, version) VALUES ('alice', 10, 100), ('bob', 20, NULL)")
+		require.NoError(t, err)
+		require.Equal(t, 2, n)
+
+		n, err = exec("UPDATE accounts SET balance = balance + 5 WHERE name = 'bob'")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		n, err = exec("UPSERT INTO accounts (id, name, balance, version) VALUES (1, 'alice', 15, 0)")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		n, err = exec("DELETE FROM accounts WHERE name = 'bob'")
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+
+		require.Equal(t, [][]string{{"1", "'alice'", "15", "2"}}, queryStrings(t, engine, accounts))
+		require.Equal(t, [][]string{
+			{"1", "'insert'", "10"},
+			{"2", "'insert'", "20"},
+			{"2", "'update'", "25"},
+			{"1", "'update'", "15"},
+			{"2", "'delete'", "25"},
+		}, queryStrings(t, engine, audit))
+		require.Equal(t, [][]string{{"15"}}, queryStrings(t, engine, totals))
+	})
+
+	t.Run("failing triggers abort the statement", func(t *testing.T) {
+		_, err := exec(`
+			CREATE TRIGGER overdraft BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.balance = NULL;
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("UPDATE accounts SET balance = 50 WHERE id = 1")
+		require.ErrorIs(t, err, ErrNotNullableColumnCannotBeNull)
+
+		_, err = exec("DROP TRIGGER overdraft ON accounts")
+		require.NoError(t, err)
+
+		_, err = exec(`
+			CREATE TRIGGER missing AFTER INSERT ON accounts FOR EACH ROW INSERT INTO unknown (id) VALUES (NEW.id)
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("INSERT INTO accounts (name, balance) VALUES ('carol', 30)")
+		require.ErrorIs(t, err, ErrTableDoesNotExist)
+
+		_, err = exec(`
+			DROP TRIGGER missing ON accounts;
+			DROP TRIGGER IF EXISTS missing ON accounts;
+		`)
+		require.NoError(t, err)
+
+		_, err = exec(`
+			BEGIN;
+			INSERT INTO accounts (name, balance) VALUES ('carol', 30);
+			ROLLBACK;
+		`)
+		require.NoError(t, err)
+
+		require.Len(t, queryStrings(t, engine, accounts), 1)
+		require.Len(t, queryStrings(t, engine, audit), 5)
+		require.Equal(t, [][]string{{"15"}}, queryStrings(t, engine, totals))
+	})
+
+	t.Run("nested triggers", func(t *testing.T) {
+		_, err := exec(`
+			CREATE TABLE events (id INTEGER AUTO_INCREMENT, n INTEGER, PRIMARY KEY id);
+			CREATE TRIGGER again AFTER INSERT ON events FOR EACH ROW INSERT INTO events (n) VALUES (NEW.n + 1);
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("INSERT INTO events (n) VALUES (0)")
+		require.ErrorIs(t, err, ErrMaxTriggerDepthExceeded)
+
+		_, err = exec(`
+			DROP TRIGGER again ON events;
+			CREATE TRIGGER again AFTER INSERT ON events FOR EACH ROW INSERT INTO events (n) SELECT n + 1 FROM events WHERE id = NEW.id AND n < 3;
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("INSERT INTO events (n) VALUES (0)")
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"0"}, {"1"}, {"2"}, {"3"}}, queryStrings(t, engine, "SELECT n FROM events ORDER BY id"))
+	})
+
+	t.Run("invalid triggers", func(t *testing.T) {
+		for sql, expectedErr := range map[string]error{
+			"CREATE TRIGGER init_version BEFORE INSERT ON accounts FOR EACH ROW SET NEW.version = 0": ErrTriggerAlreadyExists,
+			"CREATE TRIGGER t AFTER INSERT ON accounts FOR EACH ROW SET NEW.version = 0":             ErrInvalidTrigger,
+			"CREATE TRIGGER t BEFORE DELETE ON accounts FOR EACH ROW SET NEW.version = 0":            ErrInvalidTrigger,
+			"CREATE TRIGGER t BEFORE UPDATE ON accounts FOR EACH ROW SET OLD.version = 0":            ErrInvalidTrigger,
+			"CREATE TRIGGER t BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.unknown = 0":            ErrColumnDoesNotExist,
+			"CREATE TRIGGER t BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.id = 0":                 ErrPKCanNotBeUpdated,
+			"CREATE TRIGGER t BEFORE UPDATE ON unknown FOR EACH ROW SET NEW.id = 0":                  ErrTableDoesNotExist,
+			"DROP TRIGGER unknown ON accounts":                                                       ErrTriggerDoesNotExist,
+			"ALTER TABLE accounts DROP COLUMN version":                                               ErrCannotDropColumn,
+		} {
+			_, err := exec(sql)
+			require.ErrorIs(t, err, expectedErr, sql)
+		}
+	})
+
+	t.Run("reopen and truncate", func(t *testing.T) {
+		reopened, err := NewEngine(st, DefaultOptions().WithPrefix(sqlPrefix))
+		require.NoError(t, err)
+		engine = reopened
+
+		tx, err := engine.NewTx(context.Background(), DefaultTxOptions().WithReadOnly(true))
+		require.NoError(t, err)
+
+		table, err := tx.Catalog().GetTableByName("accounts")
+		require.NoError(t, err)
+
+		triggers := table.GetTriggers()
+		require.Len(t, triggers, 5)
+		require.Equal(t, "next_version", triggers[4].Name())
+		require.Equal(t, TriggerBefore, triggers[4].Timing())
+		require.Equal(t, SQLPrivilegeUpdate, triggers[4].Event())
+		require.Equal(t, "CREATE TRIGGER next_version BEFORE UPDATE ON accounts FOR EACH ROW SET NEW.version = OLD.version + 1", triggers[4].SQL())
+
+		require.NoError(t, tx.Cancel())
+
+		_, err = exec(`
+			TRUNCATE TABLE accounts;
+			DELETE FROM audit;
+		`)
+		require.NoError(t, err)
+
+		_, err = exec("INSERT INTO accounts (name, balance) VALUES ('dave', 40)")
+		require.NoError(t, err)
+
+		require.Equal(t, [][]string{{"1", "'dave'", "40", "1"}}, queryStrings(t, engine, accounts))
+		require.Equal(t, [][]string{{"1", "'insert'", "40"}}, queryStrings(t, engine, audit))
+
+		_, err = exec("DROP TABLE accounts")
+		require.NoError(t, err)
+
+		_, err = exec("CREATE TABLE accounts (id INTEGER AUTO_INCREMENT, balance INTEGER, PRIMARY KEY id)")
+		require.NoError(t, err)
+
+		_, err = exec("INSERT INTO accounts (balance) VALUES (1)")
+		require.NoError(t, err)
+		require.Len(t, queryStrings(t, engine, audit), 1)
+	})
+}
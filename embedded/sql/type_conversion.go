@@ -18,6 +18,7 @@ package sql
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -52,6 +53,10 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 		}, nil
 	}
 
+	if IsArrayType(src) || IsArrayType(dst) {
+		return getArrayConverter(src, dst)
+	}
+
 	if dst == TimestampType {
 		if src == IntegerType {
 			return func(val TypedValue) (TypedValue, error) {
@@ -123,8 +128,86 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			}, nil
 		}
 
+		if src == DateType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: TimestampType}, nil
+				}
+				return &Timestamp{val: val.RawValue().(time.Time)}, nil
+			}, nil
+		}
+
 		return nil, fmt.Errorf(
-			"%w: only INTEGER and VARCHAR types can be cast as TIMESTAMP",
+			"%w: only INTEGER, DATE and VARCHAR types can be cast as TIMESTAMP",
+			ErrUnsupportedCast,
+		)
+	}
+
+	if dst == DateType {
+		if src == TimestampType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: DateType}, nil
+				}
+				return NewDate(val.RawValue().(time.Time)), nil
+			}, nil
+		}
+
+		if src == VarcharType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: DateType}, nil
+				}
+				return parseDate(val.RawValue().(string))
+			}, nil
+		}
+
+		if src == JSONType {
+			jsonToStr, err := getConverter(src, VarcharType)
+			if err != nil {
+				return nil, err
+			}
+
+			return func(tv TypedValue) (TypedValue, error) {
+				v, err := jsonToStr(tv)
+				if err != nil {
+					return nil, err
+				}
+				if v.IsNull() {
+					return &NullValue{t: DateType}, nil
+				}
+				return parseDate(strings.Trim(v.RawValue().(string), `"`))
+			}, nil
+		}
+
+		return nil, fmt.Errorf(
+			"%w: only TIMESTAMP and VARCHAR types can be cast as DATE",
+			ErrUnsupportedCast,
+		)
+	}
+
+	if dst == IntervalType {
+		if src == VarcharType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: IntervalType}, nil
+				}
+
+				str := val.RawValue().(string)
+
+				iv, err := ParseInterval(str)
+				if errors.Is(err, ErrInvalidValue) {
+					if len(str) > 30 {
+						str = str[:30] + "..."
+					}
+					return nil, fmt.Errorf("%w: can not cast string '%s' as an INTERVAL", ErrUnsupportedCast, str)
+				}
+				return iv, err
+			}, nil
+		}
+
+		return nil, fmt.Errorf(
+			"%w: only VARCHAR type can be cast as INTERVAL",
 			ErrUnsupportedCast,
 		)
 	}
@@ -157,12 +240,68 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			}, nil
 		}
 
+		if src == DecimalType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: Float64Type}, nil
+				}
+				return &Float64{val: val.RawValue().(*Decimal).Float64()}, nil
+			}, nil
+		}
+
 		if src == JSONType {
 			return jsonConverted(dst), nil
 		}
 
 		return nil, fmt.Errorf(
-			"%w: only INTEGER and VARCHAR types can be cast as FLOAT",
+			"%w: only INTEGER, DECIMAL and VARCHAR types can be cast as FLOAT",
+			ErrUnsupportedCast,
+		)
+	}
+
+	if dst == DecimalType {
+		if src == IntegerType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: DecimalType}, nil
+				}
+				return decimalFromInt64(val.RawValue().(int64)), nil
+			}, nil
+		}
+
+		if src == Float64Type {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: DecimalType}, nil
+				}
+				return decimalFromFloat64(val.RawValue().(float64))
+			}, nil
+		}
+
+		if src == VarcharType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: DecimalType}, nil
+				}
+
+				d, err := ParseDecimal(val.RawValue().(string))
+				if err != nil {
+					return nil, fmt.Errorf(
+						"%w: can not cast string '%s' as a DECIMAL",
+						ErrUnsupportedCast,
+						val.RawValue().(string),
+					)
+				}
+				return d, nil
+			}, nil
+		}
+
+		if src == JSONType {
+			return jsonConverted(dst), nil
+		}
+
+		return nil, fmt.Errorf(
+			"%w: only INTEGER, FLOAT and VARCHAR types can be cast as DECIMAL",
 			ErrUnsupportedCast,
 		)
 	}
@@ -211,6 +350,20 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			}, nil
 		}
 
+		if src == DecimalType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: IntegerType}, nil
+				}
+
+				d := val.RawValue().(*Decimal).rescale(0)
+				if !d.val.IsInt64() {
+					return nil, fmt.Errorf("%w: %s can not be cast as INTEGER", ErrNumericValueOutOfRange, d.String())
+				}
+				return &Integer{val: d.val.Int64()}, nil
+			}, nil
+		}
+
 		if src == VarcharType {
 			return func(val TypedValue) (TypedValue, error) {
 				if val.RawValue() == nil {
@@ -234,7 +387,7 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 		}
 
 		return nil, fmt.Errorf(
-			"%w: only INTEGER and VARCHAR types can be cast as INTEGER",
+			"%w: only FLOAT, DECIMAL and VARCHAR types can be cast as INTEGER",
 			ErrUnsupportedCast,
 		)
 	}
@@ -362,6 +515,15 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			}, nil
 		}
 
+		if src == DecimalType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: VarcharType}, nil
+				}
+				return &Varchar{val: val.RawValue().(*Decimal).String()}, nil
+			}, nil
+		}
+
 		if src == BooleanType {
 			return func(val TypedValue) (TypedValue, error) {
 				if val.RawValue() == nil {
@@ -371,6 +533,15 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			}, nil
 		}
 
+		if src == DateType || src == IntervalType {
+			return func(val TypedValue) (TypedValue, error) {
+				if val.RawValue() == nil {
+					return &NullValue{t: VarcharType}, nil
+				}
+				return &Varchar{val: val.String()}, nil
+			}, nil
+		}
+
 		return nil, fmt.Errorf(
 			"%w: cannot cast %s to VARCHAR",
 			ErrUnsupportedCast,
@@ -387,6 +558,8 @@ func getConverter(src, dst SQLValueType) (converterFunc, error) {
 			switch tv.Type() {
 			case Float64Type, IntegerType, BooleanType, AnyType:
 				return &JSON{val: tv.RawValue()}, nil
+			case DecimalType:
+				return &JSON{val: tv.RawValue().(*Decimal).Float64()}, nil
 			case VarcharType:
 				var x interface{}
 				s := strings.TrimSuffix(strings.TrimPrefix(tv.String(), "'"), "'")
@@ -0,0 +1,315 @@
+/*
+Copyright 2026 Codenotary Inc. All rights reserved.
+
+SPDX-License-Identifier: BUSL-1.1
+*/
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindowFrameStmts(t *testing.T) {
+	stmts, err := ParseSQLString(`
+		SELECT id,
+			sum(val) OVER (PARTITION BY grp ORDER BY id ROWS BETWEEN 1 PRECEDING AND CURRENT ROW),
+			count(*) OVER (ORDER BY val RANGE 2.5 PRECEDING),
+			nth_value(val, 2) OVER w,
+			rank() OVER w
+		FROM t
+		WINDOW w AS (ORDER BY val DESC ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)
+		ORDER BY id
+	`)
+	require.NoError(t, err)
+	require.Len(t, stmts, 1)
+
+	sel := stmts[0].(*SelectStmt)
+	require.Len(t, sel.orderBy, 1)
+
+	sum := sel.targets[1].Exp.(*WindowFnExp)
+	require.Equal(t, "SUM", sum.fnName)
+	require.Len(t, sum.partitionBy, 1)
+	require.Equal(t, &windowFrame{
+		mode:  frameRows,
+		start: frameBound{kind: offsetPreceding, offset: &Integer{val: 1}},
+		end:   frameBound{kind: currentRow},
+	}, sum.frame)
+
+	count := sel.targets[2].Exp.(*WindowFnExp)
+	require.Equal(t, &windowFrame{
+		mode:  frameRange,
+		start: frameBound{kind: offsetPreceding, offset: &Float64{val: 2.5}},
+		end:   frameBound{kind: currentRow},
+	}, count.frame)
+
+	whole := &windowFrame{
+		mode:  frameRows,
+		start: frameBound{kind: unboundedPreceding},
+		end:   frameBound{kind: unboundedFollowing},
+	}
+
+	for _, target := range sel.targets[3:] {
+		wfn := target.Exp.(*WindowFnExp)
+		require.Empty(t, wfn.window)
+		require.Equal(t, whole, wfn.frame)
+		require.Len(t, wfn.orderBy, 1)
+		require.True(t, wfn.orderBy[0].descOrder)
+	}
+
+	_, err = ParseSQLString("SELECT rank() OVER w FROM t WINDOW v AS (ORDER BY id)")
+	require.ErrorContains(t, err, "window w does not exist")
+
+	_, err = ParseSQLString("SELECT rank() OVER w FROM t WINDOW w AS (ORDER BY id), w AS ()")
+	require.ErrorContains(t, err, "window w is already defined")
+
+	_, err = ParseSQLString("SELECT sum(val) OVER (ORDER BY id ROWS BETWEEN 1 PRECEDING) FROM t")
+	require.Error(t, err)
+}
+
+func TestWindowFrames(t *testing.T) {
+	engine := setupCommonTest(t)
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, `
+		CREATE TABLE t (id INTEGER, grp VARCHAR, val INTEGER, PRIMARY KEY id);
+		INSERT INTO t (id, grp, val) VALUES (1, 'a', 10), (2, 'a', 20), (3, 'a', 20), (4, 'a', 40), (5, 'b', 5), (6, 'b', 15);
+	`, nil)
+	require.NoError(t, err)
+
+	column := func(t *testing.T, sql string, col int) []interface{} {
+		rows, err := engine.queryAll(ctx, nil, sql, nil)
+		require.NoError(t, err)
+
+		vals := make([]interface{}, len(rows))
+		for i, row := range rows {
+			vals[i] = row.ValuesByPosition[col].RawValue()
+		}
+		return vals
+	}
+
+	t.Run("rows frames", func(t *testing.T) {
+		require.Equal(t,
+			[]interface{}{10.0, 30.0, 40.0, 60.0, 5.0, 20.0},
+			column(t, "SELECT id, sum(val) OVER (PARTITION BY grp ORDER BY id ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{10.0, 30.0, 50.0, 90.0, 5.0, 20.0},
+			column(t, "SELECT id, sum(val) OVER (PARTITION BY grp ORDER BY val ROWS UNBOUNDED PRECEDING) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(10), int64(10), int64(10), int64(5), int64(5), int64(5)},
+			column(t, "SELECT id, min(val) OVER (ORDER BY id ROWS BETWEEN 2 PRECEDING AND 1 FOLLOWING) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(3), int64(3), int64(3), int64(3), int64(2), int64(1)},
+			column(t, "SELECT id, count(val) OVER (ORDER BY id ROWS BETWEEN CURRENT ROW AND 2 FOLLOWING) FROM t ORDER BY id", 1),
+		)
+	})
+
+	t.Run("range frames", func(t *testing.T) {
+		require.Equal(t,
+			[]interface{}{10.0, 50.0, 50.0, 90.0, 5.0, 20.0},
+			column(t, "SELECT id, sum(val) OVER (PARTITION BY grp ORDER BY val RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(5), int64(4), int64(4), int64(1), int64(3), int64(5)},
+			column(t, "SELECT id, count(*) OVER (ORDER BY val RANGE BETWEEN 10 PRECEDING AND 10 FOLLOWING) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(4), int64(2), int64(2), int64(1), int64(3), int64(3)},
+			column(t, "SELECT id, count(*) OVER (ORDER BY val DESC RANGE BETWEEN 10 PRECEDING AND CURRENT ROW) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{7.5, 55.0 / 3, 55.0 / 3, 40.0, 5.0, 12.5},
+			column(t, "SELECT id, avg(val) OVER (ORDER BY val RANGE BETWEEN 5.5 PRECEDING AND 0 FOLLOWING) FROM t ORDER BY id", 1),
+		)
+	})
+
+	t.Run("value functions", func(t *testing.T) {
+		frame := "OVER (ORDER BY id ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING)"
+
+		require.Equal(t,
+			[]interface{}{int64(10), int64(10), int64(20), int64(20), int64(40), int64(5)},
+			column(t, "SELECT id, first_value(val) "+frame+" FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(20), int64(20), int64(40), int64(5), int64(15), int64(15)},
+			column(t, "SELECT id, last_value(val) "+frame+" FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{nil, int64(20), int64(40), int64(5), int64(15), nil},
+			column(t, "SELECT id, nth_value(val, 3) "+frame+" FROM t ORDER BY id", 1),
+		)
+
+		// without a frame clause the frame of an ordered window ends at the current row
+		require.Equal(t,
+			[]interface{}{nil, int64(20), int64(20), int64(20), nil, int64(15)},
+			column(t, "SELECT id, nth_value(val, 2) OVER (PARTITION BY grp ORDER BY id) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(0), int64(0), int64(10), int64(20), int64(20), int64(40)},
+			column(t, "SELECT id, lag(val, 2, 0) OVER (ORDER BY id) FROM t ORDER BY id", 1),
+		)
+	})
+
+	t.Run("ranking functions", func(t *testing.T) {
+		require.Equal(t,
+			[]interface{}{0.2, 0.6, 0.6, 1.0, 0.0, 0.4},
+			column(t, "SELECT id, percent_rank() OVER (ORDER BY val) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{2.0 / 6, 5.0 / 6, 5.0 / 6, 1.0, 1.0 / 6, 3.0 / 6},
+			column(t, "SELECT id, cume_dist() OVER (ORDER BY val) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(1), int64(1), int64(2), int64(3), int64(1), int64(2)},
+			column(t, "SELECT id, ntile(3) OVER (PARTITION BY grp ORDER BY id) FROM t ORDER BY id", 1),
+		)
+	})
+
+	t.Run("named windows", func(t *testing.T) {
+		sql := `
+			SELECT id, row_number() OVER w, sum(val) OVER w, sum(val) OVER running
+			FROM t
+			WINDOW w AS (PARTITION BY grp ORDER BY id),
+				running AS (PARTITION BY grp ORDER BY id ROWS UNBOUNDED PRECEDING)
+			ORDER BY id`
+
+		require.Equal(t, []interface{}{int64(1), int64(2), int64(3), int64(4), int64(1), int64(2)}, column(t, sql, 1))
+		require.Equal(t, []interface{}{10.0, 30.0, 50.0, 90.0, 5.0, 20.0}, column(t, sql, 2))
+		require.Equal(t, []interface{}{10.0, 30.0, 50.0, 90.0, 5.0, 20.0}, column(t, sql, 3))
+	})
+
+	t.Run("default frames", func(t *testing.T) {
+		// ordered windows default to RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW,
+		// so the running sum includes the peers of the current row
+		require.Equal(t,
+			[]interface{}{10.0, 50.0, 50.0, 90.0, 5.0, 20.0},
+			column(t, "SELECT id, sum(val) OVER (PARTITION BY grp ORDER BY val) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(1), int64(3), int64(3), int64(4), int64(1), int64(2)},
+			column(t, "SELECT id, count(*) OVER (PARTITION BY grp ORDER BY val) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(1), int64(3), int64(3), int64(4), int64(5), int64(6)},
+			column(t, "SELECT id, last_value(id) OVER (ORDER BY grp, val) FROM t ORDER BY id", 1),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(10), int64(20), int64(20), int64(40), int64(5), int64(15)},
+			column(t, "SELECT id, last_value(val) OVER (PARTITION BY grp ORDER BY id) FROM t ORDER BY id", 1),
+		)
+
+		// without ORDER BY the frame is the whole partition
+		require.Equal(t,
+			[]interface{}{90.0, 90.0, 90.0, 90.0, 20.0, 20.0},
+			column(t, "SELECT id, sum(val) OVER (PARTITION BY grp) FROM t ORDER BY id", 1),
+		)
+	})
+
+	t.Run("output order", func(t *testing.T) {
+		// without ORDER BY rows are emitted in window order, ties in input order
+		require.Equal(t,
+			[]interface{}{int64(4), int64(2), int64(3), int64(6), int64(1), int64(5)},
+			column(t, "SELECT id, row_number() OVER (ORDER BY val DESC) FROM t", 0),
+		)
+
+		require.Equal(t,
+			[]interface{}{int64(5), int64(1), int64(6), int64(2), int64(3), int64(4)},
+			column(t, "SELECT id, row_number() OVER (ORDER BY val DESC), rank() OVER (ORDER BY val) FROM t", 0),
+		)
+	})
+
+	t.Run("invalid frames", func(t *testing.T) {
+		for _, sql := range []string{
+			"SELECT sum(val) OVER (ORDER BY id ROWS BETWEEN CURRENT ROW AND 1 PRECEDING) FROM t",
+			"SELECT sum(val) OVER (ORDER BY id ROWS BETWEEN 1 FOLLOWING AND CURRENT ROW) FROM t",
+			"SELECT sum(val) OVER (ORDER BY id ROWS UNBOUNDED FOLLOWING) FROM t",
+			"SELECT sum(val) OVER (ORDER BY id ROWS 1.5 PRECEDING) FROM t",
+			"SELECT sum(val) OVER (ORDER BY id, val RANGE 1 PRECEDING) FROM t",
+			"SELECT sum(val) OVER (RANGE 1 PRECEDING) FROM t",
+			"SELECT nth_value(val, 0) OVER () FROM t",
+			"SELECT ntile(val) OVER () FROM t",
+			"SELECT median(val) OVER () FROM t",
+		} {
+			_, err := engine.queryAll(ctx, nil, sql, nil)
+			require.ErrorIs(t, err, ErrIllegalArguments, sql)
+		}
+
+		_, err := engine.queryAll(ctx, nil, "SELECT sum(val) OVER (ORDER BY grp RANGE 1 PRECEDING) FROM t", nil)
+		require.ErrorIs(t, err, ErrIllegalArguments)
+	})
+}
+
+func TestWindowFunctionsSpilling(t *testing.T) {
+	// partitions are larger than the sort buffer
+	engine, _ := setupCommonTestWithEngineOptions(t, DefaultOptions().WithPrefix(sqlPrefix).WithSortBufferSize(4))
+
+	ctx := context.Background()
+
+	_, _, err := engine.Exec(ctx, nil, "CREATE TABLE big (id INTEGER, grp INTEGER, val INTEGER, PRIMARY KEY id)", nil)
+	require.NoError(t, err)
+
+	const n = 100
+
+	for i := 1; i <= n; i++ {
+		_, _, err = engine.Exec(ctx, nil, fmt.Sprintf("INSERT INTO big (id, grp, val) VALUES (%d, %d, %d)", i, i%3, i*10), nil)
+		require.NoError(t, err)
+	}
+
+	rows, err := engine.queryAll(ctx, nil, `
+		SELECT id,
+			sum(val) OVER (PARTITION BY grp ORDER BY id ROWS BETWEEN 2 PRECEDING AND CURRENT ROW),
+			count(*) OVER (PARTITION BY grp),
+			row_number() OVER (ORDER BY id DESC),
+			last_value(val) OVER (PARTITION BY grp ORDER BY id ROWS BETWEEN CURRENT ROW AND UNBOUNDED FOLLOWING)
+		FROM big
+		ORDER BY id`, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, n)
+
+	for i, row := range rows {
+		id := int64(i + 1)
+		require.Equal(t, id, row.ValuesByPosition[0].RawValue())
+
+		var sum int64
+		for j := id; j > 0 && j > id-9; j -= 3 {
+			sum += j * 10
+		}
+		require.Equal(t, float64(sum), row.ValuesByPosition[1].RawValue())
+
+		count := int64(n / 3)
+		if id%3 == 1 {
+			count++
+		}
+		require.Equal(t, count, row.ValuesByPosition[2].RawValue())
+
+		require.Equal(t, n-id+1, row.ValuesByPosition[3].RawValue())
+
+		last := int64(n)
+		for last%3 != id%3 {
+			last--
+		}
+		require.Equal(t, last*10, row.ValuesByPosition[4].RawValue())
+	}
+}
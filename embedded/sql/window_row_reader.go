@@ -17,48 +17,162 @@ limitations under the License.
 package sql
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
+	"math"
+	"os"
 	"sort"
+	"strings"
 )
 
-// windowRowReader materializes all input rows, groups them by partition key,
-// sorts within each partition, computes window function values, and emits
-// rows with the window columns appended.
+// windowSeqColumn is the hidden column numbering the rows flowing through a
+// windowRowReader. It breaks ties when sorting, so that rows keep the order
+// of the previous pass, and restores the input order when required.
+const windowSeqColumn = "\x00seq"
+
+// windowRowReader computes window functions over the rows of its inner
+// reader and emits them with the window columns appended.
+//
+// Window functions sharing the same PARTITION BY and ORDER BY are computed
+// in a single pass: rows are sorted by partition and order keys with a
+// fileSorter, spilling to disk when they do not fit the sort buffer, and
+// then streamed one partition at a time. Only the current partition is
+// buffered, and it spills to a temporary file as well once it outgrows
+// the sort buffer.
+//
+// Rows are emitted in the order of the last pass, unless the query has its
+// own ORDER BY, in which case the order of the inner reader is preserved.
 type windowRowReader struct {
 	inner RowReader
 
-	windowFns   []*WindowFnExp
-	innerCols   []ColDescriptor
-	maxRows     int // 0 = unlimited
+	windowFns     []*WindowFnExp
+	innerCols     []ColDescriptor
+	resultTypes   []SQLValueType
+	maxRows       int // 0 = unlimited
+	preserveOrder bool
+
+	// colTypes and colPosBySelector describe the rows being sorted and
+	// buffered: inner columns, window columns and the sequence column
+	colTypes         []SQLValueType
+	colPosBySelector map[string]int
+	windowSelectors  []string
+	seqSelector      string
+	bufSize          int
 
-	loaded    bool
-	rows      []*Row
-	rowIdx    int
+	loaded  bool
+	loadErr error
+	sorters []*fileSorter
+	result  resultReader
 }
 
-func newWindowRowReader(ctx context.Context, inner RowReader, windowFns []*WindowFnExp, maxRows int) (*windowRowReader, error) {
+func newWindowRowReader(ctx context.Context, inner RowReader, windowFns []*WindowFnExp, maxRows int, preserveOrder bool) (*windowRowReader, error) {
 	innerCols, err := inner.Columns(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &windowRowReader{
-		inner:     inner,
-		windowFns: windowFns,
-		innerCols: innerCols,
-		maxRows:   maxRows,
-	}, nil
+	colsBySelector := make(map[string]ColDescriptor, len(innerCols))
+	for _, c := range innerCols {
+		colsBySelector[c.Selector()] = c
+	}
+
+	resultTypes := make([]SQLValueType, len(windowFns))
+
+	for i, wfn := range windowFns {
+		if wfn.window != "" {
+			return nil, fmt.Errorf("%w: window %s does not exist", ErrIllegalArguments, wfn.window)
+		}
+
+		if !isWindowFunction(wfn.fnName) {
+			return nil, fmt.Errorf("%w: unknown window function %s", ErrIllegalArguments, strings.ToLower(wfn.fnName))
+		}
+
+		if wfn.frame != nil {
+			if err := wfn.frame.validate(wfn.orderBy); err != nil {
+				return nil, err
+			}
+		}
+
+		t, err := wfn.inferType(colsBySelector, make(map[string]SQLValueType), inner.TableAlias())
+		if err != nil {
+			return nil, err
+		}
+		resultTypes[i] = t
+	}
+
+	wr := &windowRowReader{
+		inner:         inner,
+		windowFns:     windowFns,
+		innerCols:     innerCols,
+		resultTypes:   resultTypes,
+		maxRows:       maxRows,
+		preserveOrder: preserveOrder,
+		bufSize:       math.MaxInt,
+	}
+
+	cols, err := wr.Columns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seqCol := ColDescriptor{Table: inner.TableAlias(), Column: windowSeqColumn, Type: IntegerType}
+	cols = append(cols, seqCol)
+
+	wr.seqSelector = seqCol.Selector()
+
+	wr.colPosBySelector, err = getColPositionsBySelector(cols)
+	if err != nil {
+		return nil, err
+	}
+
+	spillable := true
+
+	wr.colTypes = make([]SQLValueType, len(cols))
+	for i, c := range cols {
+		wr.colTypes[i] = c.Type
+		spillable = spillable && c.Type != AnyType
+	}
+
+	for _, c := range cols[len(innerCols) : len(cols)-1] {
+		wr.windowSelectors = append(wr.windowSelectors, c.Selector())
+	}
+
+	// values of unknown type cannot be decoded back from disk, nor rows
+	// with ambiguous selectors
+	spillable = spillable && len(wr.colPosBySelector) == len(cols)
+
+	if tx := inner.Tx(); spillable && tx != nil && tx.engine != nil {
+		wr.bufSize = tx.engine.sortBufferSize
+	}
+	return wr, nil
+}
+
+func isWindowFunction(fnName string) bool {
+	switch fnName {
+	case "ROW_NUMBER", "RANK", "DENSE_RANK", "PERCENT_RANK", "CUME_DIST", "NTILE",
+		"COUNT", "SUM", "MIN", "MAX", "AVG",
+		"LAG", "LEAD", "FIRST_VALUE", "LAST_VALUE", "NTH_VALUE":
+		return true
+	}
+	return false
 }
 
 func (wr *windowRowReader) materialize(ctx context.Context) error {
-	if wr.loaded {
-		return nil
+	if !wr.loaded {
+		wr.loaded = true
+		wr.loadErr = wr.computeWindows(ctx)
 	}
-	wr.loaded = true
+	return wr.loadErr
+}
+
+func (wr *windowRowReader) computeWindows(ctx context.Context) error {
+	passes := wr.windowPasses()
+
+	sorter := wr.newSorter(wr.windowFns[passes[0][0]])
 
-	// Read all rows (with optional limit to prevent OOM)
-	var allRows []*Row
+	var n int64
 	for {
 		row, err := wr.inner.Read(ctx)
 		if err == ErrNoMoreRows {
@@ -67,315 +181,919 @@ func (wr *windowRowReader) materialize(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		allRows = append(allRows, row)
+		n++
 
-		if wr.maxRows > 0 && len(allRows) > wr.maxRows {
+		if wr.maxRows > 0 && n > int64(wr.maxRows) {
 			return fmt.Errorf("%w: %d rows exceed limit of %d",
-				ErrWindowRowsLimitExceeded, len(allRows), wr.maxRows)
+				ErrWindowRowsLimitExceeded, n, wr.maxRows)
+		}
+
+		wr.extendRow(row, n)
+
+		if err := sorter.update(row); err != nil {
+			return err
 		}
 	}
 
-	if len(allRows) == 0 {
-		return nil
+	for i, pass := range passes {
+		// rows of the last pass are sorted back by sequence number
+		var next *fileSorter
+		if i+1 < len(passes) {
+			next = wr.newSorter(wr.windowFns[passes[i+1][0]])
+		} else {
+			next = wr.newSorter(nil)
+		}
+
+		if err := wr.computePass(ctx, sorter, pass, next); err != nil {
+			return err
+		}
+		sorter = next
+	}
+
+	result, err := sorter.finalize()
+	if err != nil {
+		return err
+	}
+	wr.result = result
+
+	return nil
+}
+
+// windowPasses groups the window functions sharing the same partitioning
+// and ordering, in order of appearance
+func (wr *windowRowReader) windowPasses() [][]int {
+	var passes [][]int
+	passBySpec := make(map[string]int)
+
+	for i, wfn := range wr.windowFns {
+		spec := windowSpecKey(wfn)
+
+		p, ok := passBySpec[spec]
+		if !ok {
+			p = len(passes)
+			passBySpec[spec] = p
+			passes = append(passes, nil)
+		}
+		passes[p] = append(passes[p], i)
+	}
+	return passes
+}
+
+func windowSpecKey(wfn *WindowFnExp) string {
+	var sb strings.Builder
+	for _, exp := range wfn.partitionBy {
+		sb.WriteString(exp.String())
+		sb.WriteByte(0)
+	}
+	sb.WriteByte(1)
+	for _, ord := range wfn.orderBy {
+		fmt.Fprintf(&sb, "%s %v %v", ord.exp.String(), ord.descOrder, ord.nullsOrder)
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// extendRow appends placeholders for the window columns and the sequence
+// number to an input row
+func (wr *windowRowReader) extendRow(row *Row, seq int64) {
+	for i, wfn := range wr.windowFns {
+		wr.setWindowValue(row, i, wfn, NewNull(wr.resultTypes[i]))
+	}
+	wr.setSeq(row, seq)
+}
+
+func (wr *windowRowReader) setWindowValue(row *Row, i int, wfn *WindowFnExp, val TypedValue) {
+	pos := len(wr.innerCols) + i
+	if pos < len(row.ValuesByPosition) {
+		row.ValuesByPosition[pos] = val
+	} else {
+		row.ValuesByPosition = append(row.ValuesByPosition, val)
+	}
+	row.ValuesBySelector[wfn.selectorName()] = val
+	row.ValuesBySelector[wr.windowSelectors[i]] = val
+}
+
+func (wr *windowRowReader) setSeq(row *Row, seq int64) {
+	pos := len(wr.innerCols) + len(wr.windowFns)
+	val := NewInteger(seq)
+
+	if pos < len(row.ValuesByPosition) {
+		row.ValuesByPosition[pos] = val
+	} else {
+		row.ValuesByPosition = append(row.ValuesByPosition, val)
+	}
+	row.ValuesBySelector[wr.seqSelector] = val
+}
+
+func (wr *windowRowReader) seq(row *Row) int64 {
+	return row.ValuesByPosition[len(wr.innerCols)+len(wr.windowFns)].RawValue().(int64)
+}
+
+// restoreSelectors sets the selectors window function expressions are
+// reduced with, which are not kept by rows decoded from disk
+func (wr *windowRowReader) restoreSelectors(row *Row) {
+	for i, wfn := range wr.windowFns {
+		row.ValuesBySelector[wfn.selectorName()] = row.ValuesByPosition[len(wr.innerCols)+i]
+	}
+}
+
+// newSorter returns a sorter ordering rows by the partition and order
+// expressions of spec, then by sequence number
+func (wr *windowRowReader) newSorter(spec *WindowFnExp) *fileSorter {
+	sorter := &fileSorter{
+		colPosBySelector: wr.colPosBySelector,
+		colTypes:         wr.colTypes,
+		tx:               wr.inner.Tx(),
+		sortBufSize:      wr.bufSize,
 	}
 
 	tx := wr.inner.Tx()
 	tableAlias := wr.inner.TableAlias()
 
-	// Process each window function
-	for _, wfn := range wr.windowFns {
-		// Partition rows
-		partitions := wr.partitionRows(allRows, wfn, tx, tableAlias)
+	sorter.cmp = func(r1, r2 *Row) (int, error) {
+		if spec != nil {
+			for _, exp := range spec.partitionBy {
+				res, err := compareWindowExp(tx, tableAlias, exp, r1, r2, false, NullsDefault)
+				if err != nil || res != 0 {
+					return res, err
+				}
+			}
 
-		// Sort within each partition
-		for _, partition := range partitions {
-			if len(wfn.orderBy) > 0 {
-				wr.sortPartition(partition, wfn, tx, tableAlias)
+			for _, ord := range spec.orderBy {
+				res, err := compareWindowExp(tx, tableAlias, ord.exp, r1, r2, ord.descOrder, ord.nullsOrder)
+				if err != nil || res != 0 {
+					return res, err
+				}
 			}
 		}
 
-		// Compute window values
-		for _, partition := range partitions {
-			wr.computeWindowValues(partition, wfn, tx, tableAlias)
+		s1, s2 := wr.seq(r1), wr.seq(r2)
+		if s1 < s2 {
+			return -1, nil
+		}
+		if s1 > s2 {
+			return 1, nil
 		}
+		return 0, nil
 	}
 
-	wr.rows = allRows
-	return nil
+	wr.sorters = append(wr.sorters, sorter)
+
+	return sorter
+}
+
+func compareWindowExp(tx *SQLTx, tableAlias string, exp ValueExp, r1, r2 *Row, desc bool, nullsOrder NullsOrder) (int, error) {
+	v1, err := exp.reduce(tx, r1, tableAlias)
+	if err != nil {
+		return 0, err
+	}
+
+	v2, err := exp.reduce(tx, r2, tableAlias)
+	if err != nil {
+		return 0, err
+	}
+	return compareWindowValues(v1, v2, desc, nullsOrder)
+}
+
+// compareWindowValues compares two values according to their sort order,
+// placing NULLs as the ORDER BY clause does
+func compareWindowValues(v1, v2 TypedValue, desc bool, nullsOrder NullsOrder) (int, error) {
+	v1Null := v1 == nil || v1.IsNull()
+	v2Null := v2 == nil || v2.IsNull()
+
+	if v1Null && v2Null {
+		return 0, nil
+	}
+
+	if v1Null || v2Null {
+		if nullsOrder == NullsDefault {
+			// immudb default: NULLS FIRST for ASC, NULLS LAST for DESC
+			nullsOrder = NullsFirst
+			if desc {
+				nullsOrder = NullsLast
+			}
+		}
+
+		if v1Null == (nullsOrder == NullsFirst) {
+			return -1, nil
+		}
+		return 1, nil
+	}
+
+	res, err := v1.Compare(v2)
+	if err != nil {
+		return 0, err
+	}
+
+	if desc {
+		return -res, nil
+	}
+	return res, nil
 }
 
-func (wr *windowRowReader) partitionRows(rows []*Row, wfn *WindowFnExp, tx *SQLTx, tableAlias string) [][]*Row {
-	if len(wfn.partitionBy) == 0 {
-		return [][]*Row{rows}
+// computePass computes the window functions of a pass over the rows of in,
+// sorted by partition, and feeds the resulting rows into out
+func (wr *windowRowReader) computePass(ctx context.Context, in *fileSorter, pass []int, out *fileSorter) error {
+	defer in.Close()
+
+	rows, err := in.finalize()
+	if err != nil {
+		return err
 	}
+	defer rows.Close()
+
+	spec := wr.windowFns[pass[0]]
 
-	partitionMap := make(map[string][]*Row)
-	var keys []string
+	partition := &windowPartition{wr: wr}
+	defer partition.close()
 
-	for _, row := range rows {
-		key := wr.partitionKey(row, wfn, tx, tableAlias)
-		if _, exists := partitionMap[key]; !exists {
-			keys = append(keys, key)
+	var emitted int64
+
+	emit := func(row *Row) error {
+		emitted++
+		if !wr.preserveOrder {
+			wr.setSeq(row, emitted)
 		}
-		partitionMap[key] = append(partitionMap[key], row)
+		return out.update(row)
 	}
 
-	partitions := make([][]*Row, 0, len(keys))
-	for _, k := range keys {
-		partitions = append(partitions, partitionMap[k])
+	var currKey string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		row, err := rows.Read()
+		if err == ErrNoMoreRows {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		wr.restoreSelectors(row)
+
+		key, err := wr.partitionKey(row, spec)
+		if err != nil {
+			return err
+		}
+
+		if partition.len() > 0 && key != currKey {
+			if err := wr.computePartition(partition, pass, emit); err != nil {
+				return err
+			}
+			partition.reset()
+		}
+		currKey = key
+
+		if err := partition.add(row); err != nil {
+			return err
+		}
 	}
-	return partitions
+
+	if partition.len() > 0 {
+		return wr.computePartition(partition, pass, emit)
+	}
+	return nil
 }
 
-func (wr *windowRowReader) partitionKey(row *Row, wfn *WindowFnExp, tx *SQLTx, tableAlias string) string {
+func (wr *windowRowReader) partitionKey(row *Row, wfn *WindowFnExp) (string, error) {
 	// Use the same binary encoding as hashGroupedRowReader.groupKey():
 	// 0x00 byte for SQL NULL, 0x01 + EncodeValue bytes for non-NULL.
-	// The previous pipe-delimited string approach had two correctness bugs:
-	//   1. VARCHAR values containing "|" produced colliding partition keys.
-	//   2. VARCHAR value "NULL" was indistinguishable from an actual SQL NULL.
 	var key []byte
 	for _, pexp := range wfn.partitionBy {
-		val, err := pexp.reduce(tx, row, tableAlias)
-		if err != nil || val == nil || val.IsNull() {
+		val, err := pexp.reduce(wr.inner.Tx(), row, wr.inner.TableAlias())
+		if err != nil {
+			return "", err
+		}
+
+		if val == nil || val.IsNull() {
 			key = append(key, 0) // NULL sentinel
 			continue
 		}
 		key = append(key, 1) // non-NULL sentinel
-		b, _ := EncodeValue(val, val.Type(), 0)
+
+		b, err := EncodeValue(val, val.Type(), 0)
+		if err != nil {
+			return "", err
+		}
 		key = append(key, b...)
 	}
-	return string(key)
+	return string(key), nil
 }
 
-func (wr *windowRowReader) sortPartition(partition []*Row, wfn *WindowFnExp, tx *SQLTx, tableAlias string) {
-	sort.SliceStable(partition, func(i, j int) bool {
-		for _, ord := range wfn.orderBy {
-			vi, erri := ord.exp.reduce(tx, partition[i], tableAlias)
-			vj, errj := ord.exp.reduce(tx, partition[j], tableAlias)
-			if erri != nil || errj != nil {
-				continue
+// computePartition computes the window functions of a pass over the rows of
+// a single partition, emitting each row once its values are set
+func (wr *windowRowReader) computePartition(p *windowPartition, pass []int, emit func(*Row) error) error {
+	e := &windowEval{
+		wr:      wr,
+		p:       p,
+		spec:    wr.windowFns[pass[0]],
+		n:       p.len(),
+		peerEnd: -1,
+		aggs:    make([]windowAggregate, len(pass)),
+	}
+
+	for i := 0; i < e.n; i++ {
+		if i > e.peerEnd {
+			peerEnd, err := e.lastPeer(i)
+			if err != nil {
+				return err
 			}
-			cmp, err := vi.Compare(vj)
-			if err != nil || cmp == 0 {
-				continue
+			e.peerStart, e.peerEnd = i, peerEnd
+			e.denseRank++
+		}
+
+		row, err := p.get(i)
+		if err != nil {
+			return err
+		}
+
+		for k, fnIdx := range pass {
+			wfn := wr.windowFns[fnIdx]
+
+			val, err := e.value(wfn, &e.aggs[k], i)
+			if err != nil {
+				return err
 			}
-			if ord.descOrder {
-				return cmp > 0
+
+			t := wr.resultTypes[fnIdx]
+			if t != AnyType && (val.IsNull() || val.Type() != t) {
+				val, err = convertToType(val, t)
+				if err != nil {
+					return err
+				}
 			}
-			return cmp < 0
+			wr.setWindowValue(row, fnIdx, wfn, val)
 		}
-		return false
-	})
+
+		if err := emit(row); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (wr *windowRowReader) computeWindowValues(partition []*Row, wfn *WindowFnExp, tx *SQLTx, tableAlias string) {
-	fnName := wfn.fnName
-	colSel := wfn.selectorName()
+// windowEval holds the state of the computation of window functions over
+// the rows of a partition
+type windowEval struct {
+	wr   *windowRowReader
+	p    *windowPartition
+	spec *WindowFnExp
+	n    int
 
-	switch fnName {
-	case "ROW_NUMBER":
-		for i, row := range partition {
-			appendWindowValue(row, colSel, NewInteger(int64(i+1)))
+	// peers of the current row, i.e. rows with equal ORDER BY values
+	peerStart int
+	peerEnd   int
+	denseRank int64
+
+	aggs []windowAggregate
+}
+
+func (e *windowEval) reduce(exp ValueExp, i int) (TypedValue, error) {
+	row, err := e.p.get(i)
+	if err != nil {
+		return nil, err
+	}
+	return exp.reduce(e.wr.inner.Tx(), row, e.wr.inner.TableAlias())
+}
+
+// lastPeer returns the index of the last row of the peer group of row i;
+// without ORDER BY all the rows of the partition are peers
+func (e *windowEval) lastPeer(i int) (int, error) {
+	if len(e.spec.orderBy) == 0 {
+		return e.n - 1, nil
+	}
+
+	curr, err := e.p.get(i)
+	if err != nil {
+		return 0, err
+	}
+
+	j := i
+	for ; j+1 < e.n; j++ {
+		next, err := e.p.get(j + 1)
+		if err != nil {
+			return 0, err
 		}
 
-	case "RANK":
-		rank := 1
-		for i, row := range partition {
-			if i > 0 && !wr.orderByEqual(partition[i-1], row, wfn, tx, tableAlias) {
-				rank = i + 1
+		for _, ord := range e.spec.orderBy {
+			res, err := compareWindowExp(e.wr.inner.Tx(), e.wr.inner.TableAlias(), ord.exp, curr, next, ord.descOrder, ord.nullsOrder)
+			if err != nil {
+				return 0, err
+			}
+			if res != 0 {
+				return j, nil
 			}
-			appendWindowValue(row, colSel, NewInteger(int64(rank)))
 		}
+	}
+	return j, nil
+}
+
+func (e *windowEval) value(wfn *WindowFnExp, agg *windowAggregate, i int) (TypedValue, error) {
+	switch wfn.fnName {
+	case "ROW_NUMBER":
+		return NewInteger(int64(i + 1)), nil
+
+	case "RANK":
+		return NewInteger(int64(e.peerStart + 1)), nil
 
 	case "DENSE_RANK":
-		rank := 1
-		for i, row := range partition {
-			if i > 0 && !wr.orderByEqual(partition[i-1], row, wfn, tx, tableAlias) {
-				rank++
-			}
-			appendWindowValue(row, colSel, NewInteger(int64(rank)))
+		return NewInteger(e.denseRank), nil
+
+	case "PERCENT_RANK":
+		if e.n == 1 {
+			return NewFloat64(0), nil
 		}
+		return NewFloat64(float64(e.peerStart) / float64(e.n-1)), nil
 
-	case "COUNT":
-		count := int64(len(partition))
-		for _, row := range partition {
-			appendWindowValue(row, colSel, NewInteger(count))
+	case "CUME_DIST":
+		return NewFloat64(float64(e.peerEnd+1) / float64(e.n)), nil
+
+	case "NTILE":
+		buckets, err := windowIntParam(wfn, 0, 1)
+		if err != nil {
+			return nil, err
 		}
+		if buckets <= 0 {
+			return nil, fmt.Errorf("%w: number of buckets must be positive", ErrIllegalArguments)
+		}
+		return NewInteger(int64(i)*buckets/int64(e.n) + 1), nil
 
-	case "SUM":
-		var sum float64
-		for _, row := range partition {
-			if len(wfn.params) > 0 {
-				val, err := wfn.params[0].reduce(tx, row, tableAlias)
-				if err == nil && !val.IsNull() {
-					switch v := val.RawValue().(type) {
-					case int64:
-						sum += float64(v)
-					case float64:
-						sum += v
-					}
-				}
-			}
+	case "LAG", "LEAD":
+		if len(wfn.params) == 0 {
+			return nil, fmt.Errorf("%w: %s requires an argument", ErrIllegalArguments, strings.ToLower(wfn.fnName))
 		}
-		for _, row := range partition {
-			appendWindowValue(row, colSel, NewFloat64(sum))
-		}
-
-	case "MIN", "MAX":
-		var result TypedValue
-		for _, row := range partition {
-			if len(wfn.params) > 0 {
-				val, err := wfn.params[0].reduce(tx, row, tableAlias)
-				if err == nil && !val.IsNull() {
-					if result == nil {
-						result = val
-					} else {
-						cmp, err := val.Compare(result)
-						if err == nil {
-							if (fnName == "MIN" && cmp < 0) || (fnName == "MAX" && cmp > 0) {
-								result = val
-							}
-						}
-					}
-				}
-			}
+
+		offset, err := windowIntParam(wfn, 1, 1)
+		if err != nil {
+			return nil, err
 		}
-		for _, row := range partition {
-			if result != nil {
-				appendWindowValue(row, colSel, result)
-			} else {
-				appendWindowValue(row, colSel, NewNull(IntegerType))
-			}
+
+		if wfn.fnName == "LAG" {
+			offset = -offset
 		}
 
-	case "AVG":
-		var sum float64
-		var count int64
-		for _, row := range partition {
-			if len(wfn.params) > 0 {
-				val, err := wfn.params[0].reduce(tx, row, tableAlias)
-				if err == nil && !val.IsNull() {
-					switch v := val.RawValue().(type) {
-					case int64:
-						sum += float64(v)
-						count++
-					case float64:
-						sum += v
-						count++
-					}
-				}
-			}
+		if j := int64(i) + offset; j >= 0 && j < int64(e.n) {
+			return e.reduce(wfn.params[0], int(j))
 		}
-		if count > 0 {
-			avg := sum / float64(count)
-			for _, row := range partition {
-				appendWindowValue(row, colSel, NewFloat64(avg))
-			}
-		} else {
-			for _, row := range partition {
-				appendWindowValue(row, colSel, NewNull(Float64Type))
-			}
+
+		if len(wfn.params) > 2 {
+			return e.reduce(wfn.params[2], i)
 		}
+		return NewNull(AnyType), nil
+	}
 
-	case "LAG":
-		for i, row := range partition {
-			offset := 1
-			if len(wfn.params) > 1 {
-				if ov, ok := wfn.params[1].(*Integer); ok {
-					offset = int(ov.val)
-				}
-			}
-			prev := i - offset
-			if prev >= 0 && prev < len(partition) && len(wfn.params) > 0 {
-				val, err := wfn.params[0].reduce(tx, partition[prev], tableAlias)
-				if err == nil {
-					appendWindowValue(row, colSel, val)
-					continue
-				}
-			}
-			appendWindowValue(row, colSel, NewNull(AnyType))
+	start, end, err := e.frame(wfn, i)
+	if err != nil {
+		return nil, err
+	}
+
+	switch wfn.fnName {
+	case "FIRST_VALUE", "LAST_VALUE", "NTH_VALUE":
+		if len(wfn.params) == 0 {
+			return nil, fmt.Errorf("%w: %s requires an argument", ErrIllegalArguments, strings.ToLower(wfn.fnName))
 		}
 
-	case "LEAD":
-		for i, row := range partition {
-			offset := 1
-			if len(wfn.params) > 1 {
-				if ov, ok := wfn.params[1].(*Integer); ok {
-					offset = int(ov.val)
-				}
-			}
-			next := i + offset
-			if next >= 0 && next < len(partition) && len(wfn.params) > 0 {
-				val, err := wfn.params[0].reduce(tx, partition[next], tableAlias)
-				if err == nil {
-					appendWindowValue(row, colSel, val)
-					continue
-				}
-			}
-			appendWindowValue(row, colSel, NewNull(AnyType))
+		j := start
+		if wfn.fnName == "LAST_VALUE" {
+			j = end
 		}
 
-	case "FIRST_VALUE":
-		if len(partition) > 0 && len(wfn.params) > 0 {
-			firstVal, err := wfn.params[0].reduce(tx, partition[0], tableAlias)
+		if wfn.fnName == "NTH_VALUE" {
+			nth, err := windowIntParam(wfn, 1, 0)
 			if err != nil {
-				firstVal = NewNull(AnyType)
+				return nil, err
 			}
-			for _, row := range partition {
-				appendWindowValue(row, colSel, firstVal)
+			if nth <= 0 {
+				return nil, fmt.Errorf("%w: nth_value position must be positive", ErrIllegalArguments)
 			}
-		}
 
-	case "LAST_VALUE":
-		if len(partition) > 0 && len(wfn.params) > 0 {
-			lastVal, err := wfn.params[0].reduce(tx, partition[len(partition)-1], tableAlias)
-			if err != nil {
-				lastVal = NewNull(AnyType)
-			}
-			for _, row := range partition {
-				appendWindowValue(row, colSel, lastVal)
+			if nth > int64(end-start+1) {
+				return NewNull(AnyType), nil
 			}
+			j = start + int(nth) - 1
 		}
 
-	case "NTILE":
-		buckets := int64(1)
-		if len(wfn.params) > 0 {
-			if bv, ok := wfn.params[0].(*Integer); ok && bv.val > 0 {
-				buckets = bv.val
+		if start > end {
+			return NewNull(AnyType), nil
+		}
+		return e.reduce(wfn.params[0], j)
+	}
+
+	if wfn.fnName == "COUNT" && len(wfn.params) == 0 {
+		return NewInteger(int64(max(end-start+1, 0))), nil
+	}
+
+	if err := agg.moveTo(e, wfn, start, end); err != nil {
+		return nil, err
+	}
+	return agg.value(wfn), nil
+}
+
+func windowIntParam(wfn *WindowFnExp, i int, defaultVal int64) (int64, error) {
+	if len(wfn.params) <= i {
+		return defaultVal, nil
+	}
+
+	v, ok := wfn.params[i].(*Integer)
+	if !ok {
+		return 0, fmt.Errorf("%w: argument %d of %s must be an integer constant", ErrIllegalArguments, i+1, strings.ToLower(wfn.fnName))
+	}
+	return v.val, nil
+}
+
+// frame returns the bounds of the frame of row i, which is empty when
+// start > end. Without a frame clause the frame is the whole partition, or
+// RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW when the window is ordered.
+func (e *windowEval) frame(wfn *WindowFnExp, i int) (start, end int, err error) {
+	if wfn.frame == nil {
+		if len(wfn.orderBy) == 0 {
+			return 0, e.n - 1, nil
+		}
+		return 0, e.peerEnd, nil
+	}
+
+	start, err = e.frameBound(wfn.frame, wfn.frame.start, i, true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	end, err = e.frameBound(wfn.frame, wfn.frame.end, i, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	return max(start, 0), min(end, e.n-1), nil
+}
+
+func (e *windowEval) frameBound(f *windowFrame, b frameBound, i int, isStart bool) (int, error) {
+	switch b.kind {
+	case unboundedPreceding:
+		return 0, nil
+	case unboundedFollowing:
+		return e.n - 1, nil
+	}
+
+	if f.mode == frameRows {
+		if b.kind == currentRow {
+			return i, nil
+		}
+
+		// offsets larger than the partition are capped to avoid overflows
+		offset := int(min(b.offset.RawValue().(int64), int64(e.n)))
+
+		if b.kind == offsetPreceding {
+			return i - offset, nil
+		}
+		return i + offset, nil
+	}
+
+	if b.kind == currentRow {
+		if isStart {
+			return e.peerStart, nil
+		}
+		return e.peerEnd, nil
+	}
+
+	ord := e.spec.orderBy[0]
+
+	v, err := e.reduce(ord.exp, i)
+	if err != nil {
+		return 0, err
+	}
+
+	// the frame of a NULL value consists of its peers
+	if v.IsNull() {
+		if isStart {
+			return e.peerStart, nil
+		}
+		return e.peerEnd, nil
+	}
+
+	// values preceding the current one are smaller in ascending order
+	sign := 1
+	if b.kind == offsetPreceding {
+		sign = -1
+	}
+	if ord.descOrder {
+		sign = -sign
+	}
+
+	target, err := addWindowOffset(v, b.offset, sign)
+	if err != nil {
+		return 0, err
+	}
+
+	var searchErr error
+
+	// first row sorted after the target value, or at it for the start bound
+	j := sort.Search(e.n, func(j int) bool {
+		vj, err := e.reduce(ord.exp, j)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+
+		res, err := compareWindowValues(vj, target, ord.descOrder, ord.nullsOrder)
+		if err != nil {
+			searchErr = err
+			return true
+		}
+
+		if isStart {
+			return res >= 0
+		}
+		return res > 0
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+
+	if isStart {
+		return j, nil
+	}
+	return j - 1, nil
+}
+
+func addWindowOffset(v, offset TypedValue, sign int) (TypedValue, error) {
+	switch x := v.RawValue().(type) {
+	case int64:
+		if o, ok := offset.RawValue().(int64); ok {
+			return NewInteger(x + int64(sign)*o), nil
+		}
+		return NewFloat64(float64(x) + float64(sign)*offset.RawValue().(float64)), nil
+	case float64:
+		switch o := offset.RawValue().(type) {
+		case int64:
+			return NewFloat64(x + float64(sign)*float64(o)), nil
+		case float64:
+			return NewFloat64(x + float64(sign)*o), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: RANGE frame offsets require a numeric ORDER BY expression", ErrIllegalArguments)
+}
+
+// windowAggregate incrementally aggregates the values of a sliding frame:
+// rows entering the frame are added and, for invertible aggregates, rows
+// leaving it are removed; MIN and MAX are recomputed instead.
+type windowAggregate struct {
+	valid      bool
+	start, end int // rows aggregated so far, none when end < start
+
+	count    int64 // non-NULL values
+	numCount int64 // numeric values
+	sumInt   int64
+	sumFloat float64
+	extreme  TypedValue
+}
+
+func (a *windowAggregate) moveTo(e *windowEval, wfn *WindowFnExp, start, end int) error {
+	invertible := wfn.fnName != "MIN" && wfn.fnName != "MAX"
+
+	if !a.valid || start < a.start || end < a.end || (start > a.start && !invertible) {
+		*a = windowAggregate{valid: true, start: start, end: start - 1}
+	}
+
+	for ; a.start < start; a.start++ {
+		if a.start <= a.end {
+			if err := a.update(e, wfn, a.start, -1); err != nil {
+				return err
 			}
 		}
-		n := int64(len(partition))
-		for i, row := range partition {
-			bucket := (int64(i) * buckets / n) + 1
-			appendWindowValue(row, colSel, NewInteger(bucket))
+	}
+
+	a.end = max(a.end, start-1)
+
+	for a.end < end {
+		a.end++
+
+		if err := a.update(e, wfn, a.end, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *windowAggregate) update(e *windowEval, wfn *WindowFnExp, i int, sign int64) error {
+	if len(wfn.params) == 0 {
+		return fmt.Errorf("%w: %s requires an argument", ErrIllegalArguments, strings.ToLower(wfn.fnName))
+	}
+
+	val, err := e.reduce(wfn.params[0], i)
+	if err != nil {
+		return err
+	}
+
+	if val.IsNull() {
+		return nil
+	}
+	a.count += sign
+
+	switch v := val.RawValue().(type) {
+	case int64:
+		a.sumInt += sign * v
+		a.numCount += sign
+	case float64:
+		a.sumFloat += float64(sign) * v
+		a.numCount += sign
+	}
+
+	if wfn.fnName == "MIN" || wfn.fnName == "MAX" {
+		if a.extreme == nil {
+			a.extreme = val
+			return nil
+		}
+
+		cmp, err := val.Compare(a.extreme)
+		if err != nil {
+			return err
 		}
 
-	default:
-		// Unknown window function — append NULL
-		for _, row := range partition {
-			appendWindowValue(row, colSel, NewNull(IntegerType))
+		if (wfn.fnName == "MIN" && cmp < 0) || (wfn.fnName == "MAX" && cmp > 0) {
+			a.extreme = val
 		}
 	}
+	return nil
 }
 
-func appendWindowValue(row *Row, selector string, val TypedValue) {
-	row.ValuesByPosition = append(row.ValuesByPosition, val)
-	row.ValuesBySelector[selector] = val
+func (a *windowAggregate) value(wfn *WindowFnExp) TypedValue {
+	switch wfn.fnName {
+	case "COUNT":
+		return NewInteger(a.count)
+	case "SUM":
+		if a.numCount == 0 {
+			return NewNull(Float64Type)
+		}
+		return NewFloat64(float64(a.sumInt) + a.sumFloat)
+	case "AVG":
+		if a.numCount == 0 {
+			return NewNull(Float64Type)
+		}
+		return NewFloat64((float64(a.sumInt) + a.sumFloat) / float64(a.numCount))
+	}
+
+	if a.extreme == nil {
+		return NewNull(AnyType)
+	}
+	return a.extreme
 }
 
-func (wr *windowRowReader) orderByEqual(a, b *Row, wfn *WindowFnExp, tx *SQLTx, tableAlias string) bool {
-	for _, ord := range wfn.orderBy {
-		va, erra := ord.exp.reduce(tx, a, tableAlias)
-		vb, errb := ord.exp.reduce(tx, b, tableAlias)
-		if erra != nil || errb != nil {
-			return false
+// windowPartition buffers the rows of the partition being computed, in
+// memory up to the sort buffer size and in a temporary file beyond it
+type windowPartition struct {
+	wr *windowRowReader
+
+	rows []*Row
+
+	spilled bool
+	file    *os.File
+	writer  *bufio.Writer
+	dirty   bool
+	offsets []int64 // offsets[i] is where row i is stored, the last one is the file size
+
+	cached    *Row
+	cachedIdx int
+}
+
+func (p *windowPartition) len() int {
+	if p.spilled {
+		return len(p.offsets) - 1
+	}
+	return len(p.rows)
+}
+
+func (p *windowPartition) add(row *Row) error {
+	if !p.spilled && len(p.rows) < p.wr.bufSize {
+		p.rows = append(p.rows, row)
+		return nil
+	}
+
+	if !p.spilled {
+		if err := p.spill(); err != nil {
+			return err
+		}
+	}
+	return p.write(row)
+}
+
+func (p *windowPartition) spill() error {
+	if p.file == nil {
+		file, err := p.wr.inner.Tx().createTempFile()
+		if err != nil {
+			return err
 		}
-		cmp, err := va.Compare(vb)
-		if err != nil || cmp != 0 {
-			return false
+		p.file = file
+		p.writer = bufio.NewWriter(file)
+	} else {
+		if err := p.file.Truncate(0); err != nil {
+			return err
 		}
+
+		if _, err := p.file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		p.writer.Reset(p.file)
 	}
-	return true
+
+	p.spilled = true
+	p.offsets = append(p.offsets[:0], 0)
+
+	for _, row := range p.rows {
+		if err := p.write(row); err != nil {
+			return err
+		}
+	}
+	p.rows = p.rows[:0]
+
+	return nil
+}
+
+func (p *windowPartition) write(row *Row) error {
+	data, err := encodeRow(row, p.wr.colTypes)
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.writer.Write(data); err != nil {
+		return err
+	}
+	p.dirty = true
+
+	p.offsets = append(p.offsets, p.offsets[len(p.offsets)-1]+int64(len(data)))
+	p.wr.inner.Tx().addSpilledBytes(uint64(len(data)))
+
+	return nil
+}
+
+func (p *windowPartition) get(i int) (*Row, error) {
+	if !p.spilled {
+		return p.rows[i], nil
+	}
+
+	if p.cached != nil && p.cachedIdx == i {
+		return p.cached, nil
+	}
+
+	if p.dirty {
+		if err := p.writer.Flush(); err != nil {
+			return nil, err
+		}
+		p.dirty = false
+	}
+
+	data := make([]byte, p.offsets[i+1]-p.offsets[i])
+
+	if _, err := p.file.ReadAt(data, p.offsets[i]); err != nil {
+		return nil, err
+	}
+
+	row := &Row{
+		ValuesByPosition: make([]TypedValue, len(p.wr.colTypes)),
+		ValuesBySelector: make(map[string]TypedValue, len(p.wr.colPosBySelector)),
+	}
+
+	// skip the size field
+	if err := decodeValues(data[2:], p.wr.colTypes, row.ValuesByPosition); err != nil {
+		return nil, err
+	}
+
+	for sel, pos := range p.wr.colPosBySelector {
+		row.ValuesBySelector[sel] = row.ValuesByPosition[pos]
+	}
+	p.wr.restoreSelectors(row)
+
+	p.cached, p.cachedIdx = row, i
+
+	return row, nil
+}
+
+func (p *windowPartition) reset() {
+	p.rows = p.rows[:0]
+	p.spilled = false
+	p.dirty = false
+	p.offsets = p.offsets[:0]
+	p.cached = nil
+
+	if p.writer != nil {
+		p.writer.Reset(p.file)
+	}
+}
+
+func (p *windowPartition) close() error {
+	if p.file == nil {
+		return nil
+	}
+
+	p.wr.inner.Tx().deregisterTempFile(p.file)
+
+	err := p.file.Close()
+	if rmErr := os.Remove(p.file.Name()); err == nil {
+		err = rmErr
+	}
+	p.file = nil
+
+	return err
 }
 
 func (wr *windowRowReader) onClose(callback func()) {
@@ -402,11 +1120,11 @@ func (wr *windowRowReader) Columns(ctx context.Context) ([]ColDescriptor, error)
 	cols := make([]ColDescriptor, len(wr.innerCols))
 	copy(cols, wr.innerCols)
 
-	for _, wfn := range wr.windowFns {
+	for i, wfn := range wr.windowFns {
 		cols = append(cols, ColDescriptor{
 			Table:  wr.inner.TableAlias(),
 			Column: wfn.alias,
-			Type:   wfn.resultType(),
+			Type:   wr.resultTypes[i],
 		})
 	}
 	return cols, nil
@@ -437,15 +1155,26 @@ func (wr *windowRowReader) Read(ctx context.Context) (*Row, error) {
 		return nil, err
 	}
 
-	if wr.rowIdx >= len(wr.rows) {
-		return nil, ErrNoMoreRows
+	row, err := wr.result.Read()
+	if err != nil {
+		return nil, err
 	}
+	wr.restoreSelectors(row)
+
+	// drop the sequence column
+	row.ValuesByPosition = row.ValuesByPosition[:len(wr.innerCols)+len(wr.windowFns)]
+	delete(row.ValuesBySelector, wr.seqSelector)
 
-	row := wr.rows[wr.rowIdx]
-	wr.rowIdx++
 	return row, nil
 }
 
 func (wr *windowRowReader) Close() error {
+	for _, s := range wr.sorters {
+		s.Close()
+	}
+
+	if wr.result != nil {
+		wr.result.Close()
+	}
 	return wr.inner.Close()
 }
@@ -3589,11 +3589,11 @@ func minUint64(a, b uint64) uint64 {
 	return a
 }
 
-// readTxOffsetAt reads the value-log offset of a specific entry (index) in a transaction (txID)
-// txID is the transaction ID
-// index is the index of the entry in the transaction
+// readTxValueOffset reads the first entry holding a value in a transaction (txID).
+// Entries without a value are not written into the value log and carry no offset,
+// ErrTxEntryIndexOutOfRange is returned when no entry of the transaction has a value.
 // allowPrecommitted indicates if a precommitted transaction can be read
-func (s *ImmuStore) readTxOffsetAt(txID uint64, allowPrecommitted bool, index int) (*TxEntry, error) {
+func (s *ImmuStore) readTxValueOffset(txID uint64, allowPrecommitted bool) (*TxEntry, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -3613,20 +3613,20 @@ func (s *ImmuStore) readTxOffsetAt(txID uint64, allowPrecommitted bool, index in
 		return nil, err
 	}
 
-	if hdr.NEntries < index {
-		return nil, ErrTxEntryIndexOutOfRange
-	}
-
 	e := &TxEntry{k: make([]byte, s.maxKeyLen)}
 
-	for i := 0; i < index; i++ {
+	for i := 0; i < hdr.NEntries; i++ {
 		err = tdr.readEntry(e)
 		if err != nil {
 			return nil, err
 		}
+
+		if e.VLen() > 0 {
+			return e, nil
+		}
 	}
 
-	return e, nil
+	return nil, ErrTxEntryIndexOutOfRange
 }
 
 // TruncateUptoTx deletes the value-log file up to transactions
@@ -3669,8 +3669,9 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 	// tombstones maintain the minimum offset for each value log file that can be safely deleted.
 	tombstones := make(map[byte]int64)
 
+	// entries without a value carry a zero offset, which would prevent any deletion
 	readFirstEntryOffset := func(id uint64) (*TxEntry, error) {
-		return s.readTxOffsetAt(id, false, 1)
+		return s.readTxValueOffset(id, false)
 	}
 
 	back := func(txID uint64) error {
@@ -3711,7 +3712,7 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 		for i > 0 && len(tombstones) != s.MaxIOConcurrency() {
 			err := back(i)
 			// if there is an error reading a transaction, stop the traversal and return the error.
-			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has entries*/ {
+			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has values */ {
 				s.logger.Errorf("failed to fetch transaction %d {traversal=back, err = %v}", i, err)
 				return err
 			}
@@ -3729,7 +3730,7 @@ func (s *ImmuStore) TruncateUptoTx(minTxID uint64) error {
 		// Iterate over all future transactions to check if any offset lies before past transaction(s) offset.
 		for j := minTxID; j <= maxTxID; j++ {
 			err := front(j)
-			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has entries*/ {
+			if err != nil && !errors.Is(err, ErrTxEntryIndexOutOfRange) /* tx has values */ {
 				s.logger.Errorf("failed to fetch transaction %d {traversal=front, err = %v}", j, err)
 				return err
 			}
@@ -4809,6 +4809,130 @@ func TestImmudbStoreTruncateUptoTx_WithSingleIOConcurrency(t *testing.T) {
 	}
 }
 
+func TestImmudbStoreTruncateUptoTx_WithEmptyValues(t *testing.T) {
+	fileSize := 1024
+
+	opts := DefaultOptions().
+		WithEmbeddedValues(false).
+		WithFileSize(fileSize).
+		WithMaxIOConcurrency(1)
+
+	st, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	require.NotNil(t, st)
+
+	defer immustoreClose(t, st)
+
+	for i := 1; i <= 10; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		// entries without a value are not written into the value log
+		err = tx.Set([]byte(fmt.Sprintf("empty_%d", i)), nil, nil)
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("key_%d", i)), nil, make([]byte, fileSize))
+		require.NoError(t, err)
+
+		_, err = tx.Commit(context.Background())
+		require.NoError(t, err)
+	}
+
+	deletePointTx := uint64(5)
+
+	require.NoError(t, st.TruncateUptoTx(deletePointTx))
+
+	for i := deletePointTx; i <= 10; i++ {
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(i, false, tx)
+		require.NoError(t, err)
+
+		for _, e := range tx.Entries() {
+			_, err := st.ReadValue(e)
+			require.NoError(t, err)
+		}
+	}
+
+	for i := deletePointTx - 1; i > 0; i-- {
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(i, false, tx)
+		require.NoError(t, err)
+
+		for _, e := range tx.Entries() {
+			if e.VLen() == 0 {
+				continue
+			}
+
+			_, err := st.ReadValue(e)
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestImmudbStoreTruncateUptoTx_WithValuelessTxs(t *testing.T) {
+	fileSize := 1024
+
+	opts := DefaultOptions().
+		WithEmbeddedValues(false).
+		WithFileSize(fileSize).
+		WithMaxIOConcurrency(1)
+
+	st, err := Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	require.NotNil(t, st)
+
+	defer immustoreClose(t, st)
+
+	// every third transaction holds no value at all
+	hasValues := func(txID uint64) bool {
+		return txID%3 != 0
+	}
+
+	for i := uint64(1); i <= 10; i++ {
+		tx, err := st.NewWriteOnlyTx(context.Background())
+		require.NoError(t, err)
+
+		err = tx.Set([]byte(fmt.Sprintf("empty_%d", i)), nil, nil)
+		require.NoError(t, err)
+
+		if hasValues(i) {
+			err = tx.Set([]byte(fmt.Sprintf("key_%d", i)), nil, make([]byte, fileSize))
+			require.NoError(t, err)
+		}
+
+		hdr, err := tx.Commit(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, i, hdr.ID)
+	}
+
+	// the truncation point holds no value, the preceding transaction sets the offset
+	deletePointTx := uint64(6)
+
+	require.NoError(t, st.TruncateUptoTx(deletePointTx))
+
+	for i := uint64(1); i <= 10; i++ {
+		tx := NewTx(st.MaxTxEntries(), st.MaxKeyLen())
+
+		err = st.ReadTx(i, false, tx)
+		require.NoError(t, err)
+
+		for _, e := range tx.Entries() {
+			if e.VLen() == 0 {
+				continue
+			}
+
+			_, err := st.ReadValue(e)
+			if i < deletePointTx-1 {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		}
+	}
+}
+
 func TestImmudbStoreTruncateUptoTx_ForIdempotency(t *testing.T) {
 	fileSize := 1024
 
@@ -64,6 +64,13 @@ type KeyReader interface {
 	Close() error
 }
 
+// NodesReader is implemented by the key readers able to report the number
+// of index nodes they visited.
+type NodesReader interface {
+	// NodesRead returns the number of index nodes visited by the reader
+	NodesRead() uint64
+}
+
 type KeyReaderSpec struct {
 	SeekKey        []byte
 	EndKey         []byte
@@ -502,3 +509,7 @@ func (r *storeKeyReader) Reset() error {
 func (r *storeKeyReader) Close() error {
 	return r.reader.Close()
 }
+
+func (r *storeKeyReader) NodesRead() uint64 {
+	return r.reader.NodesRead()
+}
@@ -93,6 +93,10 @@ func TestImmudbStoreReader(t *testing.T) {
 
 	_, _, err = reader.Read(context.Background())
 	require.ErrorIs(t, err, ErrNoMoreEntries)
+
+	nr, ok := reader.(NodesReader)
+	require.True(t, ok)
+	require.NotZero(t, nr.NodesRead())
 }
 
 func TestImmudbStoreReaderAsBefore(t *testing.T) {
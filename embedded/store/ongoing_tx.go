@@ -604,6 +604,25 @@ func (tx *OngoingTx) NewKeyReader(spec KeyReaderSpec) (KeyReader, error) {
 	return newOngoingTxKeyReader(tx, spec)
 }
 
+// SnapshotTxID returns the ID of the last transaction visible through the
+// snapshot used to read keys with the given prefix.
+func (tx *OngoingTx) SnapshotTxID(prefix []byte) (uint64, error) {
+	if tx.closed {
+		return 0, ErrAlreadyClosed
+	}
+
+	if tx.IsWriteOnly() {
+		return 0, ErrWriteOnlyTx
+	}
+
+	snap, err := tx.snap(prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	return snap.Ts(), nil
+}
+
 // MarkPrefixScanned registers that the caller has observed every
 // key matching spec on the current read-snapshot and wants the
 // prefix as a whole protected by MVCC conflict detection, without
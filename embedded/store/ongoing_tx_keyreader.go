@@ -182,3 +182,10 @@ func (r *ongoingTxKeyReader) Reset() error {
 func (r *ongoingTxKeyReader) Close() error {
 	return r.keyReader.Close()
 }
+
+func (r *ongoingTxKeyReader) NodesRead() uint64 {
+	if nr, ok := r.keyReader.(NodesReader); ok {
+		return nr.NodesRead()
+	}
+	return 0
+}
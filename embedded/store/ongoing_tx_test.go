@@ -94,3 +94,34 @@ func TestOngoingTxOptions(t *testing.T) {
 	require.EqualValues(t, 1, opts.WithSnapshotMustIncludeTxID(func(lastPrecommittedTxID uint64) uint64 { return 1 }).SnapshotMustIncludeTxID(100))
 	require.True(t, opts.WithUnsafeMVCC(true).UnsafeMVCC)
 }
+
+func TestOngoingTxSnapshotTxID(t *testing.T) {
+	st, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+	defer st.Close()
+
+	tx, err := st.NewWriteOnlyTx(context.Background())
+	require.NoError(t, err)
+
+	_, err = tx.SnapshotTxID(nil)
+	require.ErrorIs(t, err, ErrWriteOnlyTx)
+
+	err = tx.Set([]byte("key"), nil, []byte("value"))
+	require.NoError(t, err)
+
+	hdr, err := tx.Commit(context.Background())
+	require.NoError(t, err)
+
+	tx, err = st.NewTx(context.Background(), DefaultTxOptions())
+	require.NoError(t, err)
+
+	txID, err := tx.SnapshotTxID(nil)
+	require.NoError(t, err)
+	require.Equal(t, hdr.ID, txID)
+
+	err = tx.Cancel()
+	require.NoError(t, err)
+
+	_, err = tx.SnapshotTxID(nil)
+	require.ErrorIs(t, err, ErrAlreadyClosed)
+}
@@ -42,6 +42,8 @@ type Reader struct {
 	offset  uint64
 	skipped uint64
 
+	nodesRead uint64
+
 	closed bool
 }
 
@@ -56,6 +58,12 @@ type ReaderSpec struct {
 	Offset         uint64
 }
 
+// NodesRead returns the number of tree nodes visited by the reader since it
+// was created.
+func (r *Reader) NodesRead() uint64 {
+	return r.nodesRead
+}
+
 func (r *Reader) Reset() error {
 	if r.closed {
 		return ErrAlreadyClosed
@@ -84,6 +92,7 @@ func (r *Reader) ReadBetween(initialTs, finalTs uint64) (key []byte, value []byt
 		r.leafNode = startingLeaf
 		r.leafOffset = startingOffset
 		r.skipped = 0
+		r.nodesRead += uint64(len(path)) + 1
 	}
 
 	for {
@@ -111,6 +120,9 @@ func (r *Reader) ReadBetween(initialTs, finalTs uint64) (key []byte, value []byt
 					return nil, nil, 0, 0, err
 				}
 
+				// the parent node was already read
+				r.nodesRead += uint64(len(path) - len(parentPath))
+
 				r.path = path
 				r.leafNode = leaf
 				r.leafOffset = off
@@ -178,6 +190,7 @@ func (r *Reader) Read() (key []byte, value []byte, ts, hc uint64, err error) {
 		r.leafNode = startingLeaf
 		r.leafOffset = startingOffset
 		r.skipped = 0
+		r.nodesRead += uint64(len(path)) + 1
 	}
 
 	for {
@@ -207,6 +220,9 @@ func (r *Reader) Read() (key []byte, value []byte, ts, hc uint64, err error) {
 						return nil, nil, 0, 0, err
 					}
 
+					// the parent node was already read
+					r.nodesRead += uint64(len(path) - len(parentPath))
+
 					r.path = path
 					r.leafNode = leaf
 					r.leafOffset = off
@@ -547,3 +547,50 @@ func TestFullScanDescendingOrder(t *testing.T) {
 	}
 	require.Equal(t, keyCount, i)
 }
+
+func TestReaderNodesRead(t *testing.T) {
+	tbtree, err := Open(t.TempDir(), DefaultOptions())
+	require.NoError(t, err)
+
+	monotonicInsertions(t, tbtree, 1, 1000, true)
+
+	snapshot, err := tbtree.Snapshot()
+	require.NoError(t, err)
+	defer snapshot.Close()
+
+	seek := make([]byte, 4)
+	binary.BigEndian.PutUint32(seek, 500)
+
+	lookup, err := snapshot.NewReader(ReaderSpec{SeekKey: seek, Prefix: seek, InclusiveSeek: true})
+	require.NoError(t, err)
+	require.Zero(t, lookup.NodesRead())
+
+	_, _, _, _, err = lookup.Read()
+	require.NoError(t, err)
+
+	// a lookup descends from the root to a single leaf
+	depth := lookup.NodesRead()
+	require.Greater(t, depth, uint64(1))
+
+	err = lookup.Close()
+	require.NoError(t, err)
+
+	scan, err := snapshot.NewReader(ReaderSpec{})
+	require.NoError(t, err)
+	defer scan.Close()
+
+	n := 0
+	for {
+		_, _, _, _, err := scan.Read()
+		if err != nil {
+			require.ErrorIs(t, err, ErrNoMoreEntries)
+			break
+		}
+		n++
+	}
+	require.Equal(t, 1000, n)
+
+	// a full scan visits every leaf once
+	require.Greater(t, scan.NodesRead(), depth)
+	require.Less(t, scan.NodesRead(), uint64(n))
+}
@@ -144,7 +144,7 @@ func TypedValueToRowValue(tv sql.TypedValue) *SQLValue {
 		{
 			return &SQLValue{Value: &SQLValue_Bs{Bs: tv.RawValue().([]byte)}}
 		}
-	case sql.TimestampType:
+	case sql.TimestampType, sql.DateType:
 		{
 			return &SQLValue{Value: &SQLValue_Ts{Ts: sql.TimeToInt64(tv.RawValue().(time.Time))}}
 		}
@@ -152,8 +152,19 @@ func TypedValueToRowValue(tv sql.TypedValue) *SQLValue {
 		{
 			return &SQLValue{Value: &SQLValue_F{F: tv.RawValue().(float64)}}
 		}
+	case sql.DecimalType:
+		// exact decimal representation, kept as text to avoid any
+		// precision loss on the client side
+		return &SQLValue{Value: &SQLValue_S{S: tv.String()}}
 	case sql.JSONType:
 		return &SQLValue{Value: &SQLValue_S{S: tv.String()}}
+	case sql.IntervalType:
+		// interval text representation e.g. 1 day 02:00:00
+		return &SQLValue{Value: &SQLValue_S{S: tv.String()}}
+	}
+	if sql.IsArrayType(tv.Type()) {
+		// array text representation e.g. {1,2,NULL}
+		return &SQLValue{Value: &SQLValue_S{S: tv.String()}}
 	}
 	return nil
 }
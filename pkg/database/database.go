@@ -106,6 +106,7 @@ type DB interface {
 	// SQL-related
 	NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx, error)
 
+	SQLPrepare(ctx context.Context, sql string) (stmts []sql.SQLStmt, release func(), err error)
 	SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error)
 	SQLExecPrepared(ctx context.Context, tx *sql.SQLTx, stmts []sql.SQLStmt, params map[string]interface{}) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error)
 
@@ -238,7 +239,8 @@ func OpenDB(
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix([]byte{SQLPrefix}).
 		WithMultiDBHandler(multidbHandler).
-		WithParseTxMetadataFunc(parseTxMetadata)
+		WithParseTxMetadataFunc(parseTxMetadata).
+		WithResumeColumnMigrations(!opts.replica)
 
 	dbi.sqlEngine, err = sql.NewEngine(dbi.st, sqlOpts)
 	if err != nil {
@@ -248,7 +250,9 @@ func OpenDB(
 
 	dbi.Logger.Infof("sql-engine ready for database '%s' {replica = %v}", dbName, opts.replica)
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().
+		WithPrefix([]byte{DocumentPrefix}).
+		WithMultiDBHandler(multidbHandler))
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +367,8 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 	sqlOpts := sql.DefaultOptions().
 		WithPrefix([]byte{SQLPrefix}).
 		WithMultiDBHandler(multidbHandler).
-		WithParseTxMetadataFunc(parseTxMetadata)
+		WithParseTxMetadataFunc(parseTxMetadata).
+		WithResumeColumnMigrations(!opts.replica)
 
 	dbi.Logger.Infof("loading sql-engine for database '%s' {replica = %v}...", dbName, opts.replica)
 
@@ -374,7 +379,9 @@ func NewDB(dbName string, multidbHandler sql.MultiDBHandler, opts *Options, log
 	}
 	dbi.Logger.Infof("sql-engine ready for database '%s' {replica = %v}", dbName, opts.replica)
 
-	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().WithPrefix([]byte{DocumentPrefix}))
+	dbi.documentEngine, err = document.NewEngine(dbi.st, document.DefaultOptions().
+		WithPrefix([]byte{DocumentPrefix}).
+		WithMultiDBHandler(multidbHandler))
 	if err != nil {
 		return nil, logErr(dbi.Logger, "Unable to open database: %s", err)
 	}
@@ -1671,6 +1678,15 @@ func (d *db) Close() (err error) {
 		}
 	}()
 
+	// background work of the engines must stop before the store is closed
+	if err := d.sqlEngine.Close(); err != nil {
+		return err
+	}
+
+	if err := d.documentEngine.Close(); err != nil {
+		return err
+	}
+
 	return d.st.Close()
 }
 
@@ -304,6 +304,16 @@ func (db *lazyDB) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (*sql.SQLTx
 	return d.NewSQLTx(ctx, opts)
 }
 
+func (db *lazyDB) SQLPrepare(ctx context.Context, sql string) (stmts []sql.SQLStmt, release func(), err error) {
+	d, err := db.m.Get(db.idx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer db.m.Release(db.idx)
+
+	return d.SQLPrepare(ctx, sql)
+}
+
 func (db *lazyDB) SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error) {
 	d, err := db.m.Get(db.idx)
 	if err != nil {
@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/embedded/store"
@@ -244,6 +243,10 @@ func (d *db) DescribeTable(ctx context.Context, tx *sql.SQLTx, tableName string)
 	}}
 
 	for _, c := range table.Cols() {
+		if c.IsHidden() {
+			continue
+		}
+
 		index := "NO"
 
 		indexed, err := table.IsIndexed(c.Name())
@@ -272,6 +275,10 @@ func (d *db) DescribeTable(ctx context.Context, tx *sql.SQLTx, tableName string)
 			maxLen = fmt.Sprintf("(%d)", c.MaxLen())
 		}
 
+		if c.Precision() > 0 {
+			maxLen = fmt.Sprintf("(%d,%d)", c.Precision(), c.Scale())
+		}
+
 		res.Rows = append(res.Rows, &schema.Row{
 			Values: []*schema.SQLValue{
 				{Value: &schema.SQLValue_S{S: c.Name()}},
@@ -356,15 +363,23 @@ func (d *db) NewSQLTx(ctx context.Context, opts *sql.TxOptions) (tx *sql.SQLTx,
 	}
 }
 
+// SQLPrepare parses the statements of sql, reusing the ones prepared by
+// previous calls while the catalog is unchanged. Unless an error is
+// returned, release must be called once the statements are no longer in use.
+func (d *db) SQLPrepare(ctx context.Context, sql string) (stmts []sql.SQLStmt, release func(), err error) {
+	return d.sqlEngine.PrepareStmts(sql)
+}
+
 func (d *db) SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error) {
 	if req == nil {
 		return nil, nil, ErrIllegalArguments
 	}
 
-	stmts, err := sql.ParseSQL(strings.NewReader(req.Sql))
+	stmts, release, err := d.SQLPrepare(ctx, req.Sql)
 	if err != nil {
 		return nil, nil, err
 	}
+	defer release()
 
 	params := make(map[string]interface{})
 
@@ -395,24 +410,34 @@ func (d *db) SQLQuery(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRe
 		return nil, ErrIllegalArguments
 	}
 
-	stmts, err := sql.ParseSQL(strings.NewReader(req.Sql))
+	stmts, release, err := d.SQLPrepare(ctx, req.Sql)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(stmts) == 0 {
+		release()
 		return nil, sql.ErrExpectingDQLStmt
 	}
 
 	stmt, ok := stmts[0].(sql.DataSource)
 	if !ok {
+		release()
 		return nil, sql.ErrExpectingDQLStmt
 	}
+
 	reader, err := d.SQLQueryPrepared(ctx, tx, stmt, schema.NamedParamsFromProto(req.Params))
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	reader = &preparedRowReader{RowReader: reader, release: release}
+
 	if !req.AcceptStream {
 		reader = &limitRowReader{RowReader: reader, maxRows: d.maxResultSize}
 	}
-	return reader, err
+	return reader, nil
 }
 
 func (d *db) SQLQueryAll(ctx context.Context, tx *sql.SQLTx, req *schema.SQLQueryRequest) ([]*sql.Row, error) {
@@ -476,6 +501,17 @@ func (d *db) CopySQLCatalog(ctx context.Context, txID uint64) (uint64, error) {
 	return hdr.ID, nil
 }
 
+// preparedRowReader releases the statements of the query once closed.
+type preparedRowReader struct {
+	sql.RowReader
+	release func()
+}
+
+func (r *preparedRowReader) Close() error {
+	defer r.release()
+	return r.RowReader.Close()
+}
+
 type limitRowReader struct {
 	sql.RowReader
 	nRead   int
@@ -23,7 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// TestASTRewrite_StressBattery exercises the full 14-rule AST
+// TestASTRewrite_StressBattery exercises the full 13-rule AST
 // pipeline through one call each of removePGCatalogReferences with
 // the flag flipped to "ast". Each case names the rule it targets
 // and asserts (a) the output is non-empty, (b) the expected rewrite
@@ -86,9 +86,10 @@ func TestASTRewrite_StressBattery(t *testing.T) {
 			wantAbsent: []string{"CHECK ("},
 		},
 		{
-			rule:       "StripForeignKeys",
-			in:         `CREATE TABLE orders (id INTEGER, uid INTEGER REFERENCES users(id))`,
-			wantAbsent: []string{"REFERENCES"},
+			// foreign keys are enforced by the engine and reach it as sent
+			rule:        "ForeignKeys",
+			in:          `CREATE TABLE orders (id INTEGER, uid INTEGER REFERENCES users(id))`,
+			wantPresent: []string{"REFERENCES users"},
 		},
 		{
 			rule:       "StripCreateIndexName",
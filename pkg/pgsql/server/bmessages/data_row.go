@@ -20,9 +20,13 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
+	"github.com/google/uuid"
 )
 
 // DataRow if ResultColumnFormatCodes is nil default text format is used
@@ -59,41 +63,7 @@ func DataRow(rows []*sql.Row, colNumb int, ResultColumnFormatCodes []int16) []by
 					n := -1
 					binary.BigEndian.PutUint32(valueLength, uint32(n))
 				} else {
-					rv := val.RawValue()
-					switch val.Type() {
-					case sql.IntegerType:
-						{
-							binary.BigEndian.PutUint32(valueLength, uint32(8))
-							value = make([]byte, 8)
-							binary.BigEndian.PutUint64(value, uint64(rv.(int64)))
-						}
-					case sql.JSONType:
-						{
-							jsonStr := trimQuotes(val.String())
-							binary.BigEndian.PutUint32(valueLength, uint32(len(jsonStr)))
-							value = []byte(jsonStr)
-						}
-					case sql.VarcharType:
-						{
-							s := rv.(string)
-							binary.BigEndian.PutUint32(valueLength, uint32(len(s)))
-							value = []byte(s)
-						}
-					case sql.BooleanType:
-						{
-							binary.BigEndian.PutUint32(valueLength, uint32(1))
-							value = []byte{0}
-							if rv.(bool) {
-								value = []byte{1}
-							}
-						}
-					case sql.BLOBType:
-						{
-							blob := rv.([]byte)
-							binary.BigEndian.PutUint32(valueLength, uint32(len(blob)))
-							value = blob
-						}
-					}
+					value = renderValueAsBinary(val)
 				}
 			} else {
 				// only text format is allowed in simple query
@@ -118,6 +88,116 @@ func DataRow(rows []*sql.Row, colNumb int, ResultColumnFormatCodes []int16) []by
 	return rowsB
 }
 
+// renderValueAsBinary returns the binary format of a non-NULL value.
+// Types without a binary representation render as an empty value.
+func renderValueAsBinary(val sql.TypedValue) []byte {
+	rv := val.RawValue()
+
+	switch val.Type() {
+	case sql.IntegerType:
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, uint64(rv.(int64)))
+		return value
+	case sql.Float64Type:
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, math.Float64bits(rv.(float64)))
+		return value
+	case sql.JSONType:
+		return []byte(trimQuotes(val.String()))
+	case sql.VarcharType:
+		return []byte(rv.(string))
+	case sql.BooleanType:
+		if rv.(bool) {
+			return []byte{1}
+		}
+		return []byte{0}
+	case sql.BLOBType:
+		return rv.([]byte)
+	case sql.UUIDType:
+		u := rv.(uuid.UUID)
+		return u[:]
+	case sql.TimestampType:
+		// microseconds since 2000-01-01 00:00:00 UTC
+		value := make([]byte, 8)
+		binary.BigEndian.PutUint64(value, uint64(rv.(time.Time).Sub(pgEpoch).Microseconds()))
+		return value
+	case sql.DateType:
+		// days since 2000-01-01
+		value := make([]byte, 4)
+		binary.BigEndian.PutUint32(value, uint32(int32(rv.(time.Time).Sub(pgEpoch).Hours()/24)))
+		return value
+	case sql.IntervalType:
+		// microseconds, days and months
+		iv := rv.(*sql.Interval)
+		value := binary.BigEndian.AppendUint64(nil, uint64(iv.Microseconds()))
+		value = binary.BigEndian.AppendUint32(value, uint32(iv.Days()))
+		return binary.BigEndian.AppendUint32(value, uint32(iv.Months()))
+	case sql.DecimalType:
+		return encodeNumeric(val.String())
+	}
+
+	if arr, ok := val.(arrayValue); ok {
+		return encodeArray(arr)
+	}
+	return []byte{}
+}
+
+// arrayValue is implemented by array values, including the ones
+// built by ARRAY_AGG.
+type arrayValue interface {
+	ElemType() sql.SQLValueType
+	Values() []sql.TypedValue
+}
+
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// encodeArray renders a one-dimensional array in PG's binary array
+// format: ndim, has-nulls flag and element type OID as int32, then the
+// length and lower bound of the dimension, followed by each element as
+// its length (-1 for NULL) and binary value. Empty arrays have no
+// dimensions.
+func encodeArray(arr arrayValue) []byte {
+	elemOID := pgmeta.PgTypeMap[arr.ElemType()][pgmeta.PgTypeMapOid]
+
+	vals := arr.Values()
+
+	hasNulls := uint32(0)
+	for _, e := range vals {
+		if e.IsNull() {
+			hasNulls = 1
+			break
+		}
+	}
+
+	ndim := uint32(1)
+	if len(vals) == 0 {
+		ndim = 0
+	}
+
+	value := binary.BigEndian.AppendUint32(nil, ndim)
+	value = binary.BigEndian.AppendUint32(value, hasNulls)
+	value = binary.BigEndian.AppendUint32(value, uint32(elemOID))
+
+	if ndim == 0 {
+		return value
+	}
+
+	value = binary.BigEndian.AppendUint32(value, uint32(len(vals)))
+	value = binary.BigEndian.AppendUint32(value, 1)
+
+	for _, e := range vals {
+		if e.IsNull() {
+			value = binary.BigEndian.AppendUint32(value, math.MaxUint32)
+			continue
+		}
+
+		ev := renderValueAsBinary(e)
+		value = binary.BigEndian.AppendUint32(value, uint32(len(ev)))
+		value = append(value, ev...)
+	}
+	return value
+}
+
 func renderValueAsByte(v sql.TypedValue) []byte {
 	if v.IsNull() {
 		return nil
@@ -151,3 +231,60 @@ func renderValueAsByte(v sql.TypedValue) []byte {
 func trimQuotes(s string) string {
 	return strings.TrimSuffix(strings.TrimPrefix(s, "'"), "'")
 }
+
+// encodeNumeric renders the decimal representation of a DECIMAL value in
+// PG's binary numeric format: ndigits, weight, sign and dscale as int16,
+// followed by ndigits base-10000 digits, most significant first.
+func encodeNumeric(s string) []byte {
+	var sign uint16
+	if strings.HasPrefix(s, "-") {
+		sign = 0x4000
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	dscale := len(fracPart)
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if pad := len(intPart) % 4; pad > 0 {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; pad > 0 {
+		fracPart += strings.Repeat("0", 4-pad)
+	}
+
+	digitsStr := intPart + fracPart
+	weight := len(intPart)/4 - 1
+
+	digits := make([]uint16, 0, len(digitsStr)/4)
+	for i := 0; i < len(digitsStr); i += 4 {
+		var d uint16
+		for _, c := range digitsStr[i : i+4] {
+			d = d*10 + uint16(c-'0')
+		}
+		digits = append(digits, d)
+	}
+
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+
+	if len(digits) == 0 {
+		sign = 0
+		weight = 0
+	}
+
+	buf := make([]byte, 8+2*len(digits))
+	binary.BigEndian.PutUint16(buf[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(buf[2:], uint16(int16(weight)))
+	binary.BigEndian.PutUint16(buf[4:], sign)
+	binary.BigEndian.PutUint16(buf[6:], uint16(dscale))
+	for i, d := range digits {
+		binary.BigEndian.PutUint16(buf[8+2*i:], d)
+	}
+	return buf
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package bmessages
 
 import (
+	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/stretchr/testify/require"
@@ -94,6 +96,49 @@ func TestDataRowBinaryFormatBlob(t *testing.T) {
 	require.NotNil(t, result)
 }
 
+func TestEncodeNumeric(t *testing.T) {
+	for _, tc := range []struct {
+		in     string
+		weight int16
+		digits []uint16
+		dscale uint16
+	}{
+		{"12.50", 0, []uint16{12, 5000}, 2},
+		{"-0.001", -1, []uint16{10}, 3},
+		{"123456789.5", 2, []uint16{1, 2345, 6789, 5000}, 1},
+		{"10000", 1, []uint16{1}, 0},
+		{"0.00", 0, nil, 2},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			enc := encodeNumeric(tc.in)
+			require.Len(t, enc, 8+2*len(tc.digits))
+			require.Equal(t, uint16(len(tc.digits)), binary.BigEndian.Uint16(enc[0:]))
+			require.Equal(t, tc.weight, int16(binary.BigEndian.Uint16(enc[2:])))
+			require.Equal(t, tc.dscale, binary.BigEndian.Uint16(enc[6:]))
+			for i, d := range tc.digits {
+				require.Equal(t, d, binary.BigEndian.Uint16(enc[8+2*i:]))
+			}
+		})
+	}
+
+	require.Equal(t, uint16(0x4000), binary.BigEndian.Uint16(encodeNumeric("-0.001")[4:]))
+}
+
+func TestDataRowBinaryFormatDecimal(t *testing.T) {
+	d, err := sql.ParseDecimal("12.50")
+	require.NoError(t, err)
+
+	result := DataRow([]*sql.Row{{ValuesByPosition: []sql.TypedValue{d}}}, 1, []int16{1})
+	require.NotNil(t, result)
+
+	// D + len(4) + ncols(2) + vlen(4) + numeric(8 + 2*2)
+	require.Len(t, result, 1+4+2+4+12)
+	require.Equal(t, uint32(12), binary.BigEndian.Uint32(result[7:]))
+
+	text := DataRow([]*sql.Row{{ValuesByPosition: []sql.TypedValue{d}}}, 1, nil)
+	require.Equal(t, "12.50", string(text[11:]))
+}
+
 func TestDataRowNilValue(t *testing.T) {
 	row := &sql.Row{
 		ValuesByPosition: []sql.TypedValue{nil},
@@ -164,3 +209,39 @@ func TestTrimQuotes(t *testing.T) {
 	require.Equal(t, "", trimQuotes("''"))
 	require.Equal(t, "", trimQuotes(""))
 }
+
+func TestDataRowBinaryFormatArray(t *testing.T) {
+	arr := sql.NewArray(sql.IntegerType, []sql.TypedValue{sql.NewInteger(7), sql.NewNull(sql.IntegerType)})
+
+	value := renderValueAsBinary(arr)
+
+	// ndim, hasnull, elem oid, dim len, lbound, then each element
+	require.Len(t, value, 5*4+4+8+4)
+	require.Equal(t, uint32(1), binary.BigEndian.Uint32(value[0:]))
+	require.Equal(t, uint32(1), binary.BigEndian.Uint32(value[4:]))
+	require.Equal(t, uint32(20), binary.BigEndian.Uint32(value[8:]))
+	require.Equal(t, uint32(2), binary.BigEndian.Uint32(value[12:]))
+	require.Equal(t, uint32(1), binary.BigEndian.Uint32(value[16:]))
+	require.Equal(t, uint32(8), binary.BigEndian.Uint32(value[20:]))
+	require.Equal(t, uint64(7), binary.BigEndian.Uint64(value[24:]))
+	require.Equal(t, int32(-1), int32(binary.BigEndian.Uint32(value[32:])))
+
+	empty := renderValueAsBinary(sql.NewArray(sql.VarcharType, nil))
+	require.Len(t, empty, 12)
+	require.Equal(t, uint32(0), binary.BigEndian.Uint32(empty[0:]))
+	require.Equal(t, uint32(25), binary.BigEndian.Uint32(empty[8:]))
+}
+
+func TestDataRowBinaryFormatDateAndInterval(t *testing.T) {
+	d := renderValueAsBinary(sql.NewDate(time.Date(2000, time.January, 31, 15, 0, 0, 0, time.UTC)))
+	require.Equal(t, []byte{0, 0, 0, 30}, d)
+
+	d = renderValueAsBinary(sql.NewDate(time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, int32(-1), int32(binary.BigEndian.Uint32(d)))
+
+	iv := renderValueAsBinary(sql.NewInterval(14, -3, 1500))
+	require.Len(t, iv, 16)
+	require.Equal(t, int64(1500), int64(binary.BigEndian.Uint64(iv[0:])))
+	require.Equal(t, int32(-3), int32(binary.BigEndian.Uint32(iv[8:])))
+	require.Equal(t, int32(14), int32(binary.BigEndian.Uint32(iv[12:])))
+}
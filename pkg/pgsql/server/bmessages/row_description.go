@@ -37,7 +37,7 @@ import (
 // and the issue-list template renders every issue as "#0".
 var reservedRenameColumns = map[string]bool{
 	"add": true, "admin": true, "after": true, "all": true, "alter": true, "and": true,
-	"as": true, "asc": true, "auto_increment": true, "avg": true, "before": true,
+	"any": true, "array": true, "as": true, "asc": true, "auto_increment": true, "avg": true, "before": true,
 	"begin": true, "between": true, "bigint": true, "bigserial": true, "blob": true,
 	"boolean": true, "by": true, "bytea": true, "cascade": true, "case": true,
 	"cast": true, "check": true, "column": true, "commit": true, "conflict": true,
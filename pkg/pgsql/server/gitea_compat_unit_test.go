@@ -48,6 +48,10 @@ func TestCommandTagFor(t *testing.T) {
 		{"insert into t values (1)", "INSERT 0 0"},
 		{"UPDATE t SET x = 1", "UPDATE 0"},
 		{"DELETE FROM t", "DELETE 0"},
+		{"MERGE INTO t USING s ON t.id = s.id WHEN MATCHED THEN DELETE", "MERGE 0"},
+		{"CREATE OR REPLACE FUNCTION f() RETURNS INTEGER AS $$ SELECT 1 $$", "CREATE FUNCTION"},
+		{"DROP PROCEDURE p", "DROP PROCEDURE"},
+		{"CALL p(1)", "CALL"},
 		{"SELECT * FROM t", "SELECT 0"},
 		{"FETCH 10 FROM c", "FETCH 0"},
 		{"CREATE TABLE t (id INT)", "CREATE TABLE"},
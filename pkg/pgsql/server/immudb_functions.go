@@ -25,6 +25,7 @@ import (
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
 	bm "github.com/codenotary/immudb/pkg/pgsql/server/bmessages"
+	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 )
 
 // immudbState handles SELECT immudb_state() — returns the current immutable database state
@@ -435,6 +436,10 @@ func (s *session) handleShow(param string) error {
 		value = ""
 	}
 
+	if paramLower == "search_path" && s.searchPath != nil {
+		value = strings.Join(s.searchPath, ", ")
+	}
+
 	cols := []sql.ColDescriptor{{Column: param, Type: sql.VarcharType}}
 	if _, err := s.writeMessage(bm.RowDescription(cols, nil)); err != nil {
 		return err
@@ -542,6 +547,8 @@ func immudbToPGType(t sql.SQLValueType) (string, int64) {
 		return "bigint", 20
 	case sql.Float64Type:
 		return "double precision", 701
+	case sql.DecimalType:
+		return "numeric", 1700
 	case sql.BooleanType:
 		return "boolean", 16
 	case sql.VarcharType:
@@ -554,6 +561,14 @@ func immudbToPGType(t sql.SQLValueType) (string, int64) {
 		return "jsonb", 3802
 	case sql.TimestampType:
 		return "timestamp without time zone", 1114
+	case sql.DateType:
+		return "date", 1082
+	case sql.IntervalType:
+		return "interval", 1186
+	}
+	if sql.IsArrayType(t) {
+		name, _ := immudbToPGType(sql.ArrayElemType(t))
+		return name + "[]", int64(pgmeta.PgTypeMap[t][pgmeta.PgTypeMapOid])
 	}
 	return "text", 25
 }
@@ -616,6 +631,10 @@ func (s *session) handlePgAttributeForTable(tableName string, extQueryMode bool)
 
 	rows := make([]*sql.Row, 0, len(table.Cols()))
 	for _, c := range table.Cols() {
+		if c.IsHidden() {
+			continue
+		}
+
 		pgName, pgOID := immudbToPGType(c.Type())
 
 		// format_type mimics Postgres' pretty-printed column type. For
@@ -651,10 +670,29 @@ func (s *session) handlePgAttributeForTable(tableName string, extQueryMode bool)
 				if strings.HasPrefix(raw, "'") && strings.HasSuffix(raw, "'") {
 					raw = raw + "::timestamp without time zone"
 				}
+			case sql.DateType:
+				if strings.HasPrefix(raw, "'") && strings.HasSuffix(raw, "'") {
+					raw = raw + "::date"
+				}
+			case sql.IntervalType:
+				if strings.HasPrefix(raw, "'") && strings.HasSuffix(raw, "'") {
+					raw = raw + "::interval"
+				}
 			}
 			defaultExpr = sql.NewVarchar(raw)
 		}
 
+		// as in Postgres, the default of a generated column is its
+		// generation expression
+		var attgenerated string
+		if c.IsGenerated() {
+			attgenerated = "s"
+			if c.IsVirtual() {
+				attgenerated = "v"
+			}
+			defaultExpr = sql.NewVarchar(c.GeneratedExp().String())
+		}
+
 		rowVals := []sql.TypedValue{
 			sql.NewVarchar(c.Name()),
 			sql.NewVarchar(formatted),
@@ -665,7 +703,7 @@ func (s *session) handlePgAttributeForTable(tableName string, extQueryMode bool)
 			sql.NewNull(sql.VarcharType), // collname
 			sql.NewNull(sql.VarcharType), // comment
 			sql.NewVarchar(""),           // identity
-			sql.NewVarchar(""),           // attgenerated
+			sql.NewVarchar(attgenerated),
 		}
 		rows = append(rows, &sql.Row{ValuesByPosition: rowVals})
 	}
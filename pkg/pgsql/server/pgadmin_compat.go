@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
@@ -335,30 +336,51 @@ var stdPgTypes = []struct {
 	oid     int64
 	typname string
 	typtype string
+	typelem int64
 }{
-	{16, "bool", "b"},
-	{17, "bytea", "b"},
-	{18, "char", "b"},
-	{19, "name", "b"},
-	{20, "int8", "b"},
-	{21, "int2", "b"},
-	{23, "int4", "b"},
-	{25, "text", "b"},
-	{26, "oid", "b"},
-	{114, "json", "b"},
-	{142, "xml", "b"},
-	{700, "float4", "b"},
-	{701, "float8", "b"},
-	{1042, "bpchar", "b"},
-	{1043, "varchar", "b"},
-	{1082, "date", "b"},
-	{1083, "time", "b"},
-	{1114, "timestamp", "b"},
-	{1184, "timestamptz", "b"},
-	{1186, "interval", "b"},
-	{1700, "numeric", "b"},
-	{2950, "uuid", "b"},
-	{3802, "jsonb", "b"},
+	{16, "bool", "b", 0},
+	{17, "bytea", "b", 0},
+	{18, "char", "b", 0},
+	{19, "name", "b", 0},
+	{20, "int8", "b", 0},
+	{21, "int2", "b", 0},
+	{23, "int4", "b", 0},
+	{25, "text", "b", 0},
+	{26, "oid", "b", 0},
+	{114, "json", "b", 0},
+	{142, "xml", "b", 0},
+	{700, "float4", "b", 0},
+	{701, "float8", "b", 0},
+	{1042, "bpchar", "b", 0},
+	{1043, "varchar", "b", 0},
+	{1082, "date", "b", 0},
+	{1083, "time", "b", 0},
+	{1114, "timestamp", "b", 0},
+	{1184, "timestamptz", "b", 0},
+	{1186, "interval", "b", 0},
+	{1700, "numeric", "b", 0},
+	{2950, "uuid", "b", 0},
+	{3802, "jsonb", "b", 0},
+	// array types of the column types immudb supports; typelem lets
+	// Rails register an array decoder for the element type
+	{1000, "_bool", "b", 16},
+	{1001, "_bytea", "b", 17},
+	{1009, "_text", "b", 25},
+	{1015, "_varchar", "b", 1043},
+	{1016, "_int8", "b", 20},
+	{1022, "_float8", "b", 701},
+	{1115, "_timestamp", "b", 1114},
+	{1231, "_numeric", "b", 1700},
+	{2951, "_uuid", "b", 2950},
+	{3807, "_jsonb", "b", 3802},
+}
+
+// pgTypeInput returns the name of the input function of a pg_type row.
+func pgTypeInput(typname string, typelem int64) string {
+	if typelem != 0 {
+		return "array_in"
+	}
+	return typname + "in"
 }
 
 // handlePgTypeRows answers Rails's pg_type catalog query with one row per
@@ -392,11 +414,11 @@ func (s *session) handlePgTypeRows(query string, cols []string) error {
 			case "typtype":
 				vals[i] = sql.NewVarchar(t.typtype)
 			case "typelem":
-				vals[i] = sql.NewVarchar("0")
+				vals[i] = sql.NewVarchar(strconv.FormatInt(t.typelem, 10))
 			case "typdelim":
 				vals[i] = sql.NewVarchar(",")
 			case "typinput":
-				vals[i] = sql.NewVarchar(t.typname + "in")
+				vals[i] = sql.NewVarchar(pgTypeInput(t.typname, t.typelem))
 			case "rngsubtype":
 				vals[i] = sql.NewNull(sql.VarcharType)
 			case "typbasetype":
@@ -445,11 +467,11 @@ func (s *session) handlePgSystemQueryDataOnly(query string) error {
 				case "typtype":
 					vals[i] = sql.NewVarchar(t.typtype)
 				case "typelem":
-					vals[i] = sql.NewVarchar("0")
+					vals[i] = sql.NewVarchar(strconv.FormatInt(t.typelem, 10))
 				case "typdelim":
 					vals[i] = sql.NewVarchar(",")
 				case "typinput":
-					vals[i] = sql.NewVarchar(t.typname + "in")
+					vals[i] = sql.NewVarchar(pgTypeInput(t.typname, t.typelem))
 				case "rngsubtype":
 					vals[i] = sql.NewNull(sql.VarcharType)
 				case "typbasetype":
@@ -649,6 +671,10 @@ func (s *session) handleXormColumnsQuery(query string, params []*schema.NamedPar
 
 	rows := make([]*sql.Row, 0, len(table.Cols()))
 	for _, c := range table.Cols() {
+		if c.IsHidden() {
+			continue
+		}
+
 		pgTypeName, _ := immudbToPGType(c.Type())
 		var maxLen sql.TypedValue = sql.NewNull(sql.IntegerType)
 		if c.Type() == sql.VarcharType && c.MaxLen() > 0 {
@@ -47,7 +47,25 @@ var PgTypeMap = map[string][]int{
 	sql.VarcharType:   {25, -1},   //text
 	sql.UUIDType:      {2950, 16}, //uuid
 	sql.Float64Type:   {701, 8},   //double-precision floating point number
+	sql.DecimalType:   {1700, -1}, //numeric
+	sql.DateType:      {1082, 4},  //date
+	sql.IntervalType:  {1186, 16}, //interval
 	sql.JSONType:      {3802, -1}, //jsonb — Rails registers OID 3802 to decode via JSON.parse into Hash/Array; OID 114 (json) would work too but we advertise jsonb in pg_attribute so stay consistent
+
+	// one-dimensional arrays, VARCHAR[] maps to text[] as VARCHAR maps to text
+	sql.ArrayTypeOf(sql.BooleanType):   {1000, -1}, //bool[]
+	sql.ArrayTypeOf(sql.BLOBType):      {1001, -1}, //bytea[]
+	sql.ArrayTypeOf(sql.TimestampType): {1115, -1}, //timestamp[]
+	sql.ArrayTypeOf(sql.IntegerType):   {1016, -1}, //int8[]
+	sql.ArrayTypeOf(sql.VarcharType):   {1009, -1}, //text[]
+	sql.ArrayTypeOf(sql.UUIDType):      {2951, -1}, //uuid[]
+	sql.ArrayTypeOf(sql.Float64Type):   {1022, -1}, //float8[]
+	sql.ArrayTypeOf(sql.DecimalType):   {1231, -1}, //numeric[]
+	sql.ArrayTypeOf(sql.JSONType):      {3807, -1}, //jsonb[]
+	sql.ArrayTypeOf(sql.DateType):      {1182, -1}, //date[]
+	sql.ArrayTypeOf(sql.IntervalType):  {1187, -1}, //interval[]
+	sql.ArrayTypeOf(sql.AnyType):       {1009, -1}, //text[] — elements of an empty ARRAY[] have no type
+
 	// AnyType maps to OID 0 ("unknown") so ParameterDescription doesn't
 	// pick a concrete type for placeholders whose type the engine didn't
 	// infer. Previously we used 17 (bytea), which made the pq driver
@@ -25,6 +25,7 @@ import (
 
 	"github.com/codenotary/immudb/pkg/server"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgproto3"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1183,7 +1184,7 @@ func TestPgsqlCompat_AdvancedWindowFunctions(t *testing.T) {
 	require.Equal(t, 4, count)
 }
 
-func TestPgsqlCompat_ForeignKeyNotEnforced(t *testing.T) {
+func TestPgsqlCompat_ForeignKeyEnforced(t *testing.T) {
 	_, port := setupTestServer(t)
 
 	conn, err := pgx.Connect(context.Background(),
@@ -1204,16 +1205,34 @@ func TestPgsqlCompat_ForeignKeyNotEnforced(t *testing.T) {
 		)`)
 	require.NoError(t, err)
 
-	// FK should NOT be enforced — insert with nonexistent parent should succeed
-	_, err = conn.Exec(context.Background(),
-		"INSERT INTO fk_child (id, parent_id) VALUES (1, 999)")
+	_, err = conn.Exec(context.Background(), `
+		CREATE TABLE fk_inline_child (
+			id INTEGER PRIMARY KEY,
+			parent_id INTEGER REFERENCES fk_parent (id) ON DELETE CASCADE
+		)`)
+	require.NoError(t, err)
+
+	// orphan rows are rejected
+	for _, table := range []string{"fk_child", "fk_inline_child"} {
+		_, err = conn.Exec(context.Background(),
+			fmt.Sprintf("INSERT INTO %s (id, parent_id) VALUES (1, 999)", table))
+		require.ErrorContains(t, err, "foreign key constraint violation", table)
+	}
+
+	_, err = conn.Exec(context.Background(), "INSERT INTO fk_parent (id) VALUES (1)")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), "INSERT INTO fk_inline_child (id, parent_id) VALUES (1, 1)")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), "DELETE FROM fk_parent WHERE id = 1")
 	require.NoError(t, err)
 
 	var count int
 	err = conn.QueryRow(context.Background(),
-		"SELECT COUNT(*) FROM fk_child").Scan(&count)
+		"SELECT COUNT(*) FROM fk_inline_child").Scan(&count)
 	require.NoError(t, err)
-	require.Equal(t, 1, count)
+	require.Equal(t, 0, count)
 }
 
 func TestPgsqlCompat_ForeignKeyConstraint(t *testing.T) {
@@ -1433,3 +1452,145 @@ func TestPgsqlCompat_GolangMigrateFlow(t *testing.T) {
 	require.Equal(t, int64(20260414), version)
 	require.False(t, dirty)
 }
+
+func TestPgsqlCompat_Cursors(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	db, err := sql.Open("postgres", fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE cursor_test (id INTEGER, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, err = db.Exec(fmt.Sprintf("INSERT INTO cursor_test (id) VALUES (%d)", i))
+		require.NoError(t, err)
+	}
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	_, err = tx.Exec("DECLARE c CURSOR FOR SELECT id FROM cursor_test ORDER BY id")
+	require.NoError(t, err)
+
+	fetch := func(sql string) []int64 {
+		rows, err := tx.Query(sql)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		return ids
+	}
+
+	require.Equal(t, []int64{1, 2}, fetch("FETCH 2 FROM c"))
+	require.Equal(t, []int64{3, 4, 5}, fetch("FETCH ALL FROM c"))
+
+	_, err = tx.Exec("CLOSE c")
+	require.NoError(t, err)
+
+	require.NoError(t, tx.Commit())
+}
+
+func TestPgsqlCompat_PortalSuspension(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE TABLE portal_test (id INTEGER, PRIMARY KEY id)")
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		_, err = conn.Exec(ctx, fmt.Sprintf("INSERT INTO portal_test (id) VALUES (%d)", i))
+		require.NoError(t, err)
+	}
+
+	frontend := conn.PgConn().Frontend()
+
+	frontend.Send(&pgproto3.Parse{Query: "SELECT id FROM portal_test ORDER BY id"})
+	frontend.Send(&pgproto3.Bind{})
+	frontend.Send(&pgproto3.Execute{MaxRows: 2})
+	frontend.Send(&pgproto3.Execute{MaxRows: 2})
+	frontend.Send(&pgproto3.Execute{MaxRows: 2})
+	frontend.Send(&pgproto3.Sync{})
+	require.NoError(t, frontend.Flush())
+
+	var ids []string
+	var suspensions int
+
+	for {
+		msg, err := frontend.Receive()
+		require.NoError(t, err)
+
+		switch m := msg.(type) {
+		case *pgproto3.DataRow:
+			ids = append(ids, string(m.Values[0]))
+		case *pgproto3.PortalSuspended:
+			suspensions++
+		case *pgproto3.ErrorResponse:
+			require.Fail(t, m.Message)
+		}
+
+		if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+			break
+		}
+	}
+
+	require.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	require.Equal(t, 2, suspensions)
+}
+
+func TestPgsqlCompat_MergeCommandTag(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, `
+		CREATE TABLE merge_target (id INTEGER, v INTEGER, PRIMARY KEY id);
+		CREATE TABLE merge_source (id INTEGER, v INTEGER, PRIMARY KEY id);
+	`)
+	require.NoError(t, err)
+
+	_, err = conn.Exec(ctx, `
+		INSERT INTO merge_target (id, v) VALUES (1, 1), (2, 2);
+		INSERT INTO merge_source (id, v) VALUES (2, 20), (3, 30), (4, 40);
+	`)
+	require.NoError(t, err)
+
+	merge := "MERGE INTO merge_target USING merge_source ON merge_target.id = merge_source.id " +
+		"WHEN MATCHED THEN UPDATE SET v = merge_source.v " +
+		"WHEN NOT MATCHED THEN INSERT (id, v) VALUES (merge_source.id, merge_source.v)"
+
+	tag, err := conn.Exec(ctx, merge)
+	require.NoError(t, err)
+	require.Equal(t, "MERGE 3", tag.String())
+
+	// within a transaction only the rows of the statement itself are counted
+	tx, err := conn.Begin(ctx)
+	require.NoError(t, err)
+
+	tag, err = tx.Exec(ctx, merge)
+	require.NoError(t, err)
+	require.Equal(t, "MERGE 3", tag.String())
+
+	tag, err = tx.Exec(ctx, "MERGE INTO merge_target USING merge_source ON merge_target.id = merge_source.id WHEN MATCHED AND merge_source.id = 4 THEN DELETE")
+	require.NoError(t, err)
+	require.Equal(t, "MERGE 1", tag.String())
+
+	require.NoError(t, tx.Commit(ctx))
+}
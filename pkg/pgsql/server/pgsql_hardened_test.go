@@ -559,6 +559,199 @@ func TestHardened_ExplainValues(t *testing.T) {
 	require.Contains(t, planLines[0], "Scan", "first plan line should mention a scan operation")
 }
 
+func TestHardened_ExplainAnalyze(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	conn, err := pgx.Connect(context.Background(),
+		fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(context.Background(), `
+		CREATE TABLE hr_expl_analyze (id INTEGER, name VARCHAR, PRIMARY KEY id);
+		INSERT INTO hr_expl_analyze (id, name) VALUES (1, 'a'), (2, 'b'), (3, 'c')
+	`)
+	require.NoError(t, err)
+
+	rows, err := conn.Query(context.Background(),
+		"EXPLAIN ANALYZE SELECT id, name FROM hr_expl_analyze WHERE name <> 'b'")
+	require.NoError(t, err)
+
+	var planLines []string
+	for rows.Next() {
+		var line string
+		err = rows.Scan(&line)
+		require.NoError(t, err)
+		planLines = append(planLines, line)
+	}
+	rows.Close()
+	require.NoError(t, rows.Err())
+
+	require.Len(t, planLines, 3)
+	require.Contains(t, planLines[0], "-> Projection (rows_in=2 rows_out=2 ")
+	require.Contains(t, planLines[1], "  -> Filter (rows_in=3 rows_out=2 ")
+	require.Contains(t, planLines[2], "    -> Seq Scan on hr_expl_analyze (rows_in=3 rows_out=3 ")
+}
+
+func TestHardened_ExpressionIndex(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	conn, err := pgx.Connect(context.Background(),
+		fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(context.Background(), `
+		CREATE TABLE hr_expr_index (id INTEGER, email VARCHAR[64], payload JSON, PRIMARY KEY id);
+		CREATE INDEX ON hr_expr_index ((lower(email)));
+		CREATE INDEX ON hr_expr_index ((payload->'customer'->>'id'))
+	`)
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), `
+		INSERT INTO hr_expr_index (id, email, payload) VALUES
+			(1, 'Alice@Example.com', '{"customer": {"id": "c1"}}'),
+			(2, 'bob@example.com', '{"customer": {"id": "c2"}}')
+	`)
+	require.NoError(t, err)
+
+	var id int64
+	err = conn.QueryRow(context.Background(),
+		"SELECT id FROM hr_expr_index WHERE lower(email) = 'alice@example.com'").Scan(&id)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	var customerID string
+	err = conn.QueryRow(context.Background(),
+		"SELECT payload->'customer'->>'id' FROM hr_expr_index WHERE payload->'customer'->>'id' = 'c2'").Scan(&customerID)
+	require.NoError(t, err)
+	require.Equal(t, "c2", customerID)
+
+	var plan string
+	err = conn.QueryRow(context.Background(),
+		"EXPLAIN SELECT id FROM hr_expr_index WHERE payload->'customer'->>'id' = 'c2'").Scan(&plan)
+	require.NoError(t, err)
+	require.Equal(t, "-> Index Scan using (payload->'customer'->>'id') on hr_expr_index", plan)
+}
+
+func TestHardened_GeneratedColumns(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	conn, err := pgx.Connect(context.Background(),
+		fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(context.Background(), `
+		CREATE TABLE hr_generated (
+			id INTEGER,
+			price INTEGER,
+			qty INTEGER,
+			total INTEGER GENERATED ALWAYS AS (price * qty) STORED,
+			doubled INTEGER GENERATED ALWAYS AS (qty * 2) VIRTUAL,
+			PRIMARY KEY id
+		)
+	`)
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), "INSERT INTO hr_generated (id, price, qty) VALUES (1, 3, 4)")
+	require.NoError(t, err)
+
+	_, err = conn.Exec(context.Background(), "UPDATE hr_generated SET qty = 5 WHERE id = 1")
+	require.NoError(t, err)
+
+	var total, doubled int64
+	err = conn.QueryRow(context.Background(), "SELECT total, doubled FROM hr_generated WHERE id = 1").Scan(&total, &doubled)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), total)
+	require.Equal(t, int64(10), doubled)
+
+	_, err = conn.Exec(context.Background(), "UPDATE hr_generated SET total = 1 WHERE id = 1")
+	require.ErrorContains(t, err, "cannot write generated column")
+
+	var isGenerated string
+	err = conn.QueryRow(context.Background(),
+		"SELECT is_generated FROM information_schema.columns WHERE table_name = 'hr_generated' AND column_name = 'total'").Scan(&isGenerated)
+	require.NoError(t, err)
+	require.Equal(t, "ALWAYS", isGenerated)
+}
+
+func TestHardened_Schemas(t *testing.T) {
+	_, port := setupTestServer(t)
+
+	conn, err := pgx.Connect(context.Background(),
+		fmt.Sprintf("host=localhost port=%d sslmode=disable user=immudb dbname=defaultdb password=immudb", port))
+	require.NoError(t, err)
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(context.Background(), `
+		CREATE TABLE hr_schema_items (id INTEGER, PRIMARY KEY id);
+		INSERT INTO hr_schema_items (id) VALUES (1);
+		CREATE SCHEMA hr_sales;
+		CREATE TABLE hr_sales.hr_schema_items (id INTEGER, PRIMARY KEY id);
+		INSERT INTO hr_sales.hr_schema_items (id) VALUES (2)
+	`)
+	require.NoError(t, err)
+
+	var id int64
+	err = conn.QueryRow(context.Background(), "SELECT id FROM hr_schema_items").Scan(&id)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	_, err = conn.Exec(context.Background(), "SET search_path TO hr_sales, public")
+	require.NoError(t, err)
+
+	var searchPath string
+	err = conn.QueryRow(context.Background(), "SHOW search_path").Scan(&searchPath)
+	require.NoError(t, err)
+	require.Equal(t, "hr_sales, public", searchPath)
+
+	var currentSchema string
+	err = conn.QueryRow(context.Background(), "SELECT current_schema()").Scan(&currentSchema)
+	require.NoError(t, err)
+	require.Equal(t, "hr_sales", currentSchema)
+
+	// both the simple and the extended protocol resolve through the path
+	err = conn.QueryRow(context.Background(), "SELECT id FROM hr_schema_items").Scan(&id)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), id)
+
+	err = conn.QueryRow(context.Background(), "SELECT id FROM hr_schema_items WHERE id = $1", 2).Scan(&id)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), id)
+
+	_, err = conn.Exec(context.Background(), "INSERT INTO hr_schema_items (id) VALUES ($1)", 3)
+	require.NoError(t, err)
+
+	var count int64
+	err = conn.QueryRow(context.Background(), "SELECT COUNT(*) FROM hr_sales.hr_schema_items").Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	var nspOID int64
+	err = conn.QueryRow(context.Background(), "SELECT oid FROM pg_namespace WHERE nspname = 'hr_sales'").Scan(&nspOID)
+	require.NoError(t, err)
+
+	var relNamespace int64
+	err = conn.QueryRow(context.Background(),
+		"SELECT relnamespace FROM pg_class WHERE relname = 'hr_schema_items' AND relnamespace <> 2200").Scan(&relNamespace)
+	require.NoError(t, err)
+	require.Equal(t, nspOID, relNamespace)
+
+	var tableSchema string
+	err = conn.QueryRow(context.Background(),
+		"SELECT table_schema FROM information_schema.tables WHERE table_name = 'hr_schema_items' AND table_schema <> 'public'").Scan(&tableSchema)
+	require.NoError(t, err)
+	require.Equal(t, "hr_sales", tableSchema)
+
+	_, err = conn.Exec(context.Background(), "SET search_path TO DEFAULT")
+	require.NoError(t, err)
+
+	err = conn.QueryRow(context.Background(), "SELECT current_schema()").Scan(&currentSchema)
+	require.NoError(t, err)
+	require.Equal(t, "public", currentSchema)
+}
+
 func TestHardened_ILikeValues(t *testing.T) {
 	_, port := setupTestServer(t)
 
@@ -901,14 +1094,14 @@ func TestHardened_ForeignKeyParsing(t *testing.T) {
 	_, err = db.Exec("CREATE TABLE hr_child (id INTEGER, parent_id INTEGER, PRIMARY KEY id, FOREIGN KEY (parent_id) REFERENCES hr_parent (id))")
 	require.NoError(t, err)
 
-	// FK not enforced — insert with nonexistent parent should succeed
+	// FK enforced — insert with nonexistent parent should fail
 	_, err = db.Exec("INSERT INTO hr_child (id, parent_id) VALUES (1, 999)")
-	require.NoError(t, err)
+	require.ErrorContains(t, err, "foreign key constraint violation")
 
 	var count int
 	err = db.QueryRow("SELECT COUNT(*) FROM hr_child").Scan(&count)
 	require.NoError(t, err)
-	require.Equal(t, 1, count, "insert with nonexistent FK parent should succeed")
+	require.Equal(t, 0, count, "insert with nonexistent FK parent should fail")
 }
 
 func TestHardened_NullsFirstLastValues(t *testing.T) {
@@ -732,6 +732,16 @@ func TestPgsqlServer_F1DBCompatRegressions(t *testing.T) {
 		// UPDATE path — same coercion must apply.
 		_, err = db.Exec(fmt.Sprintf("UPDATE %s SET price = 10 WHERE id = 1", tbl))
 		require.NoError(t, err, "UPDATE int-literal into DECIMAL")
+
+		// Values come back as exact numerics rounded to the column scale.
+		var price string
+		err = db.QueryRow(fmt.Sprintf("SELECT price FROM %s WHERE id = 1", tbl)).Scan(&price)
+		require.NoError(t, err)
+		require.Equal(t, "10.00", price)
+
+		err = db.QueryRow(fmt.Sprintf("SELECT price FROM %s WHERE id = 9", tbl)).Scan(&price)
+		require.NoError(t, err)
+		require.Equal(t, "3.14", price)
 	})
 
 	t.Run("NUMERIC column accepts integer literal", func(t *testing.T) {
@@ -17,6 +17,7 @@ limitations under the License.
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -48,6 +49,8 @@ func (s *session) QueryMachine() error {
 		return err
 	}
 
+	defer s.closeSuspendedPortals(true)
+
 	for {
 		msg, extQueryMode, err := s.nextMessage()
 		if err != nil {
@@ -122,7 +125,6 @@ func (s *session) QueryMachine() error {
 
 			var paramCols []sql.ColDescriptor
 			var resCols []sql.ColDescriptor
-			var stmt sql.SQLStmt
 
 			// Apply the same pg → immudb SQL translations (type aliases,
 			// reserved-word renames, etc.) to the Extended Query Parse
@@ -158,27 +160,12 @@ func (s *session) QueryMachine() error {
 				// will Bind.
 				paramCols = inferParamColsFromSQL(v.Statements)
 			} else if true {
-				stmts, err := sql.ParseSQL(strings.NewReader(v.Statements))
+				paramCols, resCols, err = s.inferParamAndResultCols(v.Statements)
 				if err != nil {
 					waitForSync = extQueryMode
 					s.HandleError(err)
 					continue
 				}
-
-				// Note: as stated in the pgsql spec, the query string contained in a Parse message cannot include more than one SQL statement;
-				// else a syntax error is reported. This restriction does not exist in the simple-query protocol, but it does exist
-				// in the extended protocol, because allowing prepared statements or portals to contain multiple commands would
-				// complicate the protocol unduly.
-				if len(stmts) > 1 {
-					waitForSync = extQueryMode
-					s.HandleError(pserr.ErrMaxStmtNumberExceeded)
-					continue
-				}
-				if paramCols, resCols, err = s.inferParamAndResultCols(stmts[0]); err != nil {
-					waitForSync = extQueryMode
-					s.HandleError(err)
-					continue
-				}
 			}
 
 			_, err = s.writeMessage(bm.ParseComplete())
@@ -192,7 +179,6 @@ func (s *session) QueryMachine() error {
 				Name:         v.DestPreparedStatementName,
 				Params:       paramCols,
 				SQLStatement: v.Statements,
-				PreparedStmt: stmt,
 				Results:      resCols,
 			}
 
@@ -259,6 +245,7 @@ func (s *session) QueryMachine() error {
 			}
 		case fm.SyncMsg:
 			waitForSync = false
+			s.closeSuspendedPortals(false)
 			s.writeMessage(bm.ReadyForQuery(s.txStatus))
 		case fm.BindMsg:
 			_, ok := s.portals[v.DestPortalName]
@@ -305,6 +292,29 @@ func (s *session) QueryMachine() error {
 				continue
 			}
 
+			// rows are returned in batches of at most MaxRows when the portal
+			// contains a single query, otherwise the limit is ignored
+			if portal.suspended == nil && v.MaxRows > 0 {
+				if err := s.openPortal(portal); err != nil {
+					delete(s.portals, v.PortalName)
+					waitForSync = extQueryMode
+					s.HandleError(err)
+					continue
+				}
+			}
+
+			if portal.suspended != nil {
+				suspended, err := s.executePortal(portal, int(v.MaxRows))
+				if err != nil {
+					waitForSync = extQueryMode
+					s.HandleError(err)
+				}
+				if !suspended {
+					delete(s.portals, v.PortalName)
+				}
+				continue
+			}
+
 			delete(s.portals, v.PortalName)
 
 			err := s.fetchAndWriteResults(portal.Statement.SQLStatement,
@@ -380,6 +390,12 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 			_, err := s.writeMessage(bm.CommandComplete([]byte("ok")))
 			return err
 		}
+		if cmd, ok := i.(*setSearchPathCmd); ok {
+			s.setSearchPath(cmd.searchPath)
+
+			_, err := s.writeMessage(bm.CommandComplete([]byte(tag)))
+			return err
+		}
 		if err := s.tryToHandleInternally(i); err != nil && err != pserr.ErrMessageCannotBeHandledInternally {
 			return err
 		}
@@ -389,28 +405,13 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 	}
 
 	s.log.Infof("pgcompat: executing query via SQL engine: %.300s", statements)
-	var err error
-	cacheKey := removePGCatalogReferences(statements)
-	var stmts []sql.SQLStmt
-	if s.stmtCache != nil {
-		stmts = s.stmtCache[cacheKey]
-	}
-	if stmts == nil {
-		stmts, err = sql.ParseSQL(strings.NewReader(cacheKey))
-		if err != nil {
-			return err
-		}
-		if s.stmtCache != nil {
-			// Evict the oldest entry when the cache is full (FIFO).
-			if len(s.stmtCache) >= stmtCacheSize {
-				oldest := s.stmtCacheKeys[0]
-				s.stmtCacheKeys = s.stmtCacheKeys[1:]
-				delete(s.stmtCache, oldest)
-			}
-			s.stmtCache[cacheKey] = stmts
-			s.stmtCacheKeys = append(s.stmtCacheKeys, cacheKey)
-		}
+	// parsed statements are shared through the statement cache of the database,
+	// keyed on the post-normalization SQL string
+	stmts, release, err := s.db.SQLPrepare(s.ctx, removePGCatalogReferences(statements))
+	if err != nil {
+		return err
 	}
+	defer release()
 
 	if len(stmts) == 0 {
 		// PostgreSQL contract: a Simple Query that is empty or contains
@@ -420,6 +421,8 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 		return err
 	}
 
+	var merged int
+
 	for _, stmt := range stmts {
 		switch st := stmt.(type) {
 		case *sql.UseDatabaseStmt:
@@ -432,12 +435,18 @@ func (s *session) fetchAndWriteResults(statements string, parameters []*schema.N
 				return err
 			}
 		default:
-			if err = s.exec(st, parameters, resultColumnFormatCodes, extQueryMode); err != nil {
+			n, err := s.exec(st, parameters, resultColumnFormatCodes, extQueryMode)
+			if err != nil {
 				return err
 			}
+			merged += n
 		}
 	}
 
+	if tag == "MERGE 0" {
+		tag = fmt.Sprintf("MERGE %d", merged)
+	}
+
 	_, err = s.writeMessage(bm.CommandComplete([]byte(tag)))
 	if err != nil {
 		return err
@@ -462,7 +471,7 @@ var pgTypeReplacements = []struct {
 	// embedded/sql/parser.go (see scripts/dump_immudb_keywords.sh — or
 	// regenerate by grepping `^\s+"[A-Z_]+":` out of parser.go and
 	// lowercasing).
-	{regexp.MustCompile(`"((?i:add|admin|after|all|alter|and|as|asc|auto_increment|avg|before|begin|between|bigint|bigserial|blob|boolean|by|bytea|cascade|case|cast|check|column|commit|conflict|constraint|count|create|cross|database|databases|date|day|decimal|default|delete|desc|diff|distinct|do|double|drop|else|end|except|exists|explain|extract|false|fetch|first|float|for|foreign|from|full|grant|grants|group|having|history|hour|if|ilike|in|index|inner|insert|int|integer|intersect|into|is|join|json|jsonb|key|last|lateral|left|like|limit|max|min|minute|month|natural|not|nothing|null|nulls|numeric|of|offset|on|only|or|order|over|partition|password|primary|privileges|read|readwrite|real|recursive|references|release|rename|returning|revoke|right|rollback|rows|savepoint|second|select|sequence|serial|set|show|since|smallint|snapshot|sum|table|tables|then|timestamp|timestamptz|to|transaction|true|truncate|tx|union|unique|until|update|upsert|use|user|users|using|uuid|values|varchar|view|when|where|with|year))"`), "_$1"},
+	{regexp.MustCompile(`"((?i:add|admin|after|all|alter|and|any|array|as|asc|auto_increment|avg|before|begin|between|bigint|bigserial|blob|boolean|by|bytea|cascade|case|cast|check|close|column|commit|conflict|constraint|count|create|cross|cursor|database|databases|date|day|decimal|declare|default|delete|desc|diff|distinct|do|double|drop|else|end|except|exists|explain|extract|false|fetch|first|float|for|foreign|from|full|grant|grants|group|having|history|hour|if|ilike|in|index|inner|insert|int|integer|intersect|into|is|join|json|jsonb|key|last|lateral|left|like|limit|max|min|minute|month|natural|not|nothing|null|nulls|numeric|of|offset|on|only|or|order|over|partition|password|primary|privileges|read|readwrite|real|recursive|references|release|rename|returning|revoke|right|rollback|rows|savepoint|second|select|sequence|serial|set|show|since|smallint|snapshot|sum|table|tables|then|timestamp|timestamptz|to|transaction|true|truncate|tx|union|unique|until|update|upsert|use|user|users|using|uuid|values|varchar|view|when|where|with|year))"`), "_$1"},
 	{regexp.MustCompile(`"(\w+)"`), "$1"},
 
 	// Strip PG-only ::TYPE casts before the type-name translation below.
@@ -520,19 +529,10 @@ var pgTypeReplacements = []struct {
 	{regexp.MustCompile(`(?i)\bsmallint\b`), "INTEGER"},
 	{regexp.MustCompile(`(?i)\bbigint\b`), "INTEGER"},
 	{regexp.MustCompile(`(?i)\breal\b`), "FLOAT"},
-	{regexp.MustCompile(`(?i)\bnumeric\s*\([^)]*\)`), "FLOAT"},
-	{regexp.MustCompile(`(?i)\bnumeric\b`), "FLOAT"},
-	{regexp.MustCompile(`(?i)\bdecimal\s*\([^)]*\)`), "FLOAT"},
-	// PG array types → just use a generous VARCHAR (must come BEFORE text
-	// mapping). Handles: "text[]", "jsonb[]", "uuid[]", etc.
-	// Size bumped from 4096 to 1 MB so Gitea's PushCommits JSON payload
-	// and other unbounded-TEXT columns (workflow dispatch inputs, action
-	// logs, etc.) fit. Per-db MaxValueLen is 32 MB (pkg/server/db_options.go:135).
-	{regexp.MustCompile(`(?i)\w+\[\]`), "VARCHAR[1048576]"},
-	// Array-of-sized-VARCHAR, e.g. "character varying(255)[]" already got
-	// rewritten by an earlier rule to "VARCHAR[255][]"; collapse so the
-	// trailing [] doesn't confuse immudb's grammar.
-	{regexp.MustCompile(`(?i)VARCHAR\[\d+\]\s*\[\s*\]`), "VARCHAR[1048576]"},
+	// PG array types are native (INTEGER[], VARCHAR[255][], JSONB[], …).
+	// text[] must be handled BEFORE the text mapping below: elements are
+	// unbounded, not capped at the 1 MB of a TEXT column.
+	{regexp.MustCompile(`(?i)\btext\s*\[\s*\]`), "VARCHAR[]"},
 	// PG's unbounded TEXT → 1 MB VARCHAR. Critical for Gitea's action.content
 	// (commit JSON for a push of N commits grows O(N) and exceeds 4 KB for
 	// even modest pushes), issue bodies, workflow YAML blobs, etc.
@@ -665,31 +665,9 @@ var pgTypeReplacements = []struct {
 	// Strip CHECK constraints (may be nested parens)
 	{regexp.MustCompile(`(?i)\bCHECK\s*\([^)]*\)`), ""},
 
-	// Strip PostgreSQL stored generated (virtual) column clauses.
-	// Rails' `t.virtual ..., stored: true` emits
-	//   "col" type GENERATED ALWAYS AS (expression) STORED
-	// immudb has no generated-column support, so drop the clause and
-	// leave the column as a regular nullable column. The expression
-	// may contain arbitrary nested parens; we rely on STORED as the
-	// (non-greedy) terminator. This works provided the expression
-	// body does not itself contain the literal identifier "STORED",
-	// which is safe in practice for ActiveRecord-emitted DDL.
-	{regexp.MustCompile(`(?is)\s+GENERATED\s+ALWAYS\s+AS\s+\(.*?\)\s+STORED\b`), ""},
-
 	// Strip CONSTRAINT keyword with name
 	{regexp.MustCompile(`(?i)\bCONSTRAINT\s+\w+\s+`), ""},
 
-	// Strip table-level FOREIGN KEY … REFERENCES … constraints BEFORE the
-	// column-level REFERENCES strip below. Without this, the column-level
-	// strip removes only the REFERENCES clause, leaving a dangling
-	// "FOREIGN KEY (col)" that causes "unexpected ')', expecting REFERENCES".
-	// immudb's SQL engine does accept FOREIGN KEY in CREATE TABLE (parsed
-	// but not enforced), but stripping here is equivalent and avoids the
-	// interaction with the REFERENCES rule.
-	{regexp.MustCompile(`(?i),?\s*\bFOREIGN\s+KEY\s*\([^)]*\)\s*REFERENCES\s+\S+\s*\([^)]*\)(\s+ON\s+(DELETE|UPDATE)\s+(CASCADE|RESTRICT|SET\s+NULL|SET\s+DEFAULT|NO\s+ACTION))*`), ""},
-	// Strip column-level REFERENCES (inline FK) with optional ON DELETE/UPDATE
-	{regexp.MustCompile(`(?i)\bREFERENCES\s+\S+\s*\([^)]*\)(\s+ON\s+(DELETE|UPDATE)\s+(CASCADE|RESTRICT|SET\s+NULL|SET\s+DEFAULT|NO\s+ACTION))*`), ""},
-
 	// Strip the optional index name from `CREATE [UNIQUE] INDEX [IF NOT
 	// EXISTS] <name> ON …`. PostgreSQL requires (and pg_dump emits) a
 	// name; immudb's grammar rejects one (sql_grammar.y:390-408 has
@@ -720,10 +698,6 @@ var pgTypeReplacements = []struct {
 	// regex pipeline. Stripping the keyword outright would silently drop
 	// uniqueness guarantees — which is exactly what the buggy prior
 	// behavior did.
-
-	// date type — must come after timestamp replacements
-	// Only match standalone 'date' as a type (after a column name, not in other contexts)
-	{regexp.MustCompile(`(?i)\bdate\b`), "TIMESTAMP"},
 }
 
 var createTableRe = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+`)
@@ -804,7 +778,7 @@ var stringLiteralTokenRe = regexp.MustCompile("\x01(\\d+)\x01")
 // Go driver, XORM's transaction state machine, several JDBC drivers)
 // inspect the tag to decide whether a transaction actually committed,
 // and `ok` confuses them with "unexpected command tag ok".
-var commandTagRe = regexp.MustCompile(`(?i)^\s*(SELECT|INSERT|UPDATE|DELETE|CREATE\s+TABLE|CREATE\s+INDEX|CREATE\s+UNIQUE\s+INDEX|CREATE\s+DATABASE|CREATE\s+VIEW|DROP\s+TABLE|DROP\s+INDEX|DROP\s+VIEW|DROP\s+DATABASE|ALTER\s+TABLE|TRUNCATE|BEGIN|START\s+TRANSACTION|COMMIT|END|ROLLBACK|ABORT|SAVEPOINT|RELEASE|SET|SHOW|GRANT|REVOKE|EXPLAIN|USE|VACUUM|ANALYZE|COPY|LOCK|FETCH|MOVE|CLOSE|DECLARE|LISTEN|NOTIFY|UNLISTEN|PREPARE|EXECUTE|DEALLOCATE|RESET|CHECKPOINT|REINDEX|DISCARD)\b`)
+var commandTagRe = regexp.MustCompile(`(?i)^\s*(SELECT|INSERT|UPDATE|DELETE|MERGE|CREATE\s+TABLE|CREATE\s+INDEX|CREATE\s+UNIQUE\s+INDEX|CREATE\s+DATABASE|CREATE\s+VIEW|CREATE\s+(?:OR\s+REPLACE\s+)?(?:FUNCTION|PROCEDURE)|DROP\s+TABLE|DROP\s+INDEX|DROP\s+VIEW|DROP\s+FUNCTION|DROP\s+PROCEDURE|CALL|DROP\s+DATABASE|ALTER\s+TABLE|TRUNCATE|BEGIN|START\s+TRANSACTION|COMMIT|END|ROLLBACK|ABORT|SAVEPOINT|RELEASE|SET|SHOW|GRANT|REVOKE|EXPLAIN|USE|VACUUM|ANALYZE|COPY|LOCK|FETCH|MOVE|CLOSE|DECLARE|LISTEN|NOTIFY|UNLISTEN|PREPARE|EXECUTE|DEALLOCATE|RESET|CHECKPOINT|REINDEX|DISCARD)\b`)
 
 // commandTagFor returns the PG-canonical CommandComplete tag for a SQL
 // statement. Defaults to "ok" if the verb isn't recognised, so existing
@@ -815,6 +789,7 @@ func commandTagFor(sqlText string) string {
 		return "ok"
 	}
 	verb := strings.ToUpper(strings.Join(strings.Fields(m[1]), " "))
+	verb = strings.Replace(verb, "CREATE OR REPLACE ", "CREATE ", 1)
 	switch verb {
 	case "BEGIN", "START TRANSACTION":
 		return "BEGIN"
@@ -826,7 +801,7 @@ func commandTagFor(sqlText string) string {
 		// PG: "INSERT <oid> <count>". Most clients only check the verb;
 		// emit a plausible 0-row tag.
 		return "INSERT 0 0"
-	case "UPDATE", "DELETE", "SELECT", "FETCH", "MOVE", "COPY":
+	case "UPDATE", "DELETE", "MERGE", "SELECT", "FETCH", "MOVE", "COPY":
 		return verb + " 0"
 	}
 	return verb
@@ -1150,12 +1125,23 @@ func addPrimaryKeyToCreateTable(sql string) string {
 	return before + ",\n    PRIMARY KEY (" + pkCol + ")\n" + after
 }
 
-func (s *session) query(st sql.DataSource, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+func (s *session) query(st sql.DataSource, parameters []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) (err error) {
 	tx, err := s.sqlTx()
 	if err != nil {
 		return err
 	}
 
+	if tx != nil && tx != s.tx {
+		// the transaction was created for this query only
+		defer func() {
+			if err != nil {
+				tx.Cancel()
+				return
+			}
+			err = tx.Commit(s.ctx)
+		}()
+	}
+
 	reader, err := s.db.SQLQueryPrepared(s.ctx, tx, st, schema.NamedParamsFromProto(parameters))
 	if err != nil {
 		return err
@@ -1179,7 +1165,9 @@ func (s *session) query(st sql.DataSource, parameters []*schema.NamedParam, resu
 	})
 }
 
-func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) error {
+// exec runs a statement that returns no rows. It returns the number of rows
+// written by the statement if it is a MERGE, as reported in its command tag.
+func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultColumnFormatCodes []int16, skipRowDesc bool) (int, error) {
 	params := make(map[string]interface{}, len(namedParams))
 
 	for _, p := range namedParams {
@@ -1188,13 +1176,34 @@ func (s *session) exec(st sql.SQLStmt, namedParams []*schema.NamedParam, resultC
 
 	tx, err := s.sqlTx()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	ntx, _, err := s.db.SQLExecPrepared(s.ctx, tx, []sql.SQLStmt{st}, params)
+	// MERGE counters accumulate over the whole transaction, so the rows of
+	// this statement are the difference with the ones merged before it
+	var merged int
+	if tx != nil {
+		merged = -mergedRowCount(tx.MergedRows())
+	}
+
+	ntx, ctxs, err := s.db.SQLExecPrepared(s.ctx, tx, []sql.SQLStmt{st}, params)
 	s.tx = ntx
 
-	return err
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ctx := range ctxs {
+		merged += mergedRowCount(ctx.MergedRows())
+	}
+	if ntx != nil {
+		merged += mergedRowCount(ntx.MergedRows())
+	}
+	return merged, nil
+}
+
+func mergedRowCount(r sql.MergeResult) int {
+	return r.Inserted + r.Updated + r.Deleted
 }
 
 type portal struct {
@@ -1202,35 +1211,244 @@ type portal struct {
 	Statement               *statement
 	Parameters              []*schema.NamedParam
 	ResultColumnFormatCodes []int16
+
+	suspended *suspendedQuery // set while the rows of the portal are partially returned
+}
+
+// suspendedQuery is the query of a portal whose rows are returned in
+// batches by successive Execute messages.
+type suspendedQuery struct {
+	reader  sql.RowReader
+	cols    int
+	tx      *sql.SQLTx // the transaction created for the query only, if any
+	release func()     // hands the statement back to the statement cache
+}
+
+func (q *suspendedQuery) close(ctx context.Context) error {
+	err := q.reader.Close()
+	q.release()
+
+	if q.tx == nil {
+		return err
+	}
+
+	if err != nil {
+		q.tx.Cancel()
+		return err
+	}
+	return q.tx.Commit(ctx)
+}
+
+// executePortal returns up to maxRows rows of the query of a portal,
+// all the remaining ones when maxRows is zero. It reports whether the
+// portal was suspended because more rows may be available, in which case
+// the following Execute messages resume reading from where it stopped.
+func (s *session) executePortal(p *portal, maxRows int) (suspended bool, err error) {
+	q := p.suspended
+
+	defer func() {
+		if suspended {
+			return
+		}
+
+		p.suspended = nil
+
+		cerr := q.close(s.ctx)
+		if err == nil {
+			err = cerr
+		}
+		if err == nil {
+			_, err = s.writeMessage(bm.CommandComplete([]byte(commandTagFor(p.Statement.SQLStatement))))
+		}
+	}()
+
+	rows := make([]*sql.Row, 0, maxRowsPerMessage)
+
+	writeRows := func() error {
+		if len(rows) == 0 {
+			return nil
+		}
+		_, err := s.writeMessage(bm.DataRow(rows, q.cols, p.ResultColumnFormatCodes))
+		rows = rows[:0]
+		return err
+	}
+
+	for read := 0; maxRows == 0 || read < maxRows; read++ {
+		row, err := q.reader.Read(s.ctx)
+		if errors.Is(err, sql.ErrNoMoreRows) {
+			return false, writeRows()
+		}
+		if err != nil {
+			return false, err
+		}
+
+		rows = append(rows, row)
+
+		if len(rows) == maxRowsPerMessage {
+			if err := writeRows(); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	if err := writeRows(); err != nil {
+		return false, err
+	}
+
+	_, err = s.writeMessage(bm.PortalSuspended())
+	return err == nil, err
+}
+
+// openPortal opens the query of a portal, whose rows are then returned by
+// executePortal. Portals not containing a single query are left as they
+// are, to be executed at once.
+func (s *session) openPortal(p *portal) error {
+	statements := p.Statement.SQLStatement
+
+	if s.isInBlackList(statements) || s.isEmulableInternally(statements) != nil {
+		return nil
+	}
+
+	stmts, release, err := s.db.SQLPrepare(s.ctx, removePGCatalogReferences(statements))
+	if err != nil {
+		return err
+	}
+
+	if len(stmts) != 1 {
+		release()
+		return nil
+	}
+
+	ds, ok := stmts[0].(sql.DataSource)
+	if !ok {
+		release()
+		return nil
+	}
+
+	q, err := s.openQuery(ds, p.Parameters, release)
+	if err != nil {
+		return err
+	}
+
+	p.suspended = q
+	return nil
+}
+
+// openQuery starts reading the rows of a query, release is called once
+// the query is closed or fails to be opened.
+func (s *session) openQuery(ds sql.DataSource, parameters []*schema.NamedParam, release func()) (*suspendedQuery, error) {
+	tx, err := s.sqlTx()
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	q := &suspendedQuery{release: release}
+
+	if tx != nil && tx != s.tx {
+		// the transaction was created for this query only
+		q.tx = tx
+	}
+
+	q.reader, err = s.db.SQLQueryPrepared(s.ctx, tx, ds, schema.NamedParamsFromProto(parameters))
+	if err != nil {
+		if q.tx != nil {
+			q.tx.Cancel()
+		}
+		release()
+		return nil, err
+	}
+
+	cols, err := q.reader.Columns(s.ctx)
+	if err != nil {
+		q.close(s.ctx)
+		return nil, err
+	}
+	q.cols = len(cols)
+
+	return q, nil
+}
+
+// closeSuspendedPortals closes the portals left suspended once the
+// transaction they were read from has ended, or all of them when the
+// session ends.
+func (s *session) closeSuspendedPortals(all bool) {
+	for name, p := range s.portals {
+		if p.suspended == nil {
+			continue
+		}
+
+		if !all && s.tx != nil && p.suspended.reader.Tx() == s.tx {
+			continue
+		}
+
+		if err := p.suspended.close(s.ctx); err != nil {
+			s.log.Warningf("error closing portal '%s': %v", name, err)
+		}
+		delete(s.portals, name)
+	}
 }
 
 type statement struct {
 	Name         string
 	SQLStatement string
-	PreparedStmt sql.SQLStmt
 	Params       []sql.ColDescriptor
 	Results      []sql.ColDescriptor
 }
 
-func (s *session) inferParamAndResultCols(stmt sql.SQLStmt) ([]sql.ColDescriptor, []sql.ColDescriptor, error) {
+// inferParamAndResultCols describes the statement of a Parse message. The
+// types of its parameters are taken from the statement cache of the database,
+// shared with the other sessions.
+func (s *session) inferParamAndResultCols(statements string) ([]sql.ColDescriptor, []sql.ColDescriptor, error) {
 	var resCols []sql.ColDescriptor
 
-	ds, ok := stmt.(sql.DataSource)
-	if ok {
-		rr, err := s.db.SQLQueryPrepared(s.ctx, s.tx, ds, nil)
+	tx := s.tx
+	if tx == nil && s.searchPath != nil {
+		// names must be resolved through the search path of the session
+		ntx, err := s.db.NewSQLTx(s.ctx, s.txOptions())
 		if err != nil {
 			return nil, nil, err
 		}
+		defer ntx.Cancel()
 
-		resCols, err = rr.Columns(s.ctx)
+		tx = ntx
+	}
+
+	stmts, release, err := s.db.SQLPrepare(s.ctx, statements)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Note: as stated in the pgsql spec, the query string contained in a Parse message cannot include more than one SQL statement;
+	// else a syntax error is reported. This restriction does not exist in the simple-query protocol, but it does exist
+	// in the extended protocol, because allowing prepared statements or portals to contain multiple commands would
+	// complicate the protocol unduly.
+	if len(stmts) > 1 {
+		release()
+		return nil, nil, pserr.ErrMaxStmtNumberExceeded
+	}
+
+	ds, ok := stmts[0].(sql.DataSource)
+	if ok {
+		rr, err := s.db.SQLQueryPrepared(s.ctx, tx, ds, nil)
 		if err != nil {
+			release()
 			return nil, nil, err
 		}
 
+		resCols, err = rr.Columns(s.ctx)
 		rr.Close()
+		if err != nil {
+			release()
+			return nil, nil, err
+		}
 	}
 
-	r, err := s.db.InferParametersPrepared(s.ctx, s.tx, stmt)
+	// the statements are handed back to the cache before the parameters
+	// are inferred from the same plan
+	release()
+
+	r, err := s.db.InferParameters(s.ctx, tx, statements)
 	if err != nil {
 		return nil, nil, err
 	}
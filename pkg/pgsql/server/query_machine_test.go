@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/codenotary/immudb/embedded/logger"
@@ -283,7 +284,6 @@ func TestSession_QueriesMachine(t *testing.T) {
 				"st": {
 					Name:         "st",
 					SQLStatement: "test",
-					PreparedStmt: nil,
 					Params: []sql.ColDescriptor{{
 						Column: "test",
 						Type:   "INTEGER",
@@ -441,6 +441,14 @@ type mockDB struct {
 	database.DB
 }
 
+func (db *mockDB) SQLPrepare(ctx context.Context, sqlText string) ([]sql.SQLStmt, func(), error) {
+	stmts, err := sql.ParseSQL(strings.NewReader(sqlText))
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmts, func() {}, nil
+}
+
 func (db *mockDB) SQLQueryPrepared(ctx context.Context, tx *sql.SQLTx, stmt sql.DataSource, params map[string]interface{}) (sql.RowReader, error) {
 	return nil, fmt.Errorf("dummy error")
 }
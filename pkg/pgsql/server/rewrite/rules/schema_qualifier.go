@@ -42,9 +42,8 @@ import (
 //     naming convention where info_schema views live under the
 //     public namespace with underscored names)
 //
-// Any other schema qualifier is left alone — immudb is single-
-// schema so those queries will error at the engine layer, but that
-// failure mode is more useful than silently stripping them.
+// Any other schema qualifier is left alone — it names a schema
+// created with CREATE SCHEMA, which the engine resolves itself.
 type StripSchemaQualifier struct{}
 
 // Name implements rewrite.Rule.
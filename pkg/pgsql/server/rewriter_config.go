@@ -93,7 +93,6 @@ var astRewriterSingleton = rewrite.New().
 	WithRule(rules.StripTableStarPrefix{}).
 	WithRule(rules.StripOnConflictColumns{}).
 	WithRule(rules.StripCheckConstraints{}).
-	WithRule(rules.StripForeignKeys{}).
 	WithRule(rules.StripCreateIndexName{}).
 	WithRule(rules.StripCreateViewColList{}).
 	WithRule(rules.EnsureCreateTableIfNotExists{}).
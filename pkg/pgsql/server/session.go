@@ -35,10 +35,6 @@ import (
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
 )
 
-// stmtCacheSize is the maximum number of parsed statement lists cached
-// per session. When the cache is full the oldest entry is evicted (FIFO).
-const stmtCacheSize = 64
-
 type session struct {
 	immudbHost         string
 	immudbPort         int
@@ -57,6 +53,10 @@ type session struct {
 	db     database.DB
 	tx     *sql.SQLTx
 
+	// searchPath is the schema search path set with SET search_path, nil
+	// until the client sets one.
+	searchPath []string
+
 	mr MessageReader
 
 	// txStatus is the byte we report in the next ReadyForQuery message.
@@ -73,13 +73,6 @@ type session struct {
 
 	statements map[string]*statement
 	portals    map[string]*portal
-
-	// stmtCache is a bounded per-session cache of parsed SQL statement lists,
-	// keyed on the post-normalization SQL string (after removePGCatalogReferences).
-	// Avoids repeated lex+parse overhead for queries repeated by dashboards or ORMs.
-	// Eviction is FIFO; stmtCacheKeys tracks insertion order for eviction.
-	stmtCache     map[string][]sql.SQLStmt
-	stmtCacheKeys []string
 }
 
 type Session interface {
@@ -118,8 +111,6 @@ func newSession(
 		mr:                 NewMessageReader(c),
 		statements:         make(map[string]*statement),
 		portals:            make(map[string]*portal),
-		stmtCache:          make(map[string][]sql.SQLStmt, stmtCacheSize),
-		stmtCacheKeys:      make([]string, 0, stmtCacheSize),
 		txStatus:           bm.TxStatusIdle,
 	}
 }
@@ -213,18 +204,39 @@ func (s *session) refreshSessionActivity() {
 }
 
 func (s *session) sqlTx() (*sql.SQLTx, error) {
-	if s.tx != nil || !s.logRequestMetadata {
+	if s.tx != nil || (!s.logRequestMetadata && s.searchPath == nil) {
 		return s.tx, nil
 	}
 
-	md := schema.Metadata{
-		schema.UserRequestMetadataKey: s.user,
-		schema.IpRequestMetadataKey:   s.ipAddr,
+	ctx := s.ctx
+
+	if s.logRequestMetadata {
+		md := schema.Metadata{
+			schema.UserRequestMetadataKey: s.user,
+			schema.IpRequestMetadataKey:   s.ipAddr,
+		}
+
+		// create transaction explicitly to inject request metadata
+		ctx = schema.ContextWithMetadata(s.ctx, md)
 	}
 
-	// create transaction explicitly to inject request metadata
-	ctx := schema.ContextWithMetadata(s.ctx, md)
-	return s.db.NewSQLTx(ctx, sql.DefaultTxOptions())
+	return s.db.NewSQLTx(ctx, s.txOptions())
+}
+
+// txOptions returns the options of the transactions created for the
+// session, which resolve unqualified names through its search path.
+func (s *session) txOptions() *sql.TxOptions {
+	return sql.DefaultTxOptions().WithSearchPath(s.searchPath)
+}
+
+// setSearchPath changes the schema search path of the session, including
+// the one of the ongoing transaction.
+func (s *session) setSearchPath(searchPath []string) {
+	s.searchPath = searchPath
+
+	if s.tx != nil {
+		s.tx.SetSearchPath(searchPath)
+	}
 }
 
 // useDatabase rebinds this pgsql session to a different immudb database
@@ -30,6 +30,11 @@ var (
 	// UPDATE silently matched this regex and was dropped by the blacklist,
 	// so UPDATEs "succeeded" but never wrote anything.
 	set           = regexp.MustCompile(`(?is)^\s*set\s+\S+`)
+
+	// SET search_path TO a, b / SET SCHEMA 'a' change the schemas searched
+	// for unqualified names, so unlike the other SET commands they are
+	// not ignored.
+	setSearchPathRe = regexp.MustCompile(`(?is)^\s*set\s+(?:(?:session|local)\s+)?(?:search_path\s*(?:to|=)\s*(.+?)|schema\s+(.+?))\s*;?\s*$`)
 	selectVersion = regexp.MustCompile(`(?i)select\s+version\(\s*\)`)
 	dealloc       = regexp.MustCompile(`(?i)deallocate\s+\"([^\"]+)\"`)
 
@@ -189,6 +194,10 @@ var (
 // them into silent no-ops, which destroys uniqueness guarantees.
 var pgUnsupportedDDL = regexp.MustCompile(`(?i)^\s*(CREATE\s+TYPE|CREATE\s+FUNCTION|CREATE\s+OR\s+REPLACE\s+FUNCTION|CREATE\s+TRIGGER|CREATE\s+RULE|CREATE\s+EXTENSION|CREATE\s+CAST|CREATE\s+OPERATOR|CREATE\s+AGGREGATE|CREATE\s+DOMAIN|ALTER\s+TABLE\s+\S+\s+OWNER\s+TO|ALTER\s+TABLE\s+\S+\s+ALTER\s+COLUMN|ALTER\s+TABLE\s+ONLY|ALTER\s+TABLE\s+\S+\s+DISABLE|ALTER\s+TABLE\s+\S+\s+ENABLE|ALTER\s+TABLE\s+\S+\s+ADD\s+(?:CONSTRAINT\s+\S+\s+)?FOREIGN\s+KEY|ALTER\s+TABLE\s+\S+\s+ADD\b|ALTER\s+SEQUENCE|ALTER\s+FUNCTION|ALTER\s+TYPE|GRANT\s|REVOKE\s|COMMENT\s+ON|SELECT\s+pg_catalog\.|SELECT\s+setval|SET\s+default_tablespace|SET\s+default_table_access_method|SET\s+transaction_timeout|DROP\s+INDEX)`)
 
+// pgAlterColumnType matches the column type changes the SQL engine carries
+// out, which are exempted from the blanket ALTER COLUMN entry above.
+var pgAlterColumnType = regexp.MustCompile(`(?i)^\s*ALTER\s+TABLE\s+\S+\s+ALTER\s+COLUMN\s+\S+\s+TYPE\s`)
+
 // allPgRefsRegistered returns true when every pg_* identifier in
 // statement is either a registered system table (registeredPgTables)
 // or a registered built-in function (pgBuiltinFunctions). Used by the
@@ -255,7 +264,7 @@ func stripSingleQuotedLiterals(s string) string {
 }
 
 func (s *session) isInBlackList(statement string) bool {
-	if set.MatchString(statement) {
+	if set.MatchString(statement) && !setSearchPathRe.MatchString(statement) {
 		return true
 	}
 
@@ -264,7 +273,7 @@ func (s *session) isInBlackList(statement string) bool {
 	}
 
 	// Silently ignore unsupported PostgreSQL DDL statements
-	if pgUnsupportedDDL.MatchString(statement) {
+	if pgUnsupportedDDL.MatchString(statement) && !pgAlterColumnType.MatchString(statement) {
 		return true
 	}
 
@@ -303,6 +312,10 @@ func (s *session) isEmulableInternally(statement string) interface{} {
 		return &immudbTxCmd{args: m[1]}
 	}
 
+	if m := setSearchPathRe.FindStringSubmatch(statement); len(m) == 3 {
+		return &setSearchPathCmd{searchPath: s.parseSearchPath(m[1] + m[2])}
+	}
+
 	if m := showRe.FindStringSubmatch(statement); len(m) == 2 {
 		return &showCmd{param: m[1]}
 	}
@@ -384,6 +397,8 @@ func (s *session) tryToHandleInternally(command interface{}) error {
 		return s.immudbHistory(cmd.args)
 	case *immudbTxCmd:
 		return s.immudbTxByID(cmd.args)
+	case *setSearchPathCmd:
+		s.setSearchPath(cmd.searchPath)
 	case *showCmd:
 		return s.handleShow(cmd.param)
 	case *regtypeOidCmd:
@@ -441,6 +456,40 @@ type showCmd struct {
 	param string
 }
 
+type setSearchPathCmd struct {
+	searchPath []string
+}
+
+// parseSearchPath parses the value of SET search_path. Unquoted schema
+// names are case-insensitive, "$user" stands for the schema named after
+// the logged user and DEFAULT restores the default path.
+func (s *session) parseSearchPath(value string) []string {
+	if strings.EqualFold(strings.TrimSpace(value), "default") {
+		return nil
+	}
+
+	searchPath := []string{}
+
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+
+		if len(name) >= 2 && (name[0] == '"' || name[0] == '\'') && name[len(name)-1] == name[0] {
+			name = name[1 : len(name)-1]
+		} else {
+			name = strings.ToLower(name)
+		}
+
+		if name == "$user" {
+			name = s.user
+		}
+
+		if name != "" {
+			searchPath = append(searchPath, name)
+		}
+	}
+	return searchPath
+}
+
 type pgAdminProbe struct {
 	sql string
 }
@@ -20,12 +20,14 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/api/schema"
+	"github.com/google/uuid"
 )
 
 // pgTextBool mirrors Postgres' text-format boolean accepted values:
@@ -111,6 +113,10 @@ func buildNamedParams(paramsType []sql.ColDescriptor, paramsVal []interface{}) (
 					return nil, fmt.Errorf("invalid float bind value %q for parameter %s: %w", p, name, err)
 				}
 				pMap[name] = f
+			case sql.DecimalType:
+				// kept as text so that no precision is lost on the way
+				// to the engine, which parses it into an exact DECIMAL
+				pMap[name] = p
 			case sql.TimestampType:
 				// Rails sends timestamps as "YYYY-MM-DD HH:MM:SS.ffffff".
 				// Must become time.Time so EncodeParams emits a Ts
@@ -165,16 +171,257 @@ func buildNamedParams(paramsType []sql.ColDescriptor, paramsVal []interface{}) (
 				pMap[name] = v
 			case sql.BLOBType:
 				pMap[name] = p
+			case sql.DecimalType:
+				d, err := decodeNumeric(p)
+				if err != nil {
+					return nil, fmt.Errorf("invalid numeric bind value for parameter %s: %w", name, err)
+				}
+				pMap[name] = d
+			case sql.DateType:
+				d, err := decodeDate(p)
+				if err != nil {
+					return nil, fmt.Errorf("invalid date bind value for parameter %s: %w", name, err)
+				}
+				pMap[name] = d
+			case sql.IntervalType:
+				// handed over in the interval text representation
+				iv, err := decodeInterval(p)
+				if err != nil {
+					return nil, fmt.Errorf("invalid interval bind value for parameter %s: %w", name, err)
+				}
+				pMap[name] = iv
 			default:
-				// AnyType: pass raw bytes through; downstream
-				// coercion picks the right value.
-				pMap[name] = p
+				if !sql.IsArrayType(param.Type) {
+					// AnyType: pass raw bytes through; downstream
+					// coercion picks the right value.
+					pMap[name] = p
+					break
+				}
+				// handed over in the array text representation,
+				// the engine converts it to the parameter type
+				a, err := decodeArray(p)
+				if err != nil {
+					return nil, fmt.Errorf("invalid array bind value for parameter %s: %w", name, err)
+				}
+				pMap[name] = a
 			}
 		}
 	}
 	return schema.EncodeParams(pMap)
 }
 
+// decodeNumeric converts a value in PG's binary numeric format into its
+// decimal text representation.
+func decodeNumeric(p []byte) (string, error) {
+	if len(p) < 8 {
+		return "", fmt.Errorf("numeric value too short: %d bytes", len(p))
+	}
+
+	ndigits := int(binary.BigEndian.Uint16(p[0:]))
+	weight := int(int16(binary.BigEndian.Uint16(p[2:])))
+	sign := binary.BigEndian.Uint16(p[4:])
+	dscale := int(binary.BigEndian.Uint16(p[6:]))
+
+	if sign != 0x0000 && sign != 0x4000 {
+		return "", fmt.Errorf("unsupported numeric sign 0x%04x", sign)
+	}
+	if len(p) != 8+2*ndigits {
+		return "", fmt.Errorf("numeric value has %d bytes, expected %d", len(p), 8+2*ndigits)
+	}
+	for i := 0; i < ndigits; i++ {
+		if d := binary.BigEndian.Uint16(p[8+2*i:]); d >= 10000 {
+			return "", fmt.Errorf("invalid numeric digit %d", d)
+		}
+	}
+
+	digitAt := func(pos int) uint16 {
+		i := weight - pos
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return binary.BigEndian.Uint16(p[8+2*i:])
+	}
+
+	var sb strings.Builder
+	if sign == 0x4000 {
+		sb.WriteByte('-')
+	}
+
+	if weight < 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.WriteString(strconv.Itoa(int(digitAt(weight))))
+		for pos := weight - 1; pos >= 0; pos-- {
+			fmt.Fprintf(&sb, "%04d", digitAt(pos))
+		}
+	}
+
+	if dscale > 0 {
+		var frac strings.Builder
+		for pos := -1; frac.Len() < dscale; pos-- {
+			fmt.Fprintf(&frac, "%04d", digitAt(pos))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+
+	return sb.String(), nil
+}
+
+// decodeArray converts a one-dimensional array in PG's binary array
+// format into its text representation e.g. {1,2,NULL}.
+func decodeArray(p []byte) (string, error) {
+	if len(p) < 12 {
+		return "", fmt.Errorf("array value too short: %d bytes", len(p))
+	}
+
+	ndim := binary.BigEndian.Uint32(p)
+	elemOID := binary.BigEndian.Uint32(p[8:])
+	p = p[12:]
+
+	if ndim == 0 {
+		return "{}", nil
+	}
+
+	if ndim != 1 || len(p) < 8 {
+		return "", fmt.Errorf("only one-dimensional arrays are supported")
+	}
+
+	n := int(binary.BigEndian.Uint32(p))
+	p = p[8:]
+
+	// each element takes at least the 4 bytes of its length
+	if n > len(p)/4 {
+		return "", fmt.Errorf("array value too short for %d elements", n)
+	}
+
+	elems := make([]sql.TypedValue, 0, n)
+
+	for i := 0; i < n; i++ {
+		if len(p) < 4 {
+			return "", fmt.Errorf("array value too short")
+		}
+
+		elen := int32(binary.BigEndian.Uint32(p))
+		p = p[4:]
+
+		if elen < 0 {
+			elems = append(elems, sql.NewNull(sql.VarcharType))
+			continue
+		}
+
+		if len(p) < int(elen) {
+			return "", fmt.Errorf("array value too short")
+		}
+
+		e, err := arrayElemText(elemOID, p[:elen])
+		if err != nil {
+			return "", err
+		}
+
+		elems = append(elems, sql.NewVarchar(e))
+		p = p[elen:]
+	}
+
+	return sql.NewArray(sql.VarcharType, elems).String(), nil
+}
+
+// arrayElemText returns the text representation of an array element
+// in binary format, based on the OID of the element type.
+func arrayElemText(oid uint32, p []byte) (string, error) {
+	switch oid {
+	case 20: // int8
+		if len(p) != 8 {
+			return "", fmt.Errorf("invalid int8 array element")
+		}
+		return strconv.FormatInt(int64(binary.BigEndian.Uint64(p)), 10), nil
+	case 23: // int4
+		if len(p) != 4 {
+			return "", fmt.Errorf("invalid int4 array element")
+		}
+		return strconv.FormatInt(int64(int32(binary.BigEndian.Uint32(p))), 10), nil
+	case 21: // int2
+		if len(p) != 2 {
+			return "", fmt.Errorf("invalid int2 array element")
+		}
+		return strconv.FormatInt(int64(int16(binary.BigEndian.Uint16(p))), 10), nil
+	case 16: // bool
+		if len(p) != 1 {
+			return "", fmt.Errorf("invalid bool array element")
+		}
+		if p[0] == 1 {
+			return "t", nil
+		}
+		return "f", nil
+	case 701: // float8
+		if len(p) != 8 {
+			return "", fmt.Errorf("invalid float8 array element")
+		}
+		return strconv.FormatFloat(math.Float64frombits(binary.BigEndian.Uint64(p)), 'g', -1, 64), nil
+	case 700: // float4
+		if len(p) != 4 {
+			return "", fmt.Errorf("invalid float4 array element")
+		}
+		return strconv.FormatFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(p))), 'g', -1, 32), nil
+	case 1700: // numeric
+		return decodeNumeric(p)
+	case 2950: // uuid
+		u, err := uuid.FromBytes(p)
+		if err != nil {
+			return "", err
+		}
+		return u.String(), nil
+	case 1114, 1184: // timestamp, timestamptz
+		if len(p) != 8 {
+			return "", fmt.Errorf("invalid timestamp array element")
+		}
+		t := pgEpoch.Add(time.Duration(int64(binary.BigEndian.Uint64(p))) * time.Microsecond)
+		return t.Format("2006-01-02 15:04:05.999999"), nil
+	case 1082: // date
+		d, err := decodeDate(p)
+		if err != nil {
+			return "", err
+		}
+		return d.Format(time.DateOnly), nil
+	case 1186: // interval
+		return decodeInterval(p)
+	case 17: // bytea
+		return `\x` + hex.EncodeToString(p), nil
+	case 3802: // jsonb
+		if len(p) > 0 && p[0] == 1 {
+			p = p[1:]
+		}
+	}
+	// text, varchar, json and other types sent as text
+	return string(p), nil
+}
+
+var pgEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// decodeDate converts a value in PG's binary date format, the number
+// of days since 2000-01-01, into the corresponding time.
+func decodeDate(p []byte) (time.Time, error) {
+	if len(p) != 4 {
+		return time.Time{}, fmt.Errorf("invalid date length %d", len(p))
+	}
+	return pgEpoch.AddDate(0, 0, int(int32(binary.BigEndian.Uint32(p)))), nil
+}
+
+// decodeInterval converts a value in PG's binary interval format,
+// microseconds followed by days and months, into its text representation.
+func decodeInterval(p []byte) (string, error) {
+	if len(p) != 16 {
+		return "", fmt.Errorf("invalid interval length %d", len(p))
+	}
+
+	iv := sql.NewInterval(
+		int32(binary.BigEndian.Uint32(p[12:])),
+		int32(binary.BigEndian.Uint32(p[8:])),
+		int64(binary.BigEndian.Uint64(p)),
+	)
+	return iv.String(), nil
+}
+
 func getInt64(p []byte) (int64, error) {
 	switch len(p) {
 	case 8:
@@ -216,6 +216,139 @@ func Test_buildNamedParams_FloatText(t *testing.T) {
 	require.Error(t, err)
 }
 
+func pgNumeric(weight int16, negative bool, dscale uint16, digits ...uint16) []byte {
+	buf := make([]byte, 8+2*len(digits))
+	binary.BigEndian.PutUint16(buf[0:], uint16(len(digits)))
+	binary.BigEndian.PutUint16(buf[2:], uint16(weight))
+	if negative {
+		binary.BigEndian.PutUint16(buf[4:], 0x4000)
+	}
+	binary.BigEndian.PutUint16(buf[6:], dscale)
+	for i, d := range digits {
+		binary.BigEndian.PutUint16(buf[8+2*i:], d)
+	}
+	return buf
+}
+
+func Test_decodeNumeric(t *testing.T) {
+	for _, tc := range []struct {
+		in   []byte
+		want string
+	}{
+		{pgNumeric(0, false, 2, 12, 5000), "12.50"},
+		{pgNumeric(-1, true, 3, 10), "-0.001"},
+		{pgNumeric(2, false, 1, 1, 2345, 6789, 5000), "123456789.5"},
+		{pgNumeric(1, false, 0, 1), "10000"},
+		{pgNumeric(0, false, 2), "0.00"},
+	} {
+		t.Run(tc.want, func(t *testing.T) {
+			s, err := decodeNumeric(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, s)
+		})
+	}
+
+	_, err := decodeNumeric([]byte{0, 1})
+	require.Error(t, err)
+
+	nan := pgNumeric(0, false, 0)
+	binary.BigEndian.PutUint16(nan[4:], 0xC000)
+	_, err = decodeNumeric(nan)
+	require.Error(t, err)
+
+	_, err = decodeNumeric(pgNumeric(0, false, 0, 10000))
+	require.Error(t, err)
+
+	cols := []sql.ColDescriptor{{Column: "d", Type: sql.DecimalType}}
+
+	params, err := buildNamedParams(cols, []interface{}{pgNumeric(0, true, 2, 7, 2500)})
+	require.NoError(t, err)
+	require.Equal(t, "-7.25", paramVal(t, params, "d"))
+
+	params, err = buildNamedParams(cols, []interface{}{"19.990"})
+	require.NoError(t, err)
+	require.Equal(t, "19.990", paramVal(t, params, "d"))
+}
+
+func Test_decodeArray(t *testing.T) {
+	pgArray := func(oid uint32, elems ...[]byte) []byte {
+		b := binary.BigEndian.AppendUint32(nil, 1)
+		b = binary.BigEndian.AppendUint32(b, 0)
+		b = binary.BigEndian.AppendUint32(b, oid)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(elems)))
+		b = binary.BigEndian.AppendUint32(b, 1)
+		for _, e := range elems {
+			if e == nil {
+				b = binary.BigEndian.AppendUint32(b, 0xFFFFFFFF)
+				continue
+			}
+			b = binary.BigEndian.AppendUint32(b, uint32(len(e)))
+			b = append(b, e...)
+		}
+		return b
+	}
+
+	s, err := decodeArray(pgArray(20, binary.BigEndian.AppendUint64(nil, 1), nil, binary.BigEndian.AppendUint64(nil, uint64(1<<64-3))))
+	require.NoError(t, err)
+	require.Equal(t, "{1,NULL,-3}", s)
+
+	s, err = decodeArray(pgArray(25, []byte("a b"), []byte("c")))
+	require.NoError(t, err)
+	require.Equal(t, `{"a b",c}`, s)
+
+	s, err = decodeArray([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 20})
+	require.NoError(t, err)
+	require.Equal(t, "{}", s)
+
+	_, err = decodeArray([]byte{0, 0, 0, 1})
+	require.Error(t, err)
+
+	_, err = decodeArray(pgArray(20, []byte{1}))
+	require.Error(t, err)
+
+	// the element count is checked against the bytes holding the elements
+	oversized := pgArray(20, binary.BigEndian.AppendUint64(nil, 1))
+	binary.BigEndian.PutUint32(oversized[12:], 0xFFFFFFFF)
+	_, err = decodeArray(oversized[:len(oversized)-8])
+	require.Error(t, err)
+
+	cols := []sql.ColDescriptor{{Column: "ids", Type: sql.ArrayTypeOf(sql.IntegerType)}}
+
+	params, err := buildNamedParams(cols, []interface{}{pgArray(20, binary.BigEndian.AppendUint64(nil, 4))})
+	require.NoError(t, err)
+	require.Equal(t, "{4}", paramVal(t, params, "ids"))
+}
+
+func Test_decodeDateAndInterval(t *testing.T) {
+	d, err := decodeDate([]byte{0xff, 0xff, 0xff, 0xff})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC), d)
+
+	_, err = decodeDate([]byte{1})
+	require.Error(t, err)
+
+	iv := binary.BigEndian.AppendUint64(nil, uint64(90*time.Minute/time.Microsecond))
+	iv = binary.BigEndian.AppendUint32(iv, 3)
+	iv = binary.BigEndian.AppendUint32(iv, 14)
+
+	s, err := decodeInterval(iv)
+	require.NoError(t, err)
+	require.Equal(t, "1 year 2 mons 3 days 01:30:00", s)
+
+	_, err = decodeInterval(iv[:8])
+	require.Error(t, err)
+
+	cols := []sql.ColDescriptor{
+		{Column: "d", Type: sql.DateType},
+		{Column: "i", Type: sql.IntervalType},
+	}
+
+	params, err := buildNamedParams(cols, []interface{}{binary.BigEndian.AppendUint32(nil, 1), iv})
+	require.NoError(t, err)
+	require.Equal(t, sql.TimeToInt64(time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC)), paramVal(t, params, "d"))
+	require.Equal(t, "1 year 2 mons 3 days 01:30:00", paramVal(t, params, "i"))
+}
+
 func Test_pgTextBool(t *testing.T) {
 	for _, in := range []string{"t", "T", "true", "TRUE", "y", "yes", "on", "1"} {
 		v, ok := pgTextBool(in)
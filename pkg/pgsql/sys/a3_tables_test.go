@@ -152,6 +152,24 @@ func TestPgProc_ContainsCoreFunctions(t *testing.T) {
 	}
 }
 
+// TestPgProc_StoredRoutines asserts functions and procedures created in
+// SQL are listed together with the built-in ones, with their signature and
+// body, so `\df` can show them.
+func TestPgProc_StoredRoutines(t *testing.T) {
+	e := newEngine(t)
+	exec(t, e, `CREATE TABLE accounts (id INTEGER, balance INTEGER, PRIMARY KEY id)`)
+	exec(t, e, `CREATE FUNCTION balance_of(account INTEGER) RETURNS INTEGER AS $$ SELECT balance FROM accounts WHERE id = @account $$`)
+	exec(t, e, `CREATE PROCEDURE open_account(INTEGER) AS $$ INSERT INTO accounts (id, balance) VALUES ($1, 0) $$`)
+
+	rows := query(t, e,
+		`SELECT proname, prokind, prolang, pronargs, prorettype, proargtypes, proargnames, prosrc
+		 FROM pg_proc WHERE prolang = 14`)
+	require.Equal(t, [][]interface{}{
+		{"balance_of", "f", int64(14), int64(1), int64(20), "20", "{account}", " SELECT balance FROM accounts WHERE id = @account "},
+		{"open_account", "p", int64(14), int64(1), int64(0), "20", nil, " INSERT INTO accounts (id, balance) VALUES ($1, 0) "},
+	}, rows)
+}
+
 // TestPgType_HasCoreTypes exercises the expanded pg_type contract:
 // clients (Rails, psql \dT) hardcode OIDs for the 10-or-so types
 // every PG driver knows about. A regression here silently breaks
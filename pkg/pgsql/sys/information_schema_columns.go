@@ -81,9 +81,15 @@ func init() {
 						pkCols[c.Name()] = struct{}{}
 					}
 				}
-				for i, c := range t.Cols() {
+				ordinal := 0
+				for _, c := range t.Cols() {
+					if c.IsHidden() {
+						continue
+					}
+					ordinal++
+
 					_, isPK := pkCols[c.Name()]
-					rows = append(rows, rowInformationSchemaColumn(t.Name(), c, i+1, isPK))
+					rows = append(rows, rowInformationSchemaColumn(t.Name(), c, ordinal, isPK))
 				}
 			}
 			return rows, nil
@@ -91,7 +97,9 @@ func init() {
 	})
 }
 
-func rowInformationSchemaColumn(tableName string, c *sql.Column, ordinal int, isPK bool) *sql.Row {
+func rowInformationSchemaColumn(qualifiedName string, c *sql.Column, ordinal int, isPK bool) *sql.Row {
+	schema, tableName := sql.SplitTableName(qualifiedName)
+
 	nullable := "YES"
 	if !c.IsNullable() || isPK {
 		nullable = "NO"
@@ -116,11 +124,21 @@ func rowInformationSchemaColumn(tableName string, c *sql.Column, ordinal int, is
 	case sql.Float64Type:
 		numericPrecision = sql.NewInteger(53)
 		numericPrecisionRadix = sql.NewInteger(2)
+	case sql.DecimalType:
+		// unconstrained NUMERIC reports NULL precision and scale, as PG does
+		if c.Precision() > 0 {
+			numericPrecision = sql.NewInteger(int64(c.Precision()))
+			numericPrecisionRadix = sql.NewInteger(10)
+			numericScale = sql.NewInteger(int64(c.Scale()))
+		}
 	}
 
 	var datetimePrecision sql.TypedValue = sql.NewNull(sql.IntegerType)
-	if c.Type() == sql.TimestampType {
+	switch c.Type() {
+	case sql.TimestampType, sql.IntervalType:
 		datetimePrecision = sql.NewInteger(6)
+	case sql.DateType:
+		datetimePrecision = sql.NewInteger(0)
 	}
 
 	var columnDefault sql.TypedValue = sql.NewNull(sql.VarcharType)
@@ -131,9 +149,14 @@ func rowInformationSchemaColumn(tableName string, c *sql.Column, ordinal int, is
 		columnDefault = sql.NewVarchar("DEFAULT")
 	}
 
+	isGenerated := "NEVER"
+	if c.IsGenerated() {
+		isGenerated = "ALWAYS"
+	}
+
 	return &sql.Row{ValuesByPosition: []sql.TypedValue{
 		sql.NewVarchar("immudb"),
-		sql.NewVarchar("public"),
+		sql.NewVarchar(schema),
 		sql.NewVarchar(tableName),
 		sql.NewVarchar(c.Name()),
 		sql.NewInteger(int64(ordinal)),
@@ -150,9 +173,9 @@ func rowInformationSchemaColumn(tableName string, c *sql.Column, ordinal int, is
 		sql.NewVarchar("pg_catalog"),
 		sql.NewVarchar(infoSchemaUDTName(c.Type())),
 		sql.NewVarchar("NO"), // is_identity — immudb has AUTO_INCREMENT, not identity
-		sql.NewVarchar("NEVER"),
+		sql.NewVarchar(isGenerated),
 		sql.NewVarchar("YES"),
-		sql.NewInteger(colOID("public", tableName, c.Name())),
+		sql.NewInteger(colOID(schema, tableName, c.Name())),
 	}}
 }
 
@@ -175,15 +198,23 @@ func infoSchemaDataType(t sql.SQLValueType) string {
 		return "bytea"
 	case sql.Float64Type:
 		return "double precision"
+	case sql.DecimalType:
+		return "numeric"
 	case sql.TimestampType:
 		return "timestamp without time zone"
+	case sql.DateType:
+		return "date"
+	case sql.IntervalType:
+		return "interval"
 	case sql.UUIDType:
 		return "uuid"
 	case sql.JSONType:
 		return "jsonb"
-	default:
-		return "text"
 	}
+	if sql.IsArrayType(t) {
+		return "ARRAY"
+	}
+	return "text"
 }
 
 // infoSchemaUDTName is the short PG type name for udt_name / udt_schema
@@ -200,13 +231,22 @@ func infoSchemaUDTName(t sql.SQLValueType) string {
 		return "bytea"
 	case sql.Float64Type:
 		return "float8"
+	case sql.DecimalType:
+		return "numeric"
 	case sql.TimestampType:
 		return "timestamp"
+	case sql.DateType:
+		return "date"
+	case sql.IntervalType:
+		return "interval"
 	case sql.UUIDType:
 		return "uuid"
 	case sql.JSONType:
 		return "jsonb"
-	default:
-		return "text"
 	}
+	if sql.IsArrayType(t) {
+		// array types are named after their element type e.g. _int8
+		return "_" + infoSchemaUDTName(sql.ArrayElemType(t))
+	}
+	return "text"
 }
@@ -56,26 +56,27 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0)
 			for _, t := range tables {
+				schema, name := sql.SplitTableName(t.Name())
 				for _, idx := range t.GetIndexes() {
 					if !idx.IsPrimary() && !idx.IsUnique() {
 						continue
 					}
-					conname := idx.Name()
+					conname := indexRelName(t, idx)
 					if idx.IsPrimary() {
-						conname = t.Name() + "_pkey"
+						conname = name + "_pkey"
 					}
 					for i, c := range idx.Cols() {
 						rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
 							sql.NewVarchar("immudb"),
-							sql.NewVarchar("public"),
+							sql.NewVarchar(schema),
 							sql.NewVarchar(conname),
 							sql.NewVarchar("immudb"),
-							sql.NewVarchar("public"),
-							sql.NewVarchar(t.Name()),
+							sql.NewVarchar(schema),
+							sql.NewVarchar(name),
 							sql.NewVarchar(c.Name()),
 							sql.NewInteger(int64(i + 1)),
 							sql.NewNull(sql.IntegerType),
-							sql.NewInteger(colOID("public", t.Name(), conname+":"+c.Name())),
+							sql.NewInteger(colOID(schema, name, conname+":"+c.Name())),
 						}})
 					}
 				}
@@ -23,9 +23,9 @@ import (
 )
 
 // information_schema.schemata — one row per schema visible to the
-// session. immudb has no user-facing schema concept so the set is
-// fixed: public, pg_catalog, information_schema. Matches pg_namespace
-// (A2) but with the information_schema standard column shape.
+// session: pg_catalog, public, information_schema and the schemas
+// created with CREATE SCHEMA. Matches pg_namespace (A2) but with the
+// information_schema standard column shape.
 //
 // Expanded from pgschema.infoSchemaSchemataResolver (which returned
 // only 'public') so psql's `\dn` via the standard view shows the
@@ -48,11 +48,12 @@ func init() {
 		},
 		PKColumn: "schema_oid",
 		Scan: func(ctx context.Context, tx *sql.SQLTx) ([]*sql.Row, error) {
-			return []*sql.Row{
-				rowInformationSchemaSchema(OIDNamespacePgCatalog, "pg_catalog"),
-				rowInformationSchemaSchema(OIDNamespacePublic, "public"),
-				rowInformationSchemaSchema(OIDNamespaceInformationSchema, "information_schema"),
-			}, nil
+			schemas := schemasOf(tx)
+			rows := make([]*sql.Row, len(schemas))
+			for i, schema := range schemas {
+				rows[i] = rowInformationSchemaSchema(namespaceOID(schema), schema)
+			}
+			return rows, nil
 		},
 	})
 }
@@ -53,30 +53,31 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0)
 			for _, t := range tables {
+				schema, name := sql.SplitTableName(t.Name())
 				for _, idx := range t.GetIndexes() {
 					var conname, ctype string
 					switch {
 					case idx.IsPrimary():
-						conname = t.Name() + "_pkey"
+						conname = name + "_pkey"
 						ctype = "PRIMARY KEY"
 					case idx.IsUnique():
-						conname = idx.Name()
+						conname = indexRelName(t, idx)
 						ctype = "UNIQUE"
 					default:
 						continue
 					}
 					rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
 						sql.NewVarchar("immudb"),
-						sql.NewVarchar("public"),
+						sql.NewVarchar(schema),
 						sql.NewVarchar(conname),
 						sql.NewVarchar("immudb"),
-						sql.NewVarchar("public"),
-						sql.NewVarchar(t.Name()),
+						sql.NewVarchar(schema),
+						sql.NewVarchar(name),
 						sql.NewVarchar(ctype),
 						sql.NewVarchar("NO"),
 						sql.NewVarchar("NO"),
 						sql.NewVarchar("YES"),
-						sql.NewInteger(relOID("information_schema_constraint", conname)),
+						sql.NewInteger(relOID("information_schema_constraint", schema+"."+conname)),
 					}})
 				}
 			}
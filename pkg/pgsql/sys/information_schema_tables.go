@@ -54,12 +54,13 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0, len(tables))
 			for _, t := range tables {
+				schema, name := sql.SplitTableName(t.Name())
 				rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
 					sql.NewVarchar("immudb"),
-					sql.NewVarchar("public"),
-					sql.NewVarchar(t.Name()),
+					sql.NewVarchar(schema),
+					sql.NewVarchar(name),
 					sql.NewVarchar("BASE TABLE"),
-					sql.NewInteger(relOID("public", t.Name())),
+					sql.NewInteger(relOID(schema, name)),
 				}})
 			}
 			return rows, nil
@@ -19,6 +19,9 @@ package sys
 import (
 	"hash/fnv"
 	"math"
+	"strings"
+
+	"github.com/codenotary/immudb/embedded/sql"
 )
 
 // relOID returns a stable synthetic OID for a catalog relation (table,
@@ -46,6 +49,36 @@ func relOID(schema, name string) int64 {
 	return userOIDBase + int64(h.Sum32()%uint32(math.MaxInt32-userOIDBase))
 }
 
+// namespaceOID returns the OID of a schema: PostgreSQL's own OIDs for the
+// system schemas and a synthetic one, hashed like relations, for the schemas
+// created with CREATE SCHEMA.
+func namespaceOID(schema string) int64 {
+	switch schema {
+	case sql.PgCatalogSchema:
+		return OIDNamespacePgCatalog
+	case sql.PublicSchema:
+		return OIDNamespacePublic
+	case sql.InformationSchemaSchema:
+		return OIDNamespaceInformationSchema
+	}
+	return relOID("pg_namespace", schema)
+}
+
+// schemasOf returns the schemas of the database tx runs on, system schemas
+// first.
+func schemasOf(tx *sql.SQLTx) []string {
+	if cat := tx.Catalog(); cat != nil {
+		return cat.GetSchemas()
+	}
+	return []string{sql.PgCatalogSchema, sql.PublicSchema, sql.InformationSchemaSchema}
+}
+
+// indexRelName returns the name of an index of t without the schema of t.
+func indexRelName(t *sql.Table, idx *sql.Index) string {
+	schema, _ := sql.SplitTableName(t.Name())
+	return strings.TrimPrefix(idx.Name(), schema+".")
+}
+
 // colOID returns a stable synthetic OID for a column. Columns don't
 // have their own PG OID in real PostgreSQL — `pg_attribute` keys are
 // (attrelid, attnum) — but several emulated catalogs and functions
@@ -23,7 +23,7 @@ import (
 )
 
 // pg_attribute: one row per column per user table. attrelid links back
-// to pg_class via relOID("public", table.Name()) — the same scheme
+// to pg_class via relOID(schema, table name) — the same scheme
 // used on the pg_class side, so JOINs between them work.
 //
 // attnum is 1-based (matching PG). immudb's Column.ID() happens to be
@@ -71,7 +71,8 @@ func init() {
 			rows := make([]*sql.Row, 0, len(tables)*8)
 
 			for _, t := range tables {
-				relid := relOID("public", t.Name())
+				schema, name := sql.SplitTableName(t.Name())
+				relid := relOID(schema, name)
 
 				// immudb's Column.IsNullable() reports the literal
 				// NOT NULL flag; PostgreSQL additionally treats every
@@ -85,8 +86,12 @@ func init() {
 				}
 
 				for _, col := range t.Cols() {
+					if col.IsHidden() {
+						continue
+					}
+
 					_, isPK := pkCols[col.Name()]
-					rows = append(rows, rowPgAttribute(relid, t.Name(), col, isPK))
+					rows = append(rows, rowPgAttribute(relid, schema, name, col, isPK))
 				}
 			}
 			return rows, nil
@@ -94,7 +99,7 @@ func init() {
 	})
 }
 
-func rowPgAttribute(relid int64, tableName string, col *sql.Column, isPK bool) *sql.Row {
+func rowPgAttribute(relid int64, schema, tableName string, col *sql.Column, isPK bool) *sql.Row {
 	identity := ""
 	if col.IsAutoIncremental() {
 		identity = "d" // generated by default as identity
@@ -124,25 +129,42 @@ func rowPgAttribute(relid int64, tableName string, col *sql.Column, isPK bool) *
 		sql.NewVarchar(attStorageFor(col.Type())),
 		sql.NewVarchar(attAlignFor(col.Type())),
 		sql.NewBool(notNull),
-		sql.NewBool(col.HasDefault()),
+		sql.NewBool(col.HasDefault() || col.IsGenerated()),
 		sql.NewBool(false), // atthasmissing
 		varcharOrNullIfEmpty(identity),
-		sql.NewVarchar(""), // attgenerated — empty string = not generated
+		sql.NewVarchar(AttGeneratedFor(col)),
 		sql.NewBool(false),
 		sql.NewBool(true), // attislocal
 		sql.NewInteger(0), // attinhcount
 		sql.NewInteger(0), // attcollation
-		sql.NewInteger(colOID("public", tableName, col.Name())),
+		sql.NewInteger(colOID(schema, tableName, col.Name())),
 	}}
 }
 
-// attTypmodFor encodes VARCHAR(N)'s N for psql's format_type(). PG's
-// typmod encoding for varchar is `maxlen + 4`; we mirror it so
+// AttGeneratedFor returns pg_attribute.attgenerated for col: 's' for
+// stored generated columns, 'v' for virtual ones and an empty string
+// otherwise.
+func AttGeneratedFor(col *sql.Column) string {
+	switch {
+	case col.IsVirtual():
+		return "v"
+	case col.IsGenerated():
+		return "s"
+	}
+	return ""
+}
+
+// attTypmodFor encodes VARCHAR(N)'s N and NUMERIC(P,S)'s precision and
+// scale for psql's format_type(). PG's typmod encoding is `maxlen + 4`
+// for varchar and `((P << 16) | S) + 4` for numeric; we mirror both so
 // format_type (once wired up) can decode cleanly.
 func attTypmodFor(col *sql.Column) int64 {
 	if col.Type() == sql.VarcharType && col.MaxLen() > 0 {
 		return int64(col.MaxLen()) + 4
 	}
+	if col.Type() == sql.DecimalType && col.Precision() > 0 {
+		return int64(col.Precision())<<16 | int64(col.Scale()) + 4
+	}
 	return -1
 }
 
@@ -153,7 +175,7 @@ func attTypmodFor(col *sql.Column) int64 {
 func attStorageFor(t sql.SQLValueType) string {
 	switch t {
 	case sql.BooleanType, sql.IntegerType, sql.Float64Type,
-		sql.UUIDType, sql.TimestampType:
+		sql.UUIDType, sql.TimestampType, sql.DateType, sql.IntervalType:
 		return "p"
 	default:
 		return "x"
@@ -166,7 +188,8 @@ func attAlignFor(t sql.SQLValueType) string {
 	switch t {
 	case sql.BooleanType:
 		return "c"
-	case sql.IntegerType, sql.Float64Type, sql.TimestampType, sql.UUIDType:
+	case sql.IntegerType, sql.Float64Type, sql.TimestampType, sql.UUIDType,
+		sql.IntervalType:
 		return "d"
 	default:
 		return "i"
@@ -92,9 +92,8 @@ var pgClassColumns = []sql.SystemTableColumn{
 // us enumerate them from inside a Scan without a more invasive change
 // (deferred to a later phase).
 //
-// Every user object is reported under the 'public' namespace because
-// immudb has no schema concept; that's what psql's \d expects as a
-// default and it matches the handlePgTablesQuery canned handler.
+// Every user object is reported under the namespace of its schema,
+// 'public' unless it was created in a schema of its own.
 func init() {
 	sql.RegisterSystemTable(&sql.SystemTableDef{
 		Name:     "pg_class",
@@ -124,10 +123,11 @@ func init() {
 
 func rowPgClassForTable(t *sql.Table) *sql.Row {
 	cols := t.Cols()
+	schema, name := sql.SplitTableName(t.Name())
 	return &sql.Row{ValuesByPosition: []sql.TypedValue{
-		sql.NewInteger(relOID("public", t.Name())),
-		sql.NewVarchar(t.Name()),
-		sql.NewInteger(OIDNamespacePublic),
+		sql.NewInteger(relOID(schema, name)),
+		sql.NewVarchar(name),
+		sql.NewInteger(namespaceOID(schema)),
 		sql.NewInteger(0), // reltype — pg_type composite row; we don't synthesise these
 		sql.NewInteger(0), // reloftype
 		sql.NewInteger(10),
@@ -147,9 +147,9 @@ func rowPgClassForTable(t *sql.Table) *sql.Row {
 		sql.NewBool(false),
 		sql.NewBool(false),
 		sql.NewBool(false),
+		sql.NewBool(len(t.GetPolicies()) > 0), // relrowsecurity
 		sql.NewBool(false),
-		sql.NewBool(false),
-		sql.NewBool(true),  // relispopulated — user tables always are
+		sql.NewBool(true), // relispopulated — user tables always are
 		sql.NewVarchar("d"),
 		sql.NewBool(false),
 		sql.NewBool(false),
@@ -160,11 +160,12 @@ func rowPgClassForIndex(t *sql.Table, idx *sql.Index) *sql.Row {
 	// Index OID hashes on (table.name, index.name) so renaming either
 	// side changes it — acceptable since clients don't cache index oids
 	// across migrations.
-	indexRelName := idx.Name()
+	schema, _ := sql.SplitTableName(t.Name())
+	relName := indexRelName(t, idx)
 	return &sql.Row{ValuesByPosition: []sql.TypedValue{
-		sql.NewInteger(relOID("public", indexRelName)),
-		sql.NewVarchar(indexRelName),
-		sql.NewInteger(OIDNamespacePublic),
+		sql.NewInteger(relOID(schema, relName)),
+		sql.NewVarchar(relName),
+		sql.NewInteger(namespaceOID(schema)),
 		sql.NewInteger(0),
 		sql.NewInteger(0),
 		sql.NewInteger(10),
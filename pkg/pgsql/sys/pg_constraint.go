@@ -76,15 +76,17 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0, len(tables)*2)
 			for _, t := range tables {
-				tableOID := relOID("public", t.Name())
+				schema, name := sql.SplitTableName(t.Name())
+				tableOID := relOID(schema, name)
 				for _, idx := range t.GetIndexes() {
+					indexOID := relOID(schema, indexRelName(t, idx))
 					switch {
 					case idx.IsPrimary():
 						rows = append(rows, rowConstraint(
-							t.Name()+"_pkey", "p", tableOID, idx, relOID("public", idx.Name())))
+							schema, name+"_pkey", "p", tableOID, idx, indexOID))
 					case idx.IsUnique():
 						rows = append(rows, rowConstraint(
-							idx.Name(), "u", tableOID, idx, relOID("public", idx.Name())))
+							schema, indexRelName(t, idx), "u", tableOID, idx, indexOID))
 					}
 				}
 			}
@@ -93,7 +95,7 @@ func init() {
 	})
 }
 
-func rowConstraint(conname, contype string, tableOID int64, idx *sql.Index, conindid int64) *sql.Row {
+func rowConstraint(schema, conname, contype string, tableOID int64, idx *sql.Index, conindid int64) *sql.Row {
 	cols := idx.Cols()
 	parts := make([]string, len(cols))
 	for i, c := range cols {
@@ -101,10 +103,16 @@ func rowConstraint(conname, contype string, tableOID int64, idx *sql.Index, coni
 	}
 	conkey := "{" + strings.Join(parts, ",") + "}"
 
+	// constraint names are unique within their schema only
+	oidName := conname
+	if schema != sql.PublicSchema {
+		oidName = schema + "." + conname
+	}
+
 	return &sql.Row{ValuesByPosition: []sql.TypedValue{
-		sql.NewInteger(relOID("pg_constraint", conname)),
+		sql.NewInteger(relOID("pg_constraint", oidName)),
 		sql.NewVarchar(conname),
-		sql.NewInteger(OIDNamespacePublic),
+		sql.NewInteger(namespaceOID(schema)),
 		sql.NewVarchar(contype),
 		sql.NewBool(false), // condeferrable
 		sql.NewBool(false), // condeferred
@@ -68,7 +68,8 @@ func init() {
 			rows := make([]*sql.Row, 0, len(tables)*2)
 
 			for _, t := range tables {
-				tableOID := relOID("public", t.Name())
+				schema, name := sql.SplitTableName(t.Name())
+				tableOID := relOID(schema, name)
 				for _, idx := range t.GetIndexes() {
 					rows = append(rows, rowPgIndex(tableOID, t, idx))
 				}
@@ -80,6 +81,7 @@ func init() {
 
 func rowPgIndex(tableOID int64, t *sql.Table, idx *sql.Index) *sql.Row {
 	cols := idx.Cols()
+	schema, _ := sql.SplitTableName(t.Name())
 
 	// Build indkey: space-separated attnum list.
 	parts := make([]string, len(cols))
@@ -98,7 +100,7 @@ func rowPgIndex(tableOID int64, t *sql.Table, idx *sql.Index) *sql.Row {
 	zerosJoined := strings.Join(zeros, " ")
 
 	return &sql.Row{ValuesByPosition: []sql.TypedValue{
-		sql.NewInteger(relOID("public", idx.Name())),
+		sql.NewInteger(relOID(schema, indexRelName(t, idx))),
 		sql.NewInteger(tableOID),
 		sql.NewInteger(int64(len(cols))),
 		sql.NewInteger(int64(len(cols))), // indnkeyatts — we have no INCLUDE cols
@@ -56,15 +56,16 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0, len(tables))
 			for _, t := range tables {
+				schema, name := sql.SplitTableName(t.Name())
 				for _, idx := range t.GetIndexes() {
-					indexName := fmt.Sprintf("%s_idx_%d", t.Name(), idx.ID())
+					indexName := fmt.Sprintf("%s_idx_%d", name, idx.ID())
 					rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
-						sql.NewVarchar("public"),
-						sql.NewVarchar(t.Name()),
+						sql.NewVarchar(schema),
+						sql.NewVarchar(name),
 						sql.NewVarchar(indexName),
 						sql.NewVarchar("pg_default"),
 						sql.NewVarchar(pgIndexesIndexDef(t.Name(), idx)),
-						sql.NewInteger(relOID("pg_indexes", indexName)),
+						sql.NewInteger(relOID("pg_indexes", fmt.Sprintf("%s_idx_%d", t.Name(), idx.ID()))),
 					}})
 				}
 			}
@@ -22,10 +22,9 @@ import (
 	"github.com/codenotary/immudb/embedded/sql"
 )
 
-// pg_namespace has three synthetic rows — immudb has no user-facing
-// schema concept (all user tables are conceptually in "public") so the
-// set is fixed. We emit the same three PG ships with, using PG's own
-// OIDs so cached-oid clients don't see drift.
+// pg_namespace has one row per schema: the three PG ships with, using
+// PG's own OIDs so cached-oid clients don't see drift, followed by the
+// schemas created with CREATE SCHEMA.
 //
 // The nspowner/nspacl columns are stubs; any query that filters on
 // them would get no results, which is harmless because no client
@@ -41,11 +40,12 @@ func init() {
 		},
 		PKColumn: "oid",
 		Scan: func(ctx context.Context, tx *sql.SQLTx) ([]*sql.Row, error) {
-			return []*sql.Row{
-				rowNamespace(OIDNamespacePgCatalog, "pg_catalog"),
-				rowNamespace(OIDNamespacePublic, "public"),
-				rowNamespace(OIDNamespaceInformationSchema, "information_schema"),
-			}, nil
+			schemas := schemasOf(tx)
+			rows := make([]*sql.Row, len(schemas))
+			for i, schema := range schemas {
+				rows[i] = rowNamespace(namespaceOID(schema), schema)
+			}
+			return rows, nil
 		},
 	})
 }
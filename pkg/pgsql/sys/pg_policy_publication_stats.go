@@ -22,17 +22,16 @@ import (
 	"github.com/codenotary/immudb/embedded/sql"
 )
 
-// Three empty-stub tables that psql's `\d <table>` tail-section
-// probes:
+// Three tables that psql's `\d <table>` tail-section probes:
 //
-//	pg_policy        — row-level-security policies (PG ≥ 9.5).
-//	                   immudb has no RLS, table is empty.
+//	pg_policy        — row-level-security policies (PG ≥ 9.5), one
+//	                   row per policy created with CREATE POLICY.
 //	pg_publication   — logical-replication publications (PG ≥ 10).
 //	                   immudb has no logical replication here.
 //	pg_statistic_ext — extended statistics (PG ≥ 10). immudb's
 //	                   planner has no extended-stats object model.
 //
-// Each is registered non-empty-schema but Scan returns nil. The
+// The last two are registered non-empty-schema but Scan returns nil. The
 // registration matters because without it psql's tail queries fall
 // to the canned pgAdminProbe handler, which invents canned rows
 // and renders junk like "Policies: POLICY ''" / "Publications: ''"
@@ -60,7 +59,26 @@ func init() {
 		},
 		PKColumn: "oid",
 		Scan: func(ctx context.Context, tx *sql.SQLTx) ([]*sql.Row, error) {
-			return nil, nil
+			cat := tx.Catalog()
+			if cat == nil {
+				return nil, nil
+			}
+			var rows []*sql.Row
+			for _, t := range cat.GetTables() {
+				for _, p := range t.GetPolicies() {
+					rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
+						sql.NewInteger(relOID("pg_policy", t.Name()+"."+p.Name())),
+						sql.NewVarchar(p.Name()),
+						sql.NewInteger(relOID(sql.SplitTableName(t.Name()))),
+						sql.NewVarchar(policyCmd(p.Command())),
+						sql.NewBool(true),     // polpermissive
+						sql.NewVarchar("{0}"), // PUBLIC
+						sql.NewVarchar(p.Expression()),
+						sql.NewNull(sql.VarcharType),
+					}})
+				}
+			}
+			return rows, nil
 		},
 	})
 
@@ -101,3 +119,16 @@ func init() {
 		},
 	})
 }
+
+// policyCmd maps the command of a policy to PG's polcmd code.
+func policyCmd(cmd sql.SQLPrivilege) string {
+	switch cmd {
+	case sql.SQLPrivilegeSelect:
+		return "r"
+	case sql.SQLPrivilegeUpdate:
+		return "w"
+	case sql.SQLPrivilegeDelete:
+		return "d"
+	}
+	return "*"
+}
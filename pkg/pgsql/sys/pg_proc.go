@@ -19,12 +19,15 @@ package sys
 import (
 	"context"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 )
 
-// pg_proc: one row per registered built-in function.
+// pg_proc: one row per registered built-in function, followed by one row
+// per function and procedure created with CREATE FUNCTION and
+// CREATE PROCEDURE.
 //
 // The Scan walks sql.RegisteredFunctions() (exported in A3 alongside
 // this table) and emits a row per name with a stable FNV-hashed oid.
@@ -34,6 +37,9 @@ import (
 // about signatures read pg_type via the `proargtypes` vector and
 // mostly just want the row to *exist* so `\df` can list the name.
 //
+// Stored routines are defined in SQL, so their rows do carry their
+// signature and body.
+//
 // Row order is sorted by name for test stability.
 func init() {
 	sql.RegisterSystemTable(&sql.SystemTableDef{
@@ -58,13 +64,13 @@ func init() {
 			{Name: "pronargs", Type: sql.IntegerType},
 			{Name: "pronargdefaults", Type: sql.IntegerType},
 			{Name: "prorettype", Type: sql.IntegerType},
-			{Name: "proargtypes", Type: sql.VarcharType, MaxLen: 1},
+			{Name: "proargtypes", Type: sql.VarcharType, MaxLen: 256},
 			{Name: "proallargtypes", Type: sql.VarcharType, MaxLen: 1},
 			{Name: "proargmodes", Type: sql.VarcharType, MaxLen: 1},
-			{Name: "proargnames", Type: sql.VarcharType, MaxLen: 1},
+			{Name: "proargnames", Type: sql.VarcharType, MaxLen: 256},
 			{Name: "proargdefaults", Type: sql.VarcharType, MaxLen: 1},
 			{Name: "protrftypes", Type: sql.VarcharType, MaxLen: 1},
-			{Name: "prosrc", Type: sql.VarcharType, MaxLen: 128},
+			{Name: "prosrc", Type: sql.VarcharType, MaxLen: 4096},
 			{Name: "probin", Type: sql.VarcharType, MaxLen: 1},
 			{Name: "proconfig", Type: sql.VarcharType, MaxLen: 1},
 			{Name: "proacl", Type: sql.VarcharType, MaxLen: 1},
@@ -82,6 +88,12 @@ func init() {
 			for _, n := range names {
 				rows = append(rows, rowProc(n))
 			}
+
+			if cat := tx.Catalog(); cat != nil {
+				for _, r := range cat.GetRoutines() {
+					rows = append(rows, rowRoutine(r))
+				}
+			}
 			return rows, nil
 		},
 	})
@@ -121,3 +133,60 @@ func rowProc(name string) *sql.Row {
 		sql.NewNull(sql.VarcharType),
 	}}
 }
+
+func rowRoutine(r *sql.Routine) *sql.Row {
+	prokind := "f"
+	var rettype int64
+	if r.Kind() == sql.RoutineProcedure {
+		prokind = "p"
+	} else {
+		rettype = pgTypeOIDForSQLType(r.ReturnType())
+	}
+
+	argTypes := r.ArgTypes()
+
+	oids := make([]string, len(argTypes))
+	for i, t := range argTypes {
+		oids[i] = strconv.FormatInt(pgTypeOIDForSQLType(t), 10)
+	}
+
+	var argNames sql.TypedValue = sql.NewNull(sql.VarcharType)
+	for _, n := range r.ArgNames() {
+		if n != "" {
+			argNames = sql.NewVarchar("{" + strings.Join(r.ArgNames(), ",") + "}")
+			break
+		}
+	}
+
+	return &sql.Row{ValuesByPosition: []sql.TypedValue{
+		sql.NewInteger(relOID("public_proc", r.Name())),
+		sql.NewVarchar(r.Name()),
+		sql.NewInteger(OIDNamespacePublic),
+		sql.NewInteger(10),
+		sql.NewInteger(14), // prolang 14 = sql
+		sql.NewFloat64(100),
+		sql.NewFloat64(0),
+		sql.NewInteger(0),
+		sql.NewInteger(0),
+		sql.NewVarchar(prokind),
+		sql.NewBool(false),
+		sql.NewBool(false),
+		sql.NewBool(false),
+		sql.NewBool(false),
+		sql.NewVarchar("v"), // provolatile 'v' = volatile, routines read tables
+		sql.NewVarchar("u"), // proparallel 'u' = unsafe
+		sql.NewInteger(int64(len(argTypes))),
+		sql.NewInteger(0),
+		sql.NewInteger(rettype),
+		sql.NewVarchar(strings.Join(oids, " ")),
+		sql.NewNull(sql.VarcharType),
+		sql.NewNull(sql.VarcharType),
+		argNames,
+		sql.NewNull(sql.VarcharType),
+		sql.NewNull(sql.VarcharType),
+		sql.NewVarchar(r.Body()),
+		sql.NewNull(sql.VarcharType),
+		sql.NewNull(sql.VarcharType),
+		sql.NewNull(sql.VarcharType),
+	}}
+}
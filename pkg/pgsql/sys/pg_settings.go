@@ -18,6 +18,7 @@ package sys
 
 import (
 	"context"
+	"strings"
 
 	"github.com/codenotary/immudb/embedded/sql"
 	"github.com/codenotary/immudb/pkg/pgsql/server/pgmeta"
@@ -58,7 +59,12 @@ func init() {
 		Scan: func(ctx context.Context, tx *sql.SQLTx) ([]*sql.Row, error) {
 			rows := make([]*sql.Row, 0, len(pgSettingsRows))
 			for _, r := range pgSettingsRows {
-				rows = append(rows, rowSetting(r.name, r.value, r.category, r.desc))
+				value := r.value
+				// search_path is the only per-session setting
+				if r.name == "search_path" && tx.Catalog() != nil {
+					value = strings.Join(tx.Catalog().SearchPath(), ", ")
+				}
+				rows = append(rows, rowSetting(r.name, value, r.category, r.desc))
 			}
 			return rows, nil
 		},
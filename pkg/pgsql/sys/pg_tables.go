@@ -27,9 +27,9 @@ import (
 //
 // Column shape and values match the legacy handlePgTablesQuery canned
 // handler in pgadmin_compat.go so no ORM that used to work regresses.
-// The key columns clients filter on are schemaname (always 'public'
-// in immudb's single-schema model) and tablename (for existence
-// probes).
+// The key columns clients filter on are schemaname ('public' unless
+// the table was created in a schema of its own) and tablename (for
+// existence probes).
 //
 // hasindexes reflects whether the table has any non-PK index —
 // matching what PG reports. Other booleans are always false: immudb
@@ -59,21 +59,22 @@ func init() {
 			tables := cat.GetTables()
 			rows := make([]*sql.Row, 0, len(tables))
 			for _, t := range tables {
+				schema, name := sql.SplitTableName(t.Name())
 				// Real PG pg_tables.hasindexes counts any index; immudb
 				// creates the PK index implicitly on every table so
 				// len(idxs) > 0 is always true for base tables. Leave
 				// the literal check in place in case some future table
 				// shape skips the implicit PK.
 				rows = append(rows, &sql.Row{ValuesByPosition: []sql.TypedValue{
-					sql.NewVarchar("public"),
-					sql.NewVarchar(t.Name()),
+					sql.NewVarchar(schema),
+					sql.NewVarchar(name),
 					sql.NewVarchar("immudb"),
 					sql.NewNull(sql.VarcharType),
 					sql.NewBool(len(t.GetIndexes()) > 0),
 					sql.NewBool(false),
 					sql.NewBool(false),
-					sql.NewBool(false),
-					sql.NewInteger(relOID("public", t.Name())),
+					sql.NewBool(len(t.GetPolicies()) > 0),
+					sql.NewInteger(relOID(schema, name)),
 				}})
 			}
 			return rows, nil
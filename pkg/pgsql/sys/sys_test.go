@@ -121,6 +121,25 @@ func TestPgClass_ReflectsUserTables(t *testing.T) {
 	require.Len(t, idxRows, 2)
 }
 
+// TestPgPolicy_ReflectsPolicies verifies that row-level security
+// policies show up in pg_policy and flag their table in pg_class.
+func TestPgPolicy_ReflectsPolicies(t *testing.T) {
+	e := newEngine(t)
+
+	exec(t, e, `CREATE TABLE docs (id INTEGER AUTO_INCREMENT, owner VARCHAR, PRIMARY KEY id)`)
+	exec(t, e, `CREATE POLICY own_docs ON docs FOR SELECT USING (owner = CURRENT_USER())`)
+	exec(t, e, `CREATE POLICY all_docs ON docs USING (id > 0)`)
+
+	rows := query(t, e, `SELECT polname, polcmd, polqual FROM pg_policy ORDER BY polname`)
+	require.Equal(t, [][]interface{}{
+		{"all_docs", "*", "(id > 0)"},
+		{"own_docs", "r", "(owner = current_user())"},
+	}, rows)
+
+	rows = query(t, e, `SELECT relrowsecurity FROM pg_class WHERE relname = 'docs'`)
+	require.Equal(t, [][]interface{}{{true}}, rows)
+}
+
 // TestPgAttribute_ColumnMetadata is the pg_attribute half of psql's \d
 // query. For each user column we assert attname, attnum (1-based),
 // attnotnull, and atttypid (PG type OID).
@@ -40,15 +40,39 @@ func pgTypeOIDForSQLType(t sql.SQLValueType) int64 {
 		return 17 // bytea
 	case sql.Float64Type:
 		return 701 // float8
+	case sql.DecimalType:
+		return 1700 // numeric
 	case sql.TimestampType:
 		return 1114 // timestamp (no tz)
+	case sql.DateType:
+		return 1082 // date
+	case sql.IntervalType:
+		return 1186 // interval
 	case sql.JSONType:
 		return 3802 // jsonb
 	case sql.AnyType:
 		return 2276 // "any" — matches PG's polymorphic pseudo-type
-	default:
-		return 0 // unknown — clients will use text fallback
 	}
+	if sql.IsArrayType(t) {
+		return pgArrayTypeOIDs[sql.ArrayElemType(t)]
+	}
+	return 0 // unknown — clients will use text fallback
+}
+
+// pgArrayTypeOIDs maps the element type of an array to the OID of the
+// PostgreSQL array type, following pgTypeOIDForSQLType.
+var pgArrayTypeOIDs = map[sql.SQLValueType]int64{
+	sql.IntegerType:   1016, // int8[]
+	sql.BooleanType:   1000, // bool[]
+	sql.VarcharType:   1015, // varchar[]
+	sql.UUIDType:      2951, // uuid[]
+	sql.BLOBType:      1001, // bytea[]
+	sql.Float64Type:   1022, // float8[]
+	sql.DecimalType:   1231, // numeric[]
+	sql.TimestampType: 1115, // timestamp[]
+	sql.DateType:      1182, // date[]
+	sql.IntervalType:  1187, // interval[]
+	sql.JSONType:      3807, // jsonb[]
 }
 
 // pgTypeNameForSQLType returns the PostgreSQL type name for a given
@@ -76,15 +100,23 @@ func pgTypeNameForSQLType(t sql.SQLValueType, maxLen int) string {
 		return "bytea"
 	case sql.Float64Type:
 		return "double precision"
+	case sql.DecimalType:
+		return "numeric"
 	case sql.TimestampType:
 		return "timestamp without time zone"
+	case sql.DateType:
+		return "date"
+	case sql.IntervalType:
+		return "interval"
 	case sql.JSONType:
 		return "jsonb"
 	case sql.AnyType:
 		return "any"
-	default:
-		return "text" // conservative fallback — psql still renders something
 	}
+	if sql.IsArrayType(t) {
+		return pgTypeNameForSQLType(sql.ArrayElemType(t), maxLen) + "[]"
+	}
+	return "text" // conservative fallback — psql still renders something
 }
 
 // pgTypeLenForSQLType returns the PostgreSQL attlen value for
@@ -102,6 +134,10 @@ func pgTypeLenForSQLType(t sql.SQLValueType) int64 {
 		return 16
 	case sql.TimestampType:
 		return 8
+	case sql.DateType:
+		return 4
+	case sql.IntervalType:
+		return 16
 	// VARCHAR, BLOB, JSON, ANY — variable-width
 	default:
 		return -1
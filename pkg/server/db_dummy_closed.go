@@ -154,6 +154,10 @@ func (db *closedDB) NewSQLTx(ctx context.Context, _ *sql.TxOptions) (*sql.SQLTx,
 	return nil, store.ErrAlreadyClosed
 }
 
+func (db *closedDB) SQLPrepare(ctx context.Context, sql string) (stmts []sql.SQLStmt, release func(), err error) {
+	return nil, nil, store.ErrAlreadyClosed
+}
+
 func (db *closedDB) SQLExec(ctx context.Context, tx *sql.SQLTx, req *schema.SQLExecRequest) (ntx *sql.SQLTx, ctxs []*sql.SQLTx, err error) {
 	return nil, nil, store.ErrAlreadyClosed
 }
@@ -323,12 +323,14 @@ func init() {
 	Metrics.clientIPs = make(map[string]struct{})
 	Metrics.clientIPCap.Store(DefaultClientIPMetricsCap)
 
-	// Q3: wire embedded/sql catalog cache hooks into Prometheus counters.
+	// Q3: wire embedded/sql catalog and statement cache hooks into Prometheus counters.
 	// The hooks are no-op by default; replacing them here means any binary
 	// linking pkg/server gets cache observability for free, while embedded
 	// users (sql-only) avoid the Prometheus dependency.
 	sql.CatalogCacheHitObserver = func() { CatalogCacheTotal.WithLabelValues("hit").Inc() }
 	sql.CatalogCacheMissObserver = func() { CatalogCacheTotal.WithLabelValues("miss").Inc() }
+	sql.StmtCacheHitObserver = func() { StmtCacheTotal.WithLabelValues("hit").Inc() }
+	sql.StmtCacheMissObserver = func() { StmtCacheTotal.WithLabelValues("miss").Inc() }
 }
 
 // Q3 metrics: per-op latency histograms and cache observability. The {op}
@@ -369,6 +371,17 @@ var (
 		[]string{"result"},
 	)
 
+	// StmtCacheTotal counts statement-cache hits and misses on the preparation of
+	// SQL statements, shared by gRPC, pkg/stdlib and the pgsql server.
+	StmtCacheTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "stmt_cache_total",
+			Help:      "Statement parse cache lookups by result (hit/miss). Hit ratio = hit / (hit+miss).",
+		},
+		[]string{"result"},
+	)
+
 	// IndexSeekLatencySeconds is reserved for future instrumentation of
 	// the index seek hot path in embedded/sql + embedded/store. Histogram
 	// definition lives here so the metric exists from binary start; the
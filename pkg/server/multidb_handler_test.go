@@ -61,4 +61,8 @@ func TestServerMultidbHandler(t *testing.T) {
 
 	err = multidbHandler.AlterUser(context.Background(), "user1", "user1Password!", "READWRITE")
 	require.Error(t, err)
+
+	// statements run without a logged user are denied
+	_, err = multidbHandler.GetLoggedUser(context.Background())
+	require.ErrorIs(t, err, ErrNotLoggedIn)
 }
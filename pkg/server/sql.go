@@ -98,11 +98,16 @@ func (s *ImmuServer) SQLExec(ctx context.Context, req *schema.SQLExecRequest) (*
 			firstPKs[k] = &schema.SQLValue{Value: &schema.SQLValue_N{N: n}}
 		}
 
+		merged := ctx.MergedRows()
+
 		res.Txs[i] = &schema.CommittedSQLTx{
-			Header:           schema.TxHeaderToProto(ctx.TxHeader()),
-			UpdatedRows:      uint32(ctx.UpdatedRows()),
-			LastInsertedPKs:  lastPKs,
-			FirstInsertedPKs: firstPKs,
+			Header:            schema.TxHeaderToProto(ctx.TxHeader()),
+			UpdatedRows:       uint32(ctx.UpdatedRows()),
+			LastInsertedPKs:   lastPKs,
+			FirstInsertedPKs:  firstPKs,
+			MergeInsertedRows: uint32(merged.Inserted),
+			MergeUpdatedRows:  uint32(merged.Updated),
+			MergeDeletedRows:  uint32(merged.Deleted),
 		}
 	}
 
@@ -104,6 +104,20 @@ func TestSQLInteraction(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, nRows, 3)
 
+	var plan []string
+	err = s.SQLQuery(&schema.SQLQueryRequest{Sql: "EXPLAIN ANALYZE SELECT * FROM table1"}, &ImmuService_SQLQueryServerMock{
+		ctx: ctx,
+		sendFunc: func(sr *schema.SQLQueryResult) error {
+			for _, row := range sr.Rows {
+				plan = append(plan, row.Values[0].GetS())
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, plan)
+	require.Contains(t, plan[len(plan)-1], "-> Seq Scan on table1 (rows_in=3 rows_out=3 ")
+
 	e, err := s.VerifiableSQLGet(ctx, &schema.VerifiableSQLGetRequest{
 		SqlGetRequest: &schema.SQLGetRequest{Table: "table1", PkValues: []*schema.SQLValue{{Value: &schema.SQLValue_N{N: 1}}}},
 		ProveSinceTx:  0,
@@ -151,6 +165,21 @@ func TestSQLExecResult(t *testing.T) {
 	require.Len(t, xres.Txs, 1)
 	require.Equal(t, map[string]*schema.SQLValue{"table2": {Value: &schema.SQLValue_N{N: 1}}}, xres.FirstInsertedPks())
 	require.Equal(t, map[string]*schema.SQLValue{"table2": {Value: &schema.SQLValue_N{N: 3}}}, xres.LastInsertedPk())
+
+	xres, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: "CREATE TABLE table3 (id INTEGER, name VARCHAR, PRIMARY KEY id)"})
+	require.NoError(t, err)
+
+	xres, err = s.SQLExec(ctx, &schema.SQLExecRequest{Sql: `
+		INSERT INTO table3 (id, name) VALUES (2, 'SECOND'), (4, 'fourth');
+		MERGE INTO table2 USING table3 ON table2.id = table3.id
+			WHEN MATCHED THEN UPDATE SET name = table3.name
+			WHEN NOT MATCHED THEN INSERT (id, name) VALUES (table3.id, table3.name);
+	`})
+	require.NoError(t, err)
+	require.Len(t, xres.Txs, 1)
+	require.Equal(t, uint32(1), xres.Txs[0].MergeInsertedRows)
+	require.Equal(t, uint32(1), xres.Txs[0].MergeUpdatedRows)
+	require.Zero(t, xres.Txs[0].MergeDeletedRows)
 }
 
 func TestSQLExecCreateDatabase(t *testing.T) {
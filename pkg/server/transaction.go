@@ -89,10 +89,15 @@ func (s *ImmuServer) Commit(ctx context.Context, _ *empty.Empty) (*schema.Commit
 		lastPKs[k] = &schema.SQLValue{Value: &schema.SQLValue_N{N: n}}
 	}
 
+	merged := cTx.MergedRows()
+
 	return &schema.CommittedSQLTx{
-		Header:          schema.TxHeaderToProto(cTx.TxHeader()),
-		UpdatedRows:     uint32(cTx.UpdatedRows()),
-		LastInsertedPKs: lastPKs,
+		Header:            schema.TxHeaderToProto(cTx.TxHeader()),
+		UpdatedRows:       uint32(cTx.UpdatedRows()),
+		LastInsertedPKs:   lastPKs,
+		MergeInsertedRows: uint32(merged.Inserted),
+		MergeUpdatedRows:  uint32(merged.Updated),
+		MergeDeletedRows:  uint32(merged.Deleted),
 	}, nil
 }
 
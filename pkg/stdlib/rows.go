@@ -84,7 +84,7 @@ func (r *Rows) ColumnTypeLength(index int) (int64, bool) {
 		return 1, false
 	case sql.BLOBType:
 		return math.MaxInt64, true
-	case sql.TimestampType:
+	case sql.TimestampType, sql.DateType:
 		return math.MaxInt64, true
 	default:
 		return math.MaxInt64, true
@@ -114,7 +114,7 @@ func (r *Rows) ColumnTypeScanType(index int) reflect.Type {
 		return reflect.TypeOf(true)
 	case sql.BLOBType:
 		return reflect.TypeOf([]byte{})
-	case sql.TimestampType:
+	case sql.TimestampType, sql.DateType:
 		return reflect.TypeOf(time.Time{})
 	default:
 		return reflect.TypeOf("")
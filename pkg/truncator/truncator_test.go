@@ -263,3 +263,90 @@ func TestTruncator_with_nothing_to_truncate(t *testing.T) {
 	err = tr.Stop()
 	require.NoError(t, err)
 }
+
+func TestTruncator_with_detached_partition(t *testing.T) {
+	options := database.DefaultOptions().WithDBRootPath(t.TempDir())
+
+	so := options.GetStoreOptions()
+
+	so.WithIndexOptions(so.IndexOpts.WithCompactionThld(2)).
+		WithEmbeddedValues(false).
+		WithFileSize(64).
+		WithVLogCacheSize(0)
+	options.WithStoreOptions(so)
+
+	ctx := context.Background()
+
+	db := makeDbWith(t, "db", options)
+
+	exec := func(sql string) uint64 {
+		_, ctxs, err := db.SQLExec(ctx, nil, &schema.SQLExecRequest{Sql: sql})
+		require.NoError(t, err)
+		return ctxs[len(ctxs)-1].TxHeader().ID
+	}
+
+	exec(`
+		CREATE TABLE events (id INTEGER, day INTEGER, payload VARCHAR, PRIMARY KEY (day, id)) PARTITION BY RANGE (day);
+		CREATE TABLE events_old PARTITION OF events FOR VALUES FROM (0) TO (100);
+		CREATE TABLE events_new PARTITION OF events FOR VALUES FROM (100) TO (200);
+	`)
+
+	firstOldTx := exec("INSERT INTO events (id, day, payload) VALUES (1, 1, 'old')")
+
+	for i := 2; i <= 10; i++ {
+		exec(fmt.Sprintf("INSERT INTO events (id, day, payload) VALUES (%d, %d, 'old')", i, i))
+	}
+
+	// the rows of the old partition are no longer reachable once it is
+	// detached and dropped, so the values written up to now can be reclaimed
+	exec("ALTER TABLE events DETACH PARTITION events_old")
+	exec("DROP TABLE events_old")
+
+	state, err := db.CurrentState()
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		exec(fmt.Sprintf("INSERT INTO events (id, day, payload) VALUES (%d, %d, 'new')", i, 100+i))
+	}
+
+	c := database.NewVlogTruncator(db, logger.NewMemoryLogger())
+
+	err = c.TruncateUptoTx(ctx, state.TxId)
+	require.NoError(t, err)
+
+	// the catalog copied by the truncation records the truncation point
+	lastState, err := db.CurrentState()
+	require.NoError(t, err)
+
+	lastTx, err := db.TxByID(ctx, &schema.TxRequest{Tx: lastState.TxId})
+	require.NoError(t, err)
+	require.Equal(t, state.TxId, lastTx.Header.Metadata.GetTruncatedTxID())
+
+	// the values of the detached partition were reclaimed
+	_, err = db.TxByID(ctx, &schema.TxRequest{
+		Tx: firstOldTx,
+		EntriesSpec: &schema.EntriesSpec{
+			SqlEntriesSpec: &schema.EntryTypeSpec{Action: schema.EntryTypeAction_RAW_VALUE},
+		},
+	})
+	require.Error(t, err)
+
+	rows, err := db.SQLQueryAll(ctx, nil, &schema.SQLQueryRequest{Sql: "SELECT id, payload FROM events"})
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	for _, row := range rows {
+		require.Equal(t, "new", row.ValuesByPosition[1].RawValue())
+	}
+
+	rows, err = db.SQLQueryAll(ctx, nil, &schema.SQLQueryRequest{Sql: "SELECT id FROM events_new"})
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	exec("CREATE TABLE events_next PARTITION OF events FOR VALUES FROM (200) TO (300)")
+	exec("INSERT INTO events (id, day, payload) VALUES (1, 250, 'next')")
+
+	rows, err = db.SQLQueryAll(ctx, nil, &schema.SQLQueryRequest{Sql: "SELECT id FROM events WHERE day >= 200"})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+}